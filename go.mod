@@ -11,6 +11,7 @@ require (
 	github.com/gateio/gateapi-go/v6 v6.91.0
 	github.com/gateio/gatews/go v0.0.0-20240814073539-a32621851e21
 	github.com/google/uuid v1.6.0
+	github.com/gorilla/websocket v1.5.3
 	github.com/hirokisan/bybit/v2 v2.37.0
 	github.com/matoous/go-nanoid v1.5.1
 	github.com/matrixbotio/go-bingx v1.21.1
@@ -26,7 +27,6 @@ require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/deckarep/golang-set v1.7.1 // indirect
 	github.com/google/go-querystring v1.1.0 // indirect
-	github.com/gorilla/websocket v1.5.3 // indirect
 	github.com/jpillora/backoff v1.0.0 // indirect
 	github.com/json-iterator/go v1.1.12 // indirect
 	github.com/kr/pretty v0.3.1 // indirect
@@ -0,0 +1,155 @@
+package accounts
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+var (
+	ErrSessionExists   = errors.New("account session already opened")
+	ErrSessionNotFound = errors.New("account session not found")
+)
+
+// Manager - account sessions of one exchange. Every session has its own
+// adapter for the private requests, the market data streams are shared
+// by all the sessions: one exchange subscription per pair & interval
+type Manager struct {
+	newAdapter func() adapters.Adapter
+	streams    *publicStreams
+
+	mu       sync.RWMutex
+	sessions map[string]*Session // API key ID -> session
+	opening  map[string]struct{} // API key IDs of the sessions connecting
+}
+
+// Stats - manager sessions & shared streams count
+type Stats struct {
+	Sessions           int
+	CandleStreams      int
+	PublicTradeStreams int
+}
+
+// New - the adapter constructor is called for the shared market data adapter
+// & for every account session. The market data adapter is connected without keys
+func New(newAdapter func() adapters.Adapter) (*Manager, error) {
+	publicAdapter := newAdapter()
+	if err := publicAdapter.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+	}); err != nil {
+		return nil, fmt.Errorf("connect public adapter: %w", err)
+	}
+
+	return &Manager{
+		newAdapter: newAdapter,
+		streams:    newPublicStreams(publicAdapter),
+		sessions:   map[string]*Session{},
+		opening:    map[string]struct{}{},
+	}, nil
+}
+
+// Open - create the account session & connect it with the credentials.
+// The API key ID is reserved while connecting, the lock isn't held for it
+func (m *Manager) Open(
+	apiKeyID string,
+	credentials pkgStructs.APICredentials,
+) (*Session, error) {
+	m.mu.Lock()
+	_, isExists := m.sessions[apiKeyID]
+	_, isOpening := m.opening[apiKeyID]
+	if isExists || isOpening {
+		m.mu.Unlock()
+		return nil, fmt.Errorf("open %q: %w", apiKeyID, ErrSessionExists)
+	}
+	m.opening[apiKeyID] = struct{}{}
+	m.mu.Unlock()
+
+	adapter := m.newAdapter()
+	err := adapter.Connect(credentials)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.opening, apiKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("connect %q: %w", apiKeyID, err)
+	}
+
+	session := newSession(apiKeyID, adapter, m.streams)
+	m.sessions[apiKeyID] = session
+	return session, nil
+}
+
+func (m *Manager) GetSession(apiKeyID string) (*Session, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	session, isExists := m.sessions[apiKeyID]
+	return session, isExists
+}
+
+// Close - stop the session streams. The shared streams
+// are closed when no other session is subscribed to them
+func (m *Manager) Close(apiKeyID string) {
+	m.mu.Lock()
+	session, isExists := m.sessions[apiKeyID]
+	delete(m.sessions, apiKeyID)
+	m.mu.Unlock()
+
+	if isExists {
+		session.close()
+	}
+}
+
+// CloseAll - close all the account sessions
+func (m *Manager) CloseAll() {
+	m.mu.Lock()
+	sessions := m.sessions
+	m.sessions = map[string]*Session{}
+	m.mu.Unlock()
+
+	for _, session := range sessions {
+		session.close()
+	}
+}
+
+// SubscribeOrderEvents - route the account trades of the API key session
+// to the callback as order events
+func (m *Manager) SubscribeOrderEvents(
+	apiKeyID string,
+	eventCallback func(event workers.OrderEvent),
+	errorHandler func(err error),
+) error {
+	session, isExists := m.GetSession(apiKeyID)
+	if !isExists {
+		return fmt.Errorf("subscribe %q: %w", apiKeyID, ErrSessionNotFound)
+	}
+
+	if err := session.SubscribeOrderEvents(eventCallback, errorHandler); err != nil {
+		return fmt.Errorf("subscribe %q: %w", apiKeyID, err)
+	}
+	return nil
+}
+
+func (m *Manager) UnsubscribeOrderEvents(apiKeyID string) {
+	if session, isExists := m.GetSession(apiKeyID); isExists {
+		session.UnsubscribeAccountTrades()
+	}
+}
+
+func (m *Manager) GetStats() Stats {
+	m.mu.RLock()
+	sessionsCount := len(m.sessions)
+	m.mu.RUnlock()
+
+	candles, publicTrades := m.streams.getStats()
+	return Stats{
+		Sessions:           sessionsCount,
+		CandleStreams:      candles,
+		PublicTradeStreams: publicTrades,
+	}
+}
@@ -0,0 +1,476 @@
+package accounts
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	testPairSymbol = "LTCUSDT"
+	testInterval   = consts.Interval1min
+)
+
+// newTestManager - the first adapter is the public one, the rest are the sessions
+func newTestManager(t *testing.T, adps ...*adapters.MockAdapter) *Manager {
+	adps[0].EXPECT().Connect(gomock.Any()).Return(nil)
+
+	var created int
+	m, err := New(func() adapters.Adapter {
+		a := adps[created]
+		created++
+		return a
+	})
+	require.NoError(t, err)
+	return m
+}
+
+func getTestCredentials(keyPublic string) pkgStructs.APICredentials {
+	return pkgStructs.APICredentials{
+		Type:    pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{Public: keyPublic, Secret: "secret"},
+	}
+}
+
+func TestManagerSharedCandles(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	public := adapters.NewMockAdapter(ctrl)
+	first := adapters.NewMockAdapter(ctrl)
+	second := adapters.NewMockAdapter(ctrl)
+	m := newTestManager(t, public, first, second)
+
+	first.EXPECT().Connect(getTestCredentials("first")).Return(nil)
+	second.EXPECT().Connect(getTestCredentials("second")).Return(nil)
+
+	var streamCallback func(event workers.CandleEvent)
+	public.EXPECT().SubscribeCandle(testPairSymbol, testInterval, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ string,
+			_ consts.Interval,
+			eventCallback func(event workers.CandleEvent),
+			_ func(err error),
+		) error {
+			streamCallback = eventCallback
+			return nil
+		}).Times(1)
+
+	firstSession, err := m.Open("1", getTestCredentials("first"))
+	require.NoError(t, err)
+	secondSession, err := m.Open("2", getTestCredentials("second"))
+	require.NoError(t, err)
+
+	var firstEvents, secondEvents int
+
+	// when
+	require.NoError(t, firstSession.SubscribeCandle(
+		testPairSymbol, testInterval,
+		func(workers.CandleEvent) { firstEvents++ }, nil,
+	))
+	require.NoError(t, secondSession.SubscribeCandle(
+		testPairSymbol, testInterval,
+		func(workers.CandleEvent) { secondEvents++ }, nil,
+	))
+	streamCallback(workers.CandleEvent{Symbol: testPairSymbol})
+
+	// then
+	assert.Equal(t, 1, firstEvents)
+	assert.Equal(t, 1, secondEvents)
+	assert.Equal(t, Stats{Sessions: 2, CandleStreams: 1}, m.GetStats())
+}
+
+func TestManagerUnsubscribeLastCandleSubscriber(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	public := adapters.NewMockAdapter(ctrl)
+	first := adapters.NewMockAdapter(ctrl)
+	second := adapters.NewMockAdapter(ctrl)
+	m := newTestManager(t, public, first, second)
+
+	first.EXPECT().Connect(gomock.Any()).Return(nil)
+	second.EXPECT().Connect(gomock.Any()).Return(nil)
+	public.EXPECT().SubscribeCandle(testPairSymbol, testInterval, gomock.Any(), gomock.Any()).
+		Return(nil).Times(1)
+
+	firstSession, err := m.Open("1", getTestCredentials("first"))
+	require.NoError(t, err)
+	secondSession, err := m.Open("2", getTestCredentials("second"))
+	require.NoError(t, err)
+
+	noop := func(workers.CandleEvent) {}
+	require.NoError(t, firstSession.SubscribeCandle(testPairSymbol, testInterval, noop, nil))
+	require.NoError(t, secondSession.SubscribeCandle(testPairSymbol, testInterval, noop, nil))
+
+	// when
+	firstSession.UnsubscribeCandle(testPairSymbol, testInterval)
+
+	// then
+	assert.Equal(t, 1, m.GetStats().CandleStreams)
+
+	// when
+	public.EXPECT().UnsubscribeCandle(testPairSymbol, testInterval).Times(1)
+	secondSession.UnsubscribeCandle(testPairSymbol, testInterval)
+
+	// then
+	assert.Equal(t, 0, m.GetStats().CandleStreams)
+}
+
+func TestManagerSubscribeCandleError(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	public := adapters.NewMockAdapter(ctrl)
+	account := adapters.NewMockAdapter(ctrl)
+	m := newTestManager(t, public, account)
+
+	account.EXPECT().Connect(gomock.Any()).Return(nil)
+	public.EXPECT().SubscribeCandle(testPairSymbol, testInterval, gomock.Any(), gomock.Any()).
+		Return(errors.New("dial error"))
+
+	session, err := m.Open("1", getTestCredentials("first"))
+	require.NoError(t, err)
+
+	// when
+	err = session.SubscribeCandle(testPairSymbol, testInterval, func(workers.CandleEvent) {}, nil)
+
+	// then
+	require.Error(t, err)
+	assert.Equal(t, 0, m.GetStats().CandleStreams)
+}
+
+func TestManagerSharedPublicTradesErrors(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	public := adapters.NewMockAdapter(ctrl)
+	first := adapters.NewMockAdapter(ctrl)
+	second := adapters.NewMockAdapter(ctrl)
+	m := newTestManager(t, public, first, second)
+
+	first.EXPECT().Connect(gomock.Any()).Return(nil)
+	second.EXPECT().Connect(gomock.Any()).Return(nil)
+
+	var streamErrorHandler func(err error)
+	public.EXPECT().SubscribePublicTrades(testPairSymbol, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ string,
+			_ workers.PublicTradeEventCallback,
+			errorHandler func(err error),
+		) error {
+			streamErrorHandler = errorHandler
+			return nil
+		}).Times(1)
+
+	firstSession, err := m.Open("1", getTestCredentials("first"))
+	require.NoError(t, err)
+	secondSession, err := m.Open("2", getTestCredentials("second"))
+	require.NoError(t, err)
+
+	var errorsCount int
+	noop := func(workers.PublicTradeEvent) {}
+	onError := func(error) { errorsCount++ }
+	require.NoError(t, firstSession.SubscribePublicTrades(testPairSymbol, noop, onError))
+	require.NoError(t, secondSession.SubscribePublicTrades(testPairSymbol, noop, onError))
+
+	// when
+	streamErrorHandler(errors.New("connection lost"))
+
+	// then
+	assert.Equal(t, 2, errorsCount)
+	assert.Equal(t, 1, m.GetStats().PublicTradeStreams)
+}
+
+func TestManagerOpenDuplicate(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	public := adapters.NewMockAdapter(ctrl)
+	account := adapters.NewMockAdapter(ctrl)
+	m := newTestManager(t, public, account)
+
+	account.EXPECT().Connect(gomock.Any()).Return(nil)
+	_, err := m.Open("1", getTestCredentials("first"))
+	require.NoError(t, err)
+
+	// when
+	_, err = m.Open("1", getTestCredentials("first"))
+
+	// then
+	require.ErrorIs(t, err, ErrSessionExists)
+}
+
+func TestManagerOpenWhileConnecting(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	public := adapters.NewMockAdapter(ctrl)
+	account := adapters.NewMockAdapter(ctrl)
+	m := newTestManager(t, public, account)
+
+	connecting := make(chan struct{})
+	release := make(chan struct{})
+	account.EXPECT().Connect(gomock.Any()).DoAndReturn(
+		func(pkgStructs.APICredentials) error {
+			close(connecting)
+			<-release
+			return nil
+		},
+	)
+
+	opened := make(chan error)
+	go func() {
+		_, err := m.Open("1", getTestCredentials("first"))
+		opened <- err
+	}()
+	<-connecting
+
+	// when
+	_, err := m.Open("1", getTestCredentials("first"))
+
+	// then
+	require.ErrorIs(t, err, ErrSessionExists)
+	// the lock is not held while connecting
+	assert.Equal(t, Stats{}, m.GetStats())
+
+	close(release)
+	require.NoError(t, <-opened)
+	assert.Equal(t, 1, m.GetStats().Sessions)
+}
+
+func TestManagerOpenConnectError(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	public := adapters.NewMockAdapter(ctrl)
+	first := adapters.NewMockAdapter(ctrl)
+	second := adapters.NewMockAdapter(ctrl)
+	m := newTestManager(t, public, first, second)
+
+	first.EXPECT().Connect(gomock.Any()).Return(errors.New("invalid key"))
+	second.EXPECT().Connect(gomock.Any()).Return(nil)
+
+	// when
+	_, err := m.Open("1", getTestCredentials("first"))
+
+	// then
+	require.Error(t, err)
+	assert.Equal(t, 0, m.GetStats().Sessions)
+
+	// the API key ID reservation is released
+	_, err = m.Open("1", getTestCredentials("first"))
+	require.NoError(t, err)
+}
+
+func TestManagerSubscribeCandleWhileSubscribing(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	public := adapters.NewMockAdapter(ctrl)
+	first := adapters.NewMockAdapter(ctrl)
+	second := adapters.NewMockAdapter(ctrl)
+	m := newTestManager(t, public, first, second)
+
+	first.EXPECT().Connect(gomock.Any()).Return(nil)
+	second.EXPECT().Connect(gomock.Any()).Return(nil)
+
+	subscribing := make(chan struct{})
+	release := make(chan struct{})
+	public.EXPECT().SubscribeCandle(testPairSymbol, testInterval, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ string,
+			_ consts.Interval,
+			_ func(event workers.CandleEvent),
+			_ func(err error),
+		) error {
+			close(subscribing)
+			<-release
+			return nil
+		}).Times(1)
+
+	firstSession, err := m.Open("1", getTestCredentials("first"))
+	require.NoError(t, err)
+	secondSession, err := m.Open("2", getTestCredentials("second"))
+	require.NoError(t, err)
+
+	noop := func(workers.CandleEvent) {}
+	subscribed := make(chan error, 2)
+	go func() {
+		subscribed <- firstSession.SubscribeCandle(testPairSymbol, testInterval, noop, nil)
+	}()
+	<-subscribing
+
+	// when
+	go func() {
+		subscribed <- secondSession.SubscribeCandle(testPairSymbol, testInterval, noop, nil)
+	}()
+
+	// then
+	// the lock is not held while subscribing
+	assert.Equal(t, 1, m.GetStats().CandleStreams)
+
+	close(release)
+	require.NoError(t, <-subscribed)
+	require.NoError(t, <-subscribed)
+	assert.Equal(t, 1, m.GetStats().CandleStreams)
+}
+
+func TestManagerSubscribeCandleWhileUnsubscribing(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	public := adapters.NewMockAdapter(ctrl)
+	first := adapters.NewMockAdapter(ctrl)
+	second := adapters.NewMockAdapter(ctrl)
+	m := newTestManager(t, public, first, second)
+
+	first.EXPECT().Connect(gomock.Any()).Return(nil)
+	second.EXPECT().Connect(gomock.Any()).Return(nil)
+
+	unsubscribing := make(chan struct{})
+	release := make(chan struct{})
+	gomock.InOrder(
+		public.EXPECT().SubscribeCandle(testPairSymbol, testInterval, gomock.Any(), gomock.Any()).
+			Return(nil),
+		public.EXPECT().UnsubscribeCandle(testPairSymbol, testInterval).
+			Do(func(_ string, _ consts.Interval) {
+				close(unsubscribing)
+				<-release
+			}),
+		// the next exchange subscription is made after the previous one is closed
+		public.EXPECT().SubscribeCandle(testPairSymbol, testInterval, gomock.Any(), gomock.Any()).
+			Return(nil),
+	)
+
+	firstSession, err := m.Open("1", getTestCredentials("first"))
+	require.NoError(t, err)
+	secondSession, err := m.Open("2", getTestCredentials("second"))
+	require.NoError(t, err)
+
+	noop := func(workers.CandleEvent) {}
+	require.NoError(t, firstSession.SubscribeCandle(testPairSymbol, testInterval, noop, nil))
+
+	unsubscribed := make(chan struct{})
+	go func() {
+		firstSession.UnsubscribeCandle(testPairSymbol, testInterval)
+		close(unsubscribed)
+	}()
+	<-unsubscribing
+
+	// the lock is not held while unsubscribing
+	assert.Equal(t, 0, m.GetStats().CandleStreams)
+
+	// when
+	subscribed := make(chan error, 1)
+	go func() {
+		subscribed <- secondSession.SubscribeCandle(testPairSymbol, testInterval, noop, nil)
+	}()
+
+	// then
+	select {
+	case <-subscribed:
+		t.Fatal("subscribed while the previous stream is closing")
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	close(release)
+	<-unsubscribed
+	require.NoError(t, <-subscribed)
+	assert.Equal(t, 1, m.GetStats().CandleStreams)
+}
+
+func TestManagerRouteOrderEvents(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	public := adapters.NewMockAdapter(ctrl)
+	first := adapters.NewMockAdapter(ctrl)
+	second := adapters.NewMockAdapter(ctrl)
+	m := newTestManager(t, public, first, second)
+
+	first.EXPECT().Connect(gomock.Any()).Return(nil)
+	second.EXPECT().Connect(gomock.Any()).Return(nil)
+
+	callbacks := map[string]workers.TradeEventPrivateCallback{}
+	captureCallback := func(apiKeyID string) func(
+		workers.TradeEventPrivateCallback,
+		func(err error),
+	) error {
+		return func(eventCallback workers.TradeEventPrivateCallback, _ func(err error)) error {
+			callbacks[apiKeyID] = eventCallback
+			return nil
+		}
+	}
+	first.EXPECT().SubscribeAccountTrades(gomock.Any(), gomock.Any()).
+		DoAndReturn(captureCallback("1"))
+	second.EXPECT().SubscribeAccountTrades(gomock.Any(), gomock.Any()).
+		DoAndReturn(captureCallback("2"))
+
+	_, err := m.Open("1", getTestCredentials("first"))
+	require.NoError(t, err)
+	_, err = m.Open("2", getTestCredentials("second"))
+	require.NoError(t, err)
+
+	var received []workers.OrderEvent
+	onEvent := func(event workers.OrderEvent) { received = append(received, event) }
+	require.NoError(t, m.SubscribeOrderEvents("1", onEvent, nil))
+	require.NoError(t, m.SubscribeOrderEvents("2", onEvent, nil))
+
+	// when
+	callbacks["2"](workers.TradeEventPrivate{OrderID: "200"})
+	callbacks["1"](workers.TradeEventPrivate{OrderID: "100"})
+
+	// then
+	require.Len(t, received, 2)
+	assert.Equal(t, "2", received[0].APIKeyID)
+	assert.Equal(t, "200", received[0].Data.OrderID)
+	assert.Equal(t, "1", received[1].APIKeyID)
+	assert.Equal(t, "100", received[1].Data.OrderID)
+}
+
+func TestManagerSubscribeOrderEventsNoSession(t *testing.T) {
+	// given
+	public := adapters.NewMockAdapter(gomock.NewController(t))
+	m := newTestManager(t, public)
+
+	// when
+	err := m.SubscribeOrderEvents("1", func(workers.OrderEvent) {}, nil)
+
+	// then
+	require.ErrorIs(t, err, ErrSessionNotFound)
+}
+
+func TestManagerCloseSession(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	public := adapters.NewMockAdapter(ctrl)
+	account := adapters.NewMockAdapter(ctrl)
+	m := newTestManager(t, public, account)
+
+	account.EXPECT().Connect(gomock.Any()).Return(nil)
+	public.EXPECT().SubscribeCandle(testPairSymbol, testInterval, gomock.Any(), gomock.Any()).
+		Return(nil)
+	public.EXPECT().SubscribePublicTrades(testPairSymbol, gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	session, err := m.Open("1", getTestCredentials("first"))
+	require.NoError(t, err)
+	require.NoError(t, session.SubscribeCandle(
+		testPairSymbol, testInterval, func(workers.CandleEvent) {}, nil,
+	))
+	require.NoError(t, session.SubscribePublicTrades(
+		testPairSymbol, func(workers.PublicTradeEvent) {}, nil,
+	))
+
+	public.EXPECT().UnsubscribeCandle(testPairSymbol, testInterval).Times(1)
+	public.EXPECT().UnsubscribePublicTrades(testPairSymbol).Times(1)
+	account.EXPECT().UnsubscribeAccountTrades().Times(1)
+
+	// when
+	m.Close("1")
+
+	// then
+	_, isExists := m.GetSession("1")
+	assert.False(t, isExists)
+	assert.Equal(t, Stats{}, m.GetStats())
+}
@@ -0,0 +1,146 @@
+package accounts
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+// Session - account adapter connected with the account credentials.
+// Orders, balances & account trades are requested by the account adapter,
+// candles & public trades are received from the manager shared streams
+type Session struct {
+	adapters.Adapter
+
+	apiKeyID string
+	streams  *publicStreams
+
+	mu           sync.Mutex
+	candles      map[candleKey]uint64 // -> subscriber ID
+	publicTrades map[string]uint64    // symbol -> subscriber ID
+}
+
+func newSession(apiKeyID string, adapter adapters.Adapter, streams *publicStreams) *Session {
+	return &Session{
+		Adapter:      adapter,
+		apiKeyID:     apiKeyID,
+		streams:      streams,
+		candles:      map[candleKey]uint64{},
+		publicTrades: map[string]uint64{},
+	}
+}
+
+func (s *Session) GetAPIKeyID() string {
+	return s.apiKeyID
+}
+
+// SubscribeCandle - the repeated subscription replaces the session callbacks
+func (s *Session) SubscribeCandle(
+	pairSymbol string,
+	interval consts.Interval,
+	eventCallback func(event workers.CandleEvent),
+	errorHandler func(err error),
+) error {
+	key := candleKey{pairSymbol: pairSymbol, interval: interval}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// the new subscriber is added first to keep the shared stream open
+	id, err := s.streams.subscribeCandle(key, eventCallback, errorHandler)
+	if err != nil {
+		return fmt.Errorf("candles: %w", err)
+	}
+
+	if prevID, isExists := s.candles[key]; isExists {
+		s.streams.unsubscribeCandle(key, prevID)
+	}
+	s.candles[key] = id
+	return nil
+}
+
+func (s *Session) UnsubscribeCandle(pairSymbol string, interval consts.Interval) {
+	key := candleKey{pairSymbol: pairSymbol, interval: interval}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, isExists := s.candles[key]
+	if !isExists {
+		return
+	}
+
+	s.streams.unsubscribeCandle(key, id)
+	delete(s.candles, key)
+}
+
+// SubscribePublicTrades - the repeated subscription replaces the session callbacks
+func (s *Session) SubscribePublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, err := s.streams.subscribePublicTrades(pairSymbol, eventCallback, errorHandler)
+	if err != nil {
+		return fmt.Errorf("public trades: %w", err)
+	}
+
+	if prevID, isExists := s.publicTrades[pairSymbol]; isExists {
+		s.streams.unsubscribePublicTrades(pairSymbol, prevID)
+	}
+	s.publicTrades[pairSymbol] = id
+	return nil
+}
+
+func (s *Session) UnsubscribePublicTrades(pairSymbol string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, isExists := s.publicTrades[pairSymbol]
+	if !isExists {
+		return
+	}
+
+	s.streams.unsubscribePublicTrades(pairSymbol, id)
+	delete(s.publicTrades, pairSymbol)
+}
+
+// SubscribeOrderEvents - subscribe to the account trades
+// tagged with the session API key ID
+func (s *Session) SubscribeOrderEvents(
+	eventCallback func(event workers.OrderEvent),
+	errorHandler func(err error),
+) error {
+	return s.Adapter.SubscribeAccountTrades(
+		func(event workers.TradeEventPrivate) {
+			eventCallback(workers.OrderEvent{
+				APIKeyID: s.apiKeyID,
+				Data:     event,
+			})
+		},
+		errorHandler,
+	)
+}
+
+// close - release the shared streams & stop the account trades stream
+func (s *Session) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, id := range s.candles {
+		s.streams.unsubscribeCandle(key, id)
+	}
+	for pairSymbol, id := range s.publicTrades {
+		s.streams.unsubscribePublicTrades(pairSymbol, id)
+	}
+	s.candles = map[candleKey]uint64{}
+	s.publicTrades = map[string]uint64{}
+
+	s.Adapter.UnsubscribeAccountTrades()
+}
@@ -0,0 +1,258 @@
+package accounts
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+type candleKey struct {
+	pairSymbol string
+	interval   consts.Interval
+}
+
+// stream - exchange subscription shared by the subscribers.
+// Events & errors are fanned out to every subscriber
+type stream[T any] struct {
+	mu          sync.RWMutex
+	subscribers map[uint64]subscriber[T]
+
+	// ready - closed when the exchange subscription is made or failed,
+	// err is set before
+	ready chan struct{}
+	err   error
+}
+
+type subscriber[T any] struct {
+	eventCallback func(event T)
+	errorHandler  func(err error)
+}
+
+func newStream[T any]() *stream[T] {
+	return &stream[T]{
+		subscribers: map[uint64]subscriber[T]{},
+		ready:       make(chan struct{}),
+	}
+}
+
+func (s *stream[T]) add(id uint64, sub subscriber[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscribers[id] = sub
+}
+
+// remove - returns the number of subscribers left
+func (s *stream[T]) remove(id uint64) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.subscribers, id)
+	return len(s.subscribers)
+}
+
+func (s *stream[T]) getSubscribers() []subscriber[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]subscriber[T], 0, len(s.subscribers))
+	for _, sub := range s.subscribers {
+		result = append(result, sub)
+	}
+	return result
+}
+
+func (s *stream[T]) onEvent(event T) {
+	for _, sub := range s.getSubscribers() {
+		sub.eventCallback(event)
+	}
+}
+
+func (s *stream[T]) onError(err error) {
+	for _, sub := range s.getSubscribers() {
+		if sub.errorHandler != nil {
+			sub.errorHandler(err)
+		}
+	}
+}
+
+// streamSet - the streams by key. The closed stream is removed at once,
+// the exchange unsubscribe in progress is awaited by the next subscribe
+type streamSet[K comparable, T any] struct {
+	active  map[K]*stream[T]
+	closing map[K]chan struct{}
+}
+
+func newStreamSet[K comparable, T any]() *streamSet[K, T] {
+	return &streamSet[K, T]{
+		active:  map[K]*stream[T]{},
+		closing: map[K]chan struct{}{},
+	}
+}
+
+// publicStreams - market data streams of one exchange, reference counted:
+// the exchange subscription is made on the first subscriber
+// & closed when the last one is gone
+type publicStreams struct {
+	adapter adapters.Adapter
+
+	mu           sync.Mutex
+	lastID       uint64
+	candles      *streamSet[candleKey, workers.CandleEvent]
+	publicTrades *streamSet[string, workers.PublicTradeEvent] // symbol -> stream
+}
+
+func newPublicStreams(adapter adapters.Adapter) *publicStreams {
+	return &publicStreams{
+		adapter:      adapter,
+		candles:      newStreamSet[candleKey, workers.CandleEvent](),
+		publicTrades: newStreamSet[string, workers.PublicTradeEvent](),
+	}
+}
+
+// subscribeCandle - returns the subscriber ID to unsubscribe with
+func (p *publicStreams) subscribeCandle(
+	key candleKey,
+	eventCallback func(event workers.CandleEvent),
+	errorHandler func(err error),
+) (uint64, error) {
+	return subscribeStream(p, p.candles, key,
+		subscriber[workers.CandleEvent]{
+			eventCallback: eventCallback,
+			errorHandler:  errorHandler,
+		},
+		func(s *stream[workers.CandleEvent]) error {
+			return p.adapter.SubscribeCandle(
+				key.pairSymbol, key.interval, s.onEvent, s.onError,
+			)
+		},
+	)
+}
+
+func (p *publicStreams) unsubscribeCandle(key candleKey, id uint64) {
+	unsubscribeStream(p, p.candles, key, id, func() {
+		p.adapter.UnsubscribeCandle(key.pairSymbol, key.interval)
+	})
+}
+
+// subscribePublicTrades - returns the subscriber ID to unsubscribe with
+func (p *publicStreams) subscribePublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) (uint64, error) {
+	return subscribeStream(p, p.publicTrades, pairSymbol,
+		subscriber[workers.PublicTradeEvent]{
+			eventCallback: eventCallback,
+			errorHandler:  errorHandler,
+		},
+		func(s *stream[workers.PublicTradeEvent]) error {
+			return p.adapter.SubscribePublicTrades(pairSymbol, s.onEvent, s.onError)
+		},
+	)
+}
+
+func (p *publicStreams) unsubscribePublicTrades(pairSymbol string, id uint64) {
+	unsubscribeStream(p, p.publicTrades, pairSymbol, id, func() {
+		p.adapter.UnsubscribePublicTrades(pairSymbol)
+	})
+}
+
+// subscribeStream - the stream is reserved under the lock, the exchange
+// subscription is made without it. The subscribers of the stream in progress
+// await its result, the failed stream is dropped
+func subscribeStream[K comparable, T any](
+	p *publicStreams,
+	streams *streamSet[K, T],
+	key K,
+	sub subscriber[T],
+	subscribe func(s *stream[T]) error,
+) (uint64, error) {
+	p.mu.Lock()
+	p.lastID++
+	id := p.lastID
+
+	s, isExists := streams.active[key]
+	if !isExists {
+		s = newStream[T]()
+		streams.active[key] = s
+	}
+	s.add(id, sub)
+	closing := streams.closing[key]
+	p.mu.Unlock()
+
+	if isExists {
+		<-s.ready
+		if s.err != nil {
+			return 0, fmt.Errorf("subscribe: %w", s.err)
+		}
+		return id, nil
+	}
+
+	// the previous exchange subscription of the key could be still closing
+	if closing != nil {
+		<-closing
+	}
+
+	err := subscribe(s)
+	if err != nil {
+		p.mu.Lock()
+		delete(streams.active, key)
+		p.mu.Unlock()
+	}
+	s.err = err
+	close(s.ready)
+
+	if err != nil {
+		return 0, fmt.Errorf("subscribe: %w", err)
+	}
+	return id, nil
+}
+
+// unsubscribeStream - the exchange subscription in progress is awaited,
+// the last subscriber removes the stream under the lock & closes
+// the exchange subscription without it
+func unsubscribeStream[K comparable, T any](
+	p *publicStreams,
+	streams *streamSet[K, T],
+	key K,
+	id uint64,
+	unsubscribe func(),
+) {
+	p.mu.Lock()
+	s, isExists := streams.active[key]
+	p.mu.Unlock()
+	if !isExists {
+		return
+	}
+	<-s.ready
+
+	p.mu.Lock()
+	// the stream could be closed or failed while awaited
+	if streams.active[key] != s || s.remove(id) > 0 {
+		p.mu.Unlock()
+		return
+	}
+	delete(streams.active, key)
+	closing := make(chan struct{})
+	streams.closing[key] = closing
+	p.mu.Unlock()
+
+	unsubscribe()
+
+	p.mu.Lock()
+	delete(streams.closing, key)
+	p.mu.Unlock()
+	close(closing)
+}
+
+// getStats - number of the exchange subscriptions opened
+func (p *publicStreams) getStats() (candles, publicTrades int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.candles.active), len(p.publicTrades.active)
+}
@@ -3,6 +3,9 @@ package adapters
 
 import (
 	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
@@ -24,11 +27,33 @@ type Adapter interface {
 	Connect(credentials pkgStructs.APICredentials) error
 	// CanTrade - check the permission of the API key for trading
 	CanTrade() (bool, error)
+	// GetAPIKeyInfo - get the API key permissions, IP whitelist & expiry date
+	GetAPIKeyInfo() (pkgStructs.APIKeyInfo, error)
 	// VerifyAPIKeys - Check if the API key has expired
 	VerifyAPIKeys(keyPublic, keySecret string) error
 	// GetAccountBalance - get account balances for individual tickers
 	GetAccountBalance() ([]structs.Balance, error)
+	// GetAccountBalances - get balances of the account by type,
+	// e.g. spot or funding
+	GetAccountBalances(accountType consts.AccountType) ([]structs.Balance, error)
+	// Transfer - move the asset between the account types
+	Transfer(
+		asset string,
+		amount decimal.Decimal,
+		fromAccount consts.AccountType,
+		toAccount consts.AccountType,
+	) (structs.TransferResult, error)
 	GetLimits() pkgStructs.ExchangeLimits
+	// Capabilities - features supported by the adapter: order types,
+	// intervals, streams, etc. Anything outside returns errs.ErrNotSupported
+	Capabilities() pkgStructs.AdapterCapabilities
+	// GetServerTime - get the exchange server time
+	GetServerTime() (time.Time, error)
+	// SetServerTimeOffset - set server time minus local time,
+	// it's applied to the signed requests timestamps.
+	// NOTE: Bybit client can't take the offset, it's applied to the requests
+	// sent by the adapter & the client re-syncs the server time with a request
+	SetServerTimeOffset(offset time.Duration) error
 
 	// ORDER
 	// GetOrderData - get order data
@@ -47,6 +72,8 @@ type Adapter interface {
 		orderSide consts.OrderSide,
 		orderID int64,
 	) (structs.OrderFees, error)
+	// GetTradeFees - get account maker & taker fee rates for the pair
+	GetTradeFees(pairSymbol string) (structs.TradeFees, error)
 	GenClientOrderID() string
 
 	/*
@@ -60,11 +87,14 @@ type Adapter interface {
 		orderID int64,
 	) (structs.OrderHistory, error)
 
+	// GetAccountTrades - get all account trades (fills) of the pair in the task time range
+	GetAccountTrades(task structs.GetOrdersHistoryTask) ([]structs.AccountTrade, error)
+
 	// PAIR
 	// GetPairData - get pair data & limits
 	GetPairData(pairSymbol string) (structs.ExchangePairData, error)
 	// GetPairLastPrice - get pair last price ^ↀᴥↀ^
-	GetPairLastPrice(pairSymbol string) (float64, error)
+	GetPairLastPrice(pairSymbol string) (decimal.Decimal, error)
 	// CancelPairOrder - cancel one exchange pair order by ID
 	CancelPairOrder(pairSymbol string, orderID int64, ctx context.Context) error
 	// CancelPairOrder - cancel one exchange pair order by client order ID
@@ -98,6 +128,112 @@ type Adapter interface {
 
 	UnsubscribeAccountTrades()
 
+	// SubscribePublicTrades - subscribe to the pair market trades
+	SubscribePublicTrades(
+		pairSymbol string,
+		eventCallback workers.PublicTradeEventCallback,
+		errorHandler func(err error),
+	) error
+
+	UnsubscribePublicTrades(pairSymbol string)
+
 	// CANDLE
 	GetCandles(limit int, symbol string, interval consts.Interval) ([]workers.CandleData, error)
+	// GetSupportedIntervals - get candle intervals available on the exchange
+	GetSupportedIntervals() []consts.Interval
+}
+
+// AccountTypeSelector - adapter for exchanges with several trading account types.
+// The account type is detected on Connect unless it's set before,
+// the default one is used when the API key can't read the account info
+type AccountTypeSelector interface {
+	// SetAccountType - set trading account type
+	SetAccountType(accountType consts.AccountType) error
+	// DetectAccountType - detect & set trading account type,
+	// unlike Connect it fails when the account info is not available
+	DetectAccountType() (consts.AccountType, error)
+	// GetAccountType - get trading account type
+	GetAccountType() consts.AccountType
+}
+
+// ExchangeOrderIDAdapter - adapter for exchanges with non-numeric order IDs,
+// e.g. KuCoin. The int64 order ID is an alias resolved by the adapter order ID store,
+// the methods take ExchangeOrderID of the order data & don't depend on the store
+type ExchangeOrderIDAdapter interface {
+	GetOrderDataByExchangeOrderID(
+		pairSymbol string,
+		exchangeOrderID string,
+	) (structs.OrderData, error)
+	GetHistoryOrderByExchangeOrderID(
+		pairSymbol string,
+		exchangeOrderID string,
+	) (structs.OrderHistory, error)
+	GetOrderExecFeeByExchangeOrderID(
+		baseAssetTicker string,
+		quoteAssetTicker string,
+		exchangeOrderID string,
+	) (structs.OrderFees, error)
+	CancelPairOrderByExchangeOrderID(
+		pairSymbol string,
+		exchangeOrderID string,
+		ctx context.Context,
+	) error
+}
+
+// MarginAdapter - adapter for exchanges with margin trading support
+type MarginAdapter interface {
+	// GetMarginAccount - get margin balances, margin level & liquidation risk.
+	// Pair symbol is required for isolated margin only
+	GetMarginAccount(
+		mode consts.MarginMode,
+		pairSymbol string,
+	) (structs.MarginAccount, error)
+	// MarginBorrow - borrow the asset to the margin account
+	MarginBorrow(task structs.MarginLoanTask) (structs.MarginLoanResult, error)
+	// MarginRepay - repay the margin account debt
+	MarginRepay(task structs.MarginLoanTask) (structs.MarginLoanResult, error)
+	// PlaceMarginOrder - place limit or market order on the margin account
+	PlaceMarginOrder(
+		ctx context.Context,
+		order structs.BotOrderAdjusted,
+		params structs.MarginOrderParams,
+	) (structs.CreateOrderResponse, error)
+}
+
+// AccountMarginModeSetter - adapter for exchanges with one margin mode
+// for the whole account, e.g. Bybit unified account
+type AccountMarginModeSetter interface {
+	// SetAccountMarginMode - set the account margin mode: cross or isolated.
+	// It's applied to all the pairs
+	SetAccountMarginMode(mode consts.MarginMode) error
+}
+
+// FuturesAdapter - linear perpetual futures adapter
+type FuturesAdapter interface {
+	Adapter
+
+	// GetPositions - get open positions, all pairs when the pair symbol is empty
+	GetPositions(pairSymbol string) ([]structs.Position, error)
+	// SetLeverage - set the pair leverage
+	SetLeverage(pairSymbol string, leverage int) error
+	// SetMarginMode - set the pair margin mode: cross or isolated.
+	// It's not supported when the account has one margin mode for all the pairs,
+	// see AccountMarginModeSetter
+	SetMarginMode(pairSymbol string, mode consts.MarginMode) error
+	// GetFundingInfo - get the pair mark price & funding rate
+	GetFundingInfo(pairSymbol string) (structs.FundingInfo, error)
+	// PlaceFuturesOrder - place order with futures params, e.g. reduce only
+	PlaceFuturesOrder(
+		ctx context.Context,
+		order structs.BotOrderAdjusted,
+		params structs.FuturesOrderParams,
+	) (structs.CreateOrderResponse, error)
+
+	// SubscribePositions - subscribe to the account positions updates
+	SubscribePositions(
+		eventCallback workers.PositionEventCallback,
+		errorHandler func(err error),
+	) error
+
+	UnsubscribePositions()
 }
@@ -0,0 +1,30 @@
+package baseadp
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// ServerClock - local clock adjusted by the exchange server clock offset.
+// It's used for the signed requests timestamps & safe for concurrent use
+type ServerClock struct {
+	offset atomic.Int64
+}
+
+func NewServerClock() *ServerClock {
+	return &ServerClock{}
+}
+
+// SetOffset - set server time minus local time
+func (c *ServerClock) SetOffset(offset time.Duration) {
+	c.offset.Store(int64(offset))
+}
+
+func (c *ServerClock) Offset() time.Duration {
+	return time.Duration(c.offset.Load())
+}
+
+// Now - current server time estimate
+func (c *ServerClock) Now() time.Time {
+	return time.Now().Add(c.Offset())
+}
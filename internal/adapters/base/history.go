@@ -0,0 +1,72 @@
+package baseadp
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+// TimeWindow - history request time range, unix ms
+type TimeWindow struct {
+	StartTime int64
+	EndTime   int64
+}
+
+// SplitHistoryTask splits the task time range into windows
+// no longer than the exchange history request limit.
+// The task end time is now by default
+func SplitHistoryTask(
+	task structs.GetOrdersHistoryTask,
+	maxWindow time.Duration,
+) ([]TimeWindow, error) {
+	if task.StartTime <= 0 {
+		return nil, errors.New("start time is not set")
+	}
+
+	endTime := task.EndTime
+	if endTime <= 0 {
+		endTime = time.Now().UnixMilli()
+	}
+	if endTime < task.StartTime {
+		return nil, errors.New("end time is less than start time")
+	}
+
+	var windows []TimeWindow
+	for start := task.StartTime; start <= endTime; start += maxWindow.Milliseconds() {
+		windows = append(windows, TimeWindow{
+			StartTime: start,
+			EndTime:   min(start+maxWindow.Milliseconds()-1, endTime),
+		})
+	}
+	return windows, nil
+}
+
+// GetHistoryTaskContext returns the task context or background context
+func GetHistoryTaskContext(task structs.GetOrdersHistoryTask) context.Context {
+	if task.Ctx == nil {
+		return context.Background()
+	}
+	return task.Ctx
+}
+
+// SortAccountTrades removes duplicate trades & sorts trades by time
+func SortAccountTrades(trades []structs.AccountTrade) []structs.AccountTrade {
+	result := make([]structs.AccountTrade, 0, len(trades))
+	ids := make(map[string]struct{}, len(trades))
+	for _, trade := range trades {
+		if _, isExists := ids[trade.ID]; isExists {
+			continue
+		}
+
+		ids[trade.ID] = struct{}{}
+		result = append(result, trade)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Time < result[j].Time
+	})
+	return result
+}
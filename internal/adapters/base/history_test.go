@@ -0,0 +1,66 @@
+package baseadp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+func TestSplitHistoryTask(t *testing.T) {
+	// given
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	task := structs.GetOrdersHistoryTask{
+		PairSymbol: "LTCUSDT",
+		StartTime:  start.UnixMilli(),
+		EndTime:    start.Add(time.Hour * 30).UnixMilli(),
+	}
+
+	// when
+	windows, err := SplitHistoryTask(task, time.Hour*24)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, windows, 2)
+	assert.Equal(t, start.UnixMilli(), windows[0].StartTime)
+	assert.Equal(t, start.Add(time.Hour*24).UnixMilli()-1, windows[0].EndTime)
+	assert.Equal(t, start.Add(time.Hour*24).UnixMilli(), windows[1].StartTime)
+	assert.Equal(t, task.EndTime, windows[1].EndTime)
+}
+
+func TestSplitHistoryTaskInvalidRange(t *testing.T) {
+	// given
+	task := structs.GetOrdersHistoryTask{
+		PairSymbol: "LTCUSDT",
+		StartTime:  1714521600000,
+		EndTime:    1714521500000,
+	}
+
+	// when
+	_, err := SplitHistoryTask(task, time.Hour*24)
+
+	// then
+	require.ErrorContains(t, err, "end time is less than start time")
+}
+
+func TestSortAccountTrades(t *testing.T) {
+	// given
+	trades := []structs.AccountTrade{
+		{ID: "3", Time: 300},
+		{ID: "1", Time: 100},
+		{ID: "3", Time: 300},
+		{ID: "2", Time: 200},
+	}
+
+	// when
+	result := SortAccountTrades(trades)
+
+	// then
+	require.Len(t, result, 3)
+	assert.Equal(t, "1", result[0].ID)
+	assert.Equal(t, "2", result[1].ID)
+	assert.Equal(t, "3", result[2].ID)
+}
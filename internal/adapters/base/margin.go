@@ -0,0 +1,56 @@
+package baseadp
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+// GetMarginRisk - get liquidation risk by margin level.
+// Zero margin level means there are no liabilities
+func GetMarginRisk(
+	marginLevel decimal.Decimal,
+	marginCallLevel decimal.Decimal,
+	liquidationLevel decimal.Decimal,
+) consts.MarginRisk {
+	switch {
+	case marginLevel.IsZero():
+		return consts.MarginRiskLow
+	case marginLevel.LessThanOrEqual(liquidationLevel):
+		return consts.MarginRiskLiquidation
+	case marginLevel.LessThanOrEqual(marginCallLevel):
+		return consts.MarginRiskMarginCall
+	default:
+		return consts.MarginRiskLow
+	}
+}
+
+// CheckMarginLoanTask - check borrow or repay task
+func CheckMarginLoanTask(task structs.MarginLoanTask) error {
+	if task.Asset == "" {
+		return errors.New("asset is not set")
+	}
+	if !task.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount: %s", task.Amount.String())
+	}
+	return CheckMarginMode(task.Mode, task.PairSymbol)
+}
+
+// CheckMarginMode - check margin mode & isolated margin pair symbol
+func CheckMarginMode(mode consts.MarginMode, pairSymbol string) error {
+	switch mode {
+	default:
+		return fmt.Errorf("unknown margin mode: %q", mode)
+	case consts.MarginModeCross:
+		return nil
+	case consts.MarginModeIsolated:
+		if pairSymbol == "" {
+			return errors.New("pair symbol is required for isolated margin")
+		}
+		return nil
+	}
+}
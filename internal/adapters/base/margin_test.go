@@ -0,0 +1,84 @@
+package baseadp
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+func TestGetMarginRisk(t *testing.T) {
+	// given
+	marginCallLevel := decimal.NewFromFloat(1.3)
+	liquidationLevel := decimal.NewFromFloat(1.1)
+
+	cases := map[string]consts.MarginRisk{
+		"0":    consts.MarginRiskLow,
+		"999":  consts.MarginRiskLow,
+		"1.31": consts.MarginRiskLow,
+		"1.3":  consts.MarginRiskMarginCall,
+		"1.15": consts.MarginRiskMarginCall,
+		"1.1":  consts.MarginRiskLiquidation,
+		"1.05": consts.MarginRiskLiquidation,
+	}
+
+	for level, expected := range cases {
+		// when
+		risk := GetMarginRisk(
+			decimal.RequireFromString(level), marginCallLevel, liquidationLevel,
+		)
+
+		// then
+		assert.Equal(t, expected, risk, level)
+	}
+}
+
+func TestCheckMarginLoanTask(t *testing.T) {
+	// given
+	task := structs.MarginLoanTask{
+		Mode:       consts.MarginModeIsolated,
+		PairSymbol: "LTCUSDT",
+		Asset:      "LTC",
+		Amount:     decimal.NewFromInt(1),
+	}
+
+	// when
+	err := CheckMarginLoanTask(task)
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestCheckMarginLoanTaskIsolatedPairNotSet(t *testing.T) {
+	// given
+	task := structs.MarginLoanTask{
+		Mode:   consts.MarginModeIsolated,
+		Asset:  "LTC",
+		Amount: decimal.NewFromInt(1),
+	}
+
+	// when
+	err := CheckMarginLoanTask(task)
+
+	// then
+	require.Error(t, err)
+}
+
+func TestCheckMarginLoanTaskInvalidAmount(t *testing.T) {
+	// given
+	task := structs.MarginLoanTask{
+		Mode:   consts.MarginModeCross,
+		Asset:  "USDT",
+		Amount: decimal.Zero,
+	}
+
+	// when
+	err := CheckMarginLoanTask(task)
+
+	// then
+	require.Error(t, err)
+}
@@ -3,6 +3,9 @@ package binance
 import (
 	"context"
 	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/mappers"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
 )
 
 func (a *adapter) CanTrade() (bool, error) {
@@ -13,3 +16,11 @@ func (a *adapter) CanTrade() (bool, error) {
 
 	return data.CanTrade, nil
 }
+
+func (a *adapter) GetAPIKeyInfo() (pkgStructs.APIKeyInfo, error) {
+	data, err := a.binanceAPI.GetAPIKeyPermission(context.Background())
+	if err != nil {
+		return pkgStructs.APIKeyInfo{}, fmt.Errorf("get API key permission: %w", err)
+	}
+	return mappers.ConvertAPIKeyPermission(data), nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/adshao/go-binance/v2"
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/wrapper"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -67,3 +68,41 @@ func TestCanTradeError(t *testing.T) {
 	// then
 	require.ErrorIs(t, err, errTestException)
 }
+
+func TestGetAPIKeyInfo(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	w := wrapper.NewMockBinanceAPIWrapper(ctrl)
+	a := New(w)
+
+	w.EXPECT().GetAPIKeyPermission(gomock.Any()).Return(
+		&binance.APIKeyPermission{
+			EnableSpotAndMarginTrading: true,
+			EnableWithdrawals:          true,
+		}, nil,
+	)
+
+	// when
+	info, err := a.GetAPIKeyInfo()
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, info.HasPermission(pkgStructs.APIKeyPermissionSpotTrade))
+	assert.True(t, info.HasPermission(pkgStructs.APIKeyPermissionWithdraw))
+	assert.False(t, info.HasPermission(pkgStructs.APIKeyPermissionFuturesTrade))
+}
+
+func TestGetAPIKeyInfoError(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	w := wrapper.NewMockBinanceAPIWrapper(ctrl)
+	a := New(w)
+
+	w.EXPECT().GetAPIKeyPermission(gomock.Any()).Return(nil, errTestException)
+
+	// when
+	_, err := a.GetAPIKeyInfo()
+
+	// then
+	require.ErrorIs(t, err, errTestException)
+}
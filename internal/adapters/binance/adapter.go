@@ -10,9 +10,10 @@ import (
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/errs"
 	binanceworkers "github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/workers"
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
-	"github.com/matrixbotio/exchange-gates-lib/pkg/utils"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
 )
 
 const (
@@ -22,21 +23,26 @@ const (
 type adapter struct {
 	baseadp.AdapterBase
 	binanceAPI wrapper.BinanceAPIWrapper
+	// readTimeout - candles request timeout
+	readTimeout time.Duration
 
-	tradeWorker  *binanceworkers.TradeEventWorkerBinance
-	candleWorker *CandleWorkerBinance
+	tradeWorker       *binanceworkers.TradeEventWorkerBinance
+	candleWorker      *CandleWorkerBinance
+	publicTradeWorker *binanceworkers.PublicTradeWorkerBinance
 }
 
-func New(wrapper wrapper.BinanceAPIWrapper) adp.Adapter {
+func New(wrapper wrapper.BinanceAPIWrapper, opts ...config.Option) adp.Adapter {
 	return &adapter{
 		AdapterBase: baseadp.NewAdapterBase(
 			consts.ExchangeIDbinanceSpot,
 			adapterName,
 			consts.BinanceAdapterTag,
 		),
-		binanceAPI:   wrapper,
-		candleWorker: NewCandleWorker(wrapper),
-		tradeWorker:  binanceworkers.NewTradeEventsWorker(wrapper),
+		binanceAPI:        wrapper,
+		readTimeout:       config.New(opts...).GetRequestTimeout(consts.ReadTimeout),
+		candleWorker:      NewCandleWorker(wrapper),
+		tradeWorker:       binanceworkers.NewTradeEventsWorker(wrapper),
+		publicTradeWorker: binanceworkers.NewPublicTradeWorker(wrapper),
 	}
 }
 
@@ -48,6 +54,25 @@ func (a *adapter) GetLimits() pkgStructs.ExchangeLimits {
 	}
 }
 
+// Capabilities - GetHistoryOrder is not implemented
+func (a *adapter) Capabilities() pkgStructs.AdapterCapabilities {
+	return pkgStructs.AdapterCapabilities{
+		OrderTypes:    []pkgStructs.OrderType{pkgStructs.OrderTypeLimit, pkgStructs.OrderTypeMarket},
+		TimeInForce:   []pkgStructs.TimeInForce{pkgStructs.TimeInForceGTC},
+		BatchOrders:   false,
+		OrderHistory:  false,
+		AccountTrades: true,
+		FeeLookup:     true,
+		Intervals:     a.GetSupportedIntervals(),
+		Streams: []pkgStructs.StreamType{
+			pkgStructs.StreamCandles,
+			pkgStructs.StreamPublicTrades,
+			pkgStructs.StreamAccountTrades,
+		},
+		OrderIDFormat: pkgStructs.OrderIDFormatNumeric,
+	}
+}
+
 func (a *adapter) GetPairSymbol(baseTicker string, quoteTicker string) string {
 	return fmt.Sprintf("%s%s", baseTicker, quoteTicker)
 }
@@ -72,3 +97,17 @@ func (a *adapter) Connect(credentials pkgStructs.APICredentials) error {
 	a.binanceAPI.Sync(context.Background())
 	return nil
 }
+
+func (a *adapter) GetServerTime() (time.Time, error) {
+	serverTime, err := a.binanceAPI.GetServerTime(context.Background())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get server time: %w", err)
+	}
+	return serverTime, nil
+}
+
+// SetServerTimeOffset - the offset is reset to the measured one on Connect
+func (a *adapter) SetServerTimeOffset(offset time.Duration) error {
+	a.binanceAPI.SetServerTimeOffset(offset)
+	return nil
+}
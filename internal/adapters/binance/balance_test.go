@@ -47,10 +47,10 @@ func TestGetAccountBalanceSuccess(t *testing.T) {
 	require.Len(t, balances, 2)
 	assert.Equal(t, "LTC", balances[0].Asset)
 	assert.Equal(t, "MTXB", balances[1].Asset)
-	assert.Equal(t, float64(10.1114), balances[0].Free)
-	assert.Equal(t, float64(0), balances[0].Locked)
-	assert.Equal(t, float64(100500), balances[1].Free)
-	assert.Equal(t, float64(24.0201), balances[1].Locked)
+	assert.Equal(t, "10.1114", balances[0].Free.String())
+	assert.Equal(t, "0", balances[0].Locked.String())
+	assert.Equal(t, "100500", balances[1].Free.String())
+	assert.Equal(t, "24.0201", balances[1].Locked.String())
 }
 
 func TestGetAccountBalanceError(t *testing.T) {
@@ -85,7 +85,7 @@ func TestGetAccountBalanceConvertError(t *testing.T) {
 	_, err := a.GetAccountBalance()
 
 	// then
-	require.ErrorContains(t, err, "invalid syntax")
+	require.ErrorContains(t, err, "can't convert")
 }
 
 func TestGetAccountBalanceErrorEmptyResponse(t *testing.T) {
@@ -125,10 +125,10 @@ func TestGetPairBalanceSuccess(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, pairSymbolData.BaseTicker, pairBalance.BaseAsset.Ticker)
 	assert.Equal(t, pairSymbolData.QuoteTicker, pairBalance.QuoteAsset.Ticker)
-	assert.Equal(t, float64(10.1114), pairBalance.BaseAsset.Free)
-	assert.Equal(t, float64(0), pairBalance.BaseAsset.Locked)
-	assert.Equal(t, float64(100500), pairBalance.QuoteAsset.Free)
-	assert.Equal(t, float64(24.0201), pairBalance.QuoteAsset.Locked)
+	assert.Equal(t, "10.1114", pairBalance.BaseAsset.Free.String())
+	assert.Equal(t, "0", pairBalance.BaseAsset.Locked.String())
+	assert.Equal(t, "100500", pairBalance.QuoteAsset.Free.String())
+	assert.Equal(t, "24.0201", pairBalance.QuoteAsset.Locked.String())
 }
 
 func TestGetPairBalanceError(t *testing.T) {
@@ -29,7 +29,7 @@ func (a *adapter) GetCandles(limit int, pairSymbol string, interval consts.Inter
 	[]workers.CandleData,
 	error,
 ) {
-	ctx, cancel := context.WithTimeout(context.Background(), consts.ReadTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), a.readTimeout)
 	defer cancel()
 
 	klines, err := a.binanceAPI.GetKlines(
@@ -49,6 +49,11 @@ func (a *adapter) GetCandles(limit int, pairSymbol string, interval consts.Inter
 	return candles, nil
 }
 
+// GetSupportedIntervals - binance supports all intervals in the same format
+func (a *adapter) GetSupportedIntervals() []consts.Interval {
+	return consts.GetIntervals()
+}
+
 func convertInterval(ourFormat consts.Interval) string {
 	return string(ourFormat)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/mappers"
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/wrapper"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -32,11 +33,11 @@ func TestGetCandlesSuccess(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, candles, 2)
 	assert.Equal(t, interval, candles[0].Interval)
-	assert.Equal(t, 1000.0, candles[0].Open)
-	assert.Equal(t, 2000.0, candles[0].Close)
-	assert.Equal(t, 3000.0, candles[0].High)
-	assert.Equal(t, 500.0, candles[0].Low)
-	assert.Equal(t, 10000.0, candles[0].Volume)
+	assert.True(t, candles[0].Open.Equal(decimal.NewFromFloat(1000.0)))
+	assert.True(t, candles[0].Close.Equal(decimal.NewFromFloat(2000.0)))
+	assert.True(t, candles[0].High.Equal(decimal.NewFromFloat(3000.0)))
+	assert.True(t, candles[0].Low.Equal(decimal.NewFromFloat(500.0)))
+	assert.True(t, candles[0].Volume.Equal(decimal.NewFromFloat(10000.0)))
 }
 
 func TestGetCandlesGetKlinesError(t *testing.T) {
@@ -79,3 +80,14 @@ func TestGetCandlesConvertError(t *testing.T) {
 	// then
 	require.ErrorContains(t, err, "convert candles")
 }
+
+func TestGetSupportedIntervals(t *testing.T) {
+	// given
+	a := New(wrapper.NewMockBinanceAPIWrapper(gomock.NewController(t)))
+
+	// when
+	intervals := a.GetSupportedIntervals()
+
+	// then
+	assert.Equal(t, consts.GetIntervals(), intervals)
+}
@@ -0,0 +1,251 @@
+package binance
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/shopspring/decimal"
+
+	binanceErrs "github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/errs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/adapter/adaptertest"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	testConformancePair = "BTCUSDT"
+
+	testErrCodeOrderDuplicate = -2010
+	testErrCodeUnknownOrder   = -2011
+	testErrCodeOrderNotExist  = -2013
+)
+
+func TestConformance(t *testing.T) {
+	adaptertest.Run(t, adaptertest.Config{
+		NewHarness: newTestHarness,
+		PairSymbol: testConformancePair,
+		OrderQty:   "0.01",
+		OrderPrice: "60000.5",
+	})
+}
+
+func newTestHarness(t *testing.T) adaptertest.Harness {
+	exchange := adaptertest.NewExchange()
+	restServer := httptest.NewServer(newTestStandInHandler(exchange))
+	t.Cleanup(restServer.Close)
+	wsServer := adaptertest.NewWebsocketServer(t)
+
+	opts := []config.Option{
+		config.WithRESTBaseURL(restServer.URL),
+		config.WithWsBaseURL(wsServer.URL()),
+	}
+	a := New(wrapper.NewWrapper(opts...), opts...)
+	if err := a.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{
+			Public: "public",
+			Secret: "secret",
+		},
+	}); err != nil {
+		t.Fatalf("connect: %s", err)
+	}
+
+	return adaptertest.Harness{
+		Adapter:       a,
+		Exchange:      exchange,
+		WsConnections: wsServer.Connections,
+	}
+}
+
+// newTestStandInHandler - binance REST API over the stand-in exchange
+func newTestStandInHandler(exchange *adaptertest.Exchange) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/v3/ping", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, map[string]any{})
+	})
+	mux.HandleFunc("GET /api/v3/time", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, map[string]any{"serverTime": time.Now().UnixMilli()})
+	})
+
+	mux.HandleFunc("GET /api/v3/exchangeInfo", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, binance.ExchangeInfo{Symbols: []binance.Symbol{{
+			Symbol:               testConformancePair,
+			Status:               string(binance.SymbolStatusTypeTrading),
+			BaseAsset:            "BTC",
+			BaseAssetPrecision:   8,
+			QuoteAsset:           "USDT",
+			QuotePrecision:       8,
+			IsSpotTradingAllowed: true,
+			Filters: []map[string]any{
+				{
+					"filterType": string(binance.SymbolFilterTypeLotSize),
+					"minQty":     "0.00001",
+					"maxQty":     "10000",
+					"stepSize":   "0.00001",
+				},
+				{
+					"filterType": string(binance.SymbolFilterTypePriceFilter),
+					"minPrice":   "0.01",
+					"maxPrice":   "1000000",
+					"tickSize":   "0.01",
+				},
+				{
+					"filterType":  string(binance.SymbolFilterTypeNotional),
+					"minNotional": "5",
+				},
+			},
+		}}})
+	})
+
+	mux.HandleFunc("POST /api/v3/order", func(w http.ResponseWriter, r *http.Request) {
+		params, err := getTestParams(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		side, _ := mappers.ConvertOrderSide(binance.SideType(params.Get("side")))
+		order, err := exchange.PlaceOrder(adaptertest.Order{
+			ClientOrderID: params.Get("newClientOrderId"),
+			PairSymbol:    params.Get("symbol"),
+			Side:          side,
+			IsMarket:      params.Get("type") == string(binance.OrderTypeMarket),
+			Qty:           decimal.RequireFromString(params.Get("quantity")),
+			Price:         decimal.RequireFromString(params.Get("price")),
+		})
+		if errors.Is(err, pkgErrs.ErrOrderDuplicate) {
+			writeTestError(w, testErrCodeOrderDuplicate, binanceErrs.ErrMsgOrderDuplicate+".")
+			return
+		}
+
+		testOrder := convertTestOrder(order)
+		writeTestJSON(w, http.StatusOK, binance.CreateOrderResponse{
+			Symbol:           testOrder.Symbol,
+			OrderID:          testOrder.OrderID,
+			ClientOrderID:    testOrder.ClientOrderID,
+			TransactTime:     order.CreatedTime,
+			Price:            testOrder.Price,
+			OrigQuantity:     testOrder.OrigQuantity,
+			ExecutedQuantity: testOrder.ExecutedQuantity,
+			Status:           testOrder.Status,
+			TimeInForce:      testOrder.TimeInForce,
+			Type:             testOrder.Type,
+			Side:             testOrder.Side,
+		})
+	})
+
+	mux.HandleFunc("GET /api/v3/order", func(w http.ResponseWriter, r *http.Request) {
+		order, err := getTestOrder(exchange, r.URL.Query())
+		if err != nil {
+			writeTestError(w, testErrCodeOrderNotExist, "Order does not exist.")
+			return
+		}
+		writeTestJSON(w, http.StatusOK, convertTestOrder(order))
+	})
+
+	mux.HandleFunc("DELETE /api/v3/order", func(w http.ResponseWriter, r *http.Request) {
+		params, err := getTestParams(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		order, err := getTestOrder(exchange, params)
+		if err == nil {
+			order, err = exchange.CancelOrder(order.ID)
+		}
+		switch {
+		case errors.Is(err, pkgErrs.ErrOrderFilled):
+			writeTestError(w, testErrCodeUnknownOrder, "Order has been filled.")
+		case err != nil:
+			writeTestError(w, testErrCodeUnknownOrder, binanceErrs.UnknownOrderMsg+".")
+		default:
+			testOrder := convertTestOrder(order)
+			writeTestJSON(w, http.StatusOK, binance.CancelOrderResponse{
+				Symbol:            testOrder.Symbol,
+				OrderID:           testOrder.OrderID,
+				OrigClientOrderID: testOrder.ClientOrderID,
+				Status:            testOrder.Status,
+			})
+		}
+	})
+	return mux
+}
+
+// getTestParams - the query & the form body params, the signed requests
+// are sent with both
+func getTestParams(r *http.Request) (url.Values, error) {
+	params := r.URL.Query()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	bodyParams, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range bodyParams {
+		params[key] = values
+	}
+	return params, nil
+}
+
+func getTestOrder(exchange *adaptertest.Exchange, params url.Values) (adaptertest.Order, error) {
+	if clientOrderID := params.Get("origClientOrderId"); clientOrderID != "" {
+		return exchange.GetOrderByClientOrderID(clientOrderID)
+	}
+
+	id, err := strconv.ParseInt(params.Get("orderId"), 10, 64)
+	if err != nil {
+		return adaptertest.Order{}, err
+	}
+	return exchange.GetOrder(id)
+}
+
+func convertTestOrder(order adaptertest.Order) binance.Order {
+	side, _ := mappers.GetBinanceOrderSide(order.Side)
+	orderType := binance.OrderTypeLimit
+	if order.IsMarket {
+		orderType = binance.OrderTypeMarket
+	}
+
+	// our order statuses are the binance ones
+	return binance.Order{
+		Symbol:           order.PairSymbol,
+		OrderID:          order.ID,
+		ClientOrderID:    order.ClientOrderID,
+		Price:            order.Price.String(),
+		OrigQuantity:     order.Qty.String(),
+		ExecutedQuantity: order.FilledQty.String(),
+		Status:           binance.OrderStatusType(order.Status),
+		TimeInForce:      binance.TimeInForceTypeGTC,
+		Type:             orderType,
+		Side:             side,
+		Time:             order.CreatedTime,
+		UpdateTime:       order.UpdatedTime,
+	}
+}
+
+func writeTestError(w http.ResponseWriter, code int, message string) {
+	writeTestJSON(w, http.StatusBadRequest, map[string]any{"code": code, "msg": message})
+}
+
+func writeTestJSON(w http.ResponseWriter, status int, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -27,6 +27,18 @@ func (a *adapter) SubscribeAccountTrades(
 	return a.tradeWorker.SubscribeToTradeEventsPrivate(eventCallback, errorHandler)
 }
 
+func (a *adapter) SubscribePublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	return a.publicTradeWorker.SubscribeToPublicTrades(
+		pairSymbol,
+		eventCallback,
+		errorHandler,
+	)
+}
+
 func (a *adapter) UnsubscribeCandle(
 	pairSymbol string,
 	interval consts.Interval,
@@ -40,3 +52,7 @@ func (a *adapter) UnsubscribeCandle(
 func (a *adapter) UnsubscribeAccountTrades() {
 	a.tradeWorker.Unsubscribe(binanceworkers.TradeSubscriptionKey)
 }
+
+func (a *adapter) UnsubscribePublicTrades(pairSymbol string) {
+	a.publicTradeWorker.Unsubscribe(pairSymbol)
+}
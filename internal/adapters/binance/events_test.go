@@ -63,3 +63,30 @@ func TestUnsubscribeCandle(t *testing.T) {
 	// when
 	a.UnsubscribeCandle(pairSymbol, interval)
 }
+
+func TestSubscribePublicTrades(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	w := wrapper.NewMockBinanceAPIWrapper(ctrl)
+	a := New(w)
+
+	eventHandler := func(event workers.PublicTradeEvent) {}
+	errHandler := func(err error) {}
+
+	pairSymbol := "LTCUSDT"
+
+	w.EXPECT().SubscribeToPublicTrades(
+		consts.BinanceAdapterTag, pairSymbol,
+		gomock.Any(), gomock.Any(),
+	).
+		Return(make(chan struct{}), make(chan struct{}), nil).
+		Times(1)
+
+	// when
+	require.NoError(t, a.SubscribePublicTrades(pairSymbol, eventHandler, errHandler))
+	err := a.SubscribePublicTrades(pairSymbol, eventHandler, errHandler)
+
+	// then
+	require.NoError(t, err)
+	a.UnsubscribePublicTrades(pairSymbol)
+}
@@ -0,0 +1,36 @@
+package mappers
+
+import (
+	"github.com/adshao/go-binance/v2"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+// ConvertAPIKeyPermission - binance doesn't return the IP whitelist,
+// the expiry date is set for the trading permission only
+func ConvertAPIKeyPermission(data *binance.APIKeyPermission) pkgStructs.APIKeyInfo {
+	info := pkgStructs.APIKeyInfo{
+		IsIPRestricted: data.IPRestrict,
+		ExpiresAt:      int64(data.TradingAuthorityExpirationTime),
+	}
+
+	if data.EnableSpotAndMarginTrading {
+		info.Permissions = append(info.Permissions, consts.APIKeyPermissionSpotTrade)
+	}
+	// margin loans permission is useless without the trading one
+	if data.EnableSpotAndMarginTrading && data.EnableMargin {
+		info.Permissions = append(info.Permissions, consts.APIKeyPermissionMarginTrade)
+	}
+	if data.EnableFutures {
+		info.Permissions = append(info.Permissions, consts.APIKeyPermissionFuturesTrade)
+	}
+	if data.EnableWithdrawals {
+		info.Permissions = append(info.Permissions, consts.APIKeyPermissionWithdraw)
+	}
+
+	info.IsReadOnly = len(info.Permissions) == 0 &&
+		!data.EnableInternalTransfer &&
+		!data.PermitsUniversalTransfer
+	return info
+}
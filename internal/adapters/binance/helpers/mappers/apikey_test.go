@@ -0,0 +1,47 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertAPIKeyPermission(t *testing.T) {
+	// given
+	data := &binance.APIKeyPermission{
+		IPRestrict:                     true,
+		EnableReading:                  true,
+		EnableSpotAndMarginTrading:     true,
+		EnableMargin:                   true,
+		EnableWithdrawals:              true,
+		TradingAuthorityExpirationTime: 1700000000000,
+	}
+
+	// when
+	info := ConvertAPIKeyPermission(data)
+
+	// then
+	assert.Equal(t, []consts.APIKeyPermission{
+		consts.APIKeyPermissionSpotTrade,
+		consts.APIKeyPermissionMarginTrade,
+		consts.APIKeyPermissionWithdraw,
+	}, info.Permissions)
+	assert.True(t, info.IsIPRestricted)
+	assert.False(t, info.IsReadOnly)
+	assert.Equal(t, int64(1700000000000), info.ExpiresAt)
+}
+
+func TestConvertAPIKeyPermissionReadOnly(t *testing.T) {
+	// given
+	data := &binance.APIKeyPermission{EnableReading: true}
+
+	// when
+	info := ConvertAPIKeyPermission(data)
+
+	// then
+	assert.Empty(t, info.Permissions)
+	assert.True(t, info.IsReadOnly)
+}
@@ -2,20 +2,20 @@ package mappers
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/adshao/go-binance/v2"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/shopspring/decimal"
 )
 
 func ConvertAssetBalance(data binance.Balance) (structs.Balance, error) {
-	balanceFree, err := strconv.ParseFloat(data.Free, 64)
+	balanceFree, err := decimal.NewFromString(data.Free)
 	if err != nil {
 		return structs.Balance{},
 			fmt.Errorf("parse %q free balance: %w", data.Asset, err)
 	}
 
-	balanceLocked, err := strconv.ParseFloat(data.Locked, 64)
+	balanceLocked, err := decimal.NewFromString(data.Locked)
 	if err != nil {
 		return structs.Balance{},
 			fmt.Errorf("parse %q locked balance: %w", data.Asset, err)
@@ -58,15 +58,15 @@ func FindAssetBalances(
 	if pairBalanceData.BaseAsset == nil {
 		pairBalanceData.BaseAsset = &structs.AssetBalance{
 			Ticker: pair.BaseTicker,
-			Free:   0,
-			Locked: 0,
+			Free:   decimal.Zero,
+			Locked: decimal.Zero,
 		}
 	}
 	if pairBalanceData.QuoteAsset == nil {
 		pairBalanceData.QuoteAsset = &structs.AssetBalance{
 			Ticker: pair.QuoteTicker,
-			Free:   0,
-			Locked: 0,
+			Free:   decimal.Zero,
+			Locked: decimal.Zero,
 		}
 	}
 	return pairBalanceData
@@ -84,7 +84,7 @@ func ConvertAccountBalances(binanceAccountData binance.Account) (structs.Account
 			return structs.AccountData{}, fmt.Errorf("parse asset balance: %w", err)
 		}
 
-		if assetBalance.Free == 0 && assetBalance.Locked == 0 {
+		if assetBalance.Free.IsZero() && assetBalance.Locked.IsZero() {
 			continue
 		}
 
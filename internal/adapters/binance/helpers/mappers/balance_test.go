@@ -5,6 +5,7 @@ import (
 
 	"github.com/adshao/go-binance/v2"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -23,8 +24,8 @@ func TestParseAssetBalance(t *testing.T) {
 	// then
 	require.NoError(t, err)
 	assert.Equal(t, rawData.Asset, assetBalance.Asset)
-	assert.Equal(t, float64(0.01), assetBalance.Free)
-	assert.Equal(t, float64(0), assetBalance.Locked)
+	assert.True(t, assetBalance.Free.Equal(decimal.NewFromFloat(0.01)))
+	assert.True(t, assetBalance.Locked.Equal(decimal.Zero))
 }
 
 func TestParseAssetBalanceFreeEmpty(t *testing.T) {
@@ -39,7 +40,7 @@ func TestParseAssetBalanceFreeEmpty(t *testing.T) {
 	_, err := ConvertAssetBalance(rawData)
 
 	// then
-	require.ErrorContains(t, err, "invalid syntax")
+	require.ErrorContains(t, err, "can't convert")
 }
 
 func TestParseAssetBalanceLockedEmpty(t *testing.T) {
@@ -54,21 +55,21 @@ func TestParseAssetBalanceLockedEmpty(t *testing.T) {
 	_, err := ConvertAssetBalance(rawData)
 
 	// then
-	require.ErrorContains(t, err, "invalid syntax")
+	require.ErrorContains(t, err, "can't convert")
 }
 
 func TestFindAssetBalancesSuccess(t *testing.T) {
 	// given
 	baseAsset := "BTC"
 	quoteAsset := "BUSD"
-	baseAssetFree := float64(0.1)
-	quoteAssetFree := float64(95.12)
+	baseAssetFree := decimal.NewFromFloat(0.1)
+	quoteAssetFree := decimal.NewFromFloat(95.12)
 
 	accountData := structs.AccountData{
 		Balances: []structs.Balance{
 			{
 				Asset: "LTC",
-				Free:  10,
+				Free:  decimal.NewFromInt(10),
 			},
 			{
 				Asset: baseAsset,
@@ -92,10 +93,10 @@ func TestFindAssetBalancesSuccess(t *testing.T) {
 	// then
 	assert.Equal(t, baseAsset, pairBalance.BaseAsset.Ticker)
 	assert.Equal(t, quoteAsset, pairBalance.QuoteAsset.Ticker)
-	assert.Equal(t, baseAssetFree, pairBalance.BaseAsset.Free)
-	assert.Equal(t, float64(0), pairBalance.BaseAsset.Locked)
-	assert.Equal(t, quoteAssetFree, pairBalance.QuoteAsset.Free)
-	assert.Equal(t, float64(0), pairBalance.QuoteAsset.Locked)
+	assert.True(t, baseAssetFree.Equal(pairBalance.BaseAsset.Free))
+	assert.True(t, pairBalance.BaseAsset.Locked.Equal(decimal.Zero))
+	assert.True(t, quoteAssetFree.Equal(pairBalance.QuoteAsset.Free))
+	assert.True(t, pairBalance.QuoteAsset.Locked.Equal(decimal.Zero))
 }
 
 func TestFindAssetBalancesNotFound(t *testing.T) {
@@ -107,11 +108,11 @@ func TestFindAssetBalancesNotFound(t *testing.T) {
 		Balances: []structs.Balance{
 			{
 				Asset: "LTC",
-				Free:  10,
+				Free:  decimal.NewFromInt(10),
 			},
 			{
 				Asset: "USDT",
-				Free:  0.5,
+				Free:  decimal.NewFromFloat(0.5),
 			},
 		},
 	}
@@ -127,10 +128,10 @@ func TestFindAssetBalancesNotFound(t *testing.T) {
 	// then
 	assert.Equal(t, baseAsset, pairBalance.BaseAsset.Ticker)
 	assert.Equal(t, quoteAsset, pairBalance.QuoteAsset.Ticker)
-	assert.Equal(t, float64(0), pairBalance.BaseAsset.Free)
-	assert.Equal(t, float64(0), pairBalance.BaseAsset.Locked)
-	assert.Equal(t, float64(0), pairBalance.QuoteAsset.Free)
-	assert.Equal(t, float64(0), pairBalance.QuoteAsset.Locked)
+	assert.True(t, pairBalance.BaseAsset.Free.Equal(decimal.Zero))
+	assert.True(t, pairBalance.BaseAsset.Locked.Equal(decimal.Zero))
+	assert.True(t, pairBalance.QuoteAsset.Free.Equal(decimal.Zero))
+	assert.True(t, pairBalance.QuoteAsset.Locked.Equal(decimal.Zero))
 }
 
 func TestConvertAccountBalances(t *testing.T) {
@@ -159,9 +160,9 @@ func TestConvertAccountBalances(t *testing.T) {
 	assert.Equal(t, true, accountData.CanTrade)
 	require.Len(t, accountData.Balances, 2)
 	assert.Equal(t, "MTXB", accountData.Balances[0].Asset)
-	assert.Equal(t, float64(100500), accountData.Balances[0].Free)
-	assert.Equal(t, float64(0), accountData.Balances[0].Locked)
+	assert.True(t, accountData.Balances[0].Free.Equal(decimal.NewFromFloat(100500)))
+	assert.True(t, accountData.Balances[0].Locked.Equal(decimal.Zero))
 	assert.Equal(t, "USDT", accountData.Balances[1].Asset)
-	assert.Equal(t, float64(10.000000001), accountData.Balances[1].Free)
-	assert.Equal(t, float64(5.02), accountData.Balances[1].Locked)
+	assert.True(t, accountData.Balances[1].Free.Equal(decimal.NewFromFloat(10.000000001)))
+	assert.True(t, accountData.Balances[1].Locked.Equal(decimal.NewFromFloat(5.02)))
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/adshao/go-binance/v2"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/shopspring/decimal"
 )
 
 func GetTestKlines() []*binance.Kline {
@@ -61,35 +62,35 @@ func ConvertBinanceCandleEvent(event *binance.WsKlineEvent) (workers.CandleEvent
 	if event.Kline.Open == "" {
 		return workers.CandleEvent{}, errors.New("candle `open` value is empty")
 	}
-	if e.Candle.Open, err = strconv.ParseFloat(event.Kline.Open, 64); err != nil {
+	if e.Candle.Open, err = decimal.NewFromString(event.Kline.Open); err != nil {
 		return workers.CandleEvent{}, fmt.Errorf("parse candle `open` value: %w", err)
 	}
 
 	if event.Kline.Close == "" {
 		return workers.CandleEvent{}, errors.New("candle `close` value is empty")
 	}
-	if e.Candle.Close, err = strconv.ParseFloat(event.Kline.Close, 64); err != nil {
+	if e.Candle.Close, err = decimal.NewFromString(event.Kline.Close); err != nil {
 		return workers.CandleEvent{}, fmt.Errorf("parse candle `close` value: %w", err)
 	}
 
 	if event.Kline.High == "" {
 		return workers.CandleEvent{}, errors.New("candle `high` value is empty")
 	}
-	if e.Candle.High, err = strconv.ParseFloat(event.Kline.High, 64); err != nil {
+	if e.Candle.High, err = decimal.NewFromString(event.Kline.High); err != nil {
 		return workers.CandleEvent{}, fmt.Errorf("parse candle `high` value: %w", err)
 	}
 
 	if event.Kline.Low == "" {
 		return workers.CandleEvent{}, errors.New("candle `low` value is empty")
 	}
-	if e.Candle.Low, err = strconv.ParseFloat(event.Kline.Low, 64); err != nil {
+	if e.Candle.Low, err = decimal.NewFromString(event.Kline.Low); err != nil {
 		return workers.CandleEvent{}, fmt.Errorf("parse candle `low` value: %w", err)
 	}
 
 	if event.Kline.Volume == "" {
 		return workers.CandleEvent{}, errors.New("candle `volume` value is empty")
 	}
-	if e.Candle.Volume, err = strconv.ParseFloat(event.Kline.Volume, 64); err != nil {
+	if e.Candle.Volume, err = decimal.NewFromString(event.Kline.Volume); err != nil {
 		return workers.CandleEvent{}, fmt.Errorf("parse candle `volume` value: %w", err)
 	}
 
@@ -113,35 +114,35 @@ func ConvertCandles(
 		if kline.Open == "" {
 			return nil, errors.New("convert candles `open` value is empty")
 		}
-		if candle.Open, err = strconv.ParseFloat(kline.Open, 64); err != nil {
+		if candle.Open, err = decimal.NewFromString(kline.Open); err != nil {
 			return nil, fmt.Errorf("convert candles `open` value: %w", err)
 		}
 
 		if kline.Close == "" {
 			return nil, errors.New("convert candles `close` value is empty")
 		}
-		if candle.Close, err = strconv.ParseFloat(kline.Close, 64); err != nil {
+		if candle.Close, err = decimal.NewFromString(kline.Close); err != nil {
 			return nil, fmt.Errorf("convert candles `close` value: %w", err)
 		}
 
 		if kline.High == "" {
 			return nil, errors.New("convert candles `high` value is empty")
 		}
-		if candle.High, err = strconv.ParseFloat(kline.High, 64); err != nil {
+		if candle.High, err = decimal.NewFromString(kline.High); err != nil {
 			return nil, fmt.Errorf("convert candles `high` value: %w", err)
 		}
 
 		if kline.Low == "" {
 			return nil, errors.New("convert candles `low` value is empty")
 		}
-		if candle.Low, err = strconv.ParseFloat(kline.Low, 64); err != nil {
+		if candle.Low, err = decimal.NewFromString(kline.Low); err != nil {
 			return nil, fmt.Errorf("convert candles `low` value: %w", err)
 		}
 
 		if kline.Volume == "" {
 			return nil, errors.New("convert candles `volume` value is empty")
 		}
-		if candle.Volume, err = strconv.ParseFloat(kline.Volume, 64); err != nil {
+		if candle.Volume, err = decimal.NewFromString(kline.Volume); err != nil {
 			return nil, fmt.Errorf("convert candles `volume` value: %w", err)
 		}
 
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -29,11 +30,11 @@ func TestConvertCandles(t *testing.T) {
 	assert.Equal(t, klines[0].OpenTime, candles[0].StartTime)
 	assert.Equal(t, fixCandleEndTime(klines[0].CloseTime), candles[0].EndTime)
 	assert.Equal(t, interval, candles[0].Interval)
-	assert.Equal(t, 1000.0, candles[0].Open)
-	assert.Equal(t, 2000.0, candles[0].Close)
-	assert.Equal(t, 3000.0, candles[0].High)
-	assert.Equal(t, 500.0, candles[0].Low)
-	assert.Equal(t, 10000.0, candles[0].Volume)
+	assert.True(t, candles[0].Open.Equal(decimal.NewFromFloat(1000.0)))
+	assert.True(t, candles[0].Close.Equal(decimal.NewFromFloat(2000.0)))
+	assert.True(t, candles[0].High.Equal(decimal.NewFromFloat(3000.0)))
+	assert.True(t, candles[0].Low.Equal(decimal.NewFromFloat(500.0)))
+	assert.True(t, candles[0].Volume.Equal(decimal.NewFromFloat(10000.0)))
 }
 
 func TestConvertCandlesWithError(t *testing.T) {
@@ -77,10 +78,10 @@ func TestConvertBinanceCandleEvent(t *testing.T) {
 	// then
 	require.NoError(t, err)
 	assert.Equal(t, rawEvent.Symbol, event.Symbol)
-	assert.Equal(t, float64(100), event.Candle.Open)
-	assert.Equal(t, float64(105), event.Candle.Close)
-	assert.Equal(t, float64(120), event.Candle.High)
-	assert.Equal(t, float64(98), event.Candle.Low)
-	assert.Equal(t, float64(500), event.Candle.Volume)
+	assert.True(t, event.Candle.Open.Equal(decimal.NewFromFloat(100)))
+	assert.True(t, event.Candle.Close.Equal(decimal.NewFromFloat(105)))
+	assert.True(t, event.Candle.High.Equal(decimal.NewFromFloat(120)))
+	assert.True(t, event.Candle.Low.Equal(decimal.NewFromFloat(98)))
+	assert.True(t, event.Candle.Volume.Equal(decimal.NewFromFloat(500)))
 	assert.Equal(t, int64(1682506268000), event.Candle.EndTime)
 }
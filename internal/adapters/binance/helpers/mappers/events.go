@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	"github.com/adshao/go-binance/v2"
+	"github.com/shopspring/decimal"
 
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
 )
 
@@ -41,13 +43,13 @@ func ConvertTradeEventPrivate(
 	}
 
 	var err error
-	wEvent.Price, err = strconv.ParseFloat(event.OrderUpdate.Price, 64)
+	wEvent.Price, err = decimal.NewFromString(event.OrderUpdate.Price)
 	if err != nil {
 		return workers.TradeEventPrivate{},
 			fmt.Errorf("parse price: %w", err)
 	}
 
-	wEvent.Quantity, err = strconv.ParseFloat(event.OrderUpdate.Volume, 64)
+	wEvent.Quantity, err = decimal.NewFromString(event.OrderUpdate.Volume)
 	if err != nil {
 		return workers.TradeEventPrivate{},
 			fmt.Errorf("parse quantity: %w", err)
@@ -55,3 +57,34 @@ func ConvertTradeEventPrivate(
 
 	return wEvent, nil
 }
+
+func ConvertPublicTradeEvent(
+	event binance.WsAggTradeEvent,
+	exchangeTag string,
+) (workers.PublicTradeEvent, error) {
+	price, err := decimal.NewFromString(event.Price)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := decimal.NewFromString(event.Quantity)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse quantity: %w", err)
+	}
+
+	// the buyer is maker: the taker sold
+	takerSide := consts.OrderSideBuy
+	if event.IsBuyerMaker {
+		takerSide = consts.OrderSideSell
+	}
+
+	return workers.PublicTradeEvent{
+		ID:          strconv.FormatInt(event.AggTradeID, 10),
+		Time:        event.TradeTime,
+		ExchangeTag: exchangeTag,
+		Symbol:      event.Symbol,
+		Price:       price,
+		Quantity:    qty,
+		TakerSide:   takerSide,
+	}, nil
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/adshao/go-binance/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 )
 
 func TestConvertPriceEventSuccess(t *testing.T) {
@@ -81,6 +83,46 @@ func TestConvertTradeEventPrivateSuccess(t *testing.T) {
 	assert.Equal(t, symbol, result.Symbol)
 	assert.Equal(t, strconv.FormatInt(orderID, 10), result.OrderID)
 	assert.Equal(t, clientOrderID, result.ClientOrderID)
-	assert.Equal(t, price, result.Price)
-	assert.Equal(t, quantity, result.Quantity)
+	assert.Equal(t, "134.3335", result.Price.String())
+	assert.Equal(t, "10.12", result.Quantity.String())
+}
+
+func TestConvertPublicTradeEventSuccess(t *testing.T) {
+	// given
+	event := binance.WsAggTradeEvent{
+		Symbol:       "BTCUSDT",
+		AggTradeID:   26129,
+		Price:        "0.01633102",
+		Quantity:     "4.70443515",
+		TradeTime:    1672515782136,
+		IsBuyerMaker: true,
+	}
+
+	// when
+	result, err := ConvertPublicTradeEvent(event, "binance-spot")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "26129", result.ID)
+	assert.Equal(t, int64(1672515782136), result.Time)
+	assert.Equal(t, "binance-spot", result.ExchangeTag)
+	assert.Equal(t, "BTCUSDT", result.Symbol)
+	assert.Equal(t, "0.01633102", result.Price.String())
+	assert.Equal(t, "4.70443515", result.Quantity.String())
+	assert.Equal(t, consts.OrderSideSell, result.TakerSide)
+}
+
+func TestConvertPublicTradeEventError(t *testing.T) {
+	// given
+	event := binance.WsAggTradeEvent{
+		Symbol:   "BTCUSDT",
+		Price:    "wtf",
+		Quantity: "1",
+	}
+
+	// when
+	_, err := ConvertPublicTradeEvent(event, "binance-spot")
+
+	// then
+	require.ErrorContains(t, err, "parse price")
 }
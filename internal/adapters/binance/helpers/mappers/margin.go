@@ -0,0 +1,169 @@
+package mappers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/shopspring/decimal"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+// errBorrowLimitExceededMsg - the borrow amount is above the account max borrowable
+const errBorrowLimitExceededMsg = "exceed maximum borrow"
+
+// cross margin classic account levels
+var (
+	crossMarginCallLevel   = decimal.NewFromFloat(1.5)
+	crossLiquidationLevel  = decimal.NewFromFloat(1.1)
+	isolatedMarginStatuses = map[string]consts.MarginRisk{
+		"EXCESSIVE":         consts.MarginRiskLow,
+		"NORMAL":            consts.MarginRiskLow,
+		"MARGIN_CALL":       consts.MarginRiskMarginCall,
+		"PRE_LIQUIDATION":   consts.MarginRiskMarginCall,
+		"FORCE_LIQUIDATION": consts.MarginRiskLiquidation,
+	}
+)
+
+func GetSideEffectType(sideEffect consts.MarginSideEffect) (binance.SideEffectType, error) {
+	switch sideEffect {
+	default:
+		return "", fmt.Errorf("unknown margin side effect: %q", sideEffect)
+	case "", consts.MarginSideEffectNone:
+		return binance.SideEffectTypeNoSideEffect, nil
+	case consts.MarginSideEffectAutoBorrow:
+		return binance.SideEffectTypeMarginBuy, nil
+	case consts.MarginSideEffectAutoRepay:
+		return binance.SideEffectTypeAutoRepay, nil
+	}
+}
+
+func ConvertMarginAccount(data binance.MarginAccount) (structs.MarginAccount, error) {
+	marginLevel, err := decimal.NewFromString(data.MarginLevel)
+	if err != nil {
+		return structs.MarginAccount{}, fmt.Errorf("parse margin level: %w", err)
+	}
+
+	result := structs.MarginAccount{
+		Mode:        consts.MarginModeCross,
+		MarginLevel: marginLevel,
+		Risk: baseadp.GetMarginRisk(
+			marginLevel, crossMarginCallLevel, crossLiquidationLevel,
+		),
+	}
+
+	for _, asset := range data.UserAssets {
+		balance, err := convertMarginBalance(
+			asset.Asset, asset.Free, asset.Locked,
+			asset.Borrowed, asset.Interest, asset.NetAsset,
+		)
+		if err != nil {
+			return structs.MarginAccount{}, err
+		}
+
+		if balance.Free.IsZero() && balance.Locked.IsZero() && balance.Borrowed.IsZero() {
+			continue
+		}
+		result.Balances = append(result.Balances, balance)
+	}
+	return result, nil
+}
+
+func ConvertIsolatedMarginAccount(data binance.IsolatedMarginAsset) (structs.MarginAccount, error) {
+	marginLevel, err := decimal.NewFromString(data.MarginLevel)
+	if err != nil {
+		return structs.MarginAccount{}, fmt.Errorf("parse margin level: %w", err)
+	}
+
+	liquidationPrice, err := parseOptionalDecimal(data.LiquidatePrice)
+	if err != nil {
+		return structs.MarginAccount{}, fmt.Errorf("parse liquidation price: %w", err)
+	}
+
+	risk, isExists := isolatedMarginStatuses[data.MarginLevelStatus]
+	if !isExists {
+		return structs.MarginAccount{},
+			fmt.Errorf("unknown margin level status: %q", data.MarginLevelStatus)
+	}
+
+	result := structs.MarginAccount{
+		Mode:             consts.MarginModeIsolated,
+		PairSymbol:       data.Symbol,
+		MarginLevel:      marginLevel,
+		LiquidationPrice: liquidationPrice,
+		Risk:             risk,
+	}
+
+	for _, asset := range []binance.IsolatedUserAsset{data.BaseAsset, data.QuoteAsset} {
+		balance, err := convertMarginBalance(
+			asset.Asset, asset.Free, asset.Locked,
+			asset.Borrowed, asset.Interest, asset.NetAsset,
+		)
+		if err != nil {
+			return structs.MarginAccount{}, err
+		}
+		result.Balances = append(result.Balances, balance)
+	}
+	return result, nil
+}
+
+func convertMarginBalance(
+	asset, free, locked, borrowed, interest, netAsset string,
+) (structs.MarginBalance, error) {
+	freeParsed, err := parseOptionalDecimal(free)
+	if err != nil {
+		return structs.MarginBalance{}, fmt.Errorf("parse %q free: %w", asset, err)
+	}
+
+	lockedParsed, err := parseOptionalDecimal(locked)
+	if err != nil {
+		return structs.MarginBalance{}, fmt.Errorf("parse %q locked: %w", asset, err)
+	}
+
+	borrowedParsed, err := parseOptionalDecimal(borrowed)
+	if err != nil {
+		return structs.MarginBalance{}, fmt.Errorf("parse %q borrowed: %w", asset, err)
+	}
+
+	interestParsed, err := parseOptionalDecimal(interest)
+	if err != nil {
+		return structs.MarginBalance{}, fmt.Errorf("parse %q interest: %w", asset, err)
+	}
+
+	netAssetParsed, err := parseOptionalDecimal(netAsset)
+	if err != nil {
+		return structs.MarginBalance{}, fmt.Errorf("parse %q net asset: %w", asset, err)
+	}
+
+	return structs.MarginBalance{
+		Asset:    asset,
+		Free:     freeParsed,
+		Locked:   lockedParsed,
+		Borrowed: borrowedParsed,
+		Interest: interestParsed,
+		NetAsset: netAssetParsed,
+	}, nil
+}
+
+func parseOptionalDecimal(value string) (decimal.Decimal, error) {
+	if value == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(value)
+}
+
+// MapMarginLoanError - borrow & repay errors: insufficient balance or the borrow limit
+func MapMarginLoanError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if strings.Contains(strings.ToLower(err.Error()), errBorrowLimitExceededMsg) {
+		return fmt.Errorf("%w: %s", pkgErrs.ErrTransferLimitExceeded, err.Error())
+	}
+	return MapTransferError(err)
+}
@@ -0,0 +1,176 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+func TestGetSideEffectType(t *testing.T) {
+	// when
+	sideEffect, err := GetSideEffectType(consts.MarginSideEffectAutoBorrow)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, binance.SideEffectTypeMarginBuy, sideEffect)
+}
+
+func TestGetSideEffectTypeDefault(t *testing.T) {
+	// when
+	sideEffect, err := GetSideEffectType("")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, binance.SideEffectTypeNoSideEffect, sideEffect)
+}
+
+func TestGetSideEffectTypeUnknown(t *testing.T) {
+	// when
+	_, err := GetSideEffectType("unknown")
+
+	// then
+	require.Error(t, err)
+}
+
+func TestConvertMarginAccount(t *testing.T) {
+	// given
+	data := binance.MarginAccount{
+		MarginLevel: "1.25",
+		UserAssets: []binance.UserAsset{
+			{
+				Asset:    "LTC",
+				Free:     "0",
+				Locked:   "0",
+				Borrowed: "1.5",
+				Interest: "0.001",
+				NetAsset: "-1.501",
+			},
+			{
+				Asset:    "USDT",
+				Free:     "200",
+				Locked:   "10",
+				Borrowed: "0",
+				Interest: "0",
+				NetAsset: "210",
+			},
+			{
+				Asset:    "BTC",
+				Free:     "0",
+				Locked:   "0",
+				Borrowed: "0",
+				Interest: "0",
+				NetAsset: "0",
+			},
+		},
+	}
+
+	// when
+	account, err := ConvertMarginAccount(data)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.MarginModeCross, account.Mode)
+	assert.Equal(t, "1.25", account.MarginLevel.String())
+	assert.Equal(t, consts.MarginRiskMarginCall, account.Risk)
+	require.Len(t, account.Balances, 2)
+	assert.Equal(t, "LTC", account.Balances[0].Asset)
+	assert.Equal(t, "1.5", account.Balances[0].Borrowed.String())
+	assert.Equal(t, "-1.501", account.Balances[0].NetAsset.String())
+	assert.Equal(t, "USDT", account.Balances[1].Asset)
+	assert.Equal(t, "200", account.Balances[1].Free.String())
+}
+
+func TestConvertMarginAccountInvalidLevel(t *testing.T) {
+	// when
+	_, err := ConvertMarginAccount(binance.MarginAccount{MarginLevel: "-"})
+
+	// then
+	require.ErrorContains(t, err, "margin level")
+}
+
+func TestConvertIsolatedMarginAccount(t *testing.T) {
+	// given
+	data := binance.IsolatedMarginAsset{
+		Symbol:            "LTCUSDT",
+		MarginLevel:       "2.5",
+		MarginLevelStatus: "NORMAL",
+		LiquidatePrice:    "120.5",
+		BaseAsset: binance.IsolatedUserAsset{
+			Asset:    "LTC",
+			Free:     "0",
+			Locked:   "0",
+			Borrowed: "1",
+			Interest: "0.0001",
+			NetAsset: "-1.0001",
+		},
+		QuoteAsset: binance.IsolatedUserAsset{
+			Asset:    "USDT",
+			Free:     "250",
+			Locked:   "0",
+			Borrowed: "0",
+			Interest: "0",
+			NetAsset: "250",
+		},
+	}
+
+	// when
+	account, err := ConvertIsolatedMarginAccount(data)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.MarginModeIsolated, account.Mode)
+	assert.Equal(t, "LTCUSDT", account.PairSymbol)
+	assert.Equal(t, "120.5", account.LiquidationPrice.String())
+	assert.Equal(t, consts.MarginRiskLow, account.Risk)
+	require.Len(t, account.Balances, 2)
+	assert.Equal(t, "1", account.Balances[0].Borrowed.String())
+	assert.Equal(t, "250", account.Balances[1].Free.String())
+}
+
+func TestConvertIsolatedMarginAccountUnknownStatus(t *testing.T) {
+	// given
+	data := binance.IsolatedMarginAsset{
+		MarginLevel:       "2.5",
+		MarginLevelStatus: "UNKNOWN",
+	}
+
+	// when
+	_, err := ConvertIsolatedMarginAccount(data)
+
+	// then
+	require.ErrorContains(t, err, "margin level status")
+}
+
+func TestMapMarginLoanErrorBorrowLimit(t *testing.T) {
+	// given
+	err := &common.APIError{
+		Code:    -3006,
+		Message: "Your borrow amount has exceed maximum borrow amount.",
+	}
+
+	// when
+	mappedErr := MapMarginLoanError(err)
+
+	// then
+	require.ErrorIs(t, mappedErr, pkgErrs.ErrTransferLimitExceeded)
+}
+
+func TestMapMarginLoanErrorInsufficientBalance(t *testing.T) {
+	// given
+	err := &common.APIError{
+		Code:    -3041,
+		Message: "Balance is not enough: insufficient balance",
+	}
+
+	// when
+	mappedErr := MapMarginLoanError(err)
+
+	// then
+	require.ErrorIs(t, mappedErr, pkgErrs.ErrInsufficientBalance)
+}
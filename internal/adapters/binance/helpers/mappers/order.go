@@ -38,13 +38,13 @@ func ConvertPlacedOrder(orderResponse binance.CreateOrderResponse) (
 	structs.CreateOrderResponse,
 	error,
 ) {
-	orderResOrigQty, err := strconv.ParseFloat(orderResponse.OrigQuantity, 64)
+	orderResOrigQty, err := decimal.NewFromString(orderResponse.OrigQuantity)
 	if err != nil {
 		return structs.CreateOrderResponse{},
 			fmt.Errorf("parse order origQty: %w", err)
 	}
 
-	orderResPrice, err := strconv.ParseFloat(orderResponse.Price, 64)
+	orderResPrice, err := decimal.NewFromString(orderResponse.Price)
 	if err != nil {
 		return structs.CreateOrderResponse{},
 			fmt.Errorf("parse order price: %w", err)
@@ -70,17 +70,17 @@ func ConvertPlacedOrder(orderResponse binance.CreateOrderResponse) (
 
 // ConvertOrderData converting the order data from binance to our format
 func ConvertOrderData(orderResponse *binance.Order) (structs.OrderData, error) {
-	awaitQty, err := strconv.ParseFloat(orderResponse.OrigQuantity, 64)
+	awaitQty, err := decimal.NewFromString(orderResponse.OrigQuantity)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("parse await qty: %w", err)
 	}
 
-	filledQty, err := strconv.ParseFloat(orderResponse.ExecutedQuantity, 64)
+	filledQty, err := decimal.NewFromString(orderResponse.ExecutedQuantity)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("parse executed qty: %w", err)
 	}
 
-	price, err := strconv.ParseFloat(orderResponse.Price, 64)
+	price, err := decimal.NewFromString(orderResponse.Price)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("parse price: %w", err)
 	}
@@ -155,6 +155,7 @@ func GetFeesFromTradeList(
 ) (structs.OrderFees, error) {
 	baseAssetFees := decimal.NewFromInt(0)
 	quoteAssetFees := decimal.NewFromInt(0)
+	var commissions []structs.Commission
 
 	for _, tradeData := range trades {
 		execFee, err := decimal.NewFromString(tradeData.Commission)
@@ -168,10 +169,85 @@ func GetFeesFromTradeList(
 		if tradeData.CommissionAsset == quoteAssetTicker {
 			quoteAssetFees = quoteAssetFees.Add(execFee)
 		}
+
+		price := decimal.Zero
+		if tradeData.Price != "" {
+			price, err = decimal.NewFromString(tradeData.Price)
+			if err != nil {
+				return structs.OrderFees{}, fmt.Errorf("parse trade price: %w", err)
+			}
+		}
+
+		// fees paid in BNB are not included in base or quote asset fees
+		commissions = append(commissions, structs.Commission{
+			TradeID: strconv.FormatInt(tradeData.ID, 10),
+			Asset:   tradeData.CommissionAsset,
+			Amount:  execFee,
+			Price:   price,
+			Time:    tradeData.Time,
+		})
 	}
 
 	return structs.OrderFees{
-		BaseAsset:  baseAssetFees,
-		QuoteAsset: quoteAssetFees,
+		BaseAsset:   baseAssetFees,
+		QuoteAsset:  quoteAssetFees,
+		Commissions: commissions,
+	}, nil
+}
+
+func ConvertAccountTrade(
+	trade binance.TradeV3,
+	clientOrderID string,
+) (structs.AccountTrade, error) {
+	price, err := decimal.NewFromString(trade.Price)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := decimal.NewFromString(trade.Quantity)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	fee, err := decimal.NewFromString(trade.Commission)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse fee: %w", err)
+	}
+
+	side := consts.OrderSideSell
+	if trade.IsBuyer {
+		side = consts.OrderSideBuy
+	}
+
+	return structs.AccountTrade{
+		ID:            strconv.FormatInt(trade.ID, 10),
+		OrderID:       trade.OrderID,
+		ClientOrderID: clientOrderID,
+		Symbol:        trade.Symbol,
+		Side:          side,
+		Price:         price,
+		Qty:           qty,
+		Fee:           fee,
+		FeeAsset:      trade.CommissionAsset,
+		IsMaker:       trade.IsMaker,
+		Time:          trade.Time,
+	}, nil
+}
+
+func ConvertTradeFees(fee binance.TradeFeeDetails) (structs.TradeFees, error) {
+	maker, err := decimal.NewFromString(fee.MakerCommission)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("parse maker fee: %w", err)
+	}
+
+	taker, err := decimal.NewFromString(fee.TakerCommission)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("parse taker fee: %w", err)
+	}
+
+	return structs.TradeFees{
+		Symbol: fee.Symbol,
+		Maker:  maker,
+		Taker:  taker,
 	}, nil
 }
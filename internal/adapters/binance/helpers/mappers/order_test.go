@@ -67,6 +67,42 @@ func TestGetFeesFromTradeListSuccessful(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, fees.BaseAsset.Equal(decimal.NewFromFloat(130.0001)))
 	assert.True(t, fees.QuoteAsset.Equal(decimal.NewFromFloat(0.0000001)))
+	require.Len(t, fees.Commissions, len(trades))
+	assert.Equal(t, "LTC", fees.Commissions[3].Asset)
+	assert.True(t, fees.Commissions[3].Amount.Equal(decimal.NewFromFloat(10.1)))
+}
+
+func TestGetFeesFromTradeListThirdAsset(t *testing.T) {
+	// given
+	orderID := int64(100)
+	trades := []*binance.TradeV3{
+		{
+			ID:              1,
+			Price:           "1.5",
+			CommissionAsset: "BNB",
+			Commission:      "0.0001",
+			Time:            1714521600000,
+		},
+	}
+
+	// when
+	fees, err := GetFeesFromTradeList(
+		trades,
+		testBaseAssetTicker,
+		testQuoteAssetTicker,
+		orderID,
+	)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, fees.BaseAsset.IsZero())
+	assert.True(t, fees.QuoteAsset.IsZero())
+	require.Len(t, fees.Commissions, 1)
+	assert.Equal(t, "1", fees.Commissions[0].TradeID)
+	assert.Equal(t, "BNB", fees.Commissions[0].Asset)
+	assert.Equal(t, "0.0001", fees.Commissions[0].Amount.String())
+	assert.Equal(t, "1.5", fees.Commissions[0].Price.String())
+	assert.Equal(t, int64(1714521600000), fees.Commissions[0].Time)
 }
 
 func TestBinanceConvertOrderSide(t *testing.T) {
@@ -161,8 +197,8 @@ func TestConvertBinanceToBotOrderSuccess(t *testing.T) {
 	assert.Equal(t, orderResponse.Symbol, order.Symbol)
 	assert.Equal(t, orderResponse.OrderID, order.OrderID)
 	assert.Equal(t, orderResponse.ClientOrderID, order.ClientOrderID)
-	assert.Equal(t, float64(65.108), order.Price)
-	assert.Equal(t, float64(1.219), order.OrigQuantity)
+	assert.True(t, order.Price.Equal(decimal.NewFromFloat(65.108)))
+	assert.True(t, order.OrigQuantity.Equal(decimal.NewFromFloat(1.219)))
 	assert.Equal(t, consts.OrderSideBuy, order.Type)
 }
 
@@ -232,3 +268,36 @@ func TestConvertBinanceToBotOrderInvalidSide(t *testing.T) {
 	// then
 	require.ErrorContains(t, err, "unknown order side")
 }
+
+func TestConvertTradeFees(t *testing.T) {
+	// given
+	fee := binance.TradeFeeDetails{
+		Symbol:          "LTCBUSD",
+		MakerCommission: "0.001",
+		TakerCommission: "0.00075",
+	}
+
+	// when
+	fees, err := ConvertTradeFees(fee)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "LTCBUSD", fees.Symbol)
+	assert.Equal(t, "0.001", fees.Maker.String())
+	assert.Equal(t, "0.00075", fees.Taker.String())
+}
+
+func TestConvertTradeFeesInvalid(t *testing.T) {
+	// given
+	fee := binance.TradeFeeDetails{
+		Symbol:          "LTCBUSD",
+		MakerCommission: "wtf",
+		TakerCommission: "0.00075",
+	}
+
+	// when
+	_, err := ConvertTradeFees(fee)
+
+	// then
+	require.ErrorContains(t, err, "parse maker fee")
+}
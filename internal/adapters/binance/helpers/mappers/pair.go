@@ -2,24 +2,24 @@ package mappers
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/adshao/go-binance/v2"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/shopspring/decimal"
 )
 
-func GetPairPrice(prices []*binance.SymbolPrice, pairSymbol string) (float64, error) {
+func GetPairPrice(prices []*binance.SymbolPrice, pairSymbol string) (decimal.Decimal, error) {
 	for _, p := range prices {
 		if p.Symbol == pairSymbol {
-			price, err := strconv.ParseFloat(p.Price, 64)
+			price, err := decimal.NewFromString(p.Price)
 			if err != nil {
-				return 0, fmt.Errorf("parse price %q: %w", p.Price, err)
+				return decimal.Zero, fmt.Errorf("parse price %q: %w", p.Price, err)
 			}
 			return price, nil
 		}
 	}
-	return 0, fmt.Errorf("last price not found for pair %q", pairSymbol)
+	return decimal.Zero, fmt.Errorf("last price not found for pair %q", pairSymbol)
 }
 
 func ConvertExchangePairsData(
@@ -55,12 +55,12 @@ func ConvertExchangePairData(symbolData binance.Symbol, exchangeID int) (
 		QuotePrecision: symbolData.QuotePrecision,
 		Status:         symbolData.Status,
 		Symbol:         symbolData.Symbol,
-		MinQty:         consts.PairDefaultMinQty,
-		MaxQty:         consts.PairDefaultMaxQty,
-		MinDeposit:     consts.PairMinDeposit,
-		MinPrice:       consts.PairDefaultMinPrice,
-		QtyStep:        consts.PairDefaultQtyStep,
-		PriceStep:      consts.PairDefaultPriceStep,
+		MinQty:         decimal.NewFromFloat(consts.PairDefaultMinQty),
+		MaxQty:         decimal.NewFromFloat(consts.PairDefaultMaxQty),
+		MinDeposit:     decimal.NewFromFloat(consts.PairMinDeposit),
+		MinPrice:       decimal.NewFromFloat(consts.PairDefaultMinPrice),
+		QtyStep:        decimal.NewFromFloat(consts.PairDefaultQtyStep),
+		PriceStep:      decimal.NewFromFloat(consts.PairDefaultPriceStep),
 		AllowedMargin:  symbolData.IsMarginTradingAllowed,
 		AllowedSpot:    symbolData.IsSpotTradingAllowed,
 	}
@@ -88,7 +88,7 @@ func binanceParseMinNotionalFilter(symbolData binance.Symbol, pairData *structs.
 		return fmt.Errorf("notional filter not available for pair %q", symbolData.Symbol)
 	}
 
-	pairData.OriginalMinDeposit, err = strconv.ParseFloat(minNotionalFilter.MinNotional, 64)
+	pairData.OriginalMinDeposit, err = decimal.NewFromString(minNotionalFilter.MinNotional)
 	if err != nil {
 		return fmt.Errorf("parse float: %w", err)
 	}
@@ -104,20 +104,20 @@ func binanceParsePriceFilter(symbolData binance.Symbol, pairData *structs.Exchan
 	}
 
 	minPriceRaw := priceFilter.MinPrice
-	pairData.MinPrice, err = strconv.ParseFloat(minPriceRaw, 64)
+	pairData.MinPrice, err = decimal.NewFromString(minPriceRaw)
 	if err != nil {
 		return fmt.Errorf("data handle error: %w", err)
 	}
-	if pairData.MinPrice == 0 {
-		pairData.MinPrice = consts.PairDefaultMinPrice
+	if pairData.MinPrice.IsZero() {
+		pairData.MinPrice = decimal.NewFromFloat(consts.PairDefaultMinPrice)
 	}
 
 	priceStepRaw := priceFilter.TickSize
-	pairData.PriceStep, err = strconv.ParseFloat(priceStepRaw, 64)
+	pairData.PriceStep, err = decimal.NewFromString(priceStepRaw)
 	if err != nil {
 		return fmt.Errorf("data handle error: %w", err)
 	}
-	if pairData.PriceStep == 0 {
+	if pairData.PriceStep.IsZero() {
 		pairData.PriceStep = pairData.MinPrice
 	}
 	return nil
@@ -135,25 +135,25 @@ func binanceParseLotSizeFilter(
 	maxQtyRaw := lotSizeFilter.MaxQuantity
 
 	var err error
-	pairData.MinQty, err = strconv.ParseFloat(minQtyRaw, 64)
+	pairData.MinQty, err = decimal.NewFromString(minQtyRaw)
 	if err != nil {
 		return fmt.Errorf("parse pair min qty: %w", err)
 	}
-	if pairData.MinQty == 0 {
-		pairData.MinQty = consts.PairDefaultMinQty
+	if pairData.MinQty.IsZero() {
+		pairData.MinQty = decimal.NewFromFloat(consts.PairDefaultMinQty)
 	}
 
-	pairData.MaxQty, err = strconv.ParseFloat(maxQtyRaw, 64)
+	pairData.MaxQty, err = decimal.NewFromString(maxQtyRaw)
 	if err != nil {
 		return fmt.Errorf("parse pair max qty: %w", err)
 	}
 
 	qtyStepRaw := lotSizeFilter.StepSize
-	pairData.QtyStep, err = strconv.ParseFloat(qtyStepRaw, 64)
+	pairData.QtyStep, err = decimal.NewFromString(qtyStepRaw)
 	if err != nil {
 		return fmt.Errorf("parse pair qty step: %w", err)
 	}
-	if pairData.QtyStep == 0 {
+	if pairData.QtyStep.IsZero() {
 		pairData.QtyStep = pairData.MinQty
 	}
 	return nil
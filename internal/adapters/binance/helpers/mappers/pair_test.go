@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/adshao/go-binance/v2"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -41,7 +42,7 @@ func TestGetPairPriceSuccess(t *testing.T) {
 
 	// then
 	require.NoError(t, err)
-	assert.Equal(t, float64(65), lastPrice)
+	assert.Equal(t, "65", lastPrice.String())
 }
 
 func TestGetPairPriceParseError(t *testing.T) {
@@ -170,9 +171,9 @@ func TestGetExchangePairData(t *testing.T) {
 
 	// then
 	require.NoError(t, err)
-	assert.Greater(t, pairData.MinDeposit, float64(0))
-	assert.Greater(t, pairData.MinPrice, float64(0))
-	assert.Greater(t, pairData.MinQty, float64(0))
+	assert.True(t, pairData.MinDeposit.IsPositive())
+	assert.True(t, pairData.MinPrice.IsPositive())
+	assert.True(t, pairData.MinQty.IsPositive())
 	assert.NotEmpty(t, pairData.Symbol)
 }
 
@@ -206,9 +207,9 @@ func TestConvertExchangePairsDataSuccess(t *testing.T) {
 	require.Len(t, data, 1)
 	assert.Equal(t, "LTCUSDC", data[0].Symbol)
 	assert.Equal(t, exchangeID, data[0].ExchangeID)
-	assert.Equal(t, float64(0.0001), data[0].MinQty)
-	assert.Equal(t, float64(0.01), data[0].MinPrice)
-	assert.Equal(t, float64(1), data[0].MinDeposit)
+	assert.True(t, data[0].MinQty.Equal(decimal.NewFromFloat(0.0001)))
+	assert.True(t, data[0].MinPrice.Equal(decimal.NewFromFloat(0.01)))
+	assert.True(t, data[0].MinDeposit.Equal(decimal.NewFromFloat(1)))
 }
 
 func TestConvertExchangePairsDataFiltersEmpty(t *testing.T) {
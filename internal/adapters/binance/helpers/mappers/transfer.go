@@ -0,0 +1,80 @@
+package mappers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const errInsufficientBalanceMsg = "insufficient balance"
+
+// our account type -> universal transfer wallet name
+var transferWallets = map[consts.AccountType]string{
+	consts.AccountTypeSpot:    "MAIN",
+	consts.AccountTypeFunding: "FUNDING",
+	consts.AccountTypeMargin:  "MARGIN",
+	consts.AccountTypeFutures: "UMFUTURE",
+}
+
+func GetTransferType(
+	fromAccount consts.AccountType,
+	toAccount consts.AccountType,
+) (binance.UserUniversalTransferType, error) {
+	fromWallet, isExists := transferWallets[fromAccount]
+	if !isExists {
+		return "", fmt.Errorf("%w: %q", pkgErrs.ErrAccountTypeNotSupported, fromAccount)
+	}
+
+	toWallet, isExists := transferWallets[toAccount]
+	if !isExists {
+		return "", fmt.Errorf("%w: %q", pkgErrs.ErrAccountTypeNotSupported, toAccount)
+	}
+
+	if fromAccount == toAccount {
+		return "", fmt.Errorf("transfer to the same account: %q", fromAccount)
+	}
+	return binance.UserUniversalTransferType(fromWallet + "_" + toWallet), nil
+}
+
+func ConvertFundingBalances(assets []binance.UserAssetRecord) ([]structs.Balance, error) {
+	var result []structs.Balance
+	for _, asset := range assets {
+		free, err := decimal.NewFromString(asset.Free)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s free balance: %w", asset.Asset, err)
+		}
+
+		locked, err := decimal.NewFromString(asset.Locked)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s locked balance: %w", asset.Asset, err)
+		}
+
+		if free.IsZero() && locked.IsZero() {
+			continue
+		}
+
+		result = append(result, structs.Balance{
+			Asset:  asset.Asset,
+			Free:   free,
+			Locked: locked,
+		})
+	}
+	return result, nil
+}
+
+func MapTransferError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if strings.Contains(strings.ToLower(err.Error()), errInsufficientBalanceMsg) {
+		return fmt.Errorf("%w: %s", pkgErrs.ErrInsufficientBalance, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,82 @@
+package mappers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+func TestGetTransferType(t *testing.T) {
+	// when
+	transferType, err := GetTransferType(consts.AccountTypeFunding, consts.AccountTypeSpot)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, binance.UserUniversalTransferTypeFundingToMain, transferType)
+}
+
+func TestGetTransferTypeNotSupported(t *testing.T) {
+	// when
+	_, err := GetTransferType(consts.AccountTypeUnified, consts.AccountTypeSpot)
+
+	// then
+	require.ErrorIs(t, err, pkgErrs.ErrAccountTypeNotSupported)
+}
+
+func TestGetTransferTypeSameAccount(t *testing.T) {
+	// when
+	_, err := GetTransferType(consts.AccountTypeSpot, consts.AccountTypeSpot)
+
+	// then
+	require.ErrorContains(t, err, "same account")
+}
+
+func TestConvertFundingBalances(t *testing.T) {
+	// given
+	assets := []binance.UserAssetRecord{
+		{Asset: "USDT", Free: "100.5", Locked: "1"},
+		{Asset: "BTC", Free: "0", Locked: "0"},
+	}
+
+	// when
+	balances, err := ConvertFundingBalances(assets)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	assert.Equal(t, "USDT", balances[0].Asset)
+	assert.Equal(t, "100.5", balances[0].Free.String())
+	assert.Equal(t, "1", balances[0].Locked.String())
+}
+
+func TestMapTransferError(t *testing.T) {
+	// given
+	err := &common.APIError{
+		Code:    -5013,
+		Message: "Asset transfer failed: insufficient balance",
+	}
+
+	// when
+	mappedErr := MapTransferError(err)
+
+	// then
+	require.ErrorIs(t, mappedErr, pkgErrs.ErrInsufficientBalance)
+}
+
+func TestMapTransferErrorUnknown(t *testing.T) {
+	// given
+	err := errors.New("some error")
+
+	// when
+	mappedErr := MapTransferError(err)
+
+	// then
+	assert.Equal(t, err, mappedErr)
+}
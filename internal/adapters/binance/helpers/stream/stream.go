@@ -0,0 +1,92 @@
+// Package stream - binance websocket streams client. go-binance streams
+// use the package-wide endpoints & dialer, so the adapters dial with their own
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// rawPath - single stream path: /ws/<stream name>
+	rawPath = "/ws/"
+	// combinedPath - combined streams path: /stream?streams=<name>/<name>
+	combinedPath = "/stream?streams="
+
+	readLimit = 655350
+)
+
+// combinedMessage - combined stream message wraps the raw stream data
+type combinedMessage struct {
+	Stream string          `json:"stream"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// GetRawURL - single stream URL, e.g. btcusdt@kline_1m or the listen key
+func GetRawURL(baseURL, streamName string) string {
+	return baseURL + rawPath + streamName
+}
+
+// GetCombinedURL - combined streams URL
+func GetCombinedURL(baseURL string, streamNames []string) string {
+	return baseURL + combinedPath + strings.Join(streamNames, "/")
+}
+
+// ParseCombined - get the stream name & the raw stream data
+func ParseCombined(message []byte) (streamName string, data []byte, err error) {
+	var msg combinedMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return "", nil, fmt.Errorf("decode: %w", err)
+	}
+	return msg.Stream, msg.Data, nil
+}
+
+// Serve - read the stream messages until the stop or the connection error
+func Serve(
+	dialer *websocket.Dialer,
+	wsURL string,
+	handler func(message []byte),
+	errorHandler func(err error),
+) (doneC, stopC chan struct{}, err error) {
+	conn, _, err := dialer.Dial(wsURL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial: %w", err)
+	}
+
+	conn.SetReadLimit(readLimit)
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+
+	go func() {
+		defer close(doneC)
+		var isStopped atomic.Bool
+
+		// await stop. The server pings are answered by the read loop
+		go func() {
+			defer conn.Close()
+
+			select {
+			case <-stopC:
+				isStopped.Store(true)
+			case <-doneC:
+			}
+		}()
+
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				if !isStopped.Load() {
+					errorHandler(err)
+				}
+				return
+			}
+
+			handler(message)
+		}
+	}()
+	return doneC, stopC, nil
+}
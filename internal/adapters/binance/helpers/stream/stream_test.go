@@ -0,0 +1,109 @@
+package stream
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, messages ...string) (wsBaseURL string, paths chan string) {
+	paths = make(chan string, 1)
+	upgrader := websocket.Upgrader{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths <- r.URL.RequestURI()
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for _, message := range messages {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+				return
+			}
+		}
+		// keep the connection open until the client closes it
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return "ws" + strings.TrimPrefix(server.URL, "http"), paths
+}
+
+func TestGetURLs(t *testing.T) {
+	assert.Equal(t,
+		"wss://stream.binance.com:9443/ws/btcusdt@kline_1m",
+		GetRawURL("wss://stream.binance.com:9443", "btcusdt@kline_1m"),
+	)
+	assert.Equal(t,
+		"wss://stream.binance.com:9443/stream?streams=btcusdt@kline_1m/ethusdt@kline_1h",
+		GetCombinedURL(
+			"wss://stream.binance.com:9443",
+			[]string{"btcusdt@kline_1m", "ethusdt@kline_1h"},
+		),
+	)
+}
+
+func TestParseCombined(t *testing.T) {
+	// when
+	streamName, data, err := ParseCombined(
+		[]byte(`{"stream":"btcusdt@kline_1m","data":{"e":"kline"}}`),
+	)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "btcusdt@kline_1m", streamName)
+	assert.JSONEq(t, `{"e":"kline"}`, string(data))
+}
+
+func TestServe(t *testing.T) {
+	// given
+	wsBaseURL, paths := newTestServer(t, `{"e":"kline"}`)
+	messages := make(chan string, 1)
+
+	// when
+	doneC, stopC, err := Serve(
+		websocket.DefaultDialer,
+		GetRawURL(wsBaseURL, "btcusdt@kline_1m"),
+		func(message []byte) { messages <- string(message) },
+		func(err error) { t.Errorf("unexpected error: %v", err) },
+	)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "/ws/btcusdt@kline_1m", <-paths)
+
+	select {
+	case message := <-messages:
+		assert.Equal(t, `{"e":"kline"}`, message)
+	case <-time.After(time.Second * 5):
+		t.Fatal("message timeout")
+	}
+
+	close(stopC)
+	select {
+	case <-doneC:
+	case <-time.After(time.Second * 5):
+		t.Fatal("stop timeout")
+	}
+}
+
+func TestServeDialError(t *testing.T) {
+	// when
+	_, _, err := Serve(websocket.DefaultDialer, "ws://127.0.0.1:1/ws/test", nil, nil)
+
+	// then
+	require.ErrorContains(t, err, "dial")
+}
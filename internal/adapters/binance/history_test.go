@@ -0,0 +1,99 @@
+package binance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func getTestTrade(id int64, tradeTime int64) *binance.TradeV3 {
+	return &binance.TradeV3{
+		ID:              id,
+		Symbol:          testPairSymbol,
+		OrderID:         testOrderID,
+		Price:           "1.001",
+		Quantity:        "10",
+		Commission:      "0.01",
+		CommissionAsset: "MTXB",
+		Time:            tradeTime,
+		IsBuyer:         true,
+		IsMaker:         true,
+	}
+}
+
+func TestGetAccountTrades(t *testing.T) {
+	// given
+	w := wrapper.NewMockBinanceAPIWrapper(gomock.NewController(t))
+	a := New(w)
+
+	task := structs.GetOrdersHistoryTask{
+		PairSymbol: testPairSymbol,
+		StartTime:  1714521600000,
+		EndTime:    1714525200000,
+	}
+
+	var firstPage []*binance.TradeV3
+	for i := int64(1); i <= tradesHistoryPageLimit; i++ {
+		firstPage = append(firstPage, getTestTrade(i, task.StartTime+i))
+	}
+
+	w.EXPECT().GetAccountTrades(
+		gomock.Any(), testPairSymbol,
+		task.StartTime, task.EndTime,
+		int64(0), tradesHistoryPageLimit,
+	).Return(firstPage, nil)
+	w.EXPECT().GetAccountTrades(
+		gomock.Any(), testPairSymbol,
+		int64(0), int64(0),
+		int64(tradesHistoryPageLimit+1), tradesHistoryPageLimit,
+	).Return([]*binance.TradeV3{
+		getTestTrade(tradesHistoryPageLimit+1, task.EndTime),
+		getTestTrade(tradesHistoryPageLimit+2, task.EndTime+1),
+	}, nil)
+	w.EXPECT().GetOrders(gomock.Any(), testPairSymbol, testOrderID, ordersPageLimit).
+		Return([]*binance.Order{{OrderID: testOrderID, ClientOrderID: testClientOrderID}}, nil)
+
+	// when
+	trades, err := a.GetAccountTrades(task)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, trades, tradesHistoryPageLimit+1)
+
+	last := trades[len(trades)-1]
+	assert.Equal(t, "1001", last.ID)
+	assert.Equal(t, testOrderID, last.OrderID)
+	assert.Equal(t, testClientOrderID, last.ClientOrderID)
+	assert.Equal(t, consts.OrderSideBuy, last.Side)
+	assert.Equal(t, "0.01", last.Fee.String())
+	assert.Equal(t, "MTXB", last.FeeAsset)
+	assert.True(t, last.IsMaker)
+}
+
+func TestGetAccountTradesError(t *testing.T) {
+	// given
+	w := wrapper.NewMockBinanceAPIWrapper(gomock.NewController(t))
+	a := New(w)
+
+	w.EXPECT().GetAccountTrades(
+		gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(nil, errors.New("service unavailable"))
+
+	// when
+	_, err := a.GetAccountTrades(structs.GetOrdersHistoryTask{
+		PairSymbol: testPairSymbol,
+		StartTime:  1714521600000,
+		EndTime:    1714525200000,
+	})
+
+	// then
+	require.ErrorContains(t, err, "service unavailable")
+}
@@ -0,0 +1,135 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/adshao/go-binance/v2"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+func (a *adapter) GetMarginAccount(
+	mode consts.MarginMode,
+	pairSymbol string,
+) (structs.MarginAccount, error) {
+	if err := baseadp.CheckMarginMode(mode, pairSymbol); err != nil {
+		return structs.MarginAccount{}, err
+	}
+
+	if mode == consts.MarginModeCross {
+		data, err := a.binanceAPI.GetMarginAccount(context.Background())
+		if err != nil {
+			return structs.MarginAccount{}, fmt.Errorf("get margin account: %w", err)
+		}
+		if data == nil {
+			return structs.MarginAccount{}, errors.New("margin account data is empty")
+		}
+		return mappers.ConvertMarginAccount(*data)
+	}
+
+	data, err := a.binanceAPI.GetIsolatedMarginAccount(context.Background(), pairSymbol)
+	if err != nil {
+		return structs.MarginAccount{}, fmt.Errorf("get isolated margin account: %w", err)
+	}
+	if data == nil || len(data.Assets) == 0 {
+		return structs.MarginAccount{},
+			fmt.Errorf("isolated margin account not found for %q", pairSymbol)
+	}
+	return mappers.ConvertIsolatedMarginAccount(data.Assets[0])
+}
+
+func (a *adapter) MarginBorrow(task structs.MarginLoanTask) (structs.MarginLoanResult, error) {
+	if err := baseadp.CheckMarginLoanTask(task); err != nil {
+		return structs.MarginLoanResult{}, fmt.Errorf("check task: %w", err)
+	}
+
+	response, err := a.binanceAPI.MarginLoan(
+		context.Background(),
+		task.Asset,
+		task.Amount.String(),
+		getIsolatedPairSymbol(task.Mode, task.PairSymbol),
+	)
+	if err != nil {
+		return structs.MarginLoanResult{}, fmt.Errorf("borrow: %w", mappers.MapMarginLoanError(err))
+	}
+	return getMarginLoanResult(task, response), nil
+}
+
+func (a *adapter) MarginRepay(task structs.MarginLoanTask) (structs.MarginLoanResult, error) {
+	if err := baseadp.CheckMarginLoanTask(task); err != nil {
+		return structs.MarginLoanResult{}, fmt.Errorf("check task: %w", err)
+	}
+
+	response, err := a.binanceAPI.MarginRepay(
+		context.Background(),
+		task.Asset,
+		task.Amount.String(),
+		getIsolatedPairSymbol(task.Mode, task.PairSymbol),
+	)
+	if err != nil {
+		return structs.MarginLoanResult{}, fmt.Errorf("repay: %w", mappers.MapMarginLoanError(err))
+	}
+	return getMarginLoanResult(task, response), nil
+}
+
+func (a *adapter) PlaceMarginOrder(
+	ctx context.Context,
+	order structs.BotOrderAdjusted,
+	params structs.MarginOrderParams,
+) (structs.CreateOrderResponse, error) {
+	if err := baseadp.CheckMarginMode(params.Mode, order.PairSymbol); err != nil {
+		return structs.CreateOrderResponse{}, err
+	}
+
+	orderSide, err := mappers.GetBinanceOrderSide(order.Type)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("get order side: %w", err)
+	}
+
+	sideEffect, err := mappers.GetSideEffectType(params.SideEffect)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("get side effect: %w", err)
+	}
+
+	orderResponse, err := a.binanceAPI.PlaceMarginOrder(ctx, wrapper.MarginOrderTask{
+		PairSymbol:    order.PairSymbol,
+		Side:          orderSide,
+		IsMarketOrder: order.IsMarketOrder,
+		Qty:           order.Qty,
+		Price:         order.Price,
+		ClientOrderID: order.ClientOrderID,
+		IsIsolated:    params.Mode == consts.MarginModeIsolated,
+		SideEffect:    sideEffect,
+	})
+	return convertPlacedOrder(orderResponse, err)
+}
+
+func getIsolatedPairSymbol(mode consts.MarginMode, pairSymbol string) string {
+	if mode == consts.MarginModeIsolated {
+		return pairSymbol
+	}
+	return ""
+}
+
+func getMarginLoanResult(
+	task structs.MarginLoanTask,
+	response *binance.TransactionResponse,
+) structs.MarginLoanResult {
+	result := structs.MarginLoanResult{
+		Mode:       task.Mode,
+		PairSymbol: getIsolatedPairSymbol(task.Mode, task.PairSymbol),
+		Asset:      task.Asset,
+		Amount:     task.Amount,
+	}
+	if response != nil {
+		result.ID = strconv.FormatInt(response.TranID, 10)
+	}
+	return result
+}
@@ -0,0 +1,170 @@
+package binance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+func newTestMarginAdapter(t *testing.T) (adapters.MarginAdapter, *wrapper.MockBinanceAPIWrapper) {
+	w := wrapper.NewMockBinanceAPIWrapper(gomock.NewController(t))
+
+	a, isMarginAdapter := New(w).(adapters.MarginAdapter)
+	require.True(t, isMarginAdapter)
+	return a, w
+}
+
+func TestGetMarginAccountIsolated(t *testing.T) {
+	// given
+	a, w := newTestMarginAdapter(t)
+
+	w.EXPECT().GetIsolatedMarginAccount(context.Background(), "LTCUSDT").
+		Return(&binance.IsolatedMarginAccount{
+			Assets: []binance.IsolatedMarginAsset{{
+				Symbol:            "LTCUSDT",
+				MarginLevel:       "1.2",
+				MarginLevelStatus: "MARGIN_CALL",
+				LiquidatePrice:    "95",
+				BaseAsset:         binance.IsolatedUserAsset{Asset: "LTC", Borrowed: "2"},
+				QuoteAsset:        binance.IsolatedUserAsset{Asset: "USDT", Free: "300"},
+			}},
+		}, nil)
+
+	// when
+	account, err := a.GetMarginAccount(consts.MarginModeIsolated, "LTCUSDT")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.MarginRiskMarginCall, account.Risk)
+	assert.Equal(t, "95", account.LiquidationPrice.String())
+	require.Len(t, account.Balances, 2)
+}
+
+func TestGetMarginAccountIsolatedPairNotSet(t *testing.T) {
+	// given
+	a, _ := newTestMarginAdapter(t)
+
+	// when
+	_, err := a.GetMarginAccount(consts.MarginModeIsolated, "")
+
+	// then
+	require.Error(t, err)
+}
+
+func TestMarginBorrowCross(t *testing.T) {
+	// given
+	a, w := newTestMarginAdapter(t)
+
+	w.EXPECT().MarginLoan(context.Background(), "LTC", "1.5", "").
+		Return(&binance.TransactionResponse{TranID: 100500}, nil)
+
+	// when
+	result, err := a.MarginBorrow(structs.MarginLoanTask{
+		Mode:       consts.MarginModeCross,
+		PairSymbol: "LTCUSDT",
+		Asset:      "LTC",
+		Amount:     decimal.NewFromFloat(1.5),
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "100500", result.ID)
+	assert.Empty(t, result.PairSymbol)
+	assert.Equal(t, "1.5", result.Amount.String())
+}
+
+func TestMarginBorrowLimitExceeded(t *testing.T) {
+	// given
+	a, w := newTestMarginAdapter(t)
+
+	w.EXPECT().MarginLoan(context.Background(), "LTC", "1.5", "").
+		Return(nil, &common.APIError{
+			Code:    -3006,
+			Message: "Your borrow amount has exceed maximum borrow amount.",
+		})
+
+	// when
+	_, err := a.MarginBorrow(structs.MarginLoanTask{
+		Mode:   consts.MarginModeCross,
+		Asset:  "LTC",
+		Amount: decimal.NewFromFloat(1.5),
+	})
+
+	// then
+	require.ErrorIs(t, err, pkgErrs.ErrTransferLimitExceeded)
+}
+
+func TestMarginRepayIsolated(t *testing.T) {
+	// given
+	a, w := newTestMarginAdapter(t)
+
+	w.EXPECT().MarginRepay(context.Background(), "LTC", "1", "LTCUSDT").
+		Return(&binance.TransactionResponse{TranID: 100501}, nil)
+
+	// when
+	result, err := a.MarginRepay(structs.MarginLoanTask{
+		Mode:       consts.MarginModeIsolated,
+		PairSymbol: "LTCUSDT",
+		Asset:      "LTC",
+		Amount:     decimal.NewFromInt(1),
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "100501", result.ID)
+	assert.Equal(t, "LTCUSDT", result.PairSymbol)
+}
+
+func TestPlaceMarginOrderAutoBorrow(t *testing.T) {
+	// given
+	a, w := newTestMarginAdapter(t)
+	ctx := context.Background()
+
+	w.EXPECT().PlaceMarginOrder(ctx, wrapper.MarginOrderTask{
+		PairSymbol:    "LTCUSDT",
+		Side:          binance.SideTypeSell,
+		Qty:           "1",
+		Price:         "100",
+		ClientOrderID: "test",
+		IsIsolated:    true,
+		SideEffect:    binance.SideEffectTypeMarginBuy,
+	}).Return(&binance.CreateOrderResponse{
+		Symbol:           "LTCUSDT",
+		OrderID:          123,
+		ClientOrderID:    "test",
+		Price:            "100",
+		OrigQuantity:     "1",
+		ExecutedQuantity: "0",
+		Status:           binance.OrderStatusTypeNew,
+		Side:             binance.SideTypeSell,
+	}, nil)
+
+	// when
+	response, err := a.PlaceMarginOrder(ctx, structs.BotOrderAdjusted{
+		PairSymbol:    "LTCUSDT",
+		Type:          consts.OrderSideSell,
+		Qty:           "1",
+		Price:         "100",
+		ClientOrderID: "test",
+	}, structs.MarginOrderParams{
+		Mode:       consts.MarginModeIsolated,
+		SideEffect: consts.MarginSideEffectAutoBorrow,
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, int64(123), response.OrderID)
+	assert.Equal(t, "test", response.ClientOrderID)
+}
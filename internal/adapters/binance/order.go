@@ -99,6 +99,13 @@ func (a *adapter) PlaceOrder(ctx context.Context, order structs.BotOrderAdjusted
 		)
 	}
 
+	return convertPlacedOrder(orderResponse, err)
+}
+
+func convertPlacedOrder(
+	orderResponse *binance.CreateOrderResponse,
+	err error,
+) (structs.CreateOrderResponse, error) {
 	if err != nil {
 		if strings.Contains(err.Error(), errs.ErrMsgOrderDuplicate) {
 			return structs.CreateOrderResponse{}, pkgErrs.ErrOrderDuplicate
@@ -123,7 +130,7 @@ func (a *adapter) GetHistoryOrder(
 	orderID int64,
 ) (structs.OrderHistory, error) {
 	// not emplemented yet
-	return structs.OrderHistory{}, nil
+	return structs.OrderHistory{}, &pkgErrs.NotSupportedError{Feature: "order history"}
 }
 
 func (a *adapter) GetOrderExecFee(
@@ -154,3 +161,16 @@ func (a *adapter) GetOrderExecFee(
 	}
 	return fees, nil
 }
+
+func (a *adapter) GetTradeFees(pairSymbol string) (structs.TradeFees, error) {
+	fee, err := a.binanceAPI.GetTradeFee(context.Background(), pairSymbol)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("get: %w", err)
+	}
+
+	fees, err := mappers.ConvertTradeFees(*fee)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("convert: %w", err)
+	}
+	return fees, nil
+}
@@ -81,9 +81,9 @@ func TestGetOrderDataSuccess(t *testing.T) {
 
 	// then
 	require.NoError(t, err)
-	assert.Equal(t, float64(1.001), orderData.Price)
-	assert.Equal(t, float64(1230.213), orderData.AwaitQty)
-	assert.Equal(t, float64(102.1203220001), orderData.FilledQty)
+	assert.True(t, orderData.Price.Equal(decimal.NewFromFloat(1.001)))
+	assert.True(t, orderData.AwaitQty.Equal(decimal.NewFromFloat(1230.213)))
+	assert.True(t, orderData.FilledQty.Equal(decimal.NewFromFloat(102.1203220001)))
 	assert.Equal(t, testOrderID, orderData.OrderID)
 	assert.Equal(t, testClientOrderID, orderData.ClientOrderID)
 	assert.Equal(t, pkgStructs.OrderStatusFilled, orderData.Status)
@@ -140,7 +140,7 @@ func TestGetOrderDataCovertError(t *testing.T) {
 	_, err := a.GetOrderData(testPairSymbol, testOrderID)
 
 	// then
-	require.ErrorContains(t, err, "invalid syntax")
+	require.ErrorContains(t, err, "can't convert")
 }
 
 func TestGetOrderByClientOrderIDSuccess(t *testing.T) {
@@ -175,9 +175,9 @@ func TestGetOrderByClientOrderIDSuccess(t *testing.T) {
 	// then
 	require.NoError(t, err)
 	assert.Equal(t, testPairSymbol, orderData.Symbol)
-	assert.Equal(t, float64(1.012), orderData.Price)
-	assert.Equal(t, float64(125.1564), orderData.AwaitQty)
-	assert.Equal(t, float64(0.12), orderData.FilledQty)
+	assert.True(t, orderData.Price.Equal(decimal.NewFromFloat(1.012)))
+	assert.True(t, orderData.AwaitQty.Equal(decimal.NewFromFloat(125.1564)))
+	assert.True(t, orderData.FilledQty.Equal(decimal.NewFromFloat(0.12)))
 }
 
 func TestGetOrderByClientOrderIDNotSet(t *testing.T) {
@@ -294,8 +294,8 @@ func TestPlaceOrderSucess(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, order.PairSymbol, response.Symbol)
 	assert.Equal(t, order.Type, response.Type)
-	assert.Equal(t, float64(0.1005), response.OrigQuantity)
-	assert.Equal(t, float64(102.1924), response.Price)
+	assert.True(t, response.OrigQuantity.Equal(decimal.NewFromFloat(0.1005)))
+	assert.True(t, response.Price.Equal(decimal.NewFromFloat(102.1924)))
 }
 
 func TestPlaceOrderInvalidOrderSide(t *testing.T) {
@@ -369,7 +369,7 @@ func TestPlaceOrderConvertError(t *testing.T) {
 	_, err := a.PlaceOrder(context.Background(), order)
 
 	// then
-	require.ErrorContains(t, err, "invalid syntax")
+	require.ErrorContains(t, err, "can't convert")
 }
 
 func TestGetOrderExecFeeSuccess(t *testing.T) {
@@ -478,3 +478,54 @@ func TestGetOrderExecFeeParseError(t *testing.T) {
 	// then
 	require.ErrorContains(t, err, "parse exec order fee")
 }
+
+func TestGetTradeFeesSuccess(t *testing.T) {
+	// given
+	w := wrapper.NewMockBinanceAPIWrapper(gomock.NewController(t))
+	a := New(w)
+
+	w.EXPECT().GetTradeFee(context.Background(), testPairSymbol).
+		Return(&binance.TradeFeeDetails{
+			Symbol:          testPairSymbol,
+			MakerCommission: "0.001",
+			TakerCommission: "0.002",
+		}, nil)
+
+	// when
+	fees, err := a.GetTradeFees(testPairSymbol)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, testPairSymbol, fees.Symbol)
+	assert.True(t, fees.Maker.Equal(decimal.NewFromFloat(0.001)))
+	assert.True(t, fees.Taker.Equal(decimal.NewFromFloat(0.002)))
+}
+
+func TestGetTradeFeesError(t *testing.T) {
+	// given
+	w := wrapper.NewMockBinanceAPIWrapper(gomock.NewController(t))
+	a := New(w)
+
+	w.EXPECT().GetTradeFee(context.Background(), testPairSymbol).
+		Return(nil, errors.New("some error"))
+
+	// when
+	_, err := a.GetTradeFees(testPairSymbol)
+
+	// then
+	require.ErrorContains(t, err, "some error")
+}
+
+func TestGetHistoryOrderNotSupported(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	w := wrapper.NewMockBinanceAPIWrapper(ctrl)
+	a := New(w)
+
+	// when
+	_, err := a.GetHistoryOrder(testPairSymbol, testOrderID)
+
+	// then
+	require.ErrorIs(t, err, pkgErrs.ErrNotSupported)
+	assert.False(t, a.Capabilities().OrderHistory)
+}
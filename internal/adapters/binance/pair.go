@@ -4,20 +4,22 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/errs"
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/mappers"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
 )
 
-func (a *adapter) GetPairLastPrice(pairSymbol string) (float64, error) {
+func (a *adapter) GetPairLastPrice(pairSymbol string) (decimal.Decimal, error) {
 	prices, err := a.binanceAPI.GetPrices(context.Background(), pairSymbol)
 	if err != nil {
-		return 0, fmt.Errorf("get pair last price: %w", err)
+		return decimal.Zero, fmt.Errorf("get pair last price: %w", err)
 	}
 
 	lastPrice, err := mappers.GetPairPrice(prices, pairSymbol)
 	if err != nil {
-		return 0, fmt.Errorf("convert pair price: %w", err)
+		return decimal.Zero, fmt.Errorf("convert pair price: %w", err)
 	}
 	return lastPrice, nil
 }
@@ -37,7 +37,7 @@ func TestGetPairLastPriceSuccess(t *testing.T) {
 
 	// then
 	require.NoError(t, err)
-	assert.Equal(t, float64(65.01294), lastPrice)
+	assert.Equal(t, "65.01294", lastPrice.String())
 }
 
 func TestGetPairLastPriceError(t *testing.T) {
@@ -74,7 +74,7 @@ func TestGetPairLastPriceConvertError(t *testing.T) {
 	_, err := a.GetPairLastPrice(testPairSymbol)
 
 	// then
-	require.ErrorContains(t, err, "invalid syntax")
+	require.ErrorContains(t, err, "parse price")
 }
 
 func TestCancelPairOrderSuccess(t *testing.T) {
@@ -0,0 +1,67 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+func (a *adapter) GetAccountBalances(accountType consts.AccountType) ([]structs.Balance, error) {
+	switch accountType {
+	default:
+		return nil, fmt.Errorf("%w: %q", pkgErrs.ErrAccountTypeNotSupported, accountType)
+	case consts.AccountTypeSpot:
+		return a.GetAccountBalance()
+	case consts.AccountTypeFunding:
+		assets, err := a.binanceAPI.GetFundingAssets(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("get funding assets: %w", err)
+		}
+		return mappers.ConvertFundingBalances(assets)
+	}
+}
+
+func (a *adapter) Transfer(
+	asset string,
+	amount decimal.Decimal,
+	fromAccount consts.AccountType,
+	toAccount consts.AccountType,
+) (structs.TransferResult, error) {
+	if asset == "" {
+		return structs.TransferResult{}, errors.New("asset is not set")
+	}
+	if !amount.IsPositive() {
+		return structs.TransferResult{}, fmt.Errorf("invalid amount: %s", amount.String())
+	}
+
+	transferType, err := mappers.GetTransferType(fromAccount, toAccount)
+	if err != nil {
+		return structs.TransferResult{}, err
+	}
+
+	response, err := a.binanceAPI.Transfer(
+		context.Background(),
+		transferType,
+		asset,
+		amount.InexactFloat64(),
+	)
+	if err != nil {
+		return structs.TransferResult{}, fmt.Errorf("transfer: %w", mappers.MapTransferError(err))
+	}
+
+	return structs.TransferResult{
+		ID:          strconv.FormatInt(response.ID, 10),
+		Asset:       asset,
+		Amount:      amount,
+		FromAccount: fromAccount,
+		ToAccount:   toAccount,
+	}, nil
+}
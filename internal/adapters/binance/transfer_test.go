@@ -0,0 +1,119 @@
+package binance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+func TestGetAccountBalancesFunding(t *testing.T) {
+	// given
+	w := wrapper.NewMockBinanceAPIWrapper(gomock.NewController(t))
+	a := New(w)
+
+	w.EXPECT().GetFundingAssets(context.Background()).Return([]binance.UserAssetRecord{
+		{Asset: "USDT", Free: "150", Locked: "0"},
+	}, nil)
+
+	// when
+	balances, err := a.GetAccountBalances(consts.AccountTypeFunding)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	assert.Equal(t, "USDT", balances[0].Asset)
+	assert.Equal(t, "150", balances[0].Free.String())
+}
+
+func TestGetAccountBalancesNotSupported(t *testing.T) {
+	// given
+	a := New(wrapper.NewMockBinanceAPIWrapper(gomock.NewController(t)))
+
+	// when
+	_, err := a.GetAccountBalances(consts.AccountTypeUnified)
+
+	// then
+	require.ErrorIs(t, err, pkgErrs.ErrAccountTypeNotSupported)
+}
+
+func TestTransferSuccess(t *testing.T) {
+	// given
+	w := wrapper.NewMockBinanceAPIWrapper(gomock.NewController(t))
+	a := New(w)
+
+	w.EXPECT().Transfer(
+		context.Background(),
+		binance.UserUniversalTransferTypeFundingToMain,
+		"USDT",
+		float64(10.5),
+	).Return(&binance.CreateUserUniversalTransferResponse{ID: 13526853623}, nil)
+
+	// when
+	result, err := a.Transfer(
+		"USDT",
+		decimal.NewFromFloat(10.5),
+		consts.AccountTypeFunding,
+		consts.AccountTypeSpot,
+	)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "13526853623", result.ID)
+	assert.Equal(t, "USDT", result.Asset)
+	assert.Equal(t, "10.5", result.Amount.String())
+	assert.Equal(t, consts.AccountTypeFunding, result.FromAccount)
+	assert.Equal(t, consts.AccountTypeSpot, result.ToAccount)
+}
+
+func TestTransferInsufficientBalance(t *testing.T) {
+	// given
+	w := wrapper.NewMockBinanceAPIWrapper(gomock.NewController(t))
+	a := New(w)
+
+	w.EXPECT().Transfer(
+		context.Background(),
+		binance.UserUniversalTransferTypeMainToFunding,
+		"USDT",
+		float64(10),
+	).Return(nil, &common.APIError{
+		Code:    -5013,
+		Message: "Asset transfer failed: insufficient balance",
+	})
+
+	// when
+	_, err := a.Transfer(
+		"USDT",
+		decimal.NewFromInt(10),
+		consts.AccountTypeSpot,
+		consts.AccountTypeFunding,
+	)
+
+	// then
+	require.ErrorIs(t, err, pkgErrs.ErrInsufficientBalance)
+}
+
+func TestTransferInvalidAmount(t *testing.T) {
+	// given
+	a := New(wrapper.NewMockBinanceAPIWrapper(gomock.NewController(t)))
+
+	// when
+	_, err := a.Transfer(
+		"USDT",
+		decimal.Zero,
+		consts.AccountTypeSpot,
+		consts.AccountTypeFunding,
+	)
+
+	// then
+	require.ErrorContains(t, err, "invalid amount")
+}
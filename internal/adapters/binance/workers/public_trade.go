@@ -0,0 +1,50 @@
+package binanceworkers
+
+import (
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	iWorkers "github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+// PublicTradeWorkerBinance - PublicTradeWorker for binance
+type PublicTradeWorkerBinance struct {
+	iWorkers.PublicTradeWorker
+	binanceAPI wrapper.BinanceAPIWrapper
+}
+
+func NewPublicTradeWorker(binanceAPI wrapper.BinanceAPIWrapper) *PublicTradeWorkerBinance {
+	w := &PublicTradeWorkerBinance{
+		binanceAPI: binanceAPI,
+	}
+	w.ExchangeTag = consts.BinanceAdapterTag
+	return w
+}
+
+func (w *PublicTradeWorkerBinance) SubscribeToPublicTrades(
+	pairSymbol string,
+	eventCallback iWorkers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	if w.PublicTradeWorker.IsSubscriptionExists(pairSymbol) {
+		return nil
+	}
+
+	wsDone, wsStop, err := w.binanceAPI.SubscribeToPublicTrades(
+		w.ExchangeTag,
+		pairSymbol,
+		eventCallback,
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe to public trades: %w", err)
+	}
+
+	w.PublicTradeWorker.Save(
+		iWorkers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		pairSymbol,
+	)
+	return nil
+}
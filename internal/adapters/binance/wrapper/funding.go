@@ -0,0 +1,71 @@
+package wrapper
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// go-binance doesn't implement the funding wallet endpoint
+const endpointGetFundingAsset = "/sapi/v1/asset/get-funding-asset"
+
+func (b *BinanceClientWrapper) GetFundingAssets(
+	ctx context.Context,
+) ([]binance.UserAssetRecord, error) {
+	params := url.Values{}
+	params.Set(
+		"timestamp",
+		strconv.FormatInt(time.Now().UnixMilli()-b.TimeOffset, 10),
+	)
+
+	h := hmac.New(sha256.New, []byte(b.SecretKey))
+	h.Write([]byte(params.Encode()))
+	params.Set("signature", hex.EncodeToString(h.Sum(nil)))
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		b.BaseURL+endpointGetFundingAsset+"?"+params.Encode(),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("X-MBX-APIKEY", b.APIKey)
+
+	resp, err := b.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		apiErr := &common.APIError{}
+		if err := json.Unmarshal(body, apiErr); err != nil || !apiErr.IsValid() {
+			apiErr.Response = body
+		}
+		return nil, apiErr
+	}
+
+	var result []binance.UserAssetRecord
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return result, nil
+}
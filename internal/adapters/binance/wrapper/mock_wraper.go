@@ -12,6 +12,7 @@ package wrapper
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	binance "github.com/adshao/go-binance/v2"
 	workers "github.com/matrixbotio/exchange-gates-lib/internal/workers"
@@ -84,6 +85,21 @@ func (mr *MockBinanceAPIWrapperMockRecorder) Connect(ctx, keyPublic, keySecret a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).Connect), ctx, keyPublic, keySecret)
 }
 
+// GetAPIKeyPermission mocks base method.
+func (m *MockBinanceAPIWrapper) GetAPIKeyPermission(arg0 context.Context) (*binance.APIKeyPermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeyPermission", arg0)
+	ret0, _ := ret[0].(*binance.APIKeyPermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeyPermission indicates an expected call of GetAPIKeyPermission.
+func (mr *MockBinanceAPIWrapperMockRecorder) GetAPIKeyPermission(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeyPermission", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).GetAPIKeyPermission), arg0)
+}
+
 // GetAccountData mocks base method.
 func (m *MockBinanceAPIWrapper) GetAccountData(arg0 context.Context) (*binance.Account, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +115,21 @@ func (mr *MockBinanceAPIWrapperMockRecorder) GetAccountData(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountData", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).GetAccountData), arg0)
 }
 
+// GetAccountTrades mocks base method.
+func (m *MockBinanceAPIWrapper) GetAccountTrades(ctx context.Context, pairSymbol string, startTime, endTime, fromID int64, limit int) ([]*binance.TradeV3, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountTrades", ctx, pairSymbol, startTime, endTime, fromID, limit)
+	ret0, _ := ret[0].([]*binance.TradeV3)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTrades indicates an expected call of GetAccountTrades.
+func (mr *MockBinanceAPIWrapperMockRecorder) GetAccountTrades(ctx, pairSymbol, startTime, endTime, fromID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTrades", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).GetAccountTrades), ctx, pairSymbol, startTime, endTime, fromID, limit)
+}
+
 // GetExchangeInfo mocks base method.
 func (m *MockBinanceAPIWrapper) GetExchangeInfo(ctx context.Context, pairSymbol string) (*binance.ExchangeInfo, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +145,36 @@ func (mr *MockBinanceAPIWrapperMockRecorder) GetExchangeInfo(ctx, pairSymbol any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeInfo", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).GetExchangeInfo), ctx, pairSymbol)
 }
 
+// GetFundingAssets mocks base method.
+func (m *MockBinanceAPIWrapper) GetFundingAssets(ctx context.Context) ([]binance.UserAssetRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFundingAssets", ctx)
+	ret0, _ := ret[0].([]binance.UserAssetRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFundingAssets indicates an expected call of GetFundingAssets.
+func (mr *MockBinanceAPIWrapperMockRecorder) GetFundingAssets(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFundingAssets", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).GetFundingAssets), ctx)
+}
+
+// GetIsolatedMarginAccount mocks base method.
+func (m *MockBinanceAPIWrapper) GetIsolatedMarginAccount(ctx context.Context, pairSymbol string) (*binance.IsolatedMarginAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIsolatedMarginAccount", ctx, pairSymbol)
+	ret0, _ := ret[0].(*binance.IsolatedMarginAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIsolatedMarginAccount indicates an expected call of GetIsolatedMarginAccount.
+func (mr *MockBinanceAPIWrapperMockRecorder) GetIsolatedMarginAccount(ctx, pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIsolatedMarginAccount", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).GetIsolatedMarginAccount), ctx, pairSymbol)
+}
+
 // GetKlines mocks base method.
 func (m *MockBinanceAPIWrapper) GetKlines(ctx context.Context, pairSymbol, interval string, limit int) ([]*binance.Kline, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +190,21 @@ func (mr *MockBinanceAPIWrapperMockRecorder) GetKlines(ctx, pairSymbol, interval
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKlines", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).GetKlines), ctx, pairSymbol, interval, limit)
 }
 
+// GetMarginAccount mocks base method.
+func (m *MockBinanceAPIWrapper) GetMarginAccount(ctx context.Context) (*binance.MarginAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMarginAccount", ctx)
+	ret0, _ := ret[0].(*binance.MarginAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMarginAccount indicates an expected call of GetMarginAccount.
+func (mr *MockBinanceAPIWrapperMockRecorder) GetMarginAccount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarginAccount", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).GetMarginAccount), ctx)
+}
+
 // GetOpenOrders mocks base method.
 func (m *MockBinanceAPIWrapper) GetOpenOrders(ctx context.Context, pairSymbol string) ([]*binance.Order, error) {
 	m.ctrl.T.Helper()
@@ -189,6 +265,21 @@ func (mr *MockBinanceAPIWrapperMockRecorder) GetOrderTradeHistory(ctx, orderID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderTradeHistory", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).GetOrderTradeHistory), ctx, orderID, pairSymbol)
 }
 
+// GetOrders mocks base method.
+func (m *MockBinanceAPIWrapper) GetOrders(ctx context.Context, pairSymbol string, fromOrderID int64, limit int) ([]*binance.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrders", ctx, pairSymbol, fromOrderID, limit)
+	ret0, _ := ret[0].([]*binance.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrders indicates an expected call of GetOrders.
+func (mr *MockBinanceAPIWrapperMockRecorder) GetOrders(ctx, pairSymbol, fromOrderID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).GetOrders), ctx, pairSymbol, fromOrderID, limit)
+}
+
 // GetPrices mocks base method.
 func (m *MockBinanceAPIWrapper) GetPrices(ctx context.Context, pairSymbol string) ([]*binance.SymbolPrice, error) {
 	m.ctrl.T.Helper()
@@ -204,6 +295,66 @@ func (mr *MockBinanceAPIWrapperMockRecorder) GetPrices(ctx, pairSymbol any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrices", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).GetPrices), ctx, pairSymbol)
 }
 
+// GetServerTime mocks base method.
+func (m *MockBinanceAPIWrapper) GetServerTime(arg0 context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerTime indicates an expected call of GetServerTime.
+func (mr *MockBinanceAPIWrapperMockRecorder) GetServerTime(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerTime", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).GetServerTime), arg0)
+}
+
+// GetTradeFee mocks base method.
+func (m *MockBinanceAPIWrapper) GetTradeFee(ctx context.Context, pairSymbol string) (*binance.TradeFeeDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTradeFee", ctx, pairSymbol)
+	ret0, _ := ret[0].(*binance.TradeFeeDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTradeFee indicates an expected call of GetTradeFee.
+func (mr *MockBinanceAPIWrapperMockRecorder) GetTradeFee(ctx, pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTradeFee", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).GetTradeFee), ctx, pairSymbol)
+}
+
+// MarginLoan mocks base method.
+func (m *MockBinanceAPIWrapper) MarginLoan(ctx context.Context, asset, amount, isolatedPairSymbol string) (*binance.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarginLoan", ctx, asset, amount, isolatedPairSymbol)
+	ret0, _ := ret[0].(*binance.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarginLoan indicates an expected call of MarginLoan.
+func (mr *MockBinanceAPIWrapperMockRecorder) MarginLoan(ctx, asset, amount, isolatedPairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarginLoan", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).MarginLoan), ctx, asset, amount, isolatedPairSymbol)
+}
+
+// MarginRepay mocks base method.
+func (m *MockBinanceAPIWrapper) MarginRepay(ctx context.Context, asset, amount, isolatedPairSymbol string) (*binance.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarginRepay", ctx, asset, amount, isolatedPairSymbol)
+	ret0, _ := ret[0].(*binance.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarginRepay indicates an expected call of MarginRepay.
+func (mr *MockBinanceAPIWrapperMockRecorder) MarginRepay(ctx, asset, amount, isolatedPairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarginRepay", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).MarginRepay), ctx, asset, amount, isolatedPairSymbol)
+}
+
 // Ping mocks base method.
 func (m *MockBinanceAPIWrapper) Ping(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -233,6 +384,21 @@ func (mr *MockBinanceAPIWrapperMockRecorder) PlaceLimitOrder(ctx, pairSymbol, or
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceLimitOrder", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).PlaceLimitOrder), ctx, pairSymbol, orderSide, qty, price, optionalClientOrderID)
 }
 
+// PlaceMarginOrder mocks base method.
+func (m *MockBinanceAPIWrapper) PlaceMarginOrder(ctx context.Context, task MarginOrderTask) (*binance.CreateOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceMarginOrder", ctx, task)
+	ret0, _ := ret[0].(*binance.CreateOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceMarginOrder indicates an expected call of PlaceMarginOrder.
+func (mr *MockBinanceAPIWrapperMockRecorder) PlaceMarginOrder(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceMarginOrder", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).PlaceMarginOrder), ctx, task)
+}
+
 // PlaceMarketOrder mocks base method.
 func (m *MockBinanceAPIWrapper) PlaceMarketOrder(ctx context.Context, pairSymbol string, orderSide binance.SideType, qty, price, optionalClientOrderID string) (*binance.CreateOrderResponse, error) {
 	m.ctrl.T.Helper()
@@ -248,6 +414,18 @@ func (mr *MockBinanceAPIWrapperMockRecorder) PlaceMarketOrder(ctx, pairSymbol, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceMarketOrder", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).PlaceMarketOrder), ctx, pairSymbol, orderSide, qty, price, optionalClientOrderID)
 }
 
+// SetServerTimeOffset mocks base method.
+func (m *MockBinanceAPIWrapper) SetServerTimeOffset(offset time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetServerTimeOffset", offset)
+}
+
+// SetServerTimeOffset indicates an expected call of SetServerTimeOffset.
+func (mr *MockBinanceAPIWrapperMockRecorder) SetServerTimeOffset(offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServerTimeOffset", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).SetServerTimeOffset), offset)
+}
+
 // SubscribeToCandle mocks base method.
 func (m *MockBinanceAPIWrapper) SubscribeToCandle(pairSymbol, interval string, eventCallback func(workers.CandleEvent), errorHandler func(error)) (chan struct{}, chan struct{}, error) {
 	m.ctrl.T.Helper()
@@ -296,6 +474,22 @@ func (mr *MockBinanceAPIWrapperMockRecorder) SubscribeToPriceEvents(pairSymbol,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToPriceEvents", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).SubscribeToPriceEvents), pairSymbol, eventCallback, errorHandler)
 }
 
+// SubscribeToPublicTrades mocks base method.
+func (m *MockBinanceAPIWrapper) SubscribeToPublicTrades(exchangeTag, pairSymbol string, eventCallback workers.PublicTradeEventCallback, errorHandler func(error)) (chan struct{}, chan struct{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToPublicTrades", exchangeTag, pairSymbol, eventCallback, errorHandler)
+	ret0, _ := ret[0].(chan struct{})
+	ret1, _ := ret[1].(chan struct{})
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SubscribeToPublicTrades indicates an expected call of SubscribeToPublicTrades.
+func (mr *MockBinanceAPIWrapperMockRecorder) SubscribeToPublicTrades(exchangeTag, pairSymbol, eventCallback, errorHandler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToPublicTrades", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).SubscribeToPublicTrades), exchangeTag, pairSymbol, eventCallback, errorHandler)
+}
+
 // SubscribeToTradeEventsPrivate mocks base method.
 func (m *MockBinanceAPIWrapper) SubscribeToTradeEventsPrivate(exchangeTag string, callback workers.TradeEventPrivateCallback, handler func(error)) (chan struct{}, chan struct{}, error) {
 	m.ctrl.T.Helper()
@@ -323,3 +517,18 @@ func (mr *MockBinanceAPIWrapperMockRecorder) Sync(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).Sync), arg0)
 }
+
+// Transfer mocks base method.
+func (m *MockBinanceAPIWrapper) Transfer(ctx context.Context, transferType binance.UserUniversalTransferType, asset string, amount float64) (*binance.CreateUserUniversalTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", ctx, transferType, asset, amount)
+	ret0, _ := ret[0].(*binance.CreateUserUniversalTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockBinanceAPIWrapperMockRecorder) Transfer(ctx, transferType, asset, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockBinanceAPIWrapper)(nil).Transfer), ctx, transferType, asset, amount)
+}
@@ -3,22 +3,32 @@ package wrapper
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
 
 	"github.com/adshao/go-binance/v2"
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/stream"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
 )
 
 type BinanceAPIWrapper interface {
 	Sync(context.Context)
+	// GetServerTime - get binance server time
+	GetServerTime(context.Context) (time.Time, error)
+	// SetServerTimeOffset - set server time minus local time for the signed requests
+	SetServerTimeOffset(offset time.Duration)
 	Connect(ctx context.Context, keyPublic, keySecret string) error
 	Ping(context.Context) error
 	GetAccountData(context.Context) (*binance.Account, error)
+	// GetAPIKeyPermission - get the API key restrictions
+	GetAPIKeyPermission(context.Context) (*binance.APIKeyPermission, error)
 
 	GetPrices(
 		ctx context.Context,
@@ -109,19 +119,107 @@ type BinanceAPIWrapper interface {
 		handler func(err error),
 	) (doneC chan struct{}, stopC chan struct{}, err error)
 
+	SubscribeToPublicTrades(
+		exchangeTag string,
+		pairSymbol string,
+		eventCallback workers.PublicTradeEventCallback,
+		errorHandler func(err error),
+	) (doneC chan struct{}, stopC chan struct{}, err error)
+
 	GetOrderTradeHistory(
 		ctx context.Context,
 		orderID int64,
 		pairSymbol string,
 	) ([]*binance.TradeV3, error)
+	// GetAccountTrades - get trades by time range or from trade ID when fromID is set
+	GetAccountTrades(
+		ctx context.Context,
+		pairSymbol string,
+		startTime int64,
+		endTime int64,
+		fromID int64,
+		limit int,
+	) ([]*binance.TradeV3, error)
+	// GetOrders - get all account orders from order ID
+	GetOrders(
+		ctx context.Context,
+		pairSymbol string,
+		fromOrderID int64,
+		limit int,
+	) ([]*binance.Order, error)
+
+	GetTradeFee(
+		ctx context.Context,
+		pairSymbol string,
+	) (*binance.TradeFeeDetails, error)
+
+	// GetFundingAssets - get funding wallet balances
+	GetFundingAssets(ctx context.Context) ([]binance.UserAssetRecord, error)
+
+	// Transfer - universal transfer between the wallets
+	Transfer(
+		ctx context.Context,
+		transferType binance.UserUniversalTransferType,
+		asset string,
+		amount float64,
+	) (*binance.CreateUserUniversalTransferResponse, error)
+
+	// GetMarginAccount - get cross margin account data
+	GetMarginAccount(ctx context.Context) (*binance.MarginAccount, error)
+
+	// GetIsolatedMarginAccount - get isolated margin account data for the pair
+	GetIsolatedMarginAccount(
+		ctx context.Context,
+		pairSymbol string,
+	) (*binance.IsolatedMarginAccount, error)
+
+	// MarginLoan - borrow the asset. Isolated margin is used when the pair symbol is set
+	MarginLoan(
+		ctx context.Context,
+		asset string,
+		amount string,
+		isolatedPairSymbol string,
+	) (*binance.TransactionResponse, error)
+
+	// MarginRepay - repay the debt. Isolated margin is used when the pair symbol is set
+	MarginRepay(
+		ctx context.Context,
+		asset string,
+		amount string,
+		isolatedPairSymbol string,
+	) (*binance.TransactionResponse, error)
+
+	PlaceMarginOrder(
+		ctx context.Context,
+		task MarginOrderTask,
+	) (*binance.CreateOrderResponse, error)
+}
+
+// MarginOrderTask - margin order placement params
+type MarginOrderTask struct {
+	PairSymbol    string
+	Side          binance.SideType
+	IsMarketOrder bool
+	Qty           string
+	Price         string
+	ClientOrderID string // optional
+	IsIsolated    bool
+	SideEffect    binance.SideEffectType
 }
 
+const (
+	wsBaseURL        = "wss://stream.binance.com:9443"
+	wsTestnetBaseURL = "wss://testnet.binance.vision"
+)
+
 type BinanceClientWrapper struct {
 	*binance.Client
+
+	cfg config.Config
 }
 
-func NewWrapper() BinanceAPIWrapper {
-	return &BinanceClientWrapper{}
+func NewWrapper(opts ...config.Option) BinanceAPIWrapper {
+	return &BinanceClientWrapper{cfg: config.New(opts...)}
 }
 
 func (b *BinanceClientWrapper) Sync(ctx context.Context) {
@@ -129,12 +227,28 @@ func (b *BinanceClientWrapper) Sync(ctx context.Context) {
 	b.NewSetServerTimeService().Do(ctx)
 }
 
+func (b *BinanceClientWrapper) GetServerTime(ctx context.Context) (time.Time, error) {
+	// unix timestamp ms
+	serverTime, err := b.NewServerTimeService().Do(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(serverTime), nil
+}
+
+func (b *BinanceClientWrapper) SetServerTimeOffset(offset time.Duration) {
+	// binance client offset is local time minus server time, ms
+	b.TimeOffset = -offset.Milliseconds()
+}
+
 func (b *BinanceClientWrapper) Connect(
 	ctx context.Context,
 	keyPublic,
 	keySecret string,
 ) error {
 	b.Client = binance.NewClient(keyPublic, keySecret)
+	b.BaseURL = b.cfg.GetRESTBaseURL(binance.BaseAPIMainURL, binance.BaseAPITestnetURL)
+	b.HTTPClient = b.cfg.NewHTTPClient(0)
 	if err := b.Ping(ctx); err != nil {
 		return fmt.Errorf("ping binance: %w", err)
 	}
@@ -179,6 +293,13 @@ func (b *BinanceClientWrapper) GetAccountData(ctx context.Context) (
 	return b.NewGetAccountService().Do(ctx)
 }
 
+func (b *BinanceClientWrapper) GetAPIKeyPermission(ctx context.Context) (
+	*binance.APIKeyPermission,
+	error,
+) {
+	return b.NewGetAPIKeyPermission().Do(ctx)
+}
+
 func (b *BinanceClientWrapper) GetPrices(
 	ctx context.Context,
 	pairSymbol string,
@@ -280,10 +401,19 @@ func (b *BinanceClientWrapper) SubscribeToCandle(
 	eventCallback func(event workers.CandleEvent),
 	errorHandler func(err error),
 ) (doneC chan struct{}, stopC chan struct{}, err error) {
-	return binance.WsKlineServe(
-		pairSymbol,
-		interval,
-		helpers.GetCandleEventsHandler(eventCallback, errorHandler),
+	candleHandler := helpers.GetCandleEventsHandler(eventCallback, errorHandler)
+
+	return b.wsServe(
+		stream.GetRawURL(b.getWsBaseURL(), getKlineStreamName(pairSymbol, interval)),
+		func(message []byte) {
+			event := new(binance.WsKlineEvent)
+			if err := json.Unmarshal(message, event); err != nil {
+				errorHandler(fmt.Errorf("decode candle event: %w", err))
+				return
+			}
+
+			candleHandler(event)
+		},
 		errorHandler,
 	)
 }
@@ -293,9 +423,32 @@ func (b *BinanceClientWrapper) SubscribeToCandlesList(
 	eventCallback func(event workers.CandleEvent),
 	errorHandler func(err error),
 ) (doneC chan struct{}, stopC chan struct{}, err error) {
-	return binance.WsCombinedKlineServe(
-		intervalsPerPair,
-		helpers.GetCandleEventsHandler(eventCallback, errorHandler),
+	streamNames := make([]string, 0, len(intervalsPerPair))
+	for pairSymbol, interval := range intervalsPerPair {
+		streamNames = append(streamNames, getKlineStreamName(pairSymbol, interval))
+	}
+
+	candleHandler := helpers.GetCandleEventsHandler(eventCallback, errorHandler)
+
+	return b.wsServe(
+		stream.GetCombinedURL(b.getWsBaseURL(), streamNames),
+		func(message []byte) {
+			streamName, data, err := stream.ParseCombined(message)
+			if err != nil {
+				errorHandler(fmt.Errorf("parse combined stream: %w", err))
+				return
+			}
+
+			event := new(binance.WsKlineEvent)
+			if err := json.Unmarshal(data, event); err != nil {
+				errorHandler(fmt.Errorf("decode candle event: %w", err))
+				return
+			}
+			// stream name: <lowercase symbol>@kline_<interval>
+			event.Symbol = strings.ToUpper(strings.Split(streamName, "@")[0])
+
+			candleHandler(event)
+		},
 		errorHandler,
 	)
 }
@@ -305,9 +458,17 @@ func (b *BinanceClientWrapper) SubscribeToPriceEvents(
 	eventCallback binance.WsBookTickerHandler,
 	errorHandler func(err error),
 ) (doneC chan struct{}, stopC chan struct{}, err error) {
-	return binance.WsBookTickerServe(
-		pairSymbol,
-		eventCallback,
+	return b.wsServe(
+		stream.GetRawURL(b.getWsBaseURL(), strings.ToLower(pairSymbol)+"@bookTicker"),
+		func(message []byte) {
+			event := new(binance.WsBookTickerEvent)
+			if err := json.Unmarshal(message, event); err != nil {
+				errorHandler(fmt.Errorf("decode book ticker event: %w", err))
+				return
+			}
+
+			eventCallback(event)
+		},
 		errorHandler,
 	)
 }
@@ -322,8 +483,10 @@ func (b *BinanceClientWrapper) SubscribeToTradeEventsPrivate(
 		return nil, nil, err
 	}
 
-	binanceEventCallback := func(event *binance.WsUserDataEvent) {
-		if event == nil {
+	wsHandler := func(message []byte) {
+		event, err := parseUserDataEvent(message)
+		if err != nil {
+			errorHandler(fmt.Errorf("decode user data event: %w", err))
 			return
 		}
 
@@ -341,7 +504,8 @@ func (b *BinanceClientWrapper) SubscribeToTradeEventsPrivate(
 		eventCallback(wEvent)
 	}
 
-	doneC, bStopC, err := binance.WsUserDataServe(listenKey, binanceEventCallback, errorHandler)
+	wsURL := stream.GetRawURL(b.getWsBaseURL(), listenKey)
+	doneC, bStopC, err := b.wsServe(wsURL, wsHandler, errorHandler)
 	if err != nil {
 		return nil, nil, fmt.Errorf("serve: %w", err)
 	}
@@ -356,7 +520,7 @@ func (b *BinanceClientWrapper) SubscribeToTradeEventsPrivate(
 				if err != nil {
 					b.Logger.Println(err)
 
-					doneC, bStopC, err = binance.WsUserDataServe(listenKey, binanceEventCallback, errorHandler)
+					doneC, bStopC, err = b.wsServe(wsURL, wsHandler, errorHandler)
 					if err != nil {
 						b.Logger.Println("Failed to reestablish WebSocket connection:", err)
 						return
@@ -372,6 +536,66 @@ func (b *BinanceClientWrapper) SubscribeToTradeEventsPrivate(
 	return doneC, stopC, nil
 }
 
+func (b *BinanceClientWrapper) SubscribeToPublicTrades(
+	exchangeTag string,
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) (doneC chan struct{}, stopC chan struct{}, err error) {
+	return b.wsServe(
+		stream.GetRawURL(b.getWsBaseURL(), strings.ToLower(pairSymbol)+"@aggTrade"),
+		func(message []byte) {
+			event := new(binance.WsAggTradeEvent)
+			if err := json.Unmarshal(message, event); err != nil {
+				errorHandler(fmt.Errorf("decode public trade event: %w", err))
+				return
+			}
+
+			wEvent, err := mappers.ConvertPublicTradeEvent(*event, exchangeTag)
+			if err != nil {
+				errorHandler(fmt.Errorf("convert public trade event: %w", err))
+				return
+			}
+
+			eventCallback(wEvent)
+		},
+		errorHandler,
+	)
+}
+
+// getWsBaseURL - the streams host, the adapter ws base URL takes precedence
+func (b *BinanceClientWrapper) getWsBaseURL() string {
+	return b.cfg.GetWsBaseURL(wsBaseURL, wsTestnetBaseURL)
+}
+
+// wsServe - serve the stream with the adapter dialer: proxy & local address
+func (b *BinanceClientWrapper) wsServe(
+	wsURL string,
+	handler func(message []byte),
+	errorHandler func(err error),
+) (doneC, stopC chan struct{}, err error) {
+	return stream.Serve(b.cfg.NewWsDialer(), wsURL, handler, errorHandler)
+}
+
+func getKlineStreamName(pairSymbol, interval string) string {
+	return strings.ToLower(pairSymbol) + "@kline_" + interval
+}
+
+// parseUserDataEvent - the order update is decoded for the execution report only
+func parseUserDataEvent(message []byte) (*binance.WsUserDataEvent, error) {
+	event := new(binance.WsUserDataEvent)
+	if err := json.Unmarshal(message, event); err != nil {
+		return nil, err
+	}
+
+	if event.Event == binance.UserDataEventTypeExecutionReport {
+		if err := json.Unmarshal(message, &event.OrderUpdate); err != nil {
+			return nil, fmt.Errorf("order update: %w", err)
+		}
+	}
+	return event, nil
+}
+
 func (b *BinanceClientWrapper) GetOrderTradeHistory(
 	ctx context.Context,
 	orderID int64,
@@ -380,3 +604,123 @@ func (b *BinanceClientWrapper) GetOrderTradeHistory(
 	return b.NewListTradesService().OrderId(orderID).
 		Symbol(pairSymbol).Do(ctx)
 }
+
+func (b *BinanceClientWrapper) GetAccountTrades(
+	ctx context.Context,
+	pairSymbol string,
+	startTime int64,
+	endTime int64,
+	fromID int64,
+	limit int,
+) ([]*binance.TradeV3, error) {
+	service := b.NewListTradesService().Symbol(pairSymbol).Limit(limit)
+	if fromID > 0 {
+		// time range can't be combined with trade ID
+		return service.FromID(fromID).Do(ctx)
+	}
+
+	return service.StartTime(startTime).EndTime(endTime).Do(ctx)
+}
+
+func (b *BinanceClientWrapper) GetOrders(
+	ctx context.Context,
+	pairSymbol string,
+	fromOrderID int64,
+	limit int,
+) ([]*binance.Order, error) {
+	return b.NewListOrdersService().Symbol(pairSymbol).
+		OrderID(fromOrderID).Limit(limit).Do(ctx)
+}
+
+func (b *BinanceClientWrapper) GetTradeFee(
+	ctx context.Context,
+	pairSymbol string,
+) (*binance.TradeFeeDetails, error) {
+	fees, err := b.NewTradeFeeService().Symbol(pairSymbol).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fee := range fees {
+		if fee.Symbol == pairSymbol {
+			return fee, nil
+		}
+	}
+	return nil, fmt.Errorf("fee for %q not found", pairSymbol)
+}
+
+func (b *BinanceClientWrapper) Transfer(
+	ctx context.Context,
+	transferType binance.UserUniversalTransferType,
+	asset string,
+	amount float64,
+) (*binance.CreateUserUniversalTransferResponse, error) {
+	return b.NewUserUniversalTransferService().
+		Type(transferType).
+		Asset(asset).
+		Amount(amount).
+		Do(ctx)
+}
+
+func (b *BinanceClientWrapper) GetMarginAccount(
+	ctx context.Context,
+) (*binance.MarginAccount, error) {
+	return b.NewGetMarginAccountService().Do(ctx)
+}
+
+func (b *BinanceClientWrapper) GetIsolatedMarginAccount(
+	ctx context.Context,
+	pairSymbol string,
+) (*binance.IsolatedMarginAccount, error) {
+	return b.NewGetIsolatedMarginAccountService().Symbols(pairSymbol).Do(ctx)
+}
+
+func (b *BinanceClientWrapper) MarginLoan(
+	ctx context.Context,
+	asset string,
+	amount string,
+	isolatedPairSymbol string,
+) (*binance.TransactionResponse, error) {
+	loanService := b.NewMarginLoanService().Asset(asset).Amount(amount)
+	if isolatedPairSymbol != "" {
+		loanService.IsIsolated(true).Symbol(isolatedPairSymbol)
+	}
+
+	return loanService.Do(ctx)
+}
+
+func (b *BinanceClientWrapper) MarginRepay(
+	ctx context.Context,
+	asset string,
+	amount string,
+	isolatedPairSymbol string,
+) (*binance.TransactionResponse, error) {
+	repayService := b.NewMarginRepayService().Asset(asset).Amount(amount)
+	if isolatedPairSymbol != "" {
+		repayService.IsIsolated(true).Symbol(isolatedPairSymbol)
+	}
+
+	return repayService.Do(ctx)
+}
+
+func (b *BinanceClientWrapper) PlaceMarginOrder(
+	ctx context.Context,
+	task MarginOrderTask,
+) (*binance.CreateOrderResponse, error) {
+	orderService := b.NewCreateMarginOrderService().Symbol(task.PairSymbol).
+		Side(task.Side).Quantity(task.Qty).
+		IsIsolated(task.IsIsolated).SideEffectType(task.SideEffect)
+
+	if task.IsMarketOrder {
+		orderService.Type(binance.OrderTypeMarket)
+	} else {
+		orderService.Type(binance.OrderTypeLimit).
+			TimeInForce(binance.TimeInForceTypeGTC).Price(task.Price)
+	}
+
+	if task.ClientOrderID != "" {
+		orderService.NewClientOrderID(task.ClientOrderID)
+	}
+
+	return orderService.Do(ctx)
+}
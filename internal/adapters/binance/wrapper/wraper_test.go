@@ -0,0 +1,74 @@
+package wrapper
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+const testCombinedKlineMessage = `{"stream":"btcusdt@kline_1m","data":{` +
+	`"e":"kline","E":1700000000100,"s":"BTCUSDT","k":{"t":1700000000000,` +
+	`"T":1700000059999,"s":"BTCUSDT","i":"1m","o":"37000","c":"37010",` +
+	`"h":"37020","l":"36990","v":"1.5","x":false}}}`
+
+func TestSubscribeToCandlesListWsBaseURL(t *testing.T) {
+	// given
+	paths := make(chan string, 1)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths <- r.URL.RequestURI()
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		if err := conn.WriteMessage(
+			websocket.TextMessage, []byte(testCombinedKlineMessage),
+		); err != nil {
+			return
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	w := NewWrapper(
+		config.WithTestnet(),
+		config.WithWsBaseURL("ws"+strings.TrimPrefix(server.URL, "http")),
+	)
+	events := make(chan workers.CandleEvent, 1)
+
+	// when
+	_, stopC, err := w.SubscribeToCandlesList(
+		map[string]string{"BTCUSDT": "1m"},
+		func(event workers.CandleEvent) { events <- event },
+		func(err error) { t.Errorf("unexpected error: %v", err) },
+	)
+
+	// then
+	require.NoError(t, err)
+	defer close(stopC)
+	assert.Equal(t, "/stream?streams=btcusdt@kline_1m", <-paths)
+
+	select {
+	case event := <-events:
+		assert.Equal(t, "BTCUSDT", event.Symbol)
+		assert.Equal(t, "37010", event.Candle.Close.String())
+	case <-time.After(time.Second * 5):
+		t.Fatal("candle event timeout")
+	}
+}
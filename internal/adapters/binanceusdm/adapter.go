@@ -0,0 +1,156 @@
+package binanceusdm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	adp "github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/errs"
+	binanceMappers "github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
+)
+
+const (
+	adapterName = "Binance USDⓈ-M Futures"
+)
+
+type adapter struct {
+	baseadp.AdapterBase
+	futuresAPI wrapper.BinanceFuturesAPIWrapper
+	// readTimeout - candles request timeout
+	readTimeout time.Duration
+
+	candleWorker      *CandleWorker
+	tradeWorker       *TradeEventWorker
+	publicTradeWorker *PublicTradeWorker
+	positionWorker    *PositionWorker
+}
+
+func New(futuresAPI wrapper.BinanceFuturesAPIWrapper, opts ...config.Option) adp.FuturesAdapter {
+	return &adapter{
+		AdapterBase: baseadp.NewAdapterBase(
+			consts.ExchangeIDbinanceUSDM,
+			adapterName,
+			consts.BinanceUSDMAdapterTag,
+		),
+		futuresAPI:        futuresAPI,
+		readTimeout:       config.New(opts...).GetRequestTimeout(consts.ReadTimeout),
+		candleWorker:      NewCandleWorker(futuresAPI),
+		tradeWorker:       NewTradeEventWorker(futuresAPI),
+		publicTradeWorker: NewPublicTradeWorker(futuresAPI),
+		positionWorker:    NewPositionWorker(futuresAPI),
+	}
+}
+
+func (a *adapter) GetLimits() pkgStructs.ExchangeLimits {
+	return pkgStructs.ExchangeLimits{
+		MaxConnectionsPerBatch:   299,
+		MaxConnectionsInDuration: 5 * time.Minute,
+		MaxTopicsPerWebsocket:    200,
+	}
+}
+
+// Capabilities - GetHistoryOrder is not implemented
+func (a *adapter) Capabilities() pkgStructs.AdapterCapabilities {
+	return pkgStructs.AdapterCapabilities{
+		OrderTypes:    []pkgStructs.OrderType{pkgStructs.OrderTypeLimit, pkgStructs.OrderTypeMarket},
+		TimeInForce:   []pkgStructs.TimeInForce{pkgStructs.TimeInForceGTC},
+		BatchOrders:   false,
+		OrderHistory:  false,
+		AccountTrades: true,
+		FeeLookup:     true,
+		Intervals:     a.GetSupportedIntervals(),
+		Streams: []pkgStructs.StreamType{
+			pkgStructs.StreamCandles,
+			pkgStructs.StreamPublicTrades,
+			pkgStructs.StreamAccountTrades,
+			pkgStructs.StreamPositions,
+		},
+		OrderIDFormat: pkgStructs.OrderIDFormatNumeric,
+	}
+}
+
+func (a *adapter) GetPairSymbol(baseTicker string, quoteTicker string) string {
+	return fmt.Sprintf("%s%s", baseTicker, quoteTicker)
+}
+
+func (a *adapter) GenClientOrderID() string {
+	return utils.GenClientOrderID()
+}
+
+func (a *adapter) Connect(credentials pkgStructs.APICredentials) error {
+	if credentials.Type != pkgStructs.APICredentialsTypeKeypair {
+		return errs.ErrInvalidCredentials
+	}
+
+	if err := a.futuresAPI.Connect(
+		context.Background(),
+		credentials.Keypair.Public,
+		credentials.Keypair.Secret,
+	); err != nil {
+		return fmt.Errorf("binance futures adapter: connect: %w", err)
+	}
+
+	a.futuresAPI.Sync(context.Background())
+	return nil
+}
+
+func (a *adapter) GetServerTime() (time.Time, error) {
+	serverTime, err := a.futuresAPI.GetServerTime(context.Background())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get server time: %w", err)
+	}
+	return serverTime, nil
+}
+
+// SetServerTimeOffset - the offset is reset to the measured one on Connect
+func (a *adapter) SetServerTimeOffset(offset time.Duration) error {
+	a.futuresAPI.SetServerTimeOffset(offset)
+	return nil
+}
+
+func (a *adapter) CanTrade() (bool, error) {
+	data, err := a.futuresAPI.GetAccountData(context.Background())
+	if err != nil {
+		return false, fmt.Errorf("get account data: %w", err)
+	}
+
+	return data.CanTrade, nil
+}
+
+// GetAPIKeyInfo - the key restrictions are common for spot & futures
+func (a *adapter) GetAPIKeyInfo() (pkgStructs.APIKeyInfo, error) {
+	data, err := a.futuresAPI.GetAPIKeyPermission(context.Background())
+	if err != nil {
+		return pkgStructs.APIKeyInfo{}, fmt.Errorf("get API key permission: %w", err)
+	}
+	return binanceMappers.ConvertAPIKeyPermission(data), nil
+}
+
+func (a *adapter) VerifyAPIKeys(keyPublic, keySecret string) error {
+	if err := a.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{
+			Public: keyPublic,
+			Secret: keySecret,
+		},
+	}); err != nil {
+		return fmt.Errorf("binance futures connect: %w", err)
+	}
+
+	accountData, err := a.futuresAPI.GetAccountData(context.Background())
+	if err != nil {
+		return fmt.Errorf("invalid api key: %w", err)
+	}
+
+	if !accountData.CanTrade {
+		return errs.ErrTradingNotAllowed
+	}
+	return nil
+}
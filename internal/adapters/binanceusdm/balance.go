@@ -0,0 +1,90 @@
+package binanceusdm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+
+	binanceErrs "github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/errs"
+	binanceMappers "github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+// GetAccountBalance - get the futures wallet balances
+func (a *adapter) GetAccountBalance() ([]structs.Balance, error) {
+	data, err := a.futuresAPI.GetAccountData(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("get account data: %w", err)
+	}
+
+	if data == nil {
+		return nil, binanceErrs.ErrAccountDataEmpty
+	}
+
+	balances, err := mappers.ConvertAccountBalances(*data)
+	if err != nil {
+		return nil, fmt.Errorf("convert account balance: %w", err)
+	}
+	return balances, nil
+}
+
+// GetAccountBalances - consts.AccountTypeFutures is the trading account
+func (a *adapter) GetAccountBalances(accountType consts.AccountType) ([]structs.Balance, error) {
+	if accountType != consts.AccountTypeFutures {
+		return nil, fmt.Errorf("%w: %q", pkgErrs.ErrAccountTypeNotSupported, accountType)
+	}
+	return a.GetAccountBalance()
+}
+
+func (a *adapter) Transfer(
+	asset string,
+	amount decimal.Decimal,
+	fromAccount consts.AccountType,
+	toAccount consts.AccountType,
+) (structs.TransferResult, error) {
+	if asset == "" {
+		return structs.TransferResult{}, errors.New("asset is not set")
+	}
+	if !amount.IsPositive() {
+		return structs.TransferResult{}, fmt.Errorf("invalid amount: %s", amount.String())
+	}
+
+	transferType, err := binanceMappers.GetTransferType(fromAccount, toAccount)
+	if err != nil {
+		return structs.TransferResult{}, err
+	}
+
+	response, err := a.futuresAPI.Transfer(
+		context.Background(),
+		transferType,
+		asset,
+		amount.InexactFloat64(),
+	)
+	if err != nil {
+		return structs.TransferResult{},
+			fmt.Errorf("transfer: %w", binanceMappers.MapTransferError(err))
+	}
+
+	return structs.TransferResult{
+		ID:          strconv.FormatInt(response.ID, 10),
+		Asset:       asset,
+		Amount:      amount,
+		FromAccount: fromAccount,
+		ToAccount:   toAccount,
+	}, nil
+}
+
+func (a *adapter) GetPairBalance(pair structs.PairSymbolData) (structs.PairBalance, error) {
+	balances, err := a.GetAccountBalance()
+	if err != nil {
+		return structs.PairBalance{}, fmt.Errorf("get pair balance: %w", err)
+	}
+
+	return mappers.FindAssetBalances(balances, pair), nil
+}
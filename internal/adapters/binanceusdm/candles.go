@@ -0,0 +1,42 @@
+package binanceusdm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+func (a *adapter) GetCandles(limit int, pairSymbol string, interval consts.Interval) (
+	[]workers.CandleData,
+	error,
+) {
+	ctx, cancel := context.WithTimeout(context.Background(), a.readTimeout)
+	defer cancel()
+
+	klines, err := a.futuresAPI.GetKlines(
+		ctx, pairSymbol,
+		convertInterval(interval),
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get klines: %w", err)
+	}
+
+	candles, err := mappers.ConvertCandles(klines, interval)
+	if err != nil {
+		return nil, fmt.Errorf("convert candles: %w", err)
+	}
+	return candles, nil
+}
+
+// GetSupportedIntervals - binance supports all intervals in the same format
+func (a *adapter) GetSupportedIntervals() []consts.Interval {
+	return consts.GetIntervals()
+}
+
+func convertInterval(ourFormat consts.Interval) string {
+	return string(ourFormat)
+}
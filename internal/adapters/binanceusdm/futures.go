@@ -0,0 +1,66 @@
+package binanceusdm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/helpers/errs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+func (a *adapter) GetPositions(pairSymbol string) ([]structs.Position, error) {
+	positions, err := a.futuresAPI.GetPositions(context.Background(), pairSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("get positions: %w", err)
+	}
+
+	result, err := mappers.ConvertPositions(positions)
+	if err != nil {
+		return nil, fmt.Errorf("convert: %w", err)
+	}
+	return result, nil
+}
+
+func (a *adapter) SetLeverage(pairSymbol string, leverage int) error {
+	if leverage <= 0 {
+		return fmt.Errorf("invalid leverage: %d", leverage)
+	}
+
+	if err := a.futuresAPI.ChangeLeverage(
+		context.Background(),
+		pairSymbol,
+		leverage,
+	); err != nil {
+		return fmt.Errorf("change leverage: %w", err)
+	}
+	return nil
+}
+
+func (a *adapter) SetMarginMode(pairSymbol string, mode consts.MarginMode) error {
+	marginType, err := mappers.GetMarginType(mode)
+	if err != nil {
+		return err
+	}
+
+	err = a.futuresAPI.ChangeMarginType(context.Background(), pairSymbol, marginType)
+	if err != nil && !errs.IsAPIErrorCode(err, errs.ErrCodeMarginTypeNotModified) {
+		return fmt.Errorf("change margin type: %w", err)
+	}
+	return nil
+}
+
+func (a *adapter) GetFundingInfo(pairSymbol string) (structs.FundingInfo, error) {
+	premiumIndex, err := a.futuresAPI.GetPremiumIndex(context.Background(), pairSymbol)
+	if err != nil {
+		return structs.FundingInfo{}, fmt.Errorf("get premium index: %w", err)
+	}
+
+	for _, item := range premiumIndex {
+		if item != nil && item.Symbol == pairSymbol {
+			return mappers.ConvertFundingInfo(*item)
+		}
+	}
+	return structs.FundingInfo{}, fmt.Errorf("funding info for %q not found", pairSymbol)
+}
@@ -0,0 +1,129 @@
+package binanceusdm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/helpers/errs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func newTestAdapter(t *testing.T) (adapters.FuturesAdapter, *wrapper.MockBinanceFuturesAPIWrapper) {
+	w := wrapper.NewMockBinanceFuturesAPIWrapper(gomock.NewController(t))
+	return New(w), w
+}
+
+func TestGetPositions(t *testing.T) {
+	// given
+	a, w := newTestAdapter(t)
+
+	w.EXPECT().GetPositions(context.Background(), "").
+		Return([]*futures.PositionRisk{
+			{
+				Symbol:           "BTCUSDT",
+				PositionAmt:      "-0.01",
+				EntryPrice:       "64000",
+				MarkPrice:        "63000",
+				LiquidationPrice: "70000",
+				UnRealizedProfit: "10",
+				Leverage:         "5",
+				MarginType:       "isolated",
+				PositionSide:     string(futures.PositionSideTypeBoth),
+			},
+			{
+				Symbol:           "ETHUSDT",
+				PositionAmt:      "0",
+				EntryPrice:       "0",
+				MarkPrice:        "3000",
+				LiquidationPrice: "0",
+				UnRealizedProfit: "0",
+				Leverage:         "20",
+				MarginType:       "cross",
+			},
+		}, nil)
+
+	// when
+	positions, err := a.GetPositions("")
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, positions, 1)
+	assert.Equal(t, consts.PositionSideShort, positions[0].Side)
+	assert.Equal(t, "0.01", positions[0].Size.String())
+	assert.Equal(t, consts.MarginModeIsolated, positions[0].MarginMode)
+	assert.Equal(t, 5, positions[0].Leverage)
+}
+
+func TestSetLeverageInvalid(t *testing.T) {
+	// given
+	a, _ := newTestAdapter(t)
+
+	// when
+	err := a.SetLeverage("BTCUSDT", 0)
+
+	// then
+	require.ErrorContains(t, err, "invalid leverage")
+}
+
+func TestSetMarginModeNotModified(t *testing.T) {
+	// given
+	a, w := newTestAdapter(t)
+
+	w.EXPECT().ChangeMarginType(context.Background(), "BTCUSDT", futures.MarginTypeCrossed).
+		Return(&common.APIError{
+			Code:    errs.ErrCodeMarginTypeNotModified,
+			Message: "No need to change margin type.",
+		})
+
+	// when
+	err := a.SetMarginMode("BTCUSDT", consts.MarginModeCross)
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestSetMarginModeError(t *testing.T) {
+	// given
+	a, w := newTestAdapter(t)
+
+	w.EXPECT().ChangeMarginType(context.Background(), "BTCUSDT", futures.MarginTypeIsolated).
+		Return(errors.New("timeout"))
+
+	// when
+	err := a.SetMarginMode("BTCUSDT", consts.MarginModeIsolated)
+
+	// then
+	require.ErrorContains(t, err, "timeout")
+}
+
+func TestGetFundingInfo(t *testing.T) {
+	// given
+	a, w := newTestAdapter(t)
+
+	w.EXPECT().GetPremiumIndex(context.Background(), "BTCUSDT").
+		Return([]*futures.PremiumIndex{{
+			Symbol:          "BTCUSDT",
+			MarkPrice:       "64000.5",
+			IndexPrice:      "64001",
+			LastFundingRate: "0.0001",
+			NextFundingTime: 1700006400000,
+		}}, nil)
+
+	// when
+	info, err := a.GetFundingInfo("BTCUSDT")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "64000.5", info.MarkPrice.String())
+	assert.Equal(t, "0.0001", info.FundingRate.String())
+	assert.Equal(t, int64(1700006400000), info.NextFundingTime)
+}
@@ -0,0 +1,32 @@
+package errs
+
+import (
+	"errors"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+const (
+	// ErrCodeMarginTypeNotModified - "No need to change margin type"
+	ErrCodeMarginTypeNotModified = -4046
+	// ErrCodeUnknownOrder - "Unknown order sent"
+	ErrCodeUnknownOrder = -2011
+	// ErrCodeOrderNotExists - "Order does not exist"
+	ErrCodeOrderNotExists = -2013
+	// ErrCodeClientOrderIDDuplicated - "ClientOrderId is duplicated"
+	ErrCodeClientOrderIDDuplicated = -4116
+)
+
+// IsAPIErrorCode - check binance API error code
+func IsAPIErrorCode(err error, code int64) bool {
+	var apiErr *common.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.Code == code
+}
+
+func IsErrorAboutUnknownOrder(err error) bool {
+	return IsAPIErrorCode(err, ErrCodeUnknownOrder) ||
+		IsAPIErrorCode(err, ErrCodeOrderNotExists)
+}
@@ -0,0 +1,64 @@
+package mappers
+
+import (
+	"fmt"
+
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+// ConvertAccountBalances - the available balance is free,
+// the rest of the wallet balance is used as margin
+func ConvertAccountBalances(account futures.Account) ([]structs.Balance, error) {
+	var balances []structs.Balance
+	for _, asset := range account.Assets {
+		if asset == nil {
+			continue
+		}
+
+		walletBalance, err := decimal.NewFromString(asset.WalletBalance)
+		if err != nil {
+			return nil, fmt.Errorf("parse %q wallet balance: %w", asset.Asset, err)
+		}
+
+		free, err := decimal.NewFromString(asset.AvailableBalance)
+		if err != nil {
+			return nil, fmt.Errorf("parse %q available balance: %w", asset.Asset, err)
+		}
+
+		if walletBalance.IsZero() && free.IsZero() {
+			continue
+		}
+
+		balances = append(balances, structs.Balance{
+			Asset:  asset.Asset,
+			Free:   free,
+			Locked: decimal.Max(walletBalance.Sub(free), decimal.Zero),
+		})
+	}
+	return balances, nil
+}
+
+func FindAssetBalances(
+	balances []structs.Balance,
+	pair structs.PairSymbolData,
+) structs.PairBalance {
+	result := structs.PairBalance{
+		BaseAsset:  &structs.AssetBalance{Ticker: pair.BaseTicker},
+		QuoteAsset: &structs.AssetBalance{Ticker: pair.QuoteTicker},
+	}
+
+	for _, balance := range balances {
+		switch balance.Asset {
+		case pair.BaseTicker:
+			result.BaseAsset.Free = balance.Free
+			result.BaseAsset.Locked = balance.Locked
+		case pair.QuoteTicker:
+			result.QuoteAsset.Free = balance.Free
+			result.QuoteAsset.Locked = balance.Locked
+		}
+	}
+	return result
+}
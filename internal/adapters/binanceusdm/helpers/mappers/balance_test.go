@@ -0,0 +1,51 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+func TestConvertAccountBalances(t *testing.T) {
+	// given
+	account := futures.Account{
+		Assets: []*futures.AccountAsset{
+			{Asset: "USDT", WalletBalance: "100", AvailableBalance: "70"},
+			{Asset: "BNB", WalletBalance: "0", AvailableBalance: "0"},
+		},
+	}
+
+	// when
+	balances, err := ConvertAccountBalances(account)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	assert.Equal(t, "70", balances[0].Free.String())
+	assert.Equal(t, "30", balances[0].Locked.String())
+}
+
+func TestFindAssetBalances(t *testing.T) {
+	// given
+	balances, err := ConvertAccountBalances(futures.Account{
+		Assets: []*futures.AccountAsset{
+			{Asset: "USDT", WalletBalance: "100", AvailableBalance: "100"},
+		},
+	})
+	require.NoError(t, err)
+
+	// when
+	pairBalance := FindAssetBalances(balances, structs.PairSymbolData{
+		BaseTicker:  "BTC",
+		QuoteTicker: "USDT",
+	})
+
+	// then
+	assert.Equal(t, "BTC", pairBalance.BaseAsset.Ticker)
+	assert.True(t, pairBalance.BaseAsset.Free.IsZero())
+	assert.Equal(t, "100", pairBalance.QuoteAsset.Free.String())
+}
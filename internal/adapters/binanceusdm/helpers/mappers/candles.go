@@ -0,0 +1,99 @@
+package mappers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+type candleValues struct {
+	Open   string
+	Close  string
+	High   string
+	Low    string
+	Volume string
+}
+
+func fixCandleEndTime(endTime int64) int64 {
+	if strings.HasSuffix(strconv.FormatInt(endTime, 10), "999") {
+		return endTime - 59999
+	}
+	return endTime
+}
+
+func ConvertCandleEvent(event futures.WsKlineEvent) (workers.CandleEvent, error) {
+	candle := workers.CandleData{
+		StartTime: event.Kline.StartTime,
+		EndTime:   fixCandleEndTime(event.Kline.EndTime),
+		Interval:  consts.Interval(event.Kline.Interval),
+	}
+
+	if err := parseCandleValues(candleValues{
+		Open:   event.Kline.Open,
+		Close:  event.Kline.Close,
+		High:   event.Kline.High,
+		Low:    event.Kline.Low,
+		Volume: event.Kline.Volume,
+	}, &candle); err != nil {
+		return workers.CandleEvent{}, err
+	}
+
+	return workers.CandleEvent{
+		Symbol:     event.Symbol,
+		Candle:     candle,
+		Time:       event.Time,
+		IsFinished: event.Kline.IsFinal,
+	}, nil
+}
+
+func ConvertCandles(
+	klines []*futures.Kline,
+	interval consts.Interval,
+) ([]workers.CandleData, error) {
+	var candles []workers.CandleData
+	for _, kline := range klines {
+		candle := workers.CandleData{
+			StartTime: kline.OpenTime,
+			EndTime:   fixCandleEndTime(kline.CloseTime),
+			Interval:  interval,
+		}
+
+		if err := parseCandleValues(candleValues{
+			Open:   kline.Open,
+			Close:  kline.Close,
+			High:   kline.High,
+			Low:    kline.Low,
+			Volume: kline.Volume,
+		}, &candle); err != nil {
+			return nil, fmt.Errorf("convert candles: %w", err)
+		}
+		candles = append(candles, candle)
+	}
+	return candles, nil
+}
+
+func parseCandleValues(values candleValues, candle *workers.CandleData) error {
+	var err error
+	if candle.Open, err = decimal.NewFromString(values.Open); err != nil {
+		return fmt.Errorf("parse candle `open` value: %w", err)
+	}
+	if candle.Close, err = decimal.NewFromString(values.Close); err != nil {
+		return fmt.Errorf("parse candle `close` value: %w", err)
+	}
+	if candle.High, err = decimal.NewFromString(values.High); err != nil {
+		return fmt.Errorf("parse candle `high` value: %w", err)
+	}
+	if candle.Low, err = decimal.NewFromString(values.Low); err != nil {
+		return fmt.Errorf("parse candle `low` value: %w", err)
+	}
+	if candle.Volume, err = decimal.NewFromString(values.Volume); err != nil {
+		return fmt.Errorf("parse candle `volume` value: %w", err)
+	}
+	return nil
+}
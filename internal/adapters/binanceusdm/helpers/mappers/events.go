@@ -0,0 +1,70 @@
+package mappers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+// ConvertTradeEventPrivate - convert the order trade update with the last fill data
+func ConvertTradeEventPrivate(
+	event futures.WsOrderTradeUpdate,
+	exchangeTag string,
+) (workers.TradeEventPrivate, error) {
+	price, err := decimal.NewFromString(event.LastFilledPrice)
+	if err != nil {
+		return workers.TradeEventPrivate{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := decimal.NewFromString(event.LastFilledQty)
+	if err != nil {
+		return workers.TradeEventPrivate{}, fmt.Errorf("parse quantity: %w", err)
+	}
+
+	return workers.TradeEventPrivate{
+		ID:            strconv.FormatInt(event.TradeID, 10),
+		Time:          event.TradeTime,
+		ExchangeTag:   exchangeTag,
+		Symbol:        event.Symbol,
+		OrderID:       strconv.FormatInt(event.ID, 10),
+		ClientOrderID: event.ClientOrderID,
+		Price:         price,
+		Quantity:      qty,
+	}, nil
+}
+
+func ConvertPublicTradeEvent(
+	event futures.WsAggTradeEvent,
+	exchangeTag string,
+) (workers.PublicTradeEvent, error) {
+	price, err := decimal.NewFromString(event.Price)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := decimal.NewFromString(event.Quantity)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse quantity: %w", err)
+	}
+
+	// the buyer is maker: the taker sold
+	takerSide := consts.OrderSideBuy
+	if event.Maker {
+		takerSide = consts.OrderSideSell
+	}
+
+	return workers.PublicTradeEvent{
+		ID:          strconv.FormatInt(event.AggregateTradeID, 10),
+		Time:        event.TradeTime,
+		ExchangeTag: exchangeTag,
+		Symbol:      event.Symbol,
+		Price:       price,
+		Quantity:    qty,
+		TakerSide:   takerSide,
+	}, nil
+}
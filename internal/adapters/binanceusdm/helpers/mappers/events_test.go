@@ -0,0 +1,71 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertTradeEventPrivate(t *testing.T) {
+	// given
+	event := futures.WsOrderTradeUpdate{
+		Symbol:          "BTCUSDT",
+		ClientOrderID:   "test",
+		ID:              100,
+		TradeID:         200,
+		TradeTime:       1700000000000,
+		LastFilledPrice: "64000.5",
+		LastFilledQty:   "0.002",
+	}
+
+	// when
+	wEvent, err := ConvertTradeEventPrivate(event, consts.BinanceUSDMAdapterTag)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "200", wEvent.ID)
+	assert.Equal(t, "100", wEvent.OrderID)
+	assert.Equal(t, "64000.5", wEvent.Price.String())
+	assert.Equal(t, "0.002", wEvent.Quantity.String())
+}
+
+func TestConvertCandleEvent(t *testing.T) {
+	// given
+	event := futures.WsKlineEvent{
+		Symbol: "BTCUSDT",
+		Time:   1700000000000,
+		Kline: futures.WsKline{
+			StartTime: 1699999940000,
+			EndTime:   1699999999999,
+			Interval:  "1m",
+			Open:      "1",
+			Close:     "2",
+			High:      "3",
+			Low:       "0.5",
+			Volume:    "10",
+			IsFinal:   true,
+		},
+	}
+
+	// when
+	candleEvent, err := ConvertCandleEvent(event)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.Interval1min, candleEvent.Candle.Interval)
+	assert.Equal(t, "3", candleEvent.Candle.High.String())
+	assert.Equal(t, int64(1699999940000), candleEvent.Candle.EndTime)
+	assert.True(t, candleEvent.IsFinished)
+}
+
+func TestConvertCandleEventEmptyValue(t *testing.T) {
+	// when
+	_, err := ConvertCandleEvent(futures.WsKlineEvent{})
+
+	// then
+	require.ErrorContains(t, err, "open")
+}
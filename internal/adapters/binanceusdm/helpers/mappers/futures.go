@@ -0,0 +1,183 @@
+package mappers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+// GetMarginType - convert margin mode to binance margin type
+func GetMarginType(mode consts.MarginMode) (futures.MarginType, error) {
+	switch mode {
+	default:
+		return "", fmt.Errorf("unknown margin mode: %q", mode)
+	case consts.MarginModeCross:
+		return futures.MarginTypeCrossed, nil
+	case consts.MarginModeIsolated:
+		return futures.MarginTypeIsolated, nil
+	}
+}
+
+// ConvertMarginType - position risk contains lowercase margin type, e.g. "isolated"
+func ConvertMarginType(marginType string) consts.MarginMode {
+	if strings.EqualFold(marginType, string(futures.MarginTypeIsolated)) {
+		return consts.MarginModeIsolated
+	}
+	return consts.MarginModeCross
+}
+
+// getPositionSide - one-way mode position has BOTH side,
+// the short position amount is negative
+func getPositionSide(side futures.PositionSideType, amount decimal.Decimal) consts.PositionSide {
+	switch side {
+	case futures.PositionSideTypeLong:
+		return consts.PositionSideLong
+	case futures.PositionSideTypeShort:
+		return consts.PositionSideShort
+	}
+
+	if amount.IsNegative() {
+		return consts.PositionSideShort
+	}
+	return consts.PositionSideLong
+}
+
+// ConvertPositions - convert open positions, empty positions are skipped
+func ConvertPositions(positions []*futures.PositionRisk) ([]structs.Position, error) {
+	var result []structs.Position
+	for _, item := range positions {
+		if item == nil {
+			continue
+		}
+
+		position, err := ConvertPosition(*item)
+		if err != nil {
+			return nil, fmt.Errorf("convert %q position: %w", item.Symbol, err)
+		}
+
+		if position.Size.IsZero() {
+			continue
+		}
+		result = append(result, position)
+	}
+	return result, nil
+}
+
+func ConvertPosition(data futures.PositionRisk) (structs.Position, error) {
+	amount, err := decimal.NewFromString(data.PositionAmt)
+	if err != nil {
+		return structs.Position{}, fmt.Errorf("parse amount: %w", err)
+	}
+
+	entryPrice, err := decimal.NewFromString(data.EntryPrice)
+	if err != nil {
+		return structs.Position{}, fmt.Errorf("parse entry price: %w", err)
+	}
+
+	markPrice, err := decimal.NewFromString(data.MarkPrice)
+	if err != nil {
+		return structs.Position{}, fmt.Errorf("parse mark price: %w", err)
+	}
+
+	liquidationPrice, err := decimal.NewFromString(data.LiquidationPrice)
+	if err != nil {
+		return structs.Position{}, fmt.Errorf("parse liquidation price: %w", err)
+	}
+
+	unrealizedPnL, err := decimal.NewFromString(data.UnRealizedProfit)
+	if err != nil {
+		return structs.Position{}, fmt.Errorf("parse unrealized PnL: %w", err)
+	}
+
+	leverage, err := strconv.Atoi(data.Leverage)
+	if err != nil {
+		return structs.Position{}, fmt.Errorf("parse leverage: %w", err)
+	}
+
+	return structs.Position{
+		Symbol:           data.Symbol,
+		Side:             getPositionSide(futures.PositionSideType(data.PositionSide), amount),
+		Size:             amount.Abs(),
+		EntryPrice:       entryPrice,
+		MarkPrice:        markPrice,
+		LiquidationPrice: liquidationPrice,
+		UnrealizedPnL:    unrealizedPnL,
+		Leverage:         leverage,
+		MarginMode:       ConvertMarginType(data.MarginType),
+	}, nil
+}
+
+// ConvertPositionEvent - the account update doesn't contain
+// leverage & liquidation price. Closed position has zero size
+func ConvertPositionEvent(
+	data futures.WsPosition,
+	eventTime int64,
+	exchangeTag string,
+) (workers.PositionEvent, error) {
+	amount, err := decimal.NewFromString(data.Amount)
+	if err != nil {
+		return workers.PositionEvent{}, fmt.Errorf("parse amount: %w", err)
+	}
+
+	entryPrice, err := decimal.NewFromString(data.EntryPrice)
+	if err != nil {
+		return workers.PositionEvent{}, fmt.Errorf("parse entry price: %w", err)
+	}
+
+	markPrice, err := decimal.NewFromString(data.MarkPrice)
+	if err != nil {
+		return workers.PositionEvent{}, fmt.Errorf("parse mark price: %w", err)
+	}
+
+	unrealizedPnL, err := decimal.NewFromString(data.UnrealizedPnL)
+	if err != nil {
+		return workers.PositionEvent{}, fmt.Errorf("parse unrealized PnL: %w", err)
+	}
+
+	return workers.PositionEvent{
+		ExchangeTag: exchangeTag,
+		Time:        eventTime,
+		Position: structs.Position{
+			Symbol:        data.Symbol,
+			Side:          getPositionSide(data.Side, amount),
+			Size:          amount.Abs(),
+			EntryPrice:    entryPrice,
+			MarkPrice:     markPrice,
+			UnrealizedPnL: unrealizedPnL,
+			MarginMode:    ConvertMarginType(string(data.MarginType)),
+			UpdatedTime:   eventTime,
+		},
+	}, nil
+}
+
+func ConvertFundingInfo(data futures.PremiumIndex) (structs.FundingInfo, error) {
+	markPrice, err := decimal.NewFromString(data.MarkPrice)
+	if err != nil {
+		return structs.FundingInfo{}, fmt.Errorf("parse mark price: %w", err)
+	}
+
+	indexPrice, err := decimal.NewFromString(data.IndexPrice)
+	if err != nil {
+		return structs.FundingInfo{}, fmt.Errorf("parse index price: %w", err)
+	}
+
+	fundingRate, err := decimal.NewFromString(data.LastFundingRate)
+	if err != nil {
+		return structs.FundingInfo{}, fmt.Errorf("parse funding rate: %w", err)
+	}
+
+	return structs.FundingInfo{
+		Symbol:          data.Symbol,
+		MarkPrice:       markPrice,
+		IndexPrice:      indexPrice,
+		FundingRate:     fundingRate,
+		NextFundingTime: data.NextFundingTime,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestGetMarginType(t *testing.T) {
+	marginType, err := GetMarginType(consts.MarginModeIsolated)
+	require.NoError(t, err)
+	assert.Equal(t, futures.MarginTypeIsolated, marginType)
+
+	marginType, err = GetMarginType(consts.MarginModeCross)
+	require.NoError(t, err)
+	assert.Equal(t, futures.MarginTypeCrossed, marginType)
+
+	_, err = GetMarginType("wrong")
+	require.Error(t, err)
+}
+
+func TestConvertPositionHedgeMode(t *testing.T) {
+	// given
+	data := futures.PositionRisk{
+		Symbol:           "ETHUSDT",
+		PositionAmt:      "1.5",
+		EntryPrice:       "3000",
+		MarkPrice:        "3100",
+		LiquidationPrice: "2500",
+		UnRealizedProfit: "150",
+		Leverage:         "10",
+		MarginType:       "cross",
+		PositionSide:     string(futures.PositionSideTypeLong),
+	}
+
+	// when
+	position, err := ConvertPosition(data)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.PositionSideLong, position.Side)
+	assert.Equal(t, "1.5", position.Size.String())
+	assert.Equal(t, "2500", position.LiquidationPrice.String())
+	assert.Equal(t, consts.MarginModeCross, position.MarginMode)
+}
+
+func TestConvertPositionInvalidLeverage(t *testing.T) {
+	// given
+	data := futures.PositionRisk{
+		PositionAmt:      "1",
+		EntryPrice:       "1",
+		MarkPrice:        "1",
+		LiquidationPrice: "0",
+		UnRealizedProfit: "0",
+		Leverage:         "",
+	}
+
+	// when
+	_, err := ConvertPosition(data)
+
+	// then
+	require.ErrorContains(t, err, "parse leverage")
+}
+
+func TestConvertPositionEvent(t *testing.T) {
+	// given
+	data := futures.WsPosition{
+		Symbol:        "BTCUSDT",
+		Side:          futures.PositionSideTypeBoth,
+		Amount:        "-0.002",
+		MarginType:    futures.MarginType("isolated"),
+		EntryPrice:    "64000",
+		MarkPrice:     "63900",
+		UnrealizedPnL: "0.2",
+	}
+
+	// when
+	event, err := ConvertPositionEvent(data, 1700000000000, consts.BinanceUSDMAdapterTag)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.BinanceUSDMAdapterTag, event.ExchangeTag)
+	assert.Equal(t, consts.PositionSideShort, event.Position.Side)
+	assert.Equal(t, "0.002", event.Position.Size.String())
+	assert.Equal(t, consts.MarginModeIsolated, event.Position.MarginMode)
+	assert.Equal(t, int64(1700000000000), event.Position.UpdatedTime)
+}
+
+func TestConvertFundingInfoInvalid(t *testing.T) {
+	// when
+	_, err := ConvertFundingInfo(futures.PremiumIndex{MarkPrice: "wrong"})
+
+	// then
+	require.ErrorContains(t, err, "parse mark price")
+}
@@ -0,0 +1,207 @@
+package mappers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+// ConvertOrderSide - convert order side from binance format to bot order side
+func ConvertOrderSide(orderSide futures.SideType) (consts.OrderSide, error) {
+	switch orderSide {
+	default:
+		return "", fmt.Errorf("unknown order side: %q", orderSide)
+	case futures.SideTypeBuy:
+		return consts.OrderSideBuy, nil
+	case futures.SideTypeSell:
+		return consts.OrderSideSell, nil
+	}
+}
+
+// GetFuturesOrderSide - convert bot order type to binance order type
+func GetFuturesOrderSide(botOrderSide consts.OrderSide) (futures.SideType, error) {
+	switch botOrderSide {
+	default:
+		return "", fmt.Errorf("unknown order side: %q", botOrderSide)
+	case consts.OrderSideBuy:
+		return futures.SideTypeBuy, nil
+	case consts.OrderSideSell:
+		return futures.SideTypeSell, nil
+	}
+}
+
+func ConvertPlacedOrder(orderResponse futures.CreateOrderResponse) (
+	structs.CreateOrderResponse,
+	error,
+) {
+	origQty, err := decimal.NewFromString(orderResponse.OrigQuantity)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("parse order origQty: %w", err)
+	}
+
+	price, err := decimal.NewFromString(orderResponse.Price)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("parse order price: %w", err)
+	}
+
+	orderSide, err := ConvertOrderSide(orderResponse.Side)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("convert order side: %w", err)
+	}
+
+	return structs.CreateOrderResponse{
+		OrderID:       orderResponse.OrderID,
+		ClientOrderID: orderResponse.ClientOrderID,
+		OrigQuantity:  origQty,
+		Price:         price,
+		Symbol:        orderResponse.Symbol,
+		Type:          orderSide,
+		CreatedTime:   orderResponse.UpdateTime,
+		Status:        consts.OrderStatus(orderResponse.Status),
+	}, nil
+}
+
+// ConvertOrderData - market orders have zero price, the average price is used
+func ConvertOrderData(orderResponse futures.Order) (structs.OrderData, error) {
+	awaitQty, err := decimal.NewFromString(orderResponse.OrigQuantity)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse await qty: %w", err)
+	}
+
+	filledQty, err := decimal.NewFromString(orderResponse.ExecutedQuantity)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse executed qty: %w", err)
+	}
+
+	price, err := decimal.NewFromString(orderResponse.Price)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse price: %w", err)
+	}
+	if price.IsZero() && orderResponse.AvgPrice != "" {
+		price, err = decimal.NewFromString(orderResponse.AvgPrice)
+		if err != nil {
+			return structs.OrderData{}, fmt.Errorf("parse average price: %w", err)
+		}
+	}
+
+	orderSide, err := ConvertOrderSide(orderResponse.Side)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("convert order side: %w", err)
+	}
+
+	return structs.OrderData{
+		OrderID:       orderResponse.OrderID,
+		ClientOrderID: orderResponse.ClientOrderID,
+		Status:        consts.OrderStatus(orderResponse.Status),
+		AwaitQty:      awaitQty,
+		FilledQty:     filledQty,
+		Price:         price,
+		Symbol:        orderResponse.Symbol,
+		Side:          orderSide,
+		CreatedTime:   orderResponse.Time,
+		UpdatedTime:   orderResponse.UpdateTime,
+	}, nil
+}
+
+// GetFeesFromTradeList - the fees are charged in the margin asset,
+// usually it's the quote asset
+func GetFeesFromTradeList(
+	trades []*futures.AccountTrade,
+	baseAssetTicker string,
+	quoteAssetTicker string,
+) (structs.OrderFees, error) {
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.Zero,
+		QuoteAsset: decimal.Zero,
+	}
+
+	for _, trade := range trades {
+		execFee, err := decimal.NewFromString(trade.Commission)
+		if err != nil {
+			return structs.OrderFees{}, fmt.Errorf("parse exec order fee: %w", err)
+		}
+
+		switch trade.CommissionAsset {
+		case baseAssetTicker:
+			fees.BaseAsset = fees.BaseAsset.Add(execFee)
+		case quoteAssetTicker:
+			fees.QuoteAsset = fees.QuoteAsset.Add(execFee)
+		}
+
+		price, err := decimal.NewFromString(trade.Price)
+		if err != nil {
+			return structs.OrderFees{}, fmt.Errorf("parse trade price: %w", err)
+		}
+
+		fees.Commissions = append(fees.Commissions, structs.Commission{
+			TradeID: strconv.FormatInt(trade.ID, 10),
+			Asset:   trade.CommissionAsset,
+			Amount:  execFee,
+			Price:   price,
+			Time:    trade.Time,
+		})
+	}
+	return fees, nil
+}
+
+func ConvertAccountTrade(
+	trade futures.AccountTrade,
+	clientOrderID string,
+) (structs.AccountTrade, error) {
+	price, err := decimal.NewFromString(trade.Price)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := decimal.NewFromString(trade.Quantity)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	fee, err := decimal.NewFromString(trade.Commission)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse fee: %w", err)
+	}
+
+	side, err := ConvertOrderSide(trade.Side)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("convert side: %w", err)
+	}
+
+	return structs.AccountTrade{
+		ID:            strconv.FormatInt(trade.ID, 10),
+		OrderID:       trade.OrderID,
+		ClientOrderID: clientOrderID,
+		Symbol:        trade.Symbol,
+		Side:          side,
+		Price:         price,
+		Qty:           qty,
+		Fee:           fee,
+		FeeAsset:      trade.CommissionAsset,
+		IsMaker:       trade.Maker,
+		Time:          trade.Time,
+	}, nil
+}
+
+func ConvertTradeFees(fee futures.CommissionRate) (structs.TradeFees, error) {
+	maker, err := decimal.NewFromString(fee.MakerCommissionRate)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("parse maker fee: %w", err)
+	}
+
+	taker, err := decimal.NewFromString(fee.TakerCommissionRate)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("parse taker fee: %w", err)
+	}
+
+	return structs.TradeFees{
+		Symbol: fee.Symbol,
+		Maker:  maker,
+		Taker:  taker,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package mappers
+
+import (
+	"fmt"
+
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
+)
+
+const symbolStatusTrading = "TRADING"
+
+func GetPairPrice(prices []*futures.SymbolPrice, pairSymbol string) (decimal.Decimal, error) {
+	for _, p := range prices {
+		if p.Symbol == pairSymbol {
+			price, err := decimal.NewFromString(p.Price)
+			if err != nil {
+				return decimal.Zero, fmt.Errorf("parse price %q: %w", p.Price, err)
+			}
+			return price, nil
+		}
+	}
+	return decimal.Zero, fmt.Errorf("last price not found for pair %q", pairSymbol)
+}
+
+// ConvertExchangePairsData - only perpetual contracts are used
+func ConvertExchangePairsData(
+	exchangeInfo futures.ExchangeInfo,
+	exchangeID int,
+) ([]structs.ExchangePairData, error) {
+	var pairs []structs.ExchangePairData
+	for _, symbolData := range exchangeInfo.Symbols {
+		if symbolData.ContractType != futures.ContractTypePerpetual {
+			continue
+		}
+
+		pairData, err := ConvertExchangePairData(symbolData, exchangeID)
+		if err != nil {
+			return nil, fmt.Errorf("convert %q: %w", symbolData.Symbol, err)
+		}
+		pairs = append(pairs, pairData)
+	}
+	return pairs, nil
+}
+
+func ConvertExchangePairData(
+	symbolData futures.Symbol,
+	exchangeID int,
+) (structs.ExchangePairData, error) {
+	pairData := structs.ExchangePairData{
+		ExchangeID:    exchangeID,
+		BaseAsset:     symbolData.BaseAsset,
+		QuoteAsset:    symbolData.QuoteAsset,
+		Status:        symbolData.Status,
+		Symbol:        symbolData.Symbol,
+		AllowedMargin: true,
+		AllowedSpot:   symbolData.Status == symbolStatusTrading,
+		InUse:         true,
+	}
+
+	lotSizeFilter := symbolData.LotSizeFilter()
+	if lotSizeFilter == nil {
+		return structs.ExchangePairData{}, fmt.Errorf("lot size filter not found")
+	}
+
+	var err error
+	pairData.MinQty, err = decimal.NewFromString(lotSizeFilter.MinQuantity)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse min qty: %w", err)
+	}
+
+	pairData.MaxQty, err = decimal.NewFromString(lotSizeFilter.MaxQuantity)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse max qty: %w", err)
+	}
+
+	pairData.QtyStep, err = decimal.NewFromString(lotSizeFilter.StepSize)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse qty step: %w", err)
+	}
+	pairData.BasePrecision = utils.GetDecimalPrecision(pairData.QtyStep)
+
+	priceFilter := symbolData.PriceFilter()
+	if priceFilter == nil {
+		return structs.ExchangePairData{}, fmt.Errorf("price filter not found")
+	}
+
+	pairData.MinPrice, err = decimal.NewFromString(priceFilter.MinPrice)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse min price: %w", err)
+	}
+
+	pairData.PriceStep, err = decimal.NewFromString(priceFilter.TickSize)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse price step: %w", err)
+	}
+	if pairData.PriceStep.IsZero() {
+		return structs.ExchangePairData{}, fmt.Errorf("price step is empty")
+	}
+	pairData.QuotePrecision = utils.GetDecimalPrecision(pairData.PriceStep)
+
+	if minNotionalFilter := symbolData.MinNotionalFilter(); minNotionalFilter != nil {
+		pairData.OriginalMinDeposit, err = decimal.NewFromString(minNotionalFilter.Notional)
+		if err != nil {
+			return structs.ExchangePairData{}, fmt.Errorf("parse min notional: %w", err)
+		}
+		pairData.MinDeposit = pairData.OriginalMinDeposit
+	}
+	return pairData, nil
+}
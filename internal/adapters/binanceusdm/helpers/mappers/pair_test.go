@@ -0,0 +1,73 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestSymbol(symbol string, contractType futures.ContractType) futures.Symbol {
+	return futures.Symbol{
+		Symbol:       symbol,
+		ContractType: contractType,
+		Status:       "TRADING",
+		BaseAsset:    "BTC",
+		QuoteAsset:   "USDT",
+		Filters: []map[string]interface{}{
+			{
+				"filterType": string(futures.SymbolFilterTypeLotSize),
+				"maxQty":     "1000",
+				"minQty":     "0.001",
+				"stepSize":   "0.001",
+			},
+			{
+				"filterType": string(futures.SymbolFilterTypePrice),
+				"maxPrice":   "4529764",
+				"minPrice":   "556.80",
+				"tickSize":   "0.10",
+			},
+			{
+				"filterType": string(futures.SymbolFilterTypeMinNotional),
+				"notional":   "100",
+			},
+		},
+	}
+}
+
+func TestConvertExchangePairsData(t *testing.T) {
+	// given
+	exchangeInfo := futures.ExchangeInfo{
+		Symbols: []futures.Symbol{
+			getTestSymbol("BTCUSDT", futures.ContractTypePerpetual),
+			getTestSymbol("BTCUSDT_251226", futures.ContractTypeCurrentQuarter),
+		},
+	}
+
+	// when
+	pairs, err := ConvertExchangePairsData(exchangeInfo, 5)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, pairs, 1)
+	assert.Equal(t, "BTCUSDT", pairs[0].Symbol)
+	assert.Equal(t, 5, pairs[0].ExchangeID)
+	assert.Equal(t, "0.001", pairs[0].QtyStep.String())
+	assert.Equal(t, "0.1", pairs[0].PriceStep.String())
+	assert.Equal(t, "100", pairs[0].MinDeposit.String())
+	assert.Equal(t, 3, pairs[0].BasePrecision)
+	assert.Equal(t, 1, pairs[0].QuotePrecision)
+	assert.True(t, pairs[0].AllowedSpot)
+}
+
+func TestConvertExchangePairDataNoFilters(t *testing.T) {
+	// given
+	symbol := futures.Symbol{Symbol: "BTCUSDT"}
+
+	// when
+	_, err := ConvertExchangePairData(symbol, 5)
+
+	// then
+	require.ErrorContains(t, err, "lot size filter")
+}
@@ -0,0 +1,132 @@
+package binanceusdm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/adshao/go-binance/v2/futures"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+const (
+	tradesHistoryMaxWindow = time.Hour * 24 * 7
+	tradesHistoryPageLimit = 1000
+	ordersPageLimit        = 1000
+)
+
+func (a *adapter) GetAccountTrades(
+	task structs.GetOrdersHistoryTask,
+) ([]structs.AccountTrade, error) {
+	windows, err := baseadp.SplitHistoryTask(task, tradesHistoryMaxWindow)
+	if err != nil {
+		return nil, fmt.Errorf("check task: %w", err)
+	}
+
+	ctx := baseadp.GetHistoryTaskContext(task)
+
+	var trades []*futures.AccountTrade
+	for _, window := range windows {
+		windowTrades, err := a.getWindowTrades(ctx, task.PairSymbol, window)
+		if err != nil {
+			return nil, fmt.Errorf("get trades: %w", err)
+		}
+		trades = append(trades, windowTrades...)
+	}
+
+	clientOrderIDs, err := a.getClientOrderIDs(ctx, task.PairSymbol, trades)
+	if err != nil {
+		return nil, fmt.Errorf("get orders: %w", err)
+	}
+
+	result := make([]structs.AccountTrade, 0, len(trades))
+	for _, trade := range trades {
+		tradeConverted, err := mappers.ConvertAccountTrade(
+			*trade,
+			clientOrderIDs[trade.OrderID],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("convert trade: %w", err)
+		}
+		result = append(result, tradeConverted)
+	}
+	return baseadp.SortAccountTrades(result), nil
+}
+
+// getWindowTrades - the page by time range, then the next pages by trade ID
+func (a *adapter) getWindowTrades(
+	ctx context.Context,
+	pairSymbol string,
+	window baseadp.TimeWindow,
+) ([]*futures.AccountTrade, error) {
+	trades, err := a.futuresAPI.GetAccountTrades(
+		ctx, pairSymbol,
+		window.StartTime, window.EndTime,
+		0, tradesHistoryPageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := trades
+	for len(trades) == tradesHistoryPageLimit {
+		trades, err = a.futuresAPI.GetAccountTrades(
+			ctx, pairSymbol,
+			0, 0,
+			trades[len(trades)-1].ID+1, tradesHistoryPageLimit,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trade := range trades {
+			if trade.Time > window.EndTime {
+				return result, nil
+			}
+			result = append(result, trade)
+		}
+	}
+	return result, nil
+}
+
+// getClientOrderIDs - binance trades doesn't contain client order ID
+func (a *adapter) getClientOrderIDs(
+	ctx context.Context,
+	pairSymbol string,
+	trades []*futures.AccountTrade,
+) (map[int64]string, error) {
+	result := map[int64]string{}
+	if len(trades) == 0 {
+		return result, nil
+	}
+
+	orderIDs := map[int64]struct{}{}
+	fromOrderID := trades[0].OrderID
+	for _, trade := range trades {
+		orderIDs[trade.OrderID] = struct{}{}
+		fromOrderID = min(fromOrderID, trade.OrderID)
+	}
+
+	for len(orderIDs) > 0 {
+		orders, err := a.futuresAPI.GetOrders(ctx, pairSymbol, fromOrderID, ordersPageLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, order := range orders {
+			if _, isExists := orderIDs[order.OrderID]; isExists {
+				result[order.OrderID] = order.ClientOrderID
+				delete(orderIDs, order.OrderID)
+			}
+			fromOrderID = max(fromOrderID, order.OrderID+1)
+		}
+
+		if len(orders) < ordersPageLimit {
+			break
+		}
+	}
+	return result, nil
+}
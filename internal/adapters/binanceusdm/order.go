@@ -0,0 +1,175 @@
+package binanceusdm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/futures"
+
+	binanceErrs "github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/errs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/helpers/errs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+func (a *adapter) GetOrderData(
+	pairSymbol string,
+	orderID int64,
+) (structs.OrderData, error) {
+	if orderID == 0 {
+		return structs.OrderData{}, binanceErrs.ErrOrderIDNotSet
+	}
+
+	order, err := a.futuresAPI.GetOrderDataByOrderID(
+		context.Background(),
+		pairSymbol,
+		orderID,
+	)
+	return convertOrder(order, err)
+}
+
+func (a *adapter) GetOrderByClientOrderID(pairSymbol string, clientOrderID string) (
+	structs.OrderData,
+	error,
+) {
+	if clientOrderID == "" {
+		return structs.OrderData{}, binanceErrs.ErrClientOrderIDNotSet
+	}
+
+	order, err := a.futuresAPI.GetOrderDataByClientOrderID(
+		context.Background(),
+		pairSymbol,
+		clientOrderID,
+	)
+	return convertOrder(order, err)
+}
+
+func convertOrder(order *futures.Order, err error) (structs.OrderData, error) {
+	if err != nil {
+		if errs.IsErrorAboutUnknownOrder(err) {
+			return structs.OrderData{}, pkgErrs.ErrOrderNotFound
+		}
+		return structs.OrderData{}, err
+	}
+
+	if order == nil {
+		return structs.OrderData{}, binanceErrs.ErrOrderResponseEmpty
+	}
+
+	result, err := mappers.ConvertOrderData(*order)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("convert order: %w", err)
+	}
+	return result, nil
+}
+
+func (a *adapter) PlaceOrder(ctx context.Context, order structs.BotOrderAdjusted) (
+	structs.CreateOrderResponse,
+	error,
+) {
+	return a.PlaceFuturesOrder(ctx, order, structs.FuturesOrderParams{})
+}
+
+func (a *adapter) PlaceFuturesOrder(
+	ctx context.Context,
+	order structs.BotOrderAdjusted,
+	params structs.FuturesOrderParams,
+) (structs.CreateOrderResponse, error) {
+	orderSide, err := mappers.GetFuturesOrderSide(order.Type)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("get order side: %w", err)
+	}
+
+	orderResponse, err := a.futuresAPI.PlaceOrder(ctx, wrapper.OrderTask{
+		PairSymbol:    order.PairSymbol,
+		Side:          orderSide,
+		IsMarketOrder: order.IsMarketOrder,
+		Qty:           order.Qty,
+		Price:         order.Price,
+		ClientOrderID: order.ClientOrderID,
+		ReduceOnly:    params.ReduceOnly,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), binanceErrs.ErrMsgOrderDuplicate) ||
+			errs.IsAPIErrorCode(err, errs.ErrCodeClientOrderIDDuplicated) {
+			return structs.CreateOrderResponse{}, pkgErrs.ErrOrderDuplicate
+		}
+		return structs.CreateOrderResponse{}, fmt.Errorf("create order: %w", err)
+	}
+
+	if orderResponse == nil {
+		return structs.CreateOrderResponse{}, binanceErrs.ErrOrderResponseEmpty
+	}
+
+	orderConverted, err := mappers.ConvertPlacedOrder(*orderResponse)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("convert order: %w", err)
+	}
+	return orderConverted, nil
+}
+
+func (a *adapter) GetHistoryOrder(
+	pairSymbol string,
+	orderID int64,
+) (structs.OrderHistory, error) {
+	// not emplemented yet
+	return structs.OrderHistory{}, &pkgErrs.NotSupportedError{Feature: "order history"}
+}
+
+func (a *adapter) GetOrderExecFee(
+	baseAssetTicker string,
+	quoteAssetTicker string,
+	_ consts.OrderSide,
+	orderID int64,
+) (structs.OrderFees, error) {
+	pairSymbol := baseAssetTicker + quoteAssetTicker
+
+	trades, err := a.futuresAPI.GetOrderTradeHistory(
+		context.Background(),
+		orderID,
+		pairSymbol,
+	)
+	if err != nil {
+		return structs.OrderFees{}, fmt.Errorf("get order trade history: %w", err)
+	}
+
+	fees, err := mappers.GetFeesFromTradeList(trades, baseAssetTicker, quoteAssetTicker)
+	if err != nil {
+		return structs.OrderFees{}, fmt.Errorf("convert fees: %w", err)
+	}
+	return fees, nil
+}
+
+func (a *adapter) GetTradeFees(pairSymbol string) (structs.TradeFees, error) {
+	fee, err := a.futuresAPI.GetCommissionRate(context.Background(), pairSymbol)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("get: %w", err)
+	}
+
+	fees, err := mappers.ConvertTradeFees(*fee)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("convert: %w", err)
+	}
+	return fees, nil
+}
+
+// mapCancelOrderError - filled orders are unknown for the cancel request,
+// so the order status is requested to tell them apart
+func mapCancelOrderError(err error, getOrder func() (*futures.Order, error)) error {
+	if err == nil {
+		return nil
+	}
+	if !errs.IsErrorAboutUnknownOrder(err) {
+		return err
+	}
+
+	order, getErr := getOrder()
+	if getErr == nil && order != nil && order.Status == futures.OrderStatusTypeFilled {
+		return pkgErrs.ErrOrderFilled
+	}
+	return pkgErrs.ErrOrderNotFound
+}
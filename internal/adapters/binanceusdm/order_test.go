@@ -0,0 +1,92 @@
+package binanceusdm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/helpers/errs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+func TestPlaceFuturesOrderReduceOnly(t *testing.T) {
+	// given
+	a, w := newTestAdapter(t)
+	ctx := context.Background()
+
+	w.EXPECT().PlaceOrder(ctx, wrapper.OrderTask{
+		PairSymbol:    "BTCUSDT",
+		Side:          futures.SideTypeSell,
+		Qty:           "0.01",
+		Price:         "65000",
+		ClientOrderID: "test",
+		ReduceOnly:    true,
+	}).Return(&futures.CreateOrderResponse{
+		Symbol:        "BTCUSDT",
+		OrderID:       100,
+		ClientOrderID: "test",
+		Price:         "65000",
+		OrigQuantity:  "0.01",
+		Side:          futures.SideTypeSell,
+		Status:        futures.OrderStatusTypeNew,
+		ReduceOnly:    true,
+	}, nil)
+
+	// when
+	order, err := a.PlaceFuturesOrder(ctx, structs.BotOrderAdjusted{
+		PairSymbol:    "BTCUSDT",
+		Type:          consts.OrderSideSell,
+		Qty:           "0.01",
+		Price:         "65000",
+		ClientOrderID: "test",
+	}, structs.FuturesOrderParams{ReduceOnly: true})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), order.OrderID)
+	assert.Equal(t, consts.OrderSideSell, order.Type)
+}
+
+func TestGetOrderDataNotFound(t *testing.T) {
+	// given
+	a, w := newTestAdapter(t)
+
+	w.EXPECT().GetOrderDataByOrderID(context.Background(), "BTCUSDT", int64(100)).
+		Return(nil, &common.APIError{
+			Code:    errs.ErrCodeOrderNotExists,
+			Message: "Order does not exist.",
+		})
+
+	// when
+	_, err := a.GetOrderData("BTCUSDT", 100)
+
+	// then
+	require.ErrorIs(t, err, pkgErrs.ErrOrderNotFound)
+}
+
+func TestGetOrderExecFee(t *testing.T) {
+	// given
+	a, w := newTestAdapter(t)
+
+	w.EXPECT().GetOrderTradeHistory(context.Background(), int64(100), "BTCUSDT").
+		Return([]*futures.AccountTrade{
+			{ID: 1, Commission: "0.5", CommissionAsset: "USDT", Price: "65000"},
+			{ID: 2, Commission: "0.25", CommissionAsset: "USDT", Price: "65001"},
+		}, nil)
+
+	// when
+	fees, err := a.GetOrderExecFee("BTC", "USDT", consts.OrderSideBuy, 100)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, fees.BaseAsset.IsZero())
+	assert.Equal(t, "0.75", fees.QuoteAsset.String())
+	assert.Len(t, fees.Commissions, 2)
+}
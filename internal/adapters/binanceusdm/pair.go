@@ -0,0 +1,80 @@
+package binanceusdm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/shopspring/decimal"
+
+	binanceErrs "github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/errs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+func (a *adapter) GetPairLastPrice(pairSymbol string) (decimal.Decimal, error) {
+	prices, err := a.futuresAPI.GetPrices(context.Background(), pairSymbol)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("get pair last price: %w", err)
+	}
+
+	lastPrice, err := mappers.GetPairPrice(prices, pairSymbol)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("convert pair price: %w", err)
+	}
+	return lastPrice, nil
+}
+
+// GetPairData - the futures exchange info can't be filtered by symbol
+func (a *adapter) GetPairData(pairSymbol string) (structs.ExchangePairData, error) {
+	exchangeInfo, err := a.futuresAPI.GetExchangeInfo(context.Background())
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("get exchange info: %w", err)
+	}
+
+	if exchangeInfo == nil {
+		return structs.ExchangePairData{}, binanceErrs.ErrPairResponseEmpty
+	}
+
+	for _, symbolData := range exchangeInfo.Symbols {
+		if symbolData.Symbol == pairSymbol {
+			return mappers.ConvertExchangePairData(symbolData, a.ExchangeID)
+		}
+	}
+
+	return structs.ExchangePairData{},
+		fmt.Errorf("data for %q pair not found", pairSymbol)
+}
+
+func (a *adapter) GetPairs() ([]structs.ExchangePairData, error) {
+	exchangeInfo, err := a.futuresAPI.GetExchangeInfo(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("get pairs: %w", err)
+	}
+
+	if exchangeInfo == nil {
+		return nil, binanceErrs.ErrPairResponseEmpty
+	}
+
+	return mappers.ConvertExchangePairsData(*exchangeInfo, a.ExchangeID)
+}
+
+func (a *adapter) CancelPairOrder(pairSymbol string, orderID int64, ctx context.Context) error {
+	err := a.futuresAPI.CancelOrderByID(context.Background(), pairSymbol, orderID)
+	return mapCancelOrderError(err, func() (*futures.Order, error) {
+		return a.futuresAPI.GetOrderDataByOrderID(context.Background(), pairSymbol, orderID)
+	})
+}
+
+func (a *adapter) CancelPairOrderByClientOrderID(
+	pairSymbol string,
+	clientOrderID string,
+	ctx context.Context,
+) error {
+	err := a.futuresAPI.CancelOrderByClientOrderID(context.Background(), pairSymbol, clientOrderID)
+	return mapCancelOrderError(err, func() (*futures.Order, error) {
+		return a.futuresAPI.GetOrderDataByClientOrderID(
+			context.Background(), pairSymbol, clientOrderID,
+		)
+	})
+}
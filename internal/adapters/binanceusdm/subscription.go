@@ -0,0 +1,68 @@
+package binanceusdm
+
+import (
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+func (a *adapter) SubscribeCandle(
+	pairSymbol string,
+	interval consts.Interval,
+	eventCallback func(event workers.CandleEvent),
+	errorHandler func(err error),
+) error {
+	return a.candleWorker.SubscribeToCandle(
+		pairSymbol,
+		interval,
+		eventCallback,
+		errorHandler,
+	)
+}
+
+func (a *adapter) SubscribeAccountTrades(
+	eventCallback workers.TradeEventPrivateCallback,
+	errorHandler func(err error),
+) error {
+	return a.tradeWorker.SubscribeToTradeEventsPrivate(eventCallback, errorHandler)
+}
+
+func (a *adapter) SubscribePublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	return a.publicTradeWorker.SubscribeToPublicTrades(
+		pairSymbol,
+		eventCallback,
+		errorHandler,
+	)
+}
+
+func (a *adapter) SubscribePositions(
+	eventCallback workers.PositionEventCallback,
+	errorHandler func(err error),
+) error {
+	return a.positionWorker.SubscribeToPositions(eventCallback, errorHandler)
+}
+
+func (a *adapter) UnsubscribeCandle(
+	pairSymbol string,
+	interval consts.Interval,
+) {
+	a.candleWorker.Unsubscribe(
+		pairSymbol,
+		convertInterval(interval),
+	)
+}
+
+func (a *adapter) UnsubscribeAccountTrades() {
+	a.tradeWorker.Unsubscribe(tradeSubscriptionKey)
+}
+
+func (a *adapter) UnsubscribePublicTrades(pairSymbol string) {
+	a.publicTradeWorker.Unsubscribe(pairSymbol)
+}
+
+func (a *adapter) UnsubscribePositions() {
+	a.positionWorker.Unsubscribe(positionSubscriptionKey)
+}
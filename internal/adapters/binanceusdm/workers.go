@@ -0,0 +1,198 @@
+package binanceusdm
+
+import (
+	"fmt"
+
+	"github.com/adshao/go-binance/v2/futures"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+const (
+	tradeSubscriptionKey    = "subscription"
+	positionSubscriptionKey = "positions"
+)
+
+// CandleWorker - candle worker for binance futures
+type CandleWorker struct {
+	workers.CandleWorker
+	futuresAPI wrapper.BinanceFuturesAPIWrapper
+}
+
+// TradeEventWorker - account trades worker for binance futures
+type TradeEventWorker struct {
+	workers.TradeEventWorker
+	futuresAPI wrapper.BinanceFuturesAPIWrapper
+}
+
+// PublicTradeWorker - pair market trades worker for binance futures
+type PublicTradeWorker struct {
+	workers.PublicTradeWorker
+	futuresAPI wrapper.BinanceFuturesAPIWrapper
+}
+
+// PositionWorker - account positions worker for binance futures
+type PositionWorker struct {
+	workers.PositionWorker
+	futuresAPI wrapper.BinanceFuturesAPIWrapper
+}
+
+func NewCandleWorker(futuresAPI wrapper.BinanceFuturesAPIWrapper) *CandleWorker {
+	w := &CandleWorker{futuresAPI: futuresAPI}
+	w.ExchangeTag = consts.BinanceUSDMAdapterTag
+	return w
+}
+
+func NewTradeEventWorker(futuresAPI wrapper.BinanceFuturesAPIWrapper) *TradeEventWorker {
+	w := &TradeEventWorker{futuresAPI: futuresAPI}
+	w.ExchangeTag = consts.BinanceUSDMAdapterTag
+	return w
+}
+
+func NewPublicTradeWorker(futuresAPI wrapper.BinanceFuturesAPIWrapper) *PublicTradeWorker {
+	w := &PublicTradeWorker{futuresAPI: futuresAPI}
+	w.ExchangeTag = consts.BinanceUSDMAdapterTag
+	return w
+}
+
+func NewPositionWorker(futuresAPI wrapper.BinanceFuturesAPIWrapper) *PositionWorker {
+	w := &PositionWorker{futuresAPI: futuresAPI}
+	w.ExchangeTag = consts.BinanceUSDMAdapterTag
+	return w
+}
+
+func (w *CandleWorker) SubscribeToCandle(
+	pairSymbol string,
+	interval consts.Interval,
+	eventCallback func(event workers.CandleEvent),
+	errorHandler func(err error),
+) error {
+	if w.CandleWorker.IsSubscriptionExists(pairSymbol, convertInterval(interval)) {
+		return nil
+	}
+
+	wsDone, wsStop, err := w.futuresAPI.SubscribeToCandle(
+		pairSymbol,
+		convertInterval(interval),
+		eventCallback,
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	w.CandleWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		pairSymbol, convertInterval(interval),
+	)
+	return nil
+}
+
+func (w *TradeEventWorker) SubscribeToTradeEventsPrivate(
+	eventCallback workers.TradeEventPrivateCallback,
+	errorHandler func(err error),
+) error {
+	if w.TradeEventWorker.IsSubscriptionExists(tradeSubscriptionKey) {
+		return nil
+	}
+
+	wsDone, wsStop, err := w.futuresAPI.SubscribeToUserData(
+		func(event *futures.WsUserDataEvent) {
+			if event == nil ||
+				event.Event != futures.UserDataEventTypeOrderTradeUpdate ||
+				event.OrderTradeUpdate.ExecutionType != futures.OrderExecutionTypeTrade {
+				// ignore non trade events
+				return
+			}
+
+			wEvent, err := mappers.ConvertTradeEventPrivate(event.OrderTradeUpdate, w.ExchangeTag)
+			if err != nil {
+				errorHandler(fmt.Errorf("convert trade event: %w", err))
+				return
+			}
+
+			eventCallback(wEvent)
+		},
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe to trade events: %w", err)
+	}
+
+	w.TradeEventWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		tradeSubscriptionKey,
+	)
+	return nil
+}
+
+func (w *PublicTradeWorker) SubscribeToPublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	if w.PublicTradeWorker.IsSubscriptionExists(pairSymbol) {
+		return nil
+	}
+
+	wsDone, wsStop, err := w.futuresAPI.SubscribeToPublicTrades(
+		w.ExchangeTag,
+		pairSymbol,
+		eventCallback,
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe to public trades: %w", err)
+	}
+
+	w.PublicTradeWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		pairSymbol,
+	)
+	return nil
+}
+
+func (w *PositionWorker) SubscribeToPositions(
+	eventCallback workers.PositionEventCallback,
+	errorHandler func(err error),
+) error {
+	if w.PositionWorker.IsSubscriptionExists(positionSubscriptionKey) {
+		return nil
+	}
+
+	wsDone, wsStop, err := w.futuresAPI.SubscribeToUserData(
+		func(event *futures.WsUserDataEvent) {
+			if event == nil || event.Event != futures.UserDataEventTypeAccountUpdate {
+				// ignore non position events
+				return
+			}
+
+			for _, position := range event.AccountUpdate.Positions {
+				wEvent, err := mappers.ConvertPositionEvent(position, event.Time, w.ExchangeTag)
+				if err != nil {
+					errorHandler(fmt.Errorf("convert position event: %w", err))
+					continue
+				}
+
+				eventCallback(wEvent)
+			}
+		},
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe to positions: %w", err)
+	}
+
+	w.PositionWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		positionSubscriptionKey,
+	)
+	return nil
+}
@@ -0,0 +1,441 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: wrapper.go
+//
+// Generated by this command:
+//
+//	mockgen -source=wrapper.go -destination=mock_wrapper.go -package=wrapper
+//
+
+// Package wrapper is a generated GoMock package.
+package wrapper
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	binance "github.com/adshao/go-binance/v2"
+	futures "github.com/adshao/go-binance/v2/futures"
+	workers "github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBinanceFuturesAPIWrapper is a mock of BinanceFuturesAPIWrapper interface.
+type MockBinanceFuturesAPIWrapper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBinanceFuturesAPIWrapperMockRecorder
+	isgomock struct{}
+}
+
+// MockBinanceFuturesAPIWrapperMockRecorder is the mock recorder for MockBinanceFuturesAPIWrapper.
+type MockBinanceFuturesAPIWrapperMockRecorder struct {
+	mock *MockBinanceFuturesAPIWrapper
+}
+
+// NewMockBinanceFuturesAPIWrapper creates a new mock instance.
+func NewMockBinanceFuturesAPIWrapper(ctrl *gomock.Controller) *MockBinanceFuturesAPIWrapper {
+	mock := &MockBinanceFuturesAPIWrapper{ctrl: ctrl}
+	mock.recorder = &MockBinanceFuturesAPIWrapperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBinanceFuturesAPIWrapper) EXPECT() *MockBinanceFuturesAPIWrapperMockRecorder {
+	return m.recorder
+}
+
+// CancelOrderByClientOrderID mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) CancelOrderByClientOrderID(ctx context.Context, pairSymbol, clientOrderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrderByClientOrderID", ctx, pairSymbol, clientOrderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelOrderByClientOrderID indicates an expected call of CancelOrderByClientOrderID.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) CancelOrderByClientOrderID(ctx, pairSymbol, clientOrderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrderByClientOrderID", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).CancelOrderByClientOrderID), ctx, pairSymbol, clientOrderID)
+}
+
+// CancelOrderByID mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) CancelOrderByID(ctx context.Context, pairSymbol string, orderID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrderByID", ctx, pairSymbol, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelOrderByID indicates an expected call of CancelOrderByID.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) CancelOrderByID(ctx, pairSymbol, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrderByID", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).CancelOrderByID), ctx, pairSymbol, orderID)
+}
+
+// ChangeLeverage mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) ChangeLeverage(ctx context.Context, pairSymbol string, leverage int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeLeverage", ctx, pairSymbol, leverage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeLeverage indicates an expected call of ChangeLeverage.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) ChangeLeverage(ctx, pairSymbol, leverage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeLeverage", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).ChangeLeverage), ctx, pairSymbol, leverage)
+}
+
+// ChangeMarginType mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) ChangeMarginType(ctx context.Context, pairSymbol string, marginType futures.MarginType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMarginType", ctx, pairSymbol, marginType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeMarginType indicates an expected call of ChangeMarginType.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) ChangeMarginType(ctx, pairSymbol, marginType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMarginType", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).ChangeMarginType), ctx, pairSymbol, marginType)
+}
+
+// Connect mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) Connect(ctx context.Context, keyPublic, keySecret string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Connect", ctx, keyPublic, keySecret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Connect indicates an expected call of Connect.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) Connect(ctx, keyPublic, keySecret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).Connect), ctx, keyPublic, keySecret)
+}
+
+// GetAPIKeyPermission mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetAPIKeyPermission(arg0 context.Context) (*binance.APIKeyPermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeyPermission", arg0)
+	ret0, _ := ret[0].(*binance.APIKeyPermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeyPermission indicates an expected call of GetAPIKeyPermission.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetAPIKeyPermission(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeyPermission", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetAPIKeyPermission), arg0)
+}
+
+// GetAccountData mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetAccountData(arg0 context.Context) (*futures.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountData", arg0)
+	ret0, _ := ret[0].(*futures.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountData indicates an expected call of GetAccountData.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetAccountData(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountData", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetAccountData), arg0)
+}
+
+// GetAccountTrades mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetAccountTrades(ctx context.Context, pairSymbol string, startTime, endTime, fromID int64, limit int) ([]*futures.AccountTrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountTrades", ctx, pairSymbol, startTime, endTime, fromID, limit)
+	ret0, _ := ret[0].([]*futures.AccountTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTrades indicates an expected call of GetAccountTrades.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetAccountTrades(ctx, pairSymbol, startTime, endTime, fromID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTrades", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetAccountTrades), ctx, pairSymbol, startTime, endTime, fromID, limit)
+}
+
+// GetCommissionRate mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetCommissionRate(ctx context.Context, pairSymbol string) (*futures.CommissionRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommissionRate", ctx, pairSymbol)
+	ret0, _ := ret[0].(*futures.CommissionRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommissionRate indicates an expected call of GetCommissionRate.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetCommissionRate(ctx, pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommissionRate", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetCommissionRate), ctx, pairSymbol)
+}
+
+// GetExchangeInfo mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetExchangeInfo(ctx context.Context) (*futures.ExchangeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeInfo", ctx)
+	ret0, _ := ret[0].(*futures.ExchangeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeInfo indicates an expected call of GetExchangeInfo.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetExchangeInfo(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeInfo", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetExchangeInfo), ctx)
+}
+
+// GetKlines mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetKlines(ctx context.Context, pairSymbol, interval string, limit int) ([]*futures.Kline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKlines", ctx, pairSymbol, interval, limit)
+	ret0, _ := ret[0].([]*futures.Kline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKlines indicates an expected call of GetKlines.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetKlines(ctx, pairSymbol, interval, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKlines", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetKlines), ctx, pairSymbol, interval, limit)
+}
+
+// GetOrderDataByClientOrderID mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetOrderDataByClientOrderID(ctx context.Context, pairSymbol, clientOrderID string) (*futures.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderDataByClientOrderID", ctx, pairSymbol, clientOrderID)
+	ret0, _ := ret[0].(*futures.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderDataByClientOrderID indicates an expected call of GetOrderDataByClientOrderID.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetOrderDataByClientOrderID(ctx, pairSymbol, clientOrderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderDataByClientOrderID", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetOrderDataByClientOrderID), ctx, pairSymbol, clientOrderID)
+}
+
+// GetOrderDataByOrderID mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetOrderDataByOrderID(ctx context.Context, pairSymbol string, orderID int64) (*futures.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderDataByOrderID", ctx, pairSymbol, orderID)
+	ret0, _ := ret[0].(*futures.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderDataByOrderID indicates an expected call of GetOrderDataByOrderID.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetOrderDataByOrderID(ctx, pairSymbol, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderDataByOrderID", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetOrderDataByOrderID), ctx, pairSymbol, orderID)
+}
+
+// GetOrderTradeHistory mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetOrderTradeHistory(ctx context.Context, orderID int64, pairSymbol string) ([]*futures.AccountTrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderTradeHistory", ctx, orderID, pairSymbol)
+	ret0, _ := ret[0].([]*futures.AccountTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderTradeHistory indicates an expected call of GetOrderTradeHistory.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetOrderTradeHistory(ctx, orderID, pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderTradeHistory", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetOrderTradeHistory), ctx, orderID, pairSymbol)
+}
+
+// GetOrders mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetOrders(ctx context.Context, pairSymbol string, fromOrderID int64, limit int) ([]*futures.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrders", ctx, pairSymbol, fromOrderID, limit)
+	ret0, _ := ret[0].([]*futures.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrders indicates an expected call of GetOrders.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetOrders(ctx, pairSymbol, fromOrderID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetOrders), ctx, pairSymbol, fromOrderID, limit)
+}
+
+// GetPositions mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetPositions(ctx context.Context, pairSymbol string) ([]*futures.PositionRisk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPositions", ctx, pairSymbol)
+	ret0, _ := ret[0].([]*futures.PositionRisk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPositions indicates an expected call of GetPositions.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetPositions(ctx, pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPositions", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetPositions), ctx, pairSymbol)
+}
+
+// GetPremiumIndex mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetPremiumIndex(ctx context.Context, pairSymbol string) ([]*futures.PremiumIndex, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPremiumIndex", ctx, pairSymbol)
+	ret0, _ := ret[0].([]*futures.PremiumIndex)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPremiumIndex indicates an expected call of GetPremiumIndex.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetPremiumIndex(ctx, pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPremiumIndex", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetPremiumIndex), ctx, pairSymbol)
+}
+
+// GetPrices mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetPrices(ctx context.Context, pairSymbol string) ([]*futures.SymbolPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrices", ctx, pairSymbol)
+	ret0, _ := ret[0].([]*futures.SymbolPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrices indicates an expected call of GetPrices.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetPrices(ctx, pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrices", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetPrices), ctx, pairSymbol)
+}
+
+// GetServerTime mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) GetServerTime(arg0 context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerTime indicates an expected call of GetServerTime.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) GetServerTime(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerTime", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).GetServerTime), arg0)
+}
+
+// Ping mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) Ping(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) Ping(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).Ping), arg0)
+}
+
+// PlaceOrder mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) PlaceOrder(ctx context.Context, task OrderTask) (*futures.CreateOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceOrder", ctx, task)
+	ret0, _ := ret[0].(*futures.CreateOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceOrder indicates an expected call of PlaceOrder.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) PlaceOrder(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceOrder", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).PlaceOrder), ctx, task)
+}
+
+// SetServerTimeOffset mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) SetServerTimeOffset(offset time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetServerTimeOffset", offset)
+}
+
+// SetServerTimeOffset indicates an expected call of SetServerTimeOffset.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) SetServerTimeOffset(offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServerTimeOffset", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).SetServerTimeOffset), offset)
+}
+
+// SubscribeToCandle mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) SubscribeToCandle(pairSymbol, interval string, eventCallback func(workers.CandleEvent), errorHandler func(error)) (chan struct{}, chan struct{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToCandle", pairSymbol, interval, eventCallback, errorHandler)
+	ret0, _ := ret[0].(chan struct{})
+	ret1, _ := ret[1].(chan struct{})
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SubscribeToCandle indicates an expected call of SubscribeToCandle.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) SubscribeToCandle(pairSymbol, interval, eventCallback, errorHandler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToCandle", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).SubscribeToCandle), pairSymbol, interval, eventCallback, errorHandler)
+}
+
+// SubscribeToPublicTrades mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) SubscribeToPublicTrades(exchangeTag, pairSymbol string, eventCallback workers.PublicTradeEventCallback, errorHandler func(error)) (chan struct{}, chan struct{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToPublicTrades", exchangeTag, pairSymbol, eventCallback, errorHandler)
+	ret0, _ := ret[0].(chan struct{})
+	ret1, _ := ret[1].(chan struct{})
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SubscribeToPublicTrades indicates an expected call of SubscribeToPublicTrades.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) SubscribeToPublicTrades(exchangeTag, pairSymbol, eventCallback, errorHandler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToPublicTrades", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).SubscribeToPublicTrades), exchangeTag, pairSymbol, eventCallback, errorHandler)
+}
+
+// SubscribeToUserData mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) SubscribeToUserData(eventCallback futures.WsUserDataHandler, errorHandler func(error)) (chan struct{}, chan struct{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToUserData", eventCallback, errorHandler)
+	ret0, _ := ret[0].(chan struct{})
+	ret1, _ := ret[1].(chan struct{})
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SubscribeToUserData indicates an expected call of SubscribeToUserData.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) SubscribeToUserData(eventCallback, errorHandler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToUserData", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).SubscribeToUserData), eventCallback, errorHandler)
+}
+
+// Sync mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) Sync(arg0 context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Sync", arg0)
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) Sync(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).Sync), arg0)
+}
+
+// Transfer mocks base method.
+func (m *MockBinanceFuturesAPIWrapper) Transfer(ctx context.Context, transferType binance.UserUniversalTransferType, asset string, amount float64) (*binance.CreateUserUniversalTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", ctx, transferType, asset, amount)
+	ret0, _ := ret[0].(*binance.CreateUserUniversalTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockBinanceFuturesAPIWrapperMockRecorder) Transfer(ctx, transferType, asset, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockBinanceFuturesAPIWrapper)(nil).Transfer), ctx, transferType, asset, amount)
+}
@@ -0,0 +1,523 @@
+//go:generate mockgen -source=$GOFILE -destination=mock_$GOFILE -package=$GOPACKAGE
+package wrapper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/helpers/stream"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+const listenKeyKeepaliveInterval = time.Minute * 30
+
+type BinanceFuturesAPIWrapper interface {
+	Sync(context.Context)
+	// GetServerTime - get binance server time
+	GetServerTime(context.Context) (time.Time, error)
+	// SetServerTimeOffset - set server time minus local time for the signed requests
+	SetServerTimeOffset(offset time.Duration)
+	Connect(ctx context.Context, keyPublic, keySecret string) error
+	Ping(context.Context) error
+	GetAccountData(context.Context) (*futures.Account, error)
+	// GetAPIKeyPermission - get the API key restrictions, it's the spot API
+	GetAPIKeyPermission(context.Context) (*binance.APIKeyPermission, error)
+
+	GetPrices(ctx context.Context, pairSymbol string) ([]*futures.SymbolPrice, error)
+
+	// GetExchangeInfo - get all the contracts data
+	GetExchangeInfo(ctx context.Context) (*futures.ExchangeInfo, error)
+
+	GetOrderDataByOrderID(
+		ctx context.Context,
+		pairSymbol string,
+		orderID int64,
+	) (*futures.Order, error)
+
+	GetOrderDataByClientOrderID(
+		ctx context.Context,
+		pairSymbol string,
+		clientOrderID string,
+	) (*futures.Order, error)
+
+	CancelOrderByID(ctx context.Context, pairSymbol string, orderID int64) error
+
+	CancelOrderByClientOrderID(
+		ctx context.Context,
+		pairSymbol string,
+		clientOrderID string,
+	) error
+
+	PlaceOrder(ctx context.Context, task OrderTask) (*futures.CreateOrderResponse, error)
+
+	GetOrderTradeHistory(
+		ctx context.Context,
+		orderID int64,
+		pairSymbol string,
+	) ([]*futures.AccountTrade, error)
+
+	// GetAccountTrades - get trades by time range or from trade ID when fromID is set
+	GetAccountTrades(
+		ctx context.Context,
+		pairSymbol string,
+		startTime int64,
+		endTime int64,
+		fromID int64,
+		limit int,
+	) ([]*futures.AccountTrade, error)
+
+	// GetOrders - get all account orders from order ID
+	GetOrders(
+		ctx context.Context,
+		pairSymbol string,
+		fromOrderID int64,
+		limit int,
+	) ([]*futures.Order, error)
+
+	GetCommissionRate(ctx context.Context, pairSymbol string) (*futures.CommissionRate, error)
+
+	GetKlines(
+		ctx context.Context,
+		pairSymbol string,
+		interval string,
+		limit int,
+	) ([]*futures.Kline, error)
+
+	// GetPositions - get positions risk, all pairs when the pair symbol is empty
+	GetPositions(ctx context.Context, pairSymbol string) ([]*futures.PositionRisk, error)
+
+	ChangeLeverage(ctx context.Context, pairSymbol string, leverage int) error
+
+	ChangeMarginType(
+		ctx context.Context,
+		pairSymbol string,
+		marginType futures.MarginType,
+	) error
+
+	GetPremiumIndex(ctx context.Context, pairSymbol string) ([]*futures.PremiumIndex, error)
+
+	// Transfer - universal transfer between the spot & futures wallets
+	Transfer(
+		ctx context.Context,
+		transferType binance.UserUniversalTransferType,
+		asset string,
+		amount float64,
+	) (*binance.CreateUserUniversalTransferResponse, error)
+
+	SubscribeToCandle(
+		pairSymbol string,
+		interval string,
+		eventCallback func(event workers.CandleEvent),
+		errorHandler func(err error),
+	) (doneC chan struct{}, stopC chan struct{}, err error)
+
+	SubscribeToPublicTrades(
+		exchangeTag string,
+		pairSymbol string,
+		eventCallback workers.PublicTradeEventCallback,
+		errorHandler func(err error),
+	) (doneC chan struct{}, stopC chan struct{}, err error)
+
+	// SubscribeToUserData - subscribe to the account events stream
+	SubscribeToUserData(
+		eventCallback futures.WsUserDataHandler,
+		errorHandler func(err error),
+	) (doneC chan struct{}, stopC chan struct{}, err error)
+}
+
+// OrderTask - futures order placement params
+type OrderTask struct {
+	PairSymbol    string
+	Side          futures.SideType
+	IsMarketOrder bool
+	Qty           string
+	Price         string
+	ClientOrderID string // optional
+	ReduceOnly    bool
+}
+
+const (
+	wsBaseURL        = "wss://fstream.binance.com"
+	wsTestnetBaseURL = "wss://stream.binancefuture.com"
+)
+
+type BinanceFuturesClientWrapper struct {
+	*futures.Client
+
+	// spot client is used for the wallets transfers
+	spotClient *binance.Client
+
+	cfg config.Config
+}
+
+func NewWrapper(opts ...config.Option) BinanceFuturesAPIWrapper {
+	return &BinanceFuturesClientWrapper{cfg: config.New(opts...)}
+}
+
+func (b *BinanceFuturesClientWrapper) Sync(ctx context.Context) {
+	//goland:noinspection GoUnhandledErrorResult
+	b.NewSetServerTimeService().Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) GetServerTime(ctx context.Context) (time.Time, error) {
+	// unix timestamp ms
+	serverTime, err := b.NewServerTimeService().Do(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(serverTime), nil
+}
+
+func (b *BinanceFuturesClientWrapper) SetServerTimeOffset(offset time.Duration) {
+	// binance client offset is local time minus server time, ms
+	b.TimeOffset = -offset.Milliseconds()
+	if b.spotClient != nil {
+		b.spotClient.TimeOffset = b.TimeOffset
+	}
+}
+
+func (b *BinanceFuturesClientWrapper) Connect(
+	ctx context.Context,
+	keyPublic,
+	keySecret string,
+) error {
+	b.Client = futures.NewClient(keyPublic, keySecret)
+	b.BaseURL = b.cfg.GetRESTBaseURL(futures.BaseApiMainUrl, futures.BaseApiTestnetUrl)
+	b.HTTPClient = b.cfg.NewHTTPClient(0)
+	b.spotClient = binance.NewClient(keyPublic, keySecret)
+	b.spotClient.HTTPClient = b.HTTPClient
+	if err := b.Ping(ctx); err != nil {
+		return fmt.Errorf("ping binance futures: %w", err)
+	}
+	return nil
+}
+
+func (b *BinanceFuturesClientWrapper) Ping(ctx context.Context) error {
+	var err error
+	for attemptNumber := 1; attemptNumber <= consts.PingRetryAttempts; attemptNumber++ {
+		if err = b.NewPingService().Do(ctx); err == nil {
+			return nil
+		}
+
+		time.Sleep(consts.PingRetryWaitTime)
+	}
+
+	return fmt.Errorf("ping exchange: %w", err)
+}
+
+func (b *BinanceFuturesClientWrapper) GetAccountData(
+	ctx context.Context,
+) (*futures.Account, error) {
+	return b.NewGetAccountService().Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) GetAPIKeyPermission(
+	ctx context.Context,
+) (*binance.APIKeyPermission, error) {
+	return b.spotClient.NewGetAPIKeyPermission().Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) GetPrices(
+	ctx context.Context,
+	pairSymbol string,
+) ([]*futures.SymbolPrice, error) {
+	return b.NewListPricesService().Symbol(pairSymbol).Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) GetExchangeInfo(
+	ctx context.Context,
+) (*futures.ExchangeInfo, error) {
+	return b.NewExchangeInfoService().Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) GetOrderDataByOrderID(
+	ctx context.Context,
+	pairSymbol string,
+	orderID int64,
+) (*futures.Order, error) {
+	return b.NewGetOrderService().Symbol(pairSymbol).OrderID(orderID).Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) GetOrderDataByClientOrderID(
+	ctx context.Context,
+	pairSymbol string,
+	clientOrderID string,
+) (*futures.Order, error) {
+	return b.NewGetOrderService().Symbol(pairSymbol).
+		OrigClientOrderID(clientOrderID).Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) CancelOrderByID(
+	ctx context.Context,
+	pairSymbol string,
+	orderID int64,
+) error {
+	_, err := b.NewCancelOrderService().Symbol(pairSymbol).OrderID(orderID).Do(ctx)
+	return err
+}
+
+func (b *BinanceFuturesClientWrapper) CancelOrderByClientOrderID(
+	ctx context.Context,
+	pairSymbol string,
+	clientOrderID string,
+) error {
+	_, err := b.NewCancelOrderService().Symbol(pairSymbol).
+		OrigClientOrderID(clientOrderID).Do(ctx)
+	return err
+}
+
+func (b *BinanceFuturesClientWrapper) PlaceOrder(
+	ctx context.Context,
+	task OrderTask,
+) (*futures.CreateOrderResponse, error) {
+	orderService := b.NewCreateOrderService().Symbol(task.PairSymbol).
+		Side(task.Side).Quantity(task.Qty)
+
+	if task.IsMarketOrder {
+		orderService.Type(futures.OrderTypeMarket)
+	} else {
+		orderService.Type(futures.OrderTypeLimit).
+			TimeInForce(futures.TimeInForceTypeGTC).Price(task.Price)
+	}
+
+	if task.ReduceOnly {
+		orderService.ReduceOnly(true)
+	}
+
+	if task.ClientOrderID != "" {
+		orderService.NewClientOrderID(task.ClientOrderID)
+	}
+
+	return orderService.Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) GetOrderTradeHistory(
+	ctx context.Context,
+	orderID int64,
+	pairSymbol string,
+) ([]*futures.AccountTrade, error) {
+	return b.NewListAccountTradeService().Symbol(pairSymbol).
+		OrderID(orderID).Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) GetAccountTrades(
+	ctx context.Context,
+	pairSymbol string,
+	startTime int64,
+	endTime int64,
+	fromID int64,
+	limit int,
+) ([]*futures.AccountTrade, error) {
+	service := b.NewListAccountTradeService().Symbol(pairSymbol).Limit(limit)
+	if fromID > 0 {
+		// time range can't be combined with trade ID
+		return service.FromID(fromID).Do(ctx)
+	}
+
+	return service.StartTime(startTime).EndTime(endTime).Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) GetOrders(
+	ctx context.Context,
+	pairSymbol string,
+	fromOrderID int64,
+	limit int,
+) ([]*futures.Order, error) {
+	return b.NewListOrdersService().Symbol(pairSymbol).
+		OrderID(fromOrderID).Limit(limit).Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) GetCommissionRate(
+	ctx context.Context,
+	pairSymbol string,
+) (*futures.CommissionRate, error) {
+	return b.NewCommissionRateService().Symbol(pairSymbol).Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) GetKlines(
+	ctx context.Context,
+	pairSymbol string,
+	interval string,
+	limit int,
+) ([]*futures.Kline, error) {
+	return b.NewKlinesService().Symbol(pairSymbol).Interval(interval).
+		Limit(limit).Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) GetPositions(
+	ctx context.Context,
+	pairSymbol string,
+) ([]*futures.PositionRisk, error) {
+	service := b.NewGetPositionRiskService()
+	if pairSymbol != "" {
+		service.Symbol(pairSymbol)
+	}
+
+	return service.Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) ChangeLeverage(
+	ctx context.Context,
+	pairSymbol string,
+	leverage int,
+) error {
+	_, err := b.NewChangeLeverageService().Symbol(pairSymbol).
+		Leverage(leverage).Do(ctx)
+	return err
+}
+
+func (b *BinanceFuturesClientWrapper) ChangeMarginType(
+	ctx context.Context,
+	pairSymbol string,
+	marginType futures.MarginType,
+) error {
+	return b.NewChangeMarginTypeService().Symbol(pairSymbol).
+		MarginType(marginType).Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) GetPremiumIndex(
+	ctx context.Context,
+	pairSymbol string,
+) ([]*futures.PremiumIndex, error) {
+	return b.NewPremiumIndexService().Symbol(pairSymbol).Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) Transfer(
+	ctx context.Context,
+	transferType binance.UserUniversalTransferType,
+	asset string,
+	amount float64,
+) (*binance.CreateUserUniversalTransferResponse, error) {
+	return b.spotClient.NewUserUniversalTransferService().
+		Type(transferType).
+		Asset(asset).
+		Amount(amount).
+		Do(ctx)
+}
+
+func (b *BinanceFuturesClientWrapper) SubscribeToCandle(
+	pairSymbol string,
+	interval string,
+	eventCallback func(event workers.CandleEvent),
+	errorHandler func(err error),
+) (doneC chan struct{}, stopC chan struct{}, err error) {
+	streamName := strings.ToLower(pairSymbol) + "@kline_" + interval
+
+	return b.wsServe(
+		stream.GetRawURL(b.getWsBaseURL(), streamName),
+		func(message []byte) {
+			event := new(futures.WsKlineEvent)
+			if err := json.Unmarshal(message, event); err != nil {
+				errorHandler(fmt.Errorf("decode candle event: %w", err))
+				return
+			}
+
+			wEvent, err := mappers.ConvertCandleEvent(*event)
+			if err != nil {
+				errorHandler(fmt.Errorf("convert candle event: %w", err))
+				return
+			}
+
+			eventCallback(wEvent)
+		},
+		errorHandler,
+	)
+}
+
+func (b *BinanceFuturesClientWrapper) SubscribeToPublicTrades(
+	exchangeTag string,
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) (doneC chan struct{}, stopC chan struct{}, err error) {
+	return b.wsServe(
+		stream.GetRawURL(b.getWsBaseURL(), strings.ToLower(pairSymbol)+"@aggTrade"),
+		func(message []byte) {
+			event := new(futures.WsAggTradeEvent)
+			if err := json.Unmarshal(message, event); err != nil {
+				errorHandler(fmt.Errorf("decode public trade event: %w", err))
+				return
+			}
+
+			wEvent, err := mappers.ConvertPublicTradeEvent(*event, exchangeTag)
+			if err != nil {
+				errorHandler(fmt.Errorf("convert public trade event: %w", err))
+				return
+			}
+
+			eventCallback(wEvent)
+		},
+		errorHandler,
+	)
+}
+
+func (b *BinanceFuturesClientWrapper) SubscribeToUserData(
+	eventCallback futures.WsUserDataHandler,
+	errorHandler func(err error),
+) (doneC chan struct{}, stopC chan struct{}, err error) {
+	listenKey, err := b.NewStartUserStreamService().Do(context.Background())
+	if err != nil {
+		return nil, nil, fmt.Errorf("start user stream: %w", err)
+	}
+
+	doneC, wsStopC, err := b.wsServe(
+		stream.GetRawURL(b.getWsBaseURL(), listenKey),
+		func(message []byte) {
+			event := new(futures.WsUserDataEvent)
+			if err := json.Unmarshal(message, event); err != nil {
+				errorHandler(fmt.Errorf("decode user data event: %w", err))
+				return
+			}
+
+			eventCallback(event)
+		},
+		errorHandler,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("serve: %w", err)
+	}
+
+	stopC = make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-time.After(listenKeyKeepaliveInterval):
+				if err := b.NewKeepaliveUserStreamService().ListenKey(listenKey).
+					Do(context.Background()); err != nil {
+					errorHandler(fmt.Errorf("keepalive user stream: %w", err))
+				}
+			case <-doneC:
+				return
+			case <-stopC:
+				wsStopC <- struct{}{}
+				return
+			}
+		}
+	}()
+
+	return doneC, stopC, nil
+}
+
+// getWsBaseURL - the streams host, the adapter ws base URL takes precedence
+func (b *BinanceFuturesClientWrapper) getWsBaseURL() string {
+	return b.cfg.GetWsBaseURL(wsBaseURL, wsTestnetBaseURL)
+}
+
+// wsServe - serve the stream with the adapter dialer: proxy & local address
+func (b *BinanceFuturesClientWrapper) wsServe(
+	wsURL string,
+	handler func(message []byte),
+	errorHandler func(err error),
+) (doneC, stopC chan struct{}, err error) {
+	return stream.Serve(b.cfg.NewWsDialer(), wsURL, handler, errorHandler)
+}
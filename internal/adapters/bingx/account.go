@@ -1,18 +1,36 @@
 package bingx
 
 import (
+	"context"
 	"fmt"
-	"strconv"
 
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bingx/helpers/mappers"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+	"github.com/shopspring/decimal"
 )
 
+const endpointGetAPIKeyPermissions = "/openApi/v1/account/apiPermissions"
+
 func (a *adapter) CanTrade() (bool, error) {
-	_, err := a.client.GetBalance()
+	info, err := a.GetAPIKeyInfo()
 	if err != nil {
 		return false, err
 	}
-	return true, nil
+	return info.HasPermission(pkgStructs.APIKeyPermissionSpotTrade), nil
+}
+
+func (a *adapter) GetAPIKeyInfo() (pkgStructs.APIKeyInfo, error) {
+	var data mappers.APIKeyPermissions
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetAPIKeyPermissions,
+		nil,
+		&data,
+	); err != nil {
+		return pkgStructs.APIKeyInfo{}, fmt.Errorf("get API key permissions: %w", err)
+	}
+	return mappers.ConvertAPIKeyPermissions(data), nil
 }
 
 func (a *adapter) GetAccountBalance() ([]structs.Balance, error) {
@@ -23,12 +41,12 @@ func (a *adapter) GetAccountBalance() ([]structs.Balance, error) {
 
 	var result []structs.Balance
 	for _, balanceData := range balances {
-		assetFree, err := strconv.ParseFloat(balanceData.Free, 64)
+		assetFree, err := decimal.NewFromString(balanceData.Free)
 		if err != nil {
 			return nil, fmt.Errorf("parse %q free: %w", balanceData.Asset, err)
 		}
 
-		assetLocked, err := strconv.ParseFloat(balanceData.Locked, 64)
+		assetLocked, err := decimal.NewFromString(balanceData.Locked)
 		if err != nil {
 			return nil, fmt.Errorf("parse %q locked: %w", balanceData.Asset, err)
 		}
@@ -0,0 +1,51 @@
+package bingx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bingx/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+func TestCanTrade(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions []int
+		expected    bool
+	}{
+		{name: "spot trade", permissions: []int{1, 2}, expected: true},
+		{name: "read-only", permissions: []int{2}, expected: false},
+		{name: "futures trade only", permissions: []int{2, 3}, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// given
+			mux := http.NewServeMux()
+			mux.HandleFunc(endpointGetAPIKeyPermissions, func(w http.ResponseWriter, r *http.Request) {
+				writeTestResponse(w, mappers.APIKeyPermissions{Permissions: test.permissions})
+			})
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			a := New(config.WithRESTBaseURL(server.URL))
+			require.NoError(t, a.Connect(pkgStructs.APICredentials{
+				Type:    pkgStructs.APICredentialsTypeKeypair,
+				Keypair: pkgStructs.APIKeypair{Public: "public", Secret: "secret"},
+			}))
+
+			// when
+			canTrade, err := a.CanTrade()
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, canTrade)
+		})
+	}
+}
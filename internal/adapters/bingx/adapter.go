@@ -9,8 +9,10 @@ import (
 
 	adp "github.com/matrixbotio/exchange-gates-lib/internal/adapters"
 	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
 	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
 	"github.com/matrixbotio/go-common-lib/pkg/nano"
 )
@@ -30,19 +32,28 @@ type adapter struct {
 	baseadp.AdapterBase
 
 	client bingxgo.SpotClient
+	rest   *restClient
+	cfg    config.Config
 	creds  pkgStructs.APICredentials
+	clock  *baseadp.ServerClock
 
-	candleWorker *CandleEventWorkerBingX
-	tradeWorker  *TradeEventWorkerBingX
+	candleWorker      *CandleEventWorkerBingX
+	tradeWorker       *TradeEventWorkerBingX
+	publicTradeWorker *PublicTradeWorkerBingX
 }
 
-func New() adp.Adapter {
+func New(opts ...config.Option) adp.Adapter {
+	cfg := config.New(opts...)
+	clock := baseadp.NewServerClock()
 	return &adapter{
 		AdapterBase: baseadp.NewAdapterBase(
 			consts.ExchangeIDbingx,
 			adapterName,
 			consts.BingXAdapterTag,
 		),
+		rest:  newRestClient(pkgStructs.APICredentials{}, cfg, clock.Now),
+		cfg:   cfg,
+		clock: clock,
 	}
 }
 
@@ -68,15 +79,53 @@ func (a *adapter) GetLimits() pkgStructs.ExchangeLimits {
 	}
 }
 
+// Capabilities - market orders are not implemented
+func (a *adapter) Capabilities() pkgStructs.AdapterCapabilities {
+	return pkgStructs.AdapterCapabilities{
+		OrderTypes:    []pkgStructs.OrderType{pkgStructs.OrderTypeLimit},
+		TimeInForce:   []pkgStructs.TimeInForce{pkgStructs.TimeInForceGTC},
+		BatchOrders:   false,
+		OrderHistory:  true,
+		AccountTrades: true,
+		FeeLookup:     true,
+		Intervals:     a.GetSupportedIntervals(),
+		Streams: []pkgStructs.StreamType{
+			pkgStructs.StreamCandles,
+			pkgStructs.StreamPublicTrades,
+			pkgStructs.StreamAccountTrades,
+		},
+		OrderIDFormat: pkgStructs.OrderIDFormatNumeric,
+	}
+}
+
 func (a *adapter) Connect(credentials pkgStructs.APICredentials) error {
+	// bingx has no spot testnet, the stand-in URL could be set explicitly
+	if a.cfg.Testnet && a.cfg.RESTBaseURL == "" {
+		return &errs.NotSupportedError{Feature: "testnet"}
+	}
+
 	a.creds = credentials
-	a.client = bingxgo.NewSpotClient(bingxgo.NewClient(
+	// go-bingx account stream uses the library endpoint,
+	// the config is applied to the REST requests & the market streams
+	httpClient := *a.cfg.NewHTTPClient(restRequestTimeout)
+	httpClient.Transport = newSigningTransport(
+		httpClient.Transport,
+		credentials.Keypair.Secret,
+		a.clock,
+	)
+
+	client := bingxgo.NewClient(
 		credentials.Keypair.Public,
 		credentials.Keypair.Secret,
-	).SetBrokerSourceKey(brokerSourceKey))
+	).SetBrokerSourceKey(a.cfg.GetBrokerID(brokerSourceKey))
+	client.BaseURL = a.cfg.GetRESTBaseURL(restBaseURL, restBaseURL)
+	client.HTTPClient = &httpClient
+	a.client = bingxgo.NewSpotClient(client)
+	a.rest = newRestClient(credentials, a.cfg, a.clock.Now)
 
 	a.candleWorker = a.CreateCandleWorker()
 	a.tradeWorker = a.CreateTradeEventsWorker()
+	a.publicTradeWorker = a.CreatePublicTradeWorker()
 	return nil
 }
 
@@ -116,3 +165,11 @@ func (a *adapter) GetCandles(
 
 	return ConvertKlinesRest(klines)
 }
+
+func (a *adapter) GetSupportedIntervals() []consts.Interval {
+	return consts.FilterIntervals(func(interval consts.Interval) bool {
+		_, isWsSupported := ourIntervalToBingXWs[interval]
+		_, isRestSupported := ourIntervalToBingXRest[interval]
+		return isWsSupported && isRestSupported
+	})
+}
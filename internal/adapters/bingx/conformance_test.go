@@ -0,0 +1,190 @@
+package bingx
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	bingxgo "github.com/matrixbotio/go-bingx"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bingx/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/adapter/adaptertest"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	testConformancePair = "BTC-USDT"
+
+	testCodeOrderNotFound = 100404
+	testMsgOrderNotFound  = "order not exist"
+)
+
+// our status -> bingx order status
+var testOrderStatuses = map[consts.OrderStatus]string{
+	pkgStructs.OrderStatusNew:       "NEW",
+	pkgStructs.OrderStatusFilled:    "FILLED",
+	pkgStructs.OrderStatusCancelled: "CANCELED",
+}
+
+// TestConformance - bingx doesn't document the duplicate client order ID error
+func TestConformance(t *testing.T) {
+	adaptertest.Run(t, adaptertest.Config{
+		NewHarness:               newTestHarness,
+		PairSymbol:               testConformancePair,
+		OrderQty:                 "0.0001",
+		OrderPrice:               "60000.5",
+		IsClientOrderIDNotUnique: true,
+	})
+}
+
+func newTestHarness(t *testing.T) adaptertest.Harness {
+	exchange := adaptertest.NewExchange()
+	restServer := httptest.NewServer(newTestStandInHandler(exchange))
+	t.Cleanup(restServer.Close)
+	wsServer := adaptertest.NewWebsocketServer(t)
+
+	a := New(
+		config.WithRESTBaseURL(restServer.URL),
+		config.WithWsBaseURL(wsServer.URL()),
+	)
+	if err := a.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{
+			Public: "public",
+			Secret: "secret",
+		},
+	}); err != nil {
+		t.Fatalf("connect: %s", err)
+	}
+
+	return adaptertest.Harness{
+		Adapter:       a,
+		Exchange:      exchange,
+		WsConnections: wsServer.Connections,
+	}
+}
+
+// newTestStandInHandler - bingx spot REST API over the stand-in exchange.
+// The request params are sent in the query
+func newTestStandInHandler(exchange *adaptertest.Exchange) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /openApi/spot/v1/common/symbols", func(w http.ResponseWriter, r *http.Request) {
+		writeTestData(w, bingxgo.SymbolInfos{Symbols: []bingxgo.SymbolInfo{{
+			Symbol:      testConformancePair,
+			TickSize:    0.01,
+			StepSize:    0.00001,
+			MinNotional: 2,
+			MaxNotional: 500000,
+			Status:      1,
+			MinQty:      0.00001,
+			MaxQty:      100,
+		}}})
+	})
+	mux.HandleFunc("GET /openApi/spot/v1/ticker/24hr", func(w http.ResponseWriter, r *http.Request) {
+		writeTestData(w, []bingxgo.TickerData{{
+			Symbol:    testConformancePair,
+			LastPrice: 60000,
+		}})
+	})
+
+	mux.HandleFunc("POST /openApi/spot/v1/trade/order", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		side, _ := mappers.ConvertBingXSide(query.Get("side"))
+
+		order, err := exchange.PlaceOrder(adaptertest.Order{
+			ClientOrderID: query.Get("newClientOrderId"),
+			PairSymbol:    query.Get("symbol"),
+			Side:          side,
+			Qty:           decimal.RequireFromString(query.Get("quantity")),
+			Price:         decimal.RequireFromString(query.Get("price")),
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		data := convertTestOrder(order)
+		writeTestData(w, bingxgo.SpotOrderResponse{
+			Symbol:        data.Symbol,
+			OrderId:       data.OrderID,
+			TransactTime:  data.Time,
+			Price:         data.Price,
+			OrigQty:       data.OrigQty,
+			ExecutedQty:   data.ExecutedQty,
+			Status:        data.Status,
+			Type:          data.Type,
+			Side:          data.Side,
+			ClientOrderID: data.ClientOrderID,
+		})
+	})
+
+	mux.HandleFunc("GET /openApi/spot/v1/trade/query", func(w http.ResponseWriter, r *http.Request) {
+		order, err := getTestOrder(exchange, r)
+		if err != nil {
+			writeTestError(w, testCodeOrderNotFound, testMsgOrderNotFound)
+			return
+		}
+		writeTestData(w, convertTestOrder(order))
+	})
+
+	// the finished orders can't be cancelled, like on the exchange
+	mux.HandleFunc("POST /openApi/spot/v1/trade/cancel", func(w http.ResponseWriter, r *http.Request) {
+		order, err := getTestOrder(exchange, r)
+		if err != nil {
+			writeTestError(w, testCodeOrderNotFound, testMsgOrderNotFound)
+			return
+		}
+
+		order, err = exchange.CancelOrder(order.ID)
+		if err != nil {
+			writeTestError(w, testCodeOrderNotFound, errOrderNotActualMessage)
+			return
+		}
+		writeTestData(w, convertTestOrder(order))
+	})
+	return mux
+}
+
+func getTestOrder(exchange *adaptertest.Exchange, r *http.Request) (adaptertest.Order, error) {
+	if clientOrderID := r.URL.Query().Get("clientOrderID"); clientOrderID != "" {
+		return exchange.GetOrderByClientOrderID(clientOrderID)
+	}
+
+	orderID, err := strconv.ParseInt(r.URL.Query().Get("orderId"), 10, 64)
+	if err != nil {
+		return adaptertest.Order{}, err
+	}
+	return exchange.GetOrder(orderID)
+}
+
+func convertTestOrder(order adaptertest.Order) bingxgo.SpotOrder {
+	side, _ := mappers.GetBingXOrderSide(order.Side)
+
+	return bingxgo.SpotOrder{OrderBase: bingxgo.OrderBase{
+		OrderID:       order.ID,
+		ClientOrderID: order.ClientOrderID,
+		Symbol:        order.PairSymbol,
+		Price:         order.Price.String(),
+		OrigQty:       order.Qty.String(),
+		ExecutedQty:   order.FilledQty.String(),
+		Status:        testOrderStatuses[order.Status],
+		Type:          limitOrder,
+		Side:          side,
+		Time:          order.CreatedTime,
+		UpdateTime:    order.UpdatedTime,
+	}}
+}
+
+func writeTestError(w http.ResponseWriter, code int, message string) {
+	_ = json.NewEncoder(w).Encode(bingxgo.BingXResponse[any]{Code: code, Msg: message})
+}
+
+func writeTestData(w http.ResponseWriter, data any) {
+	_ = json.NewEncoder(w).Encode(bingxgo.BingXResponse[any]{Data: data})
+}
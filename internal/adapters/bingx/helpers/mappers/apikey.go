@@ -0,0 +1,43 @@
+package mappers
+
+import (
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+// permissionRead - the read permission granted to every key
+const permissionRead = 2
+
+// bingx permission -> our permission.
+// 4 & 7 are the universal & the sub-accounts transfers
+var apiKeyPermissionConvertor = map[int]consts.APIKeyPermission{
+	1: consts.APIKeyPermissionSpotTrade,
+	3: consts.APIKeyPermissionFuturesTrade,
+	5: consts.APIKeyPermissionWithdraw,
+}
+
+// APIKeyPermissions - API key permissions & IP whitelist
+type APIKeyPermissions struct {
+	APIKey      string   `json:"apiKey"`
+	Permissions []int    `json:"permissions"`
+	IPAddresses []string `json:"ipAddresses"`
+}
+
+// ConvertAPIKeyPermissions - bingx has no margin trading & doesn't report the key expiry date
+func ConvertAPIKeyPermissions(data APIKeyPermissions) pkgStructs.APIKeyInfo {
+	info := pkgStructs.APIKeyInfo{IsReadOnly: true}
+	for _, permission := range data.Permissions {
+		if permission == permissionRead {
+			continue
+		}
+		info.IsReadOnly = false
+
+		if converted, isExists := apiKeyPermissionConvertor[permission]; isExists {
+			info.Permissions = append(info.Permissions, converted)
+		}
+	}
+
+	info.IPWhitelist = data.IPAddresses
+	info.IsIPRestricted = len(data.IPAddresses) > 0
+	return info
+}
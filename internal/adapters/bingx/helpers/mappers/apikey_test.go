@@ -0,0 +1,42 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertAPIKeyPermissions(t *testing.T) {
+	// given
+	data := APIKeyPermissions{
+		Permissions: []int{1, 2, 4, 5},
+		IPAddresses: []string{"1.2.3.4"},
+	}
+
+	// when
+	info := ConvertAPIKeyPermissions(data)
+
+	// then
+	assert.Equal(t, []consts.APIKeyPermission{
+		consts.APIKeyPermissionSpotTrade,
+		consts.APIKeyPermissionWithdraw,
+	}, info.Permissions)
+	assert.False(t, info.IsReadOnly)
+	assert.True(t, info.IsIPRestricted)
+	assert.Equal(t, []string{"1.2.3.4"}, info.IPWhitelist)
+}
+
+func TestConvertAPIKeyPermissionsReadOnly(t *testing.T) {
+	// given
+	data := APIKeyPermissions{Permissions: []int{permissionRead}}
+
+	// when
+	info := ConvertAPIKeyPermissions(data)
+
+	// then
+	assert.Empty(t, info.Permissions)
+	assert.True(t, info.IsReadOnly)
+	assert.False(t, info.IsIPRestricted)
+}
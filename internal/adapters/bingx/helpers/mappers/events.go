@@ -5,19 +5,20 @@ import (
 	"strconv"
 
 	bingxgo "github.com/matrixbotio/go-bingx"
+	"github.com/shopspring/decimal"
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
 )
 
 func ConvertOrderEvent(o *bingxgo.WsOrder) (workers.TradeEventPrivate, error) {
-	orderPrice, err := strconv.ParseFloat(o.Price, 64)
+	orderPrice, err := decimal.NewFromString(o.Price)
 	if err != nil {
 		return workers.TradeEventPrivate{},
 			fmt.Errorf("parse price: %w", err)
 	}
 
-	orderQty, err := strconv.ParseFloat(o.Quantity, 64)
+	orderQty, err := decimal.NewFromString(o.Quantity)
 	if err != nil {
 		return workers.TradeEventPrivate{},
 			fmt.Errorf("parse qty: %w", err)
@@ -34,3 +35,43 @@ func ConvertOrderEvent(o *bingxgo.WsOrder) (workers.TradeEventPrivate, error) {
 		Quantity:      orderQty,
 	}, nil
 }
+
+// WsTradeEvent - spot market trade stream event
+type WsTradeEvent struct {
+	EventType    string `json:"e"`
+	EventTime    int64  `json:"E"`
+	Symbol       string `json:"s"`
+	TradeID      string `json:"t"`
+	Price        string `json:"p"`
+	Quantity     string `json:"q"`
+	TradeTime    int64  `json:"T"`
+	IsBuyerMaker bool   `json:"m"`
+}
+
+func ConvertPublicTradeEvent(event WsTradeEvent) (workers.PublicTradeEvent, error) {
+	price, err := decimal.NewFromString(event.Price)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := decimal.NewFromString(event.Quantity)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	// the buyer is maker: the taker sold
+	takerSide := consts.OrderSideBuy
+	if event.IsBuyerMaker {
+		takerSide = consts.OrderSideSell
+	}
+
+	return workers.PublicTradeEvent{
+		ID:          event.TradeID,
+		Time:        event.TradeTime,
+		ExchangeTag: consts.BingXAdapterTag,
+		Symbol:      event.Symbol,
+		Price:       price,
+		Quantity:    qty,
+		TakerSide:   takerSide,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package mappers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertPublicTradeEvent(t *testing.T) {
+	// given
+	payload := `{
+		"e": "trade",
+		"E": 1700000000200,
+		"s": "BTC-USDT",
+		"t": "82918231",
+		"p": "60000.12",
+		"q": "0.0015",
+		"T": 1700000000123,
+		"m": true
+	}`
+	var event WsTradeEvent
+	require.NoError(t, json.Unmarshal([]byte(payload), &event))
+
+	// when
+	result, err := ConvertPublicTradeEvent(event)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "82918231", result.ID)
+	assert.Equal(t, int64(1700000000123), result.Time)
+	assert.Equal(t, consts.BingXAdapterTag, result.ExchangeTag)
+	assert.Equal(t, "BTC-USDT", result.Symbol)
+	assert.Equal(t, "60000.12", result.Price.String())
+	assert.Equal(t, "0.0015", result.Quantity.String())
+	// the buyer is maker: the taker sold
+	assert.Equal(t, consts.OrderSideSell, result.TakerSide)
+}
+
+func TestConvertPublicTradeEventBuyerTaker(t *testing.T) {
+	// given
+	event := WsTradeEvent{Price: "60000", Quantity: "1", IsBuyerMaker: false}
+
+	// when
+	result, err := ConvertPublicTradeEvent(event)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.OrderSideBuy, result.TakerSide)
+}
+
+func TestConvertPublicTradeEventInvalidQty(t *testing.T) {
+	// given
+	event := WsTradeEvent{Price: "60000", Quantity: "invalid"}
+
+	// when
+	_, err := ConvertPublicTradeEvent(event)
+
+	// then
+	require.Error(t, err)
+}
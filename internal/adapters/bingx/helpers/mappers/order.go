@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	bingxgo "github.com/matrixbotio/go-bingx"
+	"github.com/shopspring/decimal"
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
@@ -66,7 +67,7 @@ func ConvertBingXOrderData(data *bingxgo.SpotOrder) (structs.OrderData, error) {
 		return structs.OrderData{}, errors.New("order data not set")
 	}
 
-	orderPrice, err := strconv.ParseFloat(data.Price, 64)
+	orderPrice, err := decimal.NewFromString(data.Price)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("price: %w", err)
 	}
@@ -76,12 +77,12 @@ func ConvertBingXOrderData(data *bingxgo.SpotOrder) (structs.OrderData, error) {
 		return structs.OrderData{}, fmt.Errorf("convert status: %w", err)
 	}
 
-	orderQty, err := strconv.ParseFloat(data.OrigQty, 64)
+	orderQty, err := decimal.NewFromString(data.OrigQty)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("qty: %w", err)
 	}
 
-	orderFilledQty, err := strconv.ParseFloat(data.ExecutedQty, 64)
+	orderFilledQty, err := decimal.NewFromString(data.ExecutedQty)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("filled qty: %w", err)
 	}
@@ -106,7 +107,7 @@ func ConvertBingXOrderData(data *bingxgo.SpotOrder) (structs.OrderData, error) {
 }
 
 func ConvertBingXHistoryOrder(data bingxgo.HistoryOrder) (structs.OrderData, error) {
-	orderPrice, err := strconv.ParseFloat(data.Price, 64)
+	orderPrice, err := decimal.NewFromString(data.Price)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("price: %w", err)
 	}
@@ -116,12 +117,12 @@ func ConvertBingXHistoryOrder(data bingxgo.HistoryOrder) (structs.OrderData, err
 		return structs.OrderData{}, fmt.Errorf("convert status: %w", err)
 	}
 
-	orderQty, err := strconv.ParseFloat(data.OrigQty, 64)
+	orderQty, err := decimal.NewFromString(data.OrigQty)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("qty: %w", err)
 	}
 
-	orderFilledQty, err := strconv.ParseFloat(data.ExecutedQty, 64)
+	orderFilledQty, err := decimal.NewFromString(data.ExecutedQty)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("filled qty: %w", err)
 	}
@@ -152,13 +153,13 @@ func ConvertOrderResponse(
 		return structs.CreateOrderResponse{}, errors.New("not set")
 	}
 
-	orderQty, err := strconv.ParseFloat(r.OrigQty, 64)
+	orderQty, err := decimal.NewFromString(r.OrigQty)
 	if err != nil {
 		return structs.CreateOrderResponse{},
 			fmt.Errorf("parse qty: %w", err)
 	}
 
-	orderPrice, err := strconv.ParseFloat(r.Price, 64)
+	orderPrice, err := decimal.NewFromString(r.Price)
 	if err != nil {
 		return structs.CreateOrderResponse{},
 			fmt.Errorf("parse price: %w", err)
@@ -187,3 +188,61 @@ func ConvertOrderResponse(
 		Status:        orderStatus,
 	}, nil
 }
+
+// AccountTradesResponse - spot account trades list
+type AccountTradesResponse struct {
+	Fills []AccountTrade `json:"fills"`
+}
+
+// AccountTrade - spot account trade (fill)
+type AccountTrade struct {
+	ID              int64           `json:"id"`
+	OrderID         int64           `json:"orderId"`
+	Symbol          string          `json:"symbol"`
+	Price           decimal.Decimal `json:"price"`
+	Qty             decimal.Decimal `json:"qty"`
+	Commission      decimal.Decimal `json:"commission"`
+	CommissionAsset string          `json:"commissionAsset"`
+	Time            int64           `json:"time"`
+	IsBuyer         bool            `json:"isBuyer"`
+	IsMaker         bool            `json:"isMaker"`
+}
+
+func ConvertAccountTrade(
+	trade AccountTrade,
+	clientOrderID string,
+) structs.AccountTrade {
+	side := consts.OrderSideSell
+	if trade.IsBuyer {
+		side = consts.OrderSideBuy
+	}
+
+	return structs.AccountTrade{
+		ID:            strconv.FormatInt(trade.ID, 10),
+		OrderID:       trade.OrderID,
+		ClientOrderID: clientOrderID,
+		Symbol:        trade.Symbol,
+		Side:          side,
+		Price:         trade.Price,
+		Qty:           trade.Qty,
+		// commission is negative in the bingx response
+		Fee:      trade.Commission.Abs(),
+		FeeAsset: trade.CommissionAsset,
+		IsMaker:  trade.IsMaker,
+		Time:     trade.Time,
+	}
+}
+
+// CommissionRate - spot account fee rates
+type CommissionRate struct {
+	TakerCommissionRate decimal.Decimal `json:"takerCommissionRate"`
+	MakerCommissionRate decimal.Decimal `json:"makerCommissionRate"`
+}
+
+func ConvertTradeFees(pairSymbol string, rate CommissionRate) structs.TradeFees {
+	return structs.TradeFees{
+		Symbol: pairSymbol,
+		Maker:  rate.MakerCommissionRate,
+		Taker:  rate.TakerCommissionRate,
+	}
+}
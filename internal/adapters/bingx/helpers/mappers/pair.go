@@ -8,7 +8,7 @@ import (
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
-	"github.com/matrixbotio/exchange-gates-lib/pkg/utils"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
 )
 
 const (
@@ -16,6 +16,12 @@ const (
 	defaultQuotePrecision = 2
 )
 
+// Ticker - 24h ticker, the last price is a JSON number
+type Ticker struct {
+	Symbol    string          `json:"symbol"`
+	LastPrice decimal.Decimal `json:"lastPrice"`
+}
+
 func ConvertPairData(
 	data bingxgo.SymbolInfo,
 	lastPrice float64,
@@ -23,22 +29,22 @@ func ConvertPairData(
 	symbolParts := strings.Split(data.Symbol, pairSymbolDelimiter)
 	lastPriceDec := decimal.NewFromFloat(lastPrice)
 
-	var minQty float64
-	var maxQty float64
+	minNotional := decimal.NewFromFloat(data.MinNotional)
+
+	var minQty decimal.Decimal
+	var maxQty decimal.Decimal
 	if data.MinQty > 0 {
 		// use exchange deprecated field when available
-		minQty = data.MinQty
+		minQty = decimal.NewFromFloat(data.MinQty)
 	} else if lastPrice > 0 {
 		// or recalc min qty based on min order
-		minQty = decimal.NewFromFloat(data.MinNotional).
-			Div(lastPriceDec).InexactFloat64()
+		minQty = minNotional.Div(lastPriceDec)
 	}
 
 	if data.MaxQty > 0 {
-		maxQty = data.MaxQty
+		maxQty = decimal.NewFromFloat(data.MaxQty)
 	} else if lastPrice > 0 {
-		maxQty = decimal.NewFromFloat(data.MaxNotional).
-			Div(lastPriceDec).InexactFloat64()
+		maxQty = decimal.NewFromFloat(data.MaxNotional).Div(lastPriceDec)
 	}
 
 	quotePricision := utils.GetFloatPrecision(data.MinNotional)
@@ -56,11 +62,11 @@ func ConvertPairData(
 		Symbol:             data.Symbol,
 		MinQty:             minQty,
 		MaxQty:             maxQty,
-		OriginalMinDeposit: data.MinNotional,
-		MinDeposit:         data.MinNotional,
-		MinPrice:           0, // TBD
-		QtyStep:            data.StepSize,
-		PriceStep:          data.TickSize,
+		OriginalMinDeposit: minNotional,
+		MinDeposit:         minNotional,
+		MinPrice:           decimal.Zero, // TBD
+		QtyStep:            decimal.NewFromFloat(data.StepSize),
+		PriceStep:          decimal.NewFromFloat(data.TickSize),
 		AllowedMargin:      false,
 		AllowedSpot:        true,
 		InUse:              true,
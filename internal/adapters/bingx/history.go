@@ -0,0 +1,171 @@
+package bingx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bingxgo "github.com/matrixbotio/go-bingx"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bingx/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	endpointGetAccountTrades = "/openApi/spot/v1/trade/myTrades"
+	endpointGetHistoryOrders = "/openApi/spot/v1/trade/historyOrders"
+
+	tradesHistoryMaxWindow = time.Hour * 24
+	tradesHistoryPageLimit = 1000
+	ordersHistoryPageLimit = 100
+)
+
+func (a *adapter) GetAccountTrades(
+	task structs.GetOrdersHistoryTask,
+) ([]structs.AccountTrade, error) {
+	if !a.creds.Keypair.IsSet() {
+		return nil, errs.ErrAPIKeyNotSet
+	}
+
+	windows, err := baseadp.SplitHistoryTask(task, tradesHistoryMaxWindow)
+	if err != nil {
+		return nil, fmt.Errorf("check task: %w", err)
+	}
+
+	ctx := baseadp.GetHistoryTaskContext(task)
+
+	var trades []mappers.AccountTrade
+	for _, window := range windows {
+		windowTrades, err := a.getWindowTrades(ctx, task.PairSymbol, window)
+		if err != nil {
+			return nil, fmt.Errorf("get trades: %w", err)
+		}
+		trades = append(trades, windowTrades...)
+	}
+
+	clientOrderIDs, err := a.getClientOrderIDs(ctx, task.PairSymbol, windows, trades)
+	if err != nil {
+		return nil, fmt.Errorf("get orders: %w", err)
+	}
+
+	result := make([]structs.AccountTrade, 0, len(trades))
+	for _, trade := range trades {
+		result = append(result, mappers.ConvertAccountTrade(
+			trade, clientOrderIDs[trade.OrderID],
+		))
+	}
+	return baseadp.SortAccountTrades(result), nil
+}
+
+// getWindowTrades - the page by time range, then the next pages by trade ID
+func (a *adapter) getWindowTrades(
+	ctx context.Context,
+	pairSymbol string,
+	window baseadp.TimeWindow,
+) ([]mappers.AccountTrade, error) {
+	params := map[string]any{
+		"symbol":    pairSymbol,
+		"startTime": window.StartTime,
+		"endTime":   window.EndTime,
+		"limit":     tradesHistoryPageLimit,
+	}
+
+	var result []mappers.AccountTrade
+	for {
+		var response mappers.AccountTradesResponse
+		if err := a.rest.get(ctx, endpointGetAccountTrades, params, &response); err != nil {
+			return nil, err
+		}
+
+		for _, trade := range response.Fills {
+			if trade.Time > window.EndTime {
+				return result, nil
+			}
+			result = append(result, trade)
+		}
+
+		if len(response.Fills) < tradesHistoryPageLimit {
+			return result, nil
+		}
+
+		params = map[string]any{
+			"symbol": pairSymbol,
+			"fromId": response.Fills[len(response.Fills)-1].ID + 1,
+			"limit":  tradesHistoryPageLimit,
+		}
+	}
+}
+
+// getClientOrderIDs - bingx trades don't contain client order ID.
+// The orders of the windows with trades are listed by pages, the orders
+// missing in the list, e.g. placed before the window, are requested one by one
+func (a *adapter) getClientOrderIDs(
+	ctx context.Context,
+	pairSymbol string,
+	windows []baseadp.TimeWindow,
+	trades []mappers.AccountTrade,
+) (map[int64]string, error) {
+	result := map[int64]string{}
+	for _, window := range windows {
+		if !hasWindowTrades(window, trades) {
+			continue
+		}
+
+		if err := a.getWindowClientOrderIDs(ctx, pairSymbol, window, result); err != nil {
+			return nil, fmt.Errorf("get orders history: %w", err)
+		}
+	}
+
+	for _, trade := range trades {
+		if _, isExists := result[trade.OrderID]; isExists {
+			continue
+		}
+
+		order, err := a.client.GetHistoryOrder(pairSymbol, trade.OrderID)
+		if err != nil {
+			return nil, fmt.Errorf("get order %v: %w", trade.OrderID, err)
+		}
+		result[trade.OrderID] = order.ClientOrderID
+	}
+	return result, nil
+}
+
+// getWindowClientOrderIDs - go-bingx orders history is not paginated
+func (a *adapter) getWindowClientOrderIDs(
+	ctx context.Context,
+	pairSymbol string,
+	window baseadp.TimeWindow,
+	result map[int64]string,
+) error {
+	for pageIndex := 1; ; pageIndex++ {
+		var response bingxgo.OrdersHistoryResponse
+		if err := a.rest.get(ctx, endpointGetHistoryOrders, map[string]any{
+			"symbol":    pairSymbol,
+			"startTime": window.StartTime,
+			"endTime":   window.EndTime,
+			"pageIndex": pageIndex,
+			"pageSize":  ordersHistoryPageLimit,
+		}, &response); err != nil {
+			return err
+		}
+
+		for _, order := range response.Orders {
+			result[order.OrderID] = order.ClientOrderID
+		}
+
+		if len(response.Orders) < ordersHistoryPageLimit {
+			return nil
+		}
+	}
+}
+
+func hasWindowTrades(window baseadp.TimeWindow, trades []mappers.AccountTrade) bool {
+	for _, trade := range trades {
+		if trade.Time >= window.StartTime && trade.Time <= window.EndTime {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package bingx
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	bingxgo "github.com/matrixbotio/go-bingx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bingx/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+func TestGetAccountTradesClientOrderIDs(t *testing.T) {
+	// given
+	var ordersListRequests, orderRequests int
+	mux := http.NewServeMux()
+	mux.HandleFunc(endpointGetAccountTrades, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, mappers.AccountTradesResponse{Fills: []mappers.AccountTrade{
+			{ID: 1, OrderID: 10, Symbol: "BTC-USDT", Time: 1700000001000},
+			{ID: 2, OrderID: 10, Symbol: "BTC-USDT", Time: 1700000002000},
+			{ID: 3, OrderID: 20, Symbol: "BTC-USDT", Time: 1700000003000},
+		}})
+	})
+	mux.HandleFunc(endpointGetHistoryOrders, func(w http.ResponseWriter, r *http.Request) {
+		// the order placed before the task is requested by ID
+		if r.URL.Query().Get("orderId") == "20" {
+			orderRequests++
+			writeTestResponse(w, bingxgo.OrdersHistoryResponse{Orders: []bingxgo.HistoryOrder{
+				{OrderBase: bingxgo.OrderBase{OrderID: 20, ClientOrderID: "client-20"}},
+			}})
+			return
+		}
+
+		ordersListRequests++
+		writeTestResponse(w, bingxgo.OrdersHistoryResponse{Orders: []bingxgo.HistoryOrder{
+			{OrderBase: bingxgo.OrderBase{OrderID: 10, ClientOrderID: "client-10"}},
+		}})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	a := New(config.WithRESTBaseURL(server.URL))
+	require.NoError(t, a.Connect(pkgStructs.APICredentials{
+		Type:    pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{Public: "public", Secret: "secret"},
+	}))
+
+	// when
+	trades, err := a.GetAccountTrades(structs.GetOrdersHistoryTask{
+		PairSymbol: "BTC-USDT",
+		StartTime:  1700000000000,
+		EndTime:    1700000010000,
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, trades, 3)
+	assert.Equal(t, "client-10", trades[0].ClientOrderID)
+	assert.Equal(t, "client-10", trades[1].ClientOrderID)
+	assert.Equal(t, "client-20", trades[2].ClientOrderID)
+	assert.Equal(t, 1, ordersListRequests)
+	assert.Equal(t, 1, orderRequests)
+}
+
+func writeTestResponse(w http.ResponseWriter, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"code": 0, "data": data})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
 	bingxgo "github.com/matrixbotio/go-bingx"
 	"github.com/shopspring/decimal"
 )
@@ -17,26 +18,38 @@ type IntervalData struct {
 
 var intervalBingxWsToOur = map[bingxgo.Interval]IntervalData{
 	bingxgo.Interval1:   {consts.Interval1min, time.Minute},
+	bingxgo.Interval3:   {consts.Interval3min, time.Minute * 3},
 	bingxgo.Interval5:   {consts.Interval5min, time.Minute * 5},
 	bingxgo.Interval15:  {consts.Interval15min, time.Minute * 15},
 	bingxgo.Interval30:  {consts.Interval30min, time.Minute * 30},
 	bingxgo.Interval60:  {consts.Interval1hour, time.Hour},
+	bingxgo.Interval2h:  {consts.Interval2hour, time.Hour * 2},
 	bingxgo.Interval4h:  {consts.Interval4hour, time.Hour * 4},
 	bingxgo.Interval6h:  {consts.Interval6hour, time.Hour * 6},
+	bingxgo.Interval8h:  {consts.Interval8hour, time.Hour * 8},
 	bingxgo.Interval12h: {consts.Interval12hour, time.Hour * 12},
 	bingxgo.Interval1d:  {consts.Interval1day, time.Hour * 24},
+	bingxgo.Interval3d:  {consts.Interval3day, time.Hour * 24 * 3},
+	bingxgo.Interval1w:  {consts.Interval1week, time.Hour * 24 * 7},
+	bingxgo.Interval1M:  {consts.Interval1month, time.Hour * 24 * 31},
 }
 
 var intervalBingxRestToOur = map[bingxgo.Interval]IntervalData{
 	"1m":  {consts.Interval1min, time.Minute},
+	"3m":  {consts.Interval3min, time.Minute * 3},
 	"5m":  {consts.Interval5min, time.Minute * 5},
 	"15m": {consts.Interval15min, time.Minute * 15},
 	"30m": {consts.Interval30min, time.Minute * 30},
 	"1h":  {consts.Interval1hour, time.Hour},
+	"2h":  {consts.Interval2hour, time.Hour * 2},
 	"4h":  {consts.Interval4hour, time.Hour * 4},
 	"6h":  {consts.Interval6hour, time.Hour * 6},
+	"8h":  {consts.Interval8hour, time.Hour * 8},
 	"12h": {consts.Interval12hour, time.Hour * 12},
 	"1d":  {consts.Interval1day, time.Hour * 24},
+	"3d":  {consts.Interval3day, time.Hour * 24 * 3},
+	"1w":  {consts.Interval1week, time.Hour * 24 * 7},
+	"1M":  {consts.Interval1month, time.Hour * 24 * 31},
 }
 
 var ourIntervalToBingXWs = func() map[consts.Interval]bingxgo.Interval {
@@ -59,7 +72,7 @@ var ourIntervalToBingXRest = func() map[consts.Interval]bingxgo.Interval {
 func ConvertIntervalToBingXWs(interval consts.Interval) (bingxgo.Interval, error) {
 	result, isExists := ourIntervalToBingXWs[interval]
 	if !isExists {
-		return "", fmt.Errorf("unknown interval: %q", interval)
+		return "", &errs.NotSupportedError{Feature: fmt.Sprintf("interval %q", interval)}
 	}
 
 	return result, nil
@@ -69,7 +82,7 @@ func ConvertIntervalToBingXWs(interval consts.Interval) (bingxgo.Interval, error
 func ConvertIntervalToBingXRest(interval consts.Interval) (bingxgo.Interval, error) {
 	result, isExists := ourIntervalToBingXRest[interval]
 	if !isExists {
-		return "", fmt.Errorf("unknown interval: %q", interval)
+		return "", &errs.NotSupportedError{Feature: fmt.Sprintf("interval %q", interval)}
 	}
 
 	return result, nil
@@ -106,11 +119,11 @@ func ConvertKlinesRest(klines []bingxgo.KlineData) ([]workers.CandleData, error)
 			StartTime: kline.StartTime,
 			EndTime:   kline.EndTime,
 			Interval:  interval.Interval,
-			Open:      kline.Open,
-			Close:     kline.Close,
-			High:      kline.High,
-			Low:       kline.Low,
-			Volume:    kline.Volume,
+			Open:      decimal.NewFromFloat(kline.Open),
+			Close:     decimal.NewFromFloat(kline.Close),
+			High:      decimal.NewFromFloat(kline.High),
+			Low:       decimal.NewFromFloat(kline.Low),
+			Volume:    decimal.NewFromFloat(kline.Volume),
 		})
 	}
 
@@ -159,11 +172,11 @@ func ConvertWsKline(kline bingxgo.KlineEvent) (workers.CandleEvent, error) {
 			StartTime: kline.StartTime,
 			EndTime:   kline.EndTime,
 			Interval:  intervalData.Interval,
-			Open:      klineOpen.InexactFloat64(),
-			Close:     klineClose.InexactFloat64(),
-			High:      klineHigh.InexactFloat64(),
-			Low:       klineLow.InexactFloat64(),
-			Volume:    klineVolume.InexactFloat64(),
+			Open:      klineOpen,
+			Close:     klineClose,
+			High:      klineHigh,
+			Low:       klineLow,
+			Volume:    klineVolume,
 		},
 		Time:       kline.EventTime,
 		IsFinished: kline.Completed,
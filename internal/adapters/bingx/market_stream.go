@@ -0,0 +1,193 @@
+package bingx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	bingxgo "github.com/matrixbotio/go-bingx"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bingx/helpers/mappers"
+)
+
+// go-bingx has no public trades stream & its kline stream
+// uses the library endpoint & the default dialer
+const (
+	wsMarketURL = "wss://open-api-ws.bingx.com/market"
+	wsReadLimit = 655350
+)
+
+func wsPublicTradesServe(
+	dialer *websocket.Dialer,
+	wsURL string,
+	pairSymbol string,
+	handler func(event mappers.WsTradeEvent),
+	errorHandler func(err error),
+) (doneC, stopC chan struct{}, err error) {
+	return wsMarketServe(
+		dialer,
+		wsURL,
+		pairSymbol+"@trade",
+		func(data json.RawMessage) error {
+			var event mappers.WsTradeEvent
+			if err := json.Unmarshal(data, &event); err != nil {
+				return fmt.Errorf("decode trade event: %w", err)
+			}
+
+			handler(event)
+			return nil
+		},
+		errorHandler,
+	)
+}
+
+// wsKlineServe - the kline is completed when the next one is started
+func wsKlineServe(
+	dialer *websocket.Dialer,
+	wsURL string,
+	pairSymbol string,
+	interval bingxgo.Interval,
+	handler func(event bingxgo.KlineEvent),
+	errorHandler func(err error),
+) (doneC, stopC chan struct{}, err error) {
+	var lastEventEndTime int64
+
+	return wsMarketServe(
+		dialer,
+		wsURL,
+		fmt.Sprintf("%s@kline_%s", pairSymbol, interval),
+		func(data json.RawMessage) error {
+			var event bingxgo.KlineEventData
+			if err := json.Unmarshal(data, &event); err != nil {
+				return fmt.Errorf("decode kline event: %w", err)
+			}
+
+			if lastEventEndTime == 0 {
+				lastEventEndTime = event.Kline.EndTime
+			}
+			if lastEventEndTime != event.Kline.EndTime {
+				lastEventEndTime = event.Kline.EndTime
+				event.Kline.Completed = true
+			}
+
+			event.Kline.EventTime = event.EventTime
+			handler(event.Kline)
+			return nil
+		},
+		errorHandler,
+	)
+}
+
+// wsMarketServe - subscribe to the market data type
+// & read its events until the stop or the connection error
+func wsMarketServe(
+	dialer *websocket.Dialer,
+	wsURL string,
+	dataType string,
+	handler func(data json.RawMessage) error,
+	errorHandler func(err error),
+) (doneC, stopC chan struct{}, err error) {
+	reqEvent := bingxgo.RequestEvent{
+		Id:       uuid.New(),
+		ReqType:  bingxgo.SubscribeRequestType,
+		DataType: dataType,
+	}
+
+	initMessage, err := json.Marshal(reqEvent)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encode request: %w", err)
+	}
+
+	header := http.Header{}
+	header.Add("Accept-Encoding", "gzip")
+
+	wsConn, _, err := dialer.Dial(wsURL, header)
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial: %w", err)
+	}
+
+	if err := wsConn.WriteMessage(websocket.TextMessage, initMessage); err != nil {
+		wsConn.Close()
+		return nil, nil, fmt.Errorf("send request: %w", err)
+	}
+
+	wsConn.SetReadLimit(wsReadLimit)
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+
+	go func() {
+		defer close(doneC)
+		var isStopped atomic.Bool
+
+		// await stop
+		go func() {
+			select {
+			case <-stopC:
+				isStopped.Store(true)
+			case <-doneC:
+			}
+			wsConn.Close()
+		}()
+
+		for {
+			_, message, err := wsConn.ReadMessage()
+			if err != nil {
+				if !isStopped.Load() {
+					errorHandler(err)
+				}
+				return
+			}
+
+			data, err := bingxgo.DecodeGzip(message)
+			if err != nil {
+				errorHandler(fmt.Errorf("decode message: %w", err))
+				return
+			}
+
+			if err := handleMarketMessage(wsConn, dataType, data, handler); err != nil {
+				errorHandler(err)
+			}
+		}
+	}()
+	return doneC, stopC, nil
+}
+
+func handleMarketMessage(
+	wsConn *websocket.Conn,
+	dataType string,
+	data []byte,
+	handler func(data json.RawMessage) error,
+) error {
+	if strings.Contains(string(data), `"ping"`) {
+		var pingMsg bingxgo.PingMessage
+		if err := json.Unmarshal(data, &pingMsg); err != nil {
+			return fmt.Errorf("decode ping message: %w", err)
+		}
+
+		if err := wsConn.WriteJSON(bingxgo.PongMessage{
+			ID:   pingMsg.ID,
+			Time: pingMsg.Time,
+		}); err != nil {
+			return fmt.Errorf("pong: %w", err)
+		}
+		return nil
+	}
+
+	var event bingxgo.Event[json.RawMessage]
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("decode event: %w", err)
+	}
+
+	if event.Code != 0 {
+		return fmt.Errorf("subscription error: %s", string(data))
+	}
+
+	if event.DataType == dataType {
+		return handler(event.Data)
+	}
+	return nil
+}
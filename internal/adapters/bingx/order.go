@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bingx/helpers/mappers"
@@ -14,45 +15,48 @@ import (
 	"github.com/shopspring/decimal"
 )
 
-const errOrderNotActualMessage = "the order is FILLED or CANCELLED already before"
+const (
+	errOrderNotActualMessage = "the order is FILLED or CANCELLED already before"
+
+	endpointCreateOrder       = "/openApi/spot/v1/trade/order"
+	endpointGetCommissionRate = "/openApi/spot/v1/user/commissionRate"
+)
 
 func (a *adapter) PlaceOrder(
 	ctx context.Context,
 	order structs.BotOrderAdjusted,
 ) (structs.CreateOrderResponse, error) {
+	// the order is placed as limit one, market orders are not supported
+	if err := a.Capabilities().CheckOrder(order); err != nil {
+		return structs.CreateOrderResponse{}, err
+	}
+
 	orderSide, err := mappers.GetBingXOrderSide(order.Type)
 	if err != nil {
 		return structs.CreateOrderResponse{},
 			fmt.Errorf("get order side: %w", err)
 	}
 
-	orderQty, err := decimal.NewFromString(order.Qty)
-	if err != nil {
-		return structs.CreateOrderResponse{},
-			fmt.Errorf("parse qty: %w", err)
+	// the values are sent as they are, go-bingx takes them as float64
+	params := map[string]any{
+		"symbol":      order.PairSymbol,
+		"side":        orderSide,
+		"type":        limitOrder,
+		"quantity":    order.Qty,
+		"price":       order.Price,
+		"timeInForce": limitOrderTimeInForce,
+	}
+	if order.ClientOrderID != "" {
+		params["newClientOrderId"] = order.ClientOrderID
 	}
 
-	orderPrice, err := decimal.NewFromString(order.Price)
-	if err != nil {
-		return structs.CreateOrderResponse{},
-			fmt.Errorf("parse price: %w", err)
-	}
-
-	response, err := a.client.CreateOrder(bingxgo.SpotOrderRequest{
-		Symbol:        order.PairSymbol,
-		Side:          orderSide,
-		Type:          limitOrder,
-		Quantity:      orderQty.InexactFloat64(),
-		Price:         orderPrice.InexactFloat64(),
-		TimeInForce:   limitOrderTimeInForce,
-		ClientOrderID: order.ClientOrderID,
-	})
-	if err != nil {
+	var response bingxgo.SpotOrderResponse
+	if err := a.rest.post(ctx, endpointCreateOrder, params, &response); err != nil {
 		return structs.CreateOrderResponse{},
 			fmt.Errorf("create: %w", err)
 	}
 
-	result, err := mappers.ConvertOrderResponse(response)
+	result, err := mappers.ConvertOrderResponse(&response)
 	if err != nil {
 		return structs.CreateOrderResponse{},
 			fmt.Errorf("convert: %w", err)
@@ -86,11 +90,26 @@ func (a *adapter) GetOrderExecFee(
 	if err != nil {
 		return structs.OrderFees{}, fmt.Errorf("parse fee: %w", err)
 	}
+	feeValue = feeValue.Abs()
+
+	fees := getFeesFromOrderData(orderSide, feeValue)
+	if data.FeeAsset == "" || feeValue.IsZero() {
+		return fees, nil
+	}
 
-	return getFeesFromOrderData(
-		orderSide,
-		feeValue.Abs(),
-	), nil
+	if data.FeeAsset != baseAssetTicker && data.FeeAsset != quoteAssetTicker {
+		// the fee is paid in a third asset
+		fees.BaseAsset = decimal.Zero
+		fees.QuoteAsset = decimal.Zero
+	}
+	fees.Commissions = []structs.Commission{{
+		TradeID: strconv.FormatInt(data.OrderID, 10),
+		Asset:   data.FeeAsset,
+		Amount:  feeValue,
+		Price:   decimal.NewFromFloat(data.AvgPrice),
+		Time:    data.UpdateTime,
+	}}
+	return fees, nil
 }
 
 func getFeesFromOrderData(
@@ -111,6 +130,23 @@ func getFeesFromOrderData(
 	return fees
 }
 
+func (a *adapter) GetTradeFees(pairSymbol string) (structs.TradeFees, error) {
+	if !a.creds.Keypair.IsSet() {
+		return structs.TradeFees{}, errs.ErrAPIKeyNotSet
+	}
+
+	var rate mappers.CommissionRate
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetCommissionRate,
+		map[string]any{"symbol": pairSymbol},
+		&rate,
+	); err != nil {
+		return structs.TradeFees{}, fmt.Errorf("get: %w", err)
+	}
+	return mappers.ConvertTradeFees(pairSymbol, rate), nil
+}
+
 func (a *adapter) GetOrderData(
 	pairSymbol string,
 	orderID int64,
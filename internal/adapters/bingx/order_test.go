@@ -0,0 +1,62 @@
+package bingx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	bingxgo "github.com/matrixbotio/go-bingx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+func TestPlaceOrderExactValues(t *testing.T) {
+	// given
+	var params url.Values
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+endpointCreateOrder, func(w http.ResponseWriter, r *http.Request) {
+		params = r.URL.Query()
+		writeTestResponse(w, bingxgo.SpotOrderResponse{
+			Symbol:        "BTC-USDT",
+			OrderId:       1,
+			Price:         params.Get("price"),
+			OrigQty:       params.Get("quantity"),
+			Status:        "NEW",
+			Side:          "BUY",
+			ClientOrderID: params.Get("newClientOrderId"),
+		})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	a := New(config.WithRESTBaseURL(server.URL))
+	require.NoError(t, a.Connect(pkgStructs.APICredentials{
+		Type:    pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{Public: "public", Secret: "secret"},
+	}))
+
+	// when
+	response, err := a.PlaceOrder(context.Background(), structs.BotOrderAdjusted{
+		PairSymbol:    "BTC-USDT",
+		Type:          consts.OrderSideBuy,
+		Qty:           "0.30000001",
+		Price:         "60000.12",
+		ClientOrderID: "client-1",
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.30000001", params.Get("quantity"))
+	assert.Equal(t, "60000.12", params.Get("price"))
+	assert.Equal(t, "LIMIT", params.Get("type"))
+	assert.Equal(t, "client-1", params.Get("newClientOrderId"))
+	assert.Equal(t, "0.30000001", response.OrigQuantity.String())
+	assert.Equal(t, "60000.12", response.Price.String())
+}
@@ -5,12 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bingx/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
-	"github.com/matrixbotio/exchange-gates-lib/pkg/utils"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
+	"github.com/shopspring/decimal"
 )
 
+const endpointGetTickers = "/openApi/spot/v1/ticker/24hr"
+
 func (a *adapter) GetPairData(pairSymbol string) (structs.ExchangePairData, error) {
 	symbols, err := a.client.GetSymbols(pairSymbol)
 	if err != nil {
@@ -34,17 +40,24 @@ func (a *adapter) GetPairData(pairSymbol string) (structs.ExchangePairData, erro
 	return pairs[0], nil
 }
 
-func (a *adapter) GetPairLastPrice(pairSymbol string) (float64, error) {
-	tickers, err := a.client.GetTickers(pairSymbol)
-	if err != nil {
-		return 0, fmt.Errorf("get tickers: %w", err)
+func (a *adapter) GetPairLastPrice(pairSymbol string) (decimal.Decimal, error) {
+	// go-bingx parses the ticker price as float64
+	var tickers []mappers.Ticker
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetTickers,
+		map[string]any{"symbol": pairSymbol},
+		&tickers,
+	); err != nil {
+		return decimal.Zero, fmt.Errorf("get tickers: %w", err)
 	}
 
-	lastPrice, isExists := tickers[pairSymbol]
-	if !isExists {
-		return 0, fmt.Errorf("%q last price not found", pairSymbol)
+	for _, ticker := range tickers {
+		if ticker.Symbol == pairSymbol {
+			return ticker.LastPrice, nil
+		}
 	}
-	return lastPrice, nil
+	return decimal.Zero, fmt.Errorf("%q last price not found", pairSymbol)
 }
 
 func (a *adapter) CancelPairOrder(
@@ -52,10 +65,13 @@ func (a *adapter) CancelPairOrder(
 	orderID int64,
 	ctx context.Context,
 ) error {
-	return a.client.CancelOrder(
+	err := a.client.CancelOrder(
 		pairSymbol,
 		strconv.FormatInt(orderID, 10),
 	)
+	return a.mapCancelOrderErr(err, func() (structs.OrderData, error) {
+		return a.GetOrderData(pairSymbol, orderID)
+	})
 }
 
 func (a *adapter) CancelPairOrderByClientOrderID(
@@ -63,7 +79,29 @@ func (a *adapter) CancelPairOrderByClientOrderID(
 	clientOrderID string,
 	ctx context.Context,
 ) error {
-	return a.client.CancelOrderByClientOrderID(pairSymbol, clientOrderID)
+	err := a.client.CancelOrderByClientOrderID(pairSymbol, clientOrderID)
+	return a.mapCancelOrderErr(err, func() (structs.OrderData, error) {
+		return a.GetOrderByClientOrderID(pairSymbol, clientOrderID)
+	})
+}
+
+// mapCancelOrderErr - bingx doesn't tell the filled order from the cancelled one
+func (a *adapter) mapCancelOrderErr(
+	err error,
+	getOrder func() (structs.OrderData, error),
+) error {
+	if err == nil {
+		return nil
+	}
+	if !strings.Contains(err.Error(), errOrderNotActualMessage) {
+		return fmt.Errorf("cancel: %w", err)
+	}
+
+	order, getErr := getOrder()
+	if getErr == nil && order.Status == consts.OrderStatusFilled {
+		return errs.ErrOrderFilled
+	}
+	return errs.ErrOrderNotFound
 }
 
 func (a *adapter) GetPairs() ([]structs.ExchangePairData, error) {
@@ -0,0 +1,149 @@
+package bingx
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	bingxgo "github.com/matrixbotio/go-bingx"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+// go-bingx doesn't implement all the endpoints we need
+const (
+	restBaseURL        = "https://open-api.bingx.com"
+	restRequestTimeout = time.Second * 10
+)
+
+// restClient - signed requests to BingX REST API
+type restClient struct {
+	httpClient *http.Client
+	baseURL    string
+	keyPublic  string
+	keySecret  string
+	brokerID   string
+	now        func() time.Time
+}
+
+// newRestClient - the signed requests timestamps are taken from the clock
+func newRestClient(
+	credentials pkgStructs.APICredentials,
+	cfg config.Config,
+	now func() time.Time,
+) *restClient {
+	return &restClient{
+		httpClient: cfg.NewHTTPClient(restRequestTimeout),
+		baseURL:    cfg.GetRESTBaseURL(restBaseURL, restBaseURL),
+		keyPublic:  credentials.Keypair.Public,
+		keySecret:  credentials.Keypair.Secret,
+		brokerID:   cfg.GetBrokerID(brokerSourceKey),
+		now:        now,
+	}
+}
+
+// get - send signed GET request & decode response data
+func (c *restClient) get(
+	ctx context.Context,
+	endpoint string,
+	params map[string]any,
+	result any,
+) error {
+	return c.send(ctx, http.MethodGet, endpoint, params, result)
+}
+
+// post - send signed POST request & decode response data
+func (c *restClient) post(
+	ctx context.Context,
+	endpoint string,
+	params map[string]any,
+	result any,
+) error {
+	return c.send(ctx, http.MethodPost, endpoint, params, result)
+}
+
+func (c *restClient) send(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	params map[string]any,
+	result any,
+) error {
+	query := c.getSignedQuery(params)
+
+	req, err := http.NewRequestWithContext(
+		ctx, method, c.baseURL+endpoint+"?"+query, nil,
+	)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("X-BX-APIKEY", c.keyPublic)
+	req.Header.Set("X-SOURCE-KEY", c.brokerID)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("http status %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	response := bingxgo.BingXResponse[json.RawMessage]{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	if err := response.Error(); err != nil {
+		return err
+	}
+
+	if result == nil || len(response.Data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(response.Data, result); err != nil {
+		return fmt.Errorf("decode response data: %w", err)
+	}
+	return nil
+}
+
+// getSignedQuery - sorted params with timestamp & HMAC SHA256 signature
+func (c *restClient) getSignedQuery(params map[string]any) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var raw, encoded strings.Builder
+	for _, key := range keys {
+		value := fmt.Sprintf("%v", params[key])
+		raw.WriteString(key + "=" + value + "&")
+		encoded.WriteString(
+			key + "=" + strings.ReplaceAll(url.QueryEscape(value), "+", "%20") + "&",
+		)
+	}
+
+	timestamp := fmt.Sprintf("timestamp=%d", c.now().UnixMilli())
+	raw.WriteString(timestamp)
+	encoded.WriteString(timestamp)
+
+	h := hmac.New(sha256.New, []byte(c.keySecret))
+	h.Write([]byte(raw.String()))
+	return encoded.String() + "&signature=" + hex.EncodeToString(h.Sum(nil))
+}
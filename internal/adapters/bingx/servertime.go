@@ -0,0 +1,119 @@
+package bingx
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+)
+
+const (
+	endpointGetServerTime = "/openApi/spot/v1/server/time"
+
+	paramTimestamp = "timestamp"
+	paramSignature = "&signature="
+)
+
+type serverTime struct {
+	ServerTime int64 `json:"serverTime"`
+}
+
+func (a *adapter) GetServerTime() (time.Time, error) {
+	var data serverTime
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetServerTime,
+		nil,
+		&data,
+	); err != nil {
+		return time.Time{}, fmt.Errorf("get server time: %w", err)
+	}
+	if data.ServerTime <= 0 {
+		return time.Time{}, fmt.Errorf("invalid time: %d", data.ServerTime)
+	}
+	return time.UnixMilli(data.ServerTime), nil
+}
+
+// SetServerTimeOffset - the offset is applied to the REST requests signature,
+// go-bingx requests are signed again by signingTransport
+func (a *adapter) SetServerTimeOffset(offset time.Duration) error {
+	a.clock.SetOffset(offset)
+	return nil
+}
+
+// signingTransport - go-bingx signs the requests with the local time.
+// The transport replaces the timestamp with the server one & signs the query again
+type signingTransport struct {
+	base   http.RoundTripper
+	secret string
+	clock  *baseadp.ServerClock
+}
+
+func newSigningTransport(
+	base http.RoundTripper,
+	secret string,
+	clock *baseadp.ServerClock,
+) *signingTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &signingTransport{
+		base:   base,
+		secret: secret,
+		clock:  clock,
+	}
+}
+
+func (t *signingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.clock.Offset() == 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	query, isSigned := resignQuery(req.URL.RawQuery, t.secret, t.clock.Now())
+	if !isSigned {
+		return t.base.RoundTrip(req)
+	}
+
+	// the request must not be modified by the transport
+	req = req.Clone(req.Context())
+	req.URL.RawQuery = query
+	return t.base.RoundTrip(req)
+}
+
+// resignQuery - replace the timestamp params of the signed query & sign it again.
+// The raw params are signed in the same order as they are sent
+func resignQuery(rawQuery, secret string, now time.Time) (string, bool) {
+	signatureIdx := strings.LastIndex(rawQuery, paramSignature)
+	if signatureIdx < 0 {
+		return "", false
+	}
+
+	timestamp := strconv.FormatInt(now.UnixMilli(), 10)
+	parts := strings.Split(rawQuery[:signatureIdx], "&")
+	rawParts := make([]string, 0, len(parts))
+	for i, part := range parts {
+		key, value, _ := strings.Cut(part, "=")
+		if key == paramTimestamp {
+			value = timestamp
+			parts[i] = key + "=" + value
+		}
+
+		rawValue, err := url.PathUnescape(value)
+		if err != nil {
+			return "", false
+		}
+		rawParts = append(rawParts, key+"="+rawValue)
+	}
+
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(strings.Join(rawParts, "&")))
+	return strings.Join(parts, "&") + paramSignature + hex.EncodeToString(h.Sum(nil)), true
+}
@@ -0,0 +1,39 @@
+package bingx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResignQuery(t *testing.T) {
+	// given
+	params := map[string]any{
+		"symbol":   "BTC-USDT",
+		"quantity": "0.001",
+		"note":     "a b+c",
+	}
+	localTime := time.UnixMilli(1700000000000)
+	serverTime := localTime.Add(time.Second * 5)
+
+	c := &restClient{keySecret: "secret", now: func() time.Time { return localTime }}
+	query := c.getSignedQuery(params)
+	c.now = func() time.Time { return serverTime }
+
+	// when
+	result, isSigned := resignQuery(query, c.keySecret, serverTime)
+
+	// then
+	require.True(t, isSigned)
+	assert.Equal(t, c.getSignedQuery(params), result)
+}
+
+func TestResignQueryNotSigned(t *testing.T) {
+	// when
+	_, isSigned := resignQuery("symbol=BTC-USDT", "secret", time.Now())
+
+	// then
+	assert.False(t, isSigned)
+}
@@ -0,0 +1,95 @@
+package bingx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	endpointTransfer = "/openApi/api/v3/post/asset/transfer"
+
+	errInsufficientBalanceMessage = "insufficient balance"
+)
+
+// bingx transfer types are named as "<FROM>_<TO>"
+var transferAccountTypes = map[consts.AccountType]string{
+	consts.AccountTypeSpot:    "FUND",
+	consts.AccountTypeFutures: "PFUTURES",
+}
+
+type transferResponse struct {
+	TranID int64 `json:"tranId"`
+}
+
+func (a *adapter) GetAccountBalances(accountType consts.AccountType) ([]structs.Balance, error) {
+	if accountType != consts.AccountTypeSpot {
+		return nil, fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, accountType)
+	}
+	return a.GetAccountBalance()
+}
+
+func (a *adapter) Transfer(
+	asset string,
+	amount decimal.Decimal,
+	fromAccount consts.AccountType,
+	toAccount consts.AccountType,
+) (structs.TransferResult, error) {
+	if !a.creds.Keypair.IsSet() {
+		return structs.TransferResult{}, errs.ErrAPIKeyNotSet
+	}
+	if asset == "" {
+		return structs.TransferResult{}, errors.New("asset is not set")
+	}
+	if !amount.IsPositive() {
+		return structs.TransferResult{}, fmt.Errorf("invalid amount: %s", amount.String())
+	}
+
+	from, isExists := transferAccountTypes[fromAccount]
+	if !isExists {
+		return structs.TransferResult{},
+			fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, fromAccount)
+	}
+	to, isExists := transferAccountTypes[toAccount]
+	if !isExists {
+		return structs.TransferResult{},
+			fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, toAccount)
+	}
+	if from == to {
+		return structs.TransferResult{}, fmt.Errorf("transfer to the same account: %q", from)
+	}
+
+	var response transferResponse
+	if err := a.rest.post(
+		context.Background(),
+		endpointTransfer,
+		map[string]any{
+			"type":   from + "_" + to,
+			"asset":  asset,
+			"amount": amount.String(),
+		},
+		&response,
+	); err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), errInsufficientBalanceMessage) {
+			return structs.TransferResult{},
+				fmt.Errorf("%w: %s", errs.ErrInsufficientBalance, err.Error())
+		}
+		return structs.TransferResult{}, fmt.Errorf("transfer: %w", err)
+	}
+
+	return structs.TransferResult{
+		ID:          strconv.FormatInt(response.TranID, 10),
+		Asset:       asset,
+		Amount:      amount,
+		FromAccount: fromAccount,
+		ToAccount:   toAccount,
+	}, nil
+}
@@ -3,6 +3,7 @@ package bingx
 import (
 	"fmt"
 
+	"github.com/gorilla/websocket"
 	bingxgo "github.com/matrixbotio/go-bingx"
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bingx/helpers/mappers"
@@ -15,14 +16,34 @@ const tradeSubscriptionKey = "subscription"
 
 type CandleEventWorkerBingX struct {
 	workers.CandleWorker
+	wsURL  string
+	dialer *websocket.Dialer
 }
 
 func (a *adapter) CreateCandleWorker() *CandleEventWorkerBingX {
-	w := &CandleEventWorkerBingX{}
+	w := &CandleEventWorkerBingX{
+		wsURL:  a.cfg.GetWsBaseURL(wsMarketURL, wsMarketURL),
+		dialer: a.cfg.NewWsDialer(),
+	}
 	w.CandleWorker.ExchangeTag = a.GetTag()
 	return w
 }
 
+type PublicTradeWorkerBingX struct {
+	workers.PublicTradeWorker
+	wsURL  string
+	dialer *websocket.Dialer
+}
+
+func (a *adapter) CreatePublicTradeWorker() *PublicTradeWorkerBingX {
+	w := &PublicTradeWorkerBingX{
+		wsURL:  a.cfg.GetWsBaseURL(wsMarketURL, wsMarketURL),
+		dialer: a.cfg.NewWsDialer(),
+	}
+	w.PublicTradeWorker.ExchangeTag = a.GetTag()
+	return w
+}
+
 type TradeEventWorkerBingX struct {
 	workers.TradeEventWorker
 	client *bingxgo.SpotClient
@@ -84,7 +105,9 @@ func (w *CandleEventWorkerBingX) SubscribeToCandle(
 		return nil
 	}
 
-	wsDone, wsStop, err := bingxgo.WsKlineServe(
+	wsDone, wsStop, err := wsKlineServe(
+		w.dialer,
+		w.wsURL,
 		pairSymbol,
 		bingxInterval,
 		GetBingXCandleEventsHandler(
@@ -105,6 +128,42 @@ func (w *CandleEventWorkerBingX) SubscribeToCandle(
 	return nil
 }
 
+func (w *PublicTradeWorkerBingX) SubscribeToPublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	if w.PublicTradeWorker.IsSubscriptionExists(pairSymbol) {
+		return nil
+	}
+
+	wsDone, wsStop, err := wsPublicTradesServe(
+		w.dialer,
+		w.wsURL,
+		pairSymbol,
+		func(rawEvent mappers.WsTradeEvent) {
+			event, err := mappers.ConvertPublicTradeEvent(rawEvent)
+			if err != nil {
+				errorHandler(fmt.Errorf("convert: %w", err))
+				return
+			}
+
+			eventCallback(event)
+		},
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	w.PublicTradeWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		pairSymbol,
+	)
+	return nil
+}
+
 func (a *adapter) SubscribeCandle(
 	pairSymbol string,
 	interval consts.Interval,
@@ -128,6 +187,18 @@ func (a *adapter) SubscribeAccountTrades(
 	)
 }
 
+func (a *adapter) SubscribePublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	return a.publicTradeWorker.SubscribeToPublicTrades(
+		pairSymbol,
+		eventCallback,
+		errorHandler,
+	)
+}
+
 func (a *adapter) UnsubscribeCandle(
 	pairSymbol string,
 	interval consts.Interval,
@@ -147,3 +218,7 @@ func (a *adapter) UnsubscribeCandle(
 func (a *adapter) UnsubscribeAccountTrades() {
 	a.tradeWorker.UnsubscribeAll()
 }
+
+func (a *adapter) UnsubscribePublicTrades(pairSymbol string) {
+	a.publicTradeWorker.Unsubscribe(pairSymbol)
+}
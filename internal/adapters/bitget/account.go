@@ -0,0 +1,80 @@
+package bitget
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bitget/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	endpointGetSpotAssets    = "/api/v2/spot/account/assets"
+	endpointGetFundingAssets = "/api/v2/account/funding-assets"
+	endpointGetAccountInfo   = "/api/v2/spot/account/info"
+
+	errCodeAPIKeyInvalid     = "40006"
+	errCodePassphraseInvalid = "40012"
+	errCodeAPIKeyNotExists   = "40037"
+)
+
+// CanTrade - bitget doesn't return the key permissions for the spot account,
+// the key is able to trade when the balance is available
+func (a *adapter) CanTrade() (bool, error) {
+	if _, err := a.GetAccountBalance(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetAPIKeyInfo - the key IP whitelist only, see CanTrade
+func (a *adapter) GetAPIKeyInfo() (pkgStructs.APIKeyInfo, error) {
+	if !a.isPrivateAPIAvailable() {
+		return pkgStructs.APIKeyInfo{}, errs.ErrAPIKeyNotSet
+	}
+
+	var info mappers.AccountInfo
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetAccountInfo,
+		nil,
+		&info,
+	); err != nil {
+		return pkgStructs.APIKeyInfo{}, fmt.Errorf("get account info: %w", mapAPIKeyError(err))
+	}
+	return mappers.ConvertAccountInfo(info), nil
+}
+
+// GetAccountBalance - spot account balances
+func (a *adapter) GetAccountBalance() ([]structs.Balance, error) {
+	return a.getBalances(endpointGetSpotAssets)
+}
+
+func (a *adapter) getBalances(endpoint string) ([]structs.Balance, error) {
+	if !a.isPrivateAPIAvailable() {
+		return nil, errs.ErrAPIKeyNotSet
+	}
+
+	var assets []mappers.Asset
+	if err := a.rest.get(
+		context.Background(),
+		endpoint,
+		nil,
+		&assets,
+	); err != nil {
+		return nil, fmt.Errorf("get balance: %w", mapAPIKeyError(err))
+	}
+
+	return mappers.ConvertBalances(assets)
+}
+
+func mapAPIKeyError(err error) error {
+	if isAPIErrorCode(
+		err, errCodeAPIKeyInvalid, errCodePassphraseInvalid, errCodeAPIKeyNotExists,
+	) {
+		return fmt.Errorf("%w: %s", errs.ErrAPIKeyInvalid, err.Error())
+	}
+	return err
+}
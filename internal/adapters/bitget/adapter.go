@@ -0,0 +1,182 @@
+package bitget
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+
+	adp "github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bitget/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	adapterName  = "Bitget Spot"
+	symbolFormat = "%s%s"
+	instTypeSpot = "SPOT"
+
+	endpointGetCandles = "/api/v2/spot/market/candles"
+	candlesMaxLimit    = 1000
+)
+
+var errPassphraseNotSet = fmt.Errorf(
+	"%w: passphrase is not set, call Connect with the full credentials",
+	errs.ErrAPIKeyNotSet,
+)
+
+type adapter struct {
+	baseadp.AdapterBase
+
+	rest     *restClient
+	cfg      config.Config
+	ws       wsEndpoints
+	wsDialer *websocket.Dialer
+	creds    pkgStructs.APICredentials
+	clock    *baseadp.ServerClock
+
+	candleWorker      *CandleEventWorkerBitget
+	tradeWorker       *TradeEventWorkerBitget
+	publicTradeWorker *PublicTradeWorkerBitget
+}
+
+func New(opts ...config.Option) adp.Adapter {
+	cfg := config.New(opts...)
+	clock := baseadp.NewServerClock()
+	return &adapter{
+		AdapterBase: baseadp.NewAdapterBase(
+			consts.ExchangeIDbitget,
+			adapterName,
+			consts.BitgetAdapterTag,
+		),
+		rest:     newRestClient(pkgStructs.APICredentials{}, cfg, clock.Now),
+		clock:    clock,
+		cfg:      cfg,
+		ws:       getWsEndpoints(cfg),
+		wsDialer: cfg.NewWsDialer(),
+	}
+}
+
+// GenClientOrderID - bitget client order ID is up to 50 characters
+func (a *adapter) GenClientOrderID() string {
+	return strings.ReplaceAll(uuid.New().String(), "-", "")
+}
+
+func (a *adapter) GetPairSymbol(
+	baseTicker string,
+	quoteTicker string,
+) string {
+	return fmt.Sprintf(symbolFormat, baseTicker, quoteTicker)
+}
+
+// GetLimits - 300 connection requests per 5 minutes per IP
+func (a *adapter) GetLimits() pkgStructs.ExchangeLimits {
+	return pkgStructs.ExchangeLimits{
+		MaxConnectionsPerBatch:   300,
+		MaxConnectionsInDuration: time.Minute * 5,
+		MaxTopicsPerWebsocket:    50,
+	}
+}
+
+func (a *adapter) Capabilities() pkgStructs.AdapterCapabilities {
+	return pkgStructs.AdapterCapabilities{
+		OrderTypes:    []pkgStructs.OrderType{pkgStructs.OrderTypeLimit, pkgStructs.OrderTypeMarket},
+		TimeInForce:   []pkgStructs.TimeInForce{pkgStructs.TimeInForceGTC},
+		BatchOrders:   false,
+		OrderHistory:  true,
+		AccountTrades: true,
+		FeeLookup:     true,
+		Intervals:     a.GetSupportedIntervals(),
+		Streams: []pkgStructs.StreamType{
+			pkgStructs.StreamCandles,
+			pkgStructs.StreamPublicTrades,
+			pkgStructs.StreamAccountTrades,
+		},
+		OrderIDFormat: pkgStructs.OrderIDFormatNumeric,
+	}
+}
+
+func (a *adapter) Connect(credentials pkgStructs.APICredentials) error {
+	a.creds = credentials
+	a.rest = newRestClient(credentials, a.cfg, a.clock.Now)
+
+	a.candleWorker = a.CreateCandleWorker()
+	a.tradeWorker = a.CreateTradeEventsWorker()
+	a.publicTradeWorker = a.CreatePublicTradeWorker()
+	return nil
+}
+
+// VerifyAPIKeys - the passphrase is taken from the credentials set on Connect,
+// call Connect with the full credentials first
+func (a *adapter) VerifyAPIKeys(keyPublic, keySecret string) error {
+	if a.creds.Keypair.Passphrase == "" {
+		return errPassphraseNotSet
+	}
+
+	if err := a.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{
+			Public:     keyPublic,
+			Secret:     keySecret,
+			Passphrase: a.creds.Keypair.Passphrase,
+		},
+	}); err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+
+	_, err := a.GetAccountBalance()
+	return err
+}
+
+func (a *adapter) GetCandles(
+	limit int,
+	symbol string,
+	interval consts.Interval,
+) ([]workers.CandleData, error) {
+	granularity, err := ConvertIntervalToBitget(interval)
+	if err != nil {
+		return nil, fmt.Errorf("convert interval: %w", err)
+	}
+
+	if limit > candlesMaxLimit {
+		limit = candlesMaxLimit
+	}
+
+	var candles []mappers.Candle
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetCandles,
+		map[string]any{
+			"symbol":      symbol,
+			"granularity": granularity,
+			"limit":       limit,
+		},
+		&candles,
+	); err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+
+	return mappers.ConvertCandles(
+		candles, interval, intervalBitgetToOur[granularity].Duration,
+	)
+}
+
+func (a *adapter) GetSupportedIntervals() []consts.Interval {
+	return consts.FilterIntervals(func(interval consts.Interval) bool {
+		_, isSupported := ourIntervalToBitget[interval]
+		return isSupported
+	})
+}
+
+// isPrivateAPIAvailable - bitget private endpoints require the passphrase
+func (a *adapter) isPrivateAPIAvailable() bool {
+	return a.creds.Keypair.IsSet() && a.creds.Keypair.Passphrase != ""
+}
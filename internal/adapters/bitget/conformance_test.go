@@ -0,0 +1,205 @@
+package bitget
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bitget/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/adapter/adaptertest"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	testConformancePair = "BTCUSDT"
+
+	testErrCodeOrderDuplicate = "43122"
+	testErrOrderDuplicate     = "Duplicate clientOid"
+	testErrOrderNotFound      = "The order does not exist"
+)
+
+// our status -> bitget order status
+var testOrderStatuses = map[consts.OrderStatus]string{
+	pkgStructs.OrderStatusNew:       "live",
+	pkgStructs.OrderStatusFilled:    "filled",
+	pkgStructs.OrderStatusCancelled: "cancelled",
+}
+
+func TestConformance(t *testing.T) {
+	adaptertest.Run(t, adaptertest.Config{
+		NewHarness: newTestHarness,
+		PairSymbol: testConformancePair,
+		OrderQty:   "0.01",
+		OrderPrice: "60000.5",
+	})
+}
+
+func newTestHarness(t *testing.T) adaptertest.Harness {
+	exchange := adaptertest.NewExchange()
+	restServer := httptest.NewServer(newTestStandInHandler(exchange))
+	t.Cleanup(restServer.Close)
+	wsServer := adaptertest.NewWebsocketServer(t)
+
+	a := New(
+		config.WithRESTBaseURL(restServer.URL),
+		config.WithWsBaseURL(wsServer.URL()),
+	)
+	if err := a.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{
+			Public:     "public",
+			Secret:     "secret",
+			Passphrase: "passphrase",
+		},
+	}); err != nil {
+		t.Fatalf("connect: %s", err)
+	}
+
+	return adaptertest.Harness{
+		Adapter:       a,
+		Exchange:      exchange,
+		WsConnections: wsServer.Connections,
+	}
+}
+
+// newTestStandInHandler - bitget REST API over the stand-in exchange
+func newTestStandInHandler(exchange *adaptertest.Exchange) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET "+endpointGetSymbols, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, []mappers.Symbol{{
+			Symbol:            testConformancePair,
+			BaseCoin:          "BTC",
+			QuoteCoin:         "USDT",
+			MinTradeAmount:    "0.00001",
+			MaxTradeAmount:    "10000",
+			PricePrecision:    "1",
+			QuantityPrecision: "8",
+			MinTradeUSDT:      "1",
+			Status:            "online",
+		}})
+	})
+	mux.HandleFunc("GET "+endpointGetTickers, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, []mappers.Ticker{{Symbol: testConformancePair, LastPr: "60000"}})
+	})
+
+	mux.HandleFunc("POST "+endpointPlaceOrder, func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		side, _ := mappers.ConvertOrderSide(request["side"])
+		order, err := exchange.PlaceOrder(adaptertest.Order{
+			ClientOrderID: request["clientOid"],
+			PairSymbol:    request["symbol"],
+			Side:          side,
+			IsMarket:      request["orderType"] == orderTypeMarket,
+			Qty:           decimal.RequireFromString(request["size"]),
+			Price:         decimal.RequireFromString(request["price"]),
+		})
+		if errors.Is(err, errs.ErrOrderDuplicate) {
+			writeTestError(w, testErrCodeOrderDuplicate, testErrOrderDuplicate)
+			return
+		}
+
+		writeTestResponse(w, mappers.PlacedOrder{
+			OrderID:   strconv.FormatInt(order.ID, 10),
+			ClientOid: order.ClientOrderID,
+		})
+	})
+
+	mux.HandleFunc("GET "+endpointGetOrder, func(w http.ResponseWriter, r *http.Request) {
+		order, err := getTestOrder(exchange, r.URL.Query().Get("orderId"), r.URL.Query().Get("clientOid"))
+		if err != nil {
+			writeTestError(w, errCodeOrderNotFound, testErrOrderNotFound)
+			return
+		}
+		writeTestResponse(w, []mappers.Order{convertTestOrder(order)})
+	})
+
+	// bitget doesn't tell the unknown order from the finished one on cancel
+	mux.HandleFunc("POST "+endpointCancelOrder, func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		order, err := getTestOrder(exchange, request["orderId"], request["clientOid"])
+		if err == nil {
+			order, err = exchange.CancelOrder(order.ID)
+		}
+		if err != nil {
+			writeTestError(w, errCodeOrderNotFound, testErrOrderNotFound)
+			return
+		}
+		writeTestResponse(w, mappers.PlacedOrder{
+			OrderID:   strconv.FormatInt(order.ID, 10),
+			ClientOid: order.ClientOrderID,
+		})
+	})
+	return mux
+}
+
+func getTestOrder(
+	exchange *adaptertest.Exchange,
+	orderID string,
+	clientOrderID string,
+) (adaptertest.Order, error) {
+	if clientOrderID != "" {
+		return exchange.GetOrderByClientOrderID(clientOrderID)
+	}
+
+	id, err := strconv.ParseInt(orderID, 10, 64)
+	if err != nil {
+		return adaptertest.Order{}, err
+	}
+	return exchange.GetOrder(id)
+}
+
+func convertTestOrder(order adaptertest.Order) mappers.Order {
+	side, _ := mappers.GetOrderSide(order.Side)
+	orderType := orderTypeLimit
+	if order.IsMarket {
+		orderType = orderTypeMarket
+	}
+
+	return mappers.Order{
+		Symbol:      order.PairSymbol,
+		OrderID:     strconv.FormatInt(order.ID, 10),
+		ClientOid:   order.ClientOrderID,
+		Price:       order.Price.String(),
+		Size:        order.Qty.String(),
+		OrderType:   orderType,
+		Side:        side,
+		Status:      testOrderStatuses[order.Status],
+		PriceAvg:    order.Price.String(),
+		BaseVolume:  order.FilledQty.String(),
+		QuoteVolume: order.FilledQty.Mul(order.Price).String(),
+		CTime:       strconv.FormatInt(order.CreatedTime, 10),
+		UTime:       strconv.FormatInt(order.UpdatedTime, 10),
+	}
+}
+
+func writeTestResponse(w http.ResponseWriter, data any) {
+	writeTestJSON(w, map[string]any{"code": restSuccessCode, "msg": "success", "data": data})
+}
+
+func writeTestError(w http.ResponseWriter, code string, message string) {
+	writeTestJSON(w, map[string]any{"code": code, "msg": message, "data": nil})
+}
+
+func writeTestJSON(w http.ResponseWriter, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,26 @@
+package mappers
+
+import (
+	"strings"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+// AccountInfo - account & API key data
+type AccountInfo struct {
+	UserID string `json:"userId"`
+	IPs    string `json:"ips"` // the key IP whitelist, comma separated
+}
+
+// ConvertAccountInfo - the key permissions aren't reported
+func ConvertAccountInfo(data AccountInfo) pkgStructs.APIKeyInfo {
+	info := pkgStructs.APIKeyInfo{Unknown: consts.GetAPIKeyPermissions()}
+	for _, ip := range strings.Split(data.IPs, ",") {
+		if ip = strings.TrimSpace(ip); ip != "" {
+			info.IPWhitelist = append(info.IPWhitelist, ip)
+		}
+	}
+	info.IsIPRestricted = len(info.IPWhitelist) > 0
+	return info
+}
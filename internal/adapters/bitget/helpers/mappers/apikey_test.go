@@ -0,0 +1,35 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertAccountInfo(t *testing.T) {
+	// given
+	data := AccountInfo{IPs: "1.2.3.4,5.6.7.8"}
+
+	// when
+	info := ConvertAccountInfo(data)
+
+	// then
+	assert.True(t, info.IsIPRestricted)
+	assert.Equal(t, []string{"1.2.3.4", "5.6.7.8"}, info.IPWhitelist)
+	assert.False(t, info.IsPermissionKnown(consts.APIKeyPermissionSpotTrade))
+	assert.NoError(t, info.CheckPermission(consts.APIKeyPermissionSpotTrade))
+}
+
+func TestConvertAccountInfoNoIPs(t *testing.T) {
+	// given
+	data := AccountInfo{}
+
+	// when
+	info := ConvertAccountInfo(data)
+
+	// then
+	assert.False(t, info.IsIPRestricted)
+	assert.Empty(t, info.IPWhitelist)
+}
@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+// Asset - spot or funding account asset balance
+type Asset struct {
+	Coin      string `json:"coin"`
+	Available string `json:"available"`
+	Frozen    string `json:"frozen"`
+	Locked    string `json:"locked"` // spot only
+}
+
+// TransferResult - internal transfer result
+type TransferResult struct {
+	TransferID string `json:"transferId"`
+	ClientOid  string `json:"clientOid"`
+}
+
+func ConvertBalances(assets []Asset) ([]structs.Balance, error) {
+	result := make([]structs.Balance, 0, len(assets))
+	for _, asset := range assets {
+		free, err := parseDecimal(asset.Available)
+		if err != nil {
+			return nil, fmt.Errorf("parse %q free: %w", asset.Coin, err)
+		}
+
+		frozen, err := parseDecimal(asset.Frozen)
+		if err != nil {
+			return nil, fmt.Errorf("parse %q frozen: %w", asset.Coin, err)
+		}
+
+		locked, err := parseDecimal(asset.Locked)
+		if err != nil {
+			return nil, fmt.Errorf("parse %q locked: %w", asset.Coin, err)
+		}
+
+		result = append(result, structs.Balance{
+			Asset:  asset.Coin,
+			Free:   free,
+			Locked: frozen.Add(locked),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,79 @@
+package mappers
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+const candleValuesMinCount = 6
+
+// Candle - [ts, open, high, low, close, base volume, ...]
+type Candle []string
+
+func ConvertCandle(
+	candle Candle,
+	interval consts.Interval,
+	duration time.Duration,
+) (workers.CandleData, error) {
+	if len(candle) < candleValuesMinCount {
+		return workers.CandleData{}, errors.New("invalid candle data")
+	}
+
+	startTime, err := parseTime(candle[0])
+	if err != nil {
+		return workers.CandleData{}, fmt.Errorf("parse start time: %w", err)
+	}
+
+	values, err := parseCandleValues(candle[1:6])
+	if err != nil {
+		return workers.CandleData{}, err
+	}
+
+	return workers.CandleData{
+		StartTime: startTime,
+		EndTime:   startTime + duration.Milliseconds() - 1,
+		Interval:  interval,
+		Open:      values[0],
+		High:      values[1],
+		Low:       values[2],
+		Close:     values[3],
+		Volume:    values[4],
+	}, nil
+}
+
+// ConvertCandles - bitget candles are sorted from old to new
+func ConvertCandles(
+	candles []Candle,
+	interval consts.Interval,
+	duration time.Duration,
+) ([]workers.CandleData, error) {
+	result := make([]workers.CandleData, 0, len(candles))
+	for _, candle := range candles {
+		candleData, err := ConvertCandle(candle, interval, duration)
+		if err != nil {
+			return nil, fmt.Errorf("convert candle: %w", err)
+		}
+		result = append(result, candleData)
+	}
+	return result, nil
+}
+
+var candleValueNames = []string{"open", "high", "low", "close", "volume"}
+
+func parseCandleValues(values []string) ([]decimal.Decimal, error) {
+	result := make([]decimal.Decimal, 0, len(values))
+	for i, value := range values {
+		parsed, err := parseDecimal(value)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", candleValueNames[i], err)
+		}
+		result = append(result, parsed)
+	}
+	return result, nil
+}
@@ -0,0 +1,114 @@
+package mappers
+
+import (
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+// WsTrade - public trades channel event
+type WsTrade struct {
+	TradeID string `json:"tradeId"`
+	Price   string `json:"price"`
+	Size    string `json:"size"`
+	Side    string `json:"side"` // taker side
+	Ts      string `json:"ts"`
+}
+
+// WsFill - private fill channel event. The fee details are sent as a list
+type WsFill struct {
+	Symbol     string          `json:"symbol"`
+	OrderID    string          `json:"orderId"`
+	TradeID    string          `json:"tradeId"`
+	OrderType  string          `json:"orderType"`
+	Side       string          `json:"side"`
+	PriceAvg   string          `json:"priceAvg"`
+	Size       string          `json:"size"`
+	Amount     string          `json:"amount"`
+	FeeDetail  []FillFeeDetail `json:"feeDetail"`
+	TradeScope string          `json:"tradeScope"`
+	CTime      string          `json:"cTime"`
+}
+
+// ConvertCandleEvent - bitget doesn't mark the completed candles
+func ConvertCandleEvent(
+	pairSymbol string,
+	candle workers.CandleData,
+	eventTime int64,
+) workers.CandleEvent {
+	return workers.CandleEvent{
+		Symbol: pairSymbol,
+		Candle: candle,
+		Time:   eventTime,
+	}
+}
+
+func ConvertPublicTradeEvent(
+	pairSymbol string,
+	event WsTrade,
+) (workers.PublicTradeEvent, error) {
+	price, err := parseDecimal(event.Price)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := parseDecimal(event.Size)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	takerSide, err := ConvertOrderSide(event.Side)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("convert side: %w", err)
+	}
+
+	tradeTime, err := parseTime(event.Ts)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse time: %w", err)
+	}
+
+	return workers.PublicTradeEvent{
+		ID:          event.TradeID,
+		Time:        tradeTime,
+		ExchangeTag: consts.BitgetAdapterTag,
+		Symbol:      pairSymbol,
+		Price:       price,
+		Quantity:    qty,
+		TakerSide:   takerSide,
+	}, nil
+}
+
+// ConvertFillEvent - the fill channel has no client order ID
+func ConvertFillEvent(event WsFill) (workers.TradeEventPrivate, error) {
+	fill := Fill{
+		Symbol:     event.Symbol,
+		OrderID:    event.OrderID,
+		TradeID:    event.TradeID,
+		OrderType:  event.OrderType,
+		Side:       event.Side,
+		PriceAvg:   event.PriceAvg,
+		Size:       event.Size,
+		Amount:     event.Amount,
+		TradeScope: event.TradeScope,
+		CTime:      event.CTime,
+	}
+	if len(event.FeeDetail) > 0 {
+		fill.FeeDetail = event.FeeDetail[0]
+	}
+
+	trade, err := ConvertAccountTrade(fill, "")
+	if err != nil {
+		return workers.TradeEventPrivate{}, err
+	}
+
+	return workers.TradeEventPrivate{
+		ID:          trade.ID,
+		Time:        trade.Time,
+		ExchangeTag: consts.BitgetAdapterTag,
+		Symbol:      trade.Symbol,
+		OrderID:     fill.OrderID,
+		Price:       trade.Price,
+		Quantity:    trade.Qty,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package mappers
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertCandles(t *testing.T) {
+	// given
+	var candles []Candle
+	require.NoError(t, json.Unmarshal([]byte(`[
+		["1700000000000","1","3","0.5","2","100","200","200"]
+	]`), &candles))
+
+	// when
+	result, err := ConvertCandles(candles, consts.Interval1min, time.Minute)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, int64(1700000059999), result[0].EndTime)
+	assert.Equal(t, "3", result[0].High.String())
+	assert.Equal(t, "100", result[0].Volume.String())
+}
+
+func TestConvertCandleInvalid(t *testing.T) {
+	// when
+	_, err := ConvertCandle(Candle{"1700000000000", "1"}, consts.Interval1min, time.Minute)
+
+	// then
+	require.Error(t, err)
+}
+
+func TestConvertPublicTradeEvent(t *testing.T) {
+	// given
+	trade := WsTrade{
+		TradeID: "1", Price: "100.5", Size: "2", Side: "sell", Ts: "1700000000000",
+	}
+
+	// when
+	event, err := ConvertPublicTradeEvent("BTCUSDT", trade)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.BitgetAdapterTag, event.ExchangeTag)
+	assert.Equal(t, "BTCUSDT", event.Symbol)
+	assert.Equal(t, consts.OrderSideSell, event.TakerSide)
+}
+
+func TestConvertFillEvent(t *testing.T) {
+	// given
+	var fill WsFill
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"orderId":"1215460931735838720","tradeId":"2","symbol":"BTCUSDT",
+		"side":"buy","priceAvg":"60000","size":"0.01","tradeScope":"taker",
+		"feeDetail":[{"feeCoin":"BTC","totalFee":"-0.00001"}],"cTime":"1700000000000"
+	}`), &fill))
+
+	// when
+	event, err := ConvertFillEvent(fill)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "1215460931735838720", event.OrderID)
+	assert.Equal(t, "0.01", event.Quantity.String())
+	assert.Equal(t, consts.BitgetAdapterTag, event.ExchangeTag)
+}
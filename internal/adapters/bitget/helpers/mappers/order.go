@@ -0,0 +1,313 @@
+package mappers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	sideBuy  = "buy"
+	sideSell = "sell"
+
+	orderTypeMarket = "market"
+	tradeScopeMaker = "maker"
+)
+
+// exchange const -> our const
+var orderStatusConvertor = map[string]consts.OrderStatus{
+	"init":             pkgStructs.OrderStatusNew,
+	"new":              pkgStructs.OrderStatusNew,
+	"live":             pkgStructs.OrderStatusNew,
+	"partially_filled": pkgStructs.OrderStatusPartiallyFilled,
+	"filled":           pkgStructs.OrderStatusFilled,
+	"cancelled":        pkgStructs.OrderStatusCancelled,
+}
+
+// Order - spot order data
+type Order struct {
+	Symbol      string `json:"symbol"`
+	OrderID     string `json:"orderId"`
+	ClientOid   string `json:"clientOid"`
+	Price       string `json:"price"`
+	Size        string `json:"size"`
+	OrderType   string `json:"orderType"`
+	Side        string `json:"side"`
+	Status      string `json:"status"`
+	PriceAvg    string `json:"priceAvg"`
+	BaseVolume  string `json:"baseVolume"`
+	QuoteVolume string `json:"quoteVolume"`
+	CTime       string `json:"cTime"`
+	UTime       string `json:"uTime"`
+}
+
+// PlacedOrder - order placement result
+type PlacedOrder struct {
+	OrderID   string `json:"orderId"`
+	ClientOid string `json:"clientOid"`
+}
+
+// Fill - account trade
+type Fill struct {
+	Symbol     string        `json:"symbol"`
+	OrderID    string        `json:"orderId"`
+	TradeID    string        `json:"tradeId"`
+	OrderType  string        `json:"orderType"`
+	Side       string        `json:"side"`
+	PriceAvg   string        `json:"priceAvg"`
+	Size       string        `json:"size"`
+	Amount     string        `json:"amount"`
+	FeeDetail  FillFeeDetail `json:"feeDetail"`
+	TradeScope string        `json:"tradeScope"`
+	CTime      string        `json:"cTime"`
+}
+
+// FillFeeDetail - the trade fee, negative when charged
+type FillFeeDetail struct {
+	FeeCoin  string `json:"feeCoin"`
+	TotalFee string `json:"totalFee"`
+}
+
+// TradeRate - account fee rates
+type TradeRate struct {
+	MakerFeeRate string `json:"makerFeeRate"`
+	TakerFeeRate string `json:"takerFeeRate"`
+}
+
+func ConvertOrderStatus(status string) (consts.OrderStatus, error) {
+	result, isExists := orderStatusConvertor[status]
+	if !isExists {
+		return "", fmt.Errorf("unknown status: %q", status)
+	}
+	return result, nil
+}
+
+func ConvertOrderSide(side string) (consts.OrderSide, error) {
+	switch side {
+	default:
+		return "", fmt.Errorf("unknown: %q", side)
+	case "":
+		return "", errors.New("not set")
+	case sideBuy:
+		return consts.OrderSideBuy, nil
+	case sideSell:
+		return consts.OrderSideSell, nil
+	}
+}
+
+func GetOrderSide(side consts.OrderSide) (string, error) {
+	switch side {
+	default:
+		return "", fmt.Errorf("unknown: %q", side)
+	case consts.OrderSideBuy:
+		return sideBuy, nil
+	case consts.OrderSideSell:
+		return sideSell, nil
+	}
+}
+
+func ConvertOrderData(data Order) (structs.OrderData, error) {
+	orderID, err := strconv.ParseInt(data.OrderID, 10, 64)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse order ID: %w", err)
+	}
+
+	orderStatus, err := ConvertOrderStatus(data.Status)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("convert status: %w", err)
+	}
+
+	orderSide, err := ConvertOrderSide(data.Side)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("convert side: %w", err)
+	}
+
+	orderQty, err := parseDecimal(data.Size)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	orderFilledQty, err := parseDecimal(data.BaseVolume)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse filled qty: %w", err)
+	}
+
+	orderPrice, err := parseDecimal(data.Price)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	if data.OrderType == orderTypeMarket {
+		orderPrice, err = parseDecimal(data.PriceAvg)
+		if err != nil {
+			return structs.OrderData{}, fmt.Errorf("parse avg price: %w", err)
+		}
+
+		if orderSide == consts.OrderSideBuy {
+			// market buy order size is set in quote asset
+			orderQty = orderFilledQty
+		}
+	}
+
+	createdTime, err := parseTime(data.CTime)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse created time: %w", err)
+	}
+
+	updatedTime, err := parseTime(data.UTime)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse updated time: %w", err)
+	}
+
+	return structs.OrderData{
+		OrderID:       orderID,
+		ClientOrderID: data.ClientOid,
+		Status:        orderStatus,
+		AwaitQty:      orderQty,
+		FilledQty:     orderFilledQty,
+		Price:         orderPrice,
+		Symbol:        data.Symbol,
+		Side:          orderSide,
+		CreatedTime:   createdTime,
+		UpdatedTime:   updatedTime,
+	}, nil
+}
+
+// ConvertPlacedOrder - bitget returns order IDs only, the rest is taken from the task
+func ConvertPlacedOrder(
+	data PlacedOrder,
+	order structs.BotOrderAdjusted,
+	createdTime int64,
+) (structs.CreateOrderResponse, error) {
+	orderID, err := strconv.ParseInt(data.OrderID, 10, 64)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("parse order ID: %w", err)
+	}
+
+	orderQty, err := parseDecimal(order.Qty)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	orderPrice, err := parseDecimal(order.Price)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	return structs.CreateOrderResponse{
+		OrderID:       orderID,
+		ClientOrderID: data.ClientOid,
+		OrigQuantity:  orderQty,
+		Price:         orderPrice,
+		Symbol:        order.PairSymbol,
+		Type:          order.Type,
+		CreatedTime:   createdTime,
+		Status:        pkgStructs.OrderStatusNew,
+	}, nil
+}
+
+func ConvertAccountTrade(fill Fill, clientOrderID string) (structs.AccountTrade, error) {
+	orderID, err := strconv.ParseInt(fill.OrderID, 10, 64)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse order ID: %w", err)
+	}
+
+	side, err := ConvertOrderSide(fill.Side)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("convert side: %w", err)
+	}
+
+	price, err := parseDecimal(fill.PriceAvg)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := parseDecimal(fill.Size)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	fee, err := parseDecimal(fill.FeeDetail.TotalFee)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse fee: %w", err)
+	}
+
+	tradeTime, err := parseTime(fill.CTime)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse time: %w", err)
+	}
+
+	return structs.AccountTrade{
+		ID:            fill.TradeID,
+		OrderID:       orderID,
+		ClientOrderID: clientOrderID,
+		Symbol:        fill.Symbol,
+		Side:          side,
+		Price:         price,
+		Qty:           qty,
+		// the charged fee is negative in the bitget response
+		Fee:      fee.Abs(),
+		FeeAsset: fill.FeeDetail.FeeCoin,
+		IsMaker:  fill.TradeScope == tradeScopeMaker,
+		Time:     tradeTime,
+	}, nil
+}
+
+// GetFeesFromFills - order fees by the order fills
+func GetFeesFromFills(
+	fills []Fill,
+	baseAssetTicker string,
+	quoteAssetTicker string,
+) (structs.OrderFees, error) {
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.Zero,
+		QuoteAsset: decimal.Zero,
+	}
+
+	for _, fill := range fills {
+		trade, err := ConvertAccountTrade(fill, "")
+		if err != nil {
+			return structs.OrderFees{}, fmt.Errorf("convert fill: %w", err)
+		}
+
+		switch trade.FeeAsset {
+		case baseAssetTicker:
+			fees.BaseAsset = fees.BaseAsset.Add(trade.Fee)
+		case quoteAssetTicker:
+			fees.QuoteAsset = fees.QuoteAsset.Add(trade.Fee)
+		}
+
+		fees.Commissions = append(fees.Commissions, structs.Commission{
+			TradeID: trade.ID,
+			Asset:   trade.FeeAsset,
+			Amount:  trade.Fee,
+			Price:   trade.Price,
+			Time:    trade.Time,
+		})
+	}
+	return fees, nil
+}
+
+func ConvertTradeFees(pairSymbol string, rate TradeRate) (structs.TradeFees, error) {
+	maker, err := parseDecimal(rate.MakerFeeRate)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("parse maker fee: %w", err)
+	}
+
+	taker, err := parseDecimal(rate.TakerFeeRate)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("parse taker fee: %w", err)
+	}
+
+	return structs.TradeFees{
+		Symbol: pairSymbol,
+		Maker:  maker,
+		Taker:  taker,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+func TestConvertOrderDataMarketBuyOrder(t *testing.T) {
+	// given
+	order := Order{
+		Symbol:      "BTCUSDT",
+		OrderID:     "1215460931735838720",
+		ClientOid:   "test",
+		Price:       "0",
+		Size:        "600.005",
+		OrderType:   "market",
+		Side:        "buy",
+		Status:      "filled",
+		PriceAvg:    "60000.5",
+		BaseVolume:  "0.01",
+		QuoteVolume: "600.005",
+		CTime:       "1700000000000",
+		UTime:       "1700000000100",
+	}
+
+	// when
+	data, err := ConvertOrderData(order)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, int64(1215460931735838720), data.OrderID)
+	assert.Equal(t, pkgStructs.OrderStatusFilled, data.Status)
+	assert.Equal(t, consts.OrderSideBuy, data.Side)
+	assert.Equal(t, "60000.5", data.Price.String())
+	assert.Equal(t, "0.01", data.AwaitQty.String())
+	assert.True(t, data.IsFullFilled())
+}
+
+func TestConvertOrderDataLimitOrder(t *testing.T) {
+	// given
+	order := Order{
+		OrderID:    "1",
+		Price:      "100",
+		Size:       "2",
+		OrderType:  "limit",
+		Side:       "sell",
+		Status:     "partially_filled",
+		BaseVolume: "1",
+	}
+
+	// when
+	data, err := ConvertOrderData(order)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, pkgStructs.OrderStatusPartiallyFilled, data.Status)
+	assert.Equal(t, "100", data.Price.String())
+	assert.Equal(t, "2", data.AwaitQty.String())
+	assert.False(t, data.IsFullFilled())
+}
+
+func TestConvertOrderDataUnknownStatus(t *testing.T) {
+	// given
+	order := Order{OrderID: "1", Status: "test", Side: "buy"}
+
+	// when
+	_, err := ConvertOrderData(order)
+
+	// then
+	require.Error(t, err)
+}
+
+func TestGetFeesFromFills(t *testing.T) {
+	// given
+	fills := []Fill{
+		{
+			TradeID: "1", OrderID: "10", Side: "buy", PriceAvg: "100", Size: "1",
+			FeeDetail: FillFeeDetail{FeeCoin: "ETH", TotalFee: "-0.001"},
+			CTime:     "1700000000000",
+		},
+		{
+			TradeID: "2", OrderID: "10", Side: "buy", PriceAvg: "100", Size: "1",
+			FeeDetail: FillFeeDetail{FeeCoin: "ETH", TotalFee: "-0.002"},
+			CTime:     "1700000000001",
+		},
+		{
+			TradeID: "3", OrderID: "10", Side: "buy", PriceAvg: "100", Size: "1",
+			FeeDetail: FillFeeDetail{FeeCoin: "BGB", TotalFee: "-0.5"},
+			CTime:     "1700000000002",
+		},
+	}
+
+	// when
+	fees, err := GetFeesFromFills(fills, "ETH", "USDT")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.003", fees.BaseAsset.String())
+	assert.True(t, fees.QuoteAsset.IsZero())
+	require.Len(t, fees.Commissions, 3)
+	assert.Equal(t, "BGB", fees.Commissions[2].Asset)
+	assert.Equal(t, "0.5", fees.Commissions[2].Amount.String())
+}
+
+func TestConvertAccountTradeMaker(t *testing.T) {
+	// given
+	fill := Fill{
+		Symbol: "ETHUSDT", OrderID: "10", TradeID: "1", Side: "sell",
+		PriceAvg: "2000", Size: "0.5", TradeScope: "maker",
+		FeeDetail: FillFeeDetail{FeeCoin: "USDT", TotalFee: "-1"},
+		CTime:     "1700000000000",
+	}
+
+	// when
+	trade, err := ConvertAccountTrade(fill, "test")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "test", trade.ClientOrderID)
+	assert.Equal(t, consts.OrderSideSell, trade.Side)
+	assert.True(t, trade.IsMaker)
+	assert.Equal(t, "1", trade.Fee.String())
+}
@@ -0,0 +1,131 @@
+package mappers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+// minTradeQuoteCoin - the min order value is set in this coin
+const minTradeQuoteCoin = "USDT"
+
+// bitget symbol status -> our pair status
+var pairStatusConvertor = map[string]string{
+	"online":  consts.PairStatusTrading,
+	"gray":    consts.PairStatusPreOpen,
+	"halt":    consts.PairStatusSuspended,
+	"offline": consts.PairStatusOffline,
+}
+
+// Symbol - spot pair info
+type Symbol struct {
+	Symbol            string `json:"symbol"`
+	BaseCoin          string `json:"baseCoin"`
+	QuoteCoin         string `json:"quoteCoin"`
+	MinTradeAmount    string `json:"minTradeAmount"`
+	MaxTradeAmount    string `json:"maxTradeAmount"`
+	PricePrecision    string `json:"pricePrecision"`
+	QuantityPrecision string `json:"quantityPrecision"`
+	MinTradeUSDT      string `json:"minTradeUSDT"`
+	Status            string `json:"status"`
+}
+
+// Ticker - pair ticker
+type Ticker struct {
+	Symbol string `json:"symbol"`
+	LastPr string `json:"lastPr"`
+}
+
+func ConvertPairStatus(status string) string {
+	result, isExists := pairStatusConvertor[status]
+	if !isExists {
+		return consts.PairStatusUnknown
+	}
+	return result
+}
+
+// ConvertPairData - the min order value is set in USDT, it's applied
+// to USDT quoted pairs only. The other pairs are limited by the min qty
+func ConvertPairData(data Symbol) (structs.ExchangePairData, error) {
+	basePrecision, err := strconv.Atoi(data.QuantityPrecision)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse quantity precision: %w", err)
+	}
+
+	pricePrecision, err := strconv.Atoi(data.PricePrecision)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse price precision: %w", err)
+	}
+
+	minQty, err := parseDecimal(data.MinTradeAmount)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse min trade amount: %w", err)
+	}
+
+	maxQty, err := parseDecimal(data.MaxTradeAmount)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse max trade amount: %w", err)
+	}
+
+	minDeposit := decimal.Zero
+	if data.QuoteCoin == minTradeQuoteCoin {
+		minDeposit, err = parseDecimal(data.MinTradeUSDT)
+		if err != nil {
+			return structs.ExchangePairData{}, fmt.Errorf("parse min trade USDT: %w", err)
+		}
+	}
+
+	priceStep := decimal.New(1, -int32(pricePrecision))
+
+	return structs.ExchangePairData{
+		ExchangeID:         consts.ExchangeIDbitget,
+		BaseAsset:          data.BaseCoin,
+		QuoteAsset:         data.QuoteCoin,
+		BasePrecision:      basePrecision,
+		QuotePrecision:     pricePrecision,
+		Status:             ConvertPairStatus(data.Status),
+		Symbol:             data.Symbol,
+		MinQty:             minQty,
+		MaxQty:             maxQty,
+		OriginalMinDeposit: minDeposit,
+		MinDeposit:         minDeposit,
+		MinPrice:           priceStep,
+		QtyStep:            decimal.New(1, -int32(basePrecision)),
+		PriceStep:          priceStep,
+		AllowedMargin:      false,
+		AllowedSpot:        true,
+		InUse:              true,
+	}, nil
+}
+
+func ConvertPairs(symbols []Symbol) ([]structs.ExchangePairData, error) {
+	result := make([]structs.ExchangePairData, 0, len(symbols))
+	for _, symbol := range symbols {
+		pairData, err := ConvertPairData(symbol)
+		if err != nil {
+			return nil, fmt.Errorf("convert %q: %w", symbol.Symbol, err)
+		}
+		result = append(result, pairData)
+	}
+	return result, nil
+}
+
+// parseDecimal - bitget sends empty strings instead of zero values
+func parseDecimal(value string) (decimal.Decimal, error) {
+	if value == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(value)
+}
+
+// parseTime - unix timestamp ms in string
+func parseTime(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
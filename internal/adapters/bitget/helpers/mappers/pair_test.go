@@ -0,0 +1,78 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertPairData(t *testing.T) {
+	// given
+	symbol := Symbol{
+		Symbol:            "BTCUSDT",
+		BaseCoin:          "BTC",
+		QuoteCoin:         "USDT",
+		MinTradeAmount:    "0.0001",
+		MaxTradeAmount:    "10000",
+		PricePrecision:    "2",
+		QuantityPrecision: "6",
+		MinTradeUSDT:      "1",
+		Status:            "online",
+	}
+
+	// when
+	data, err := ConvertPairData(symbol)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.ExchangeIDbitget, data.ExchangeID)
+	assert.Equal(t, consts.PairStatusTrading, data.Status)
+	assert.Equal(t, 6, data.BasePrecision)
+	assert.Equal(t, 2, data.QuotePrecision)
+	assert.Equal(t, "0.000001", data.QtyStep.String())
+	assert.Equal(t, "0.01", data.PriceStep.String())
+	assert.Equal(t, "1", data.MinDeposit.String())
+	assert.Equal(t, "0.0001", data.MinQty.String())
+}
+
+func TestConvertPairDataNotUSDTQuote(t *testing.T) {
+	// given
+	symbol := Symbol{
+		Symbol:            "ETHBTC",
+		BaseCoin:          "ETH",
+		QuoteCoin:         "BTC",
+		MinTradeAmount:    "0.001",
+		MaxTradeAmount:    "10000",
+		PricePrecision:    "6",
+		QuantityPrecision: "4",
+		MinTradeUSDT:      "5",
+		Status:            "online",
+	}
+
+	// when
+	data, err := ConvertPairData(symbol)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, data.MinDeposit.IsZero())
+	assert.True(t, data.OriginalMinDeposit.IsZero())
+	assert.Equal(t, "0.001", data.MinQty.String())
+}
+
+func TestConvertPairDataInvalidPrecision(t *testing.T) {
+	// given
+	symbol := Symbol{Symbol: "BTCUSDT", QuantityPrecision: "test"}
+
+	// when
+	_, err := ConvertPairData(symbol)
+
+	// then
+	require.Error(t, err)
+}
+
+func TestConvertPairStatusUnknown(t *testing.T) {
+	assert.Equal(t, consts.PairStatusUnknown, ConvertPairStatus("test"))
+}
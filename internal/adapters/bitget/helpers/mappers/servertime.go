@@ -0,0 +1,22 @@
+package mappers
+
+import (
+	"fmt"
+	"time"
+)
+
+// ServerTime - exchange server time
+type ServerTime struct {
+	ServerTime string `json:"serverTime"`
+}
+
+func ConvertServerTime(data ServerTime) (time.Time, error) {
+	ts, err := parseTime(data.ServerTime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse time: %w", err)
+	}
+	if ts == 0 {
+		return time.Time{}, fmt.Errorf("invalid time: %q", data.ServerTime)
+	}
+	return time.UnixMilli(ts), nil
+}
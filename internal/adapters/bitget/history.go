@@ -0,0 +1,109 @@
+package bitget
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bitget/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	tradesHistoryMaxWindow = time.Hour * 24 * 7
+	fillsPageLimit         = 100
+)
+
+func (a *adapter) GetAccountTrades(
+	task structs.GetOrdersHistoryTask,
+) ([]structs.AccountTrade, error) {
+	if !a.isPrivateAPIAvailable() {
+		return nil, errs.ErrAPIKeyNotSet
+	}
+
+	windows, err := baseadp.SplitHistoryTask(task, tradesHistoryMaxWindow)
+	if err != nil {
+		return nil, fmt.Errorf("check task: %w", err)
+	}
+
+	ctx := baseadp.GetHistoryTaskContext(task)
+
+	var fills []mappers.Fill
+	for _, window := range windows {
+		windowFills, err := a.getWindowFills(ctx, task.PairSymbol, window)
+		if err != nil {
+			return nil, fmt.Errorf("get trades: %w", err)
+		}
+		fills = append(fills, windowFills...)
+	}
+
+	clientOrderIDs, err := a.getClientOrderIDs(fills)
+	if err != nil {
+		return nil, fmt.Errorf("get orders: %w", err)
+	}
+
+	result := make([]structs.AccountTrade, 0, len(fills))
+	for _, fill := range fills {
+		trade, err := mappers.ConvertAccountTrade(fill, clientOrderIDs[fill.OrderID])
+		if err != nil {
+			return nil, fmt.Errorf("convert trade: %w", err)
+		}
+		result = append(result, trade)
+	}
+	return baseadp.SortAccountTrades(result), nil
+}
+
+// getWindowFills - bitget fills are sorted from new to old,
+// the next page is requested by the last trade ID
+func (a *adapter) getWindowFills(
+	ctx context.Context,
+	pairSymbol string,
+	window baseadp.TimeWindow,
+) ([]mappers.Fill, error) {
+	params := map[string]any{
+		"symbol":    pairSymbol,
+		"startTime": window.StartTime,
+		"endTime":   window.EndTime,
+		"limit":     fillsPageLimit,
+	}
+
+	var result []mappers.Fill
+	for {
+		var fills []mappers.Fill
+		if err := a.rest.get(ctx, endpointGetFills, params, &fills); err != nil {
+			return nil, err
+		}
+
+		result = append(result, fills...)
+		if len(fills) < fillsPageLimit {
+			return result, nil
+		}
+
+		params["idLessThan"] = fills[len(fills)-1].TradeID
+	}
+}
+
+// getClientOrderIDs - bitget fills don't contain client order ID
+func (a *adapter) getClientOrderIDs(fills []mappers.Fill) (map[string]string, error) {
+	result := map[string]string{}
+	for _, fill := range fills {
+		if _, isExists := result[fill.OrderID]; isExists {
+			continue
+		}
+
+		orderID, err := strconv.ParseInt(fill.OrderID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse order ID: %w", err)
+		}
+
+		order, err := a.GetOrderData(fill.Symbol, orderID)
+		if err != nil {
+			return nil, fmt.Errorf("get order %v: %w", fill.OrderID, err)
+		}
+		result[fill.OrderID] = order.ClientOrderID
+	}
+	return result, nil
+}
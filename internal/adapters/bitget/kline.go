@@ -0,0 +1,73 @@
+package bitget
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+type IntervalData struct {
+	Interval consts.Interval
+	Duration time.Duration
+}
+
+// bitget REST granularity -> our interval. 6h & longer candles are aligned to UTC
+var intervalBitgetToOur = map[string]IntervalData{
+	"1min":   {consts.Interval1min, time.Minute},
+	"5min":   {consts.Interval5min, time.Minute * 5},
+	"15min":  {consts.Interval15min, time.Minute * 15},
+	"30min":  {consts.Interval30min, time.Minute * 30},
+	"1h":     {consts.Interval1hour, time.Hour},
+	"4h":     {consts.Interval4hour, time.Hour * 4},
+	"6Hutc":  {consts.Interval6hour, time.Hour * 6},
+	"12Hutc": {consts.Interval12hour, time.Hour * 12},
+	"1Dutc":  {consts.Interval1day, time.Hour * 24},
+	"3Dutc":  {consts.Interval3day, time.Hour * 24 * 3},
+	"1Wutc":  {consts.Interval1week, time.Hour * 24 * 7},
+	"1Mutc":  {consts.Interval1month, time.Hour * 24 * 31},
+}
+
+var ourIntervalToBitget = func() map[consts.Interval]string {
+	r := map[consts.Interval]string{}
+	for granularity, data := range intervalBitgetToOur {
+		r[data.Interval] = granularity
+	}
+	return r
+}()
+
+// our interval -> bitget websocket candle channel bar
+var ourIntervalToBitgetWs = map[consts.Interval]string{
+	consts.Interval1min:   "1m",
+	consts.Interval5min:   "5m",
+	consts.Interval15min:  "15m",
+	consts.Interval30min:  "30m",
+	consts.Interval1hour:  "1H",
+	consts.Interval4hour:  "4H",
+	consts.Interval6hour:  "6Hutc",
+	consts.Interval12hour: "12Hutc",
+	consts.Interval1day:   "1Dutc",
+	consts.Interval3day:   "3Dutc",
+	consts.Interval1week:  "1Wutc",
+	consts.Interval1month: "1Mutc",
+}
+
+// ConvertIntervalToBitget - REST candles granularity
+func ConvertIntervalToBitget(interval consts.Interval) (string, error) {
+	result, isExists := ourIntervalToBitget[interval]
+	if !isExists {
+		return "", &errs.NotSupportedError{Feature: fmt.Sprintf("interval %q", interval)}
+	}
+	return result, nil
+}
+
+// ConvertIntervalToBitgetWs - websocket candle channel bar,
+// it differs from the REST granularity
+func ConvertIntervalToBitgetWs(interval consts.Interval) (string, error) {
+	result, isExists := ourIntervalToBitgetWs[interval]
+	if !isExists {
+		return "", &errs.NotSupportedError{Feature: fmt.Sprintf("interval %q", interval)}
+	}
+	return result, nil
+}
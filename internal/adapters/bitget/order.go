@@ -0,0 +1,246 @@
+package bitget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bitget/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	endpointPlaceOrder   = "/api/v2/spot/trade/place-order"
+	endpointGetOrder     = "/api/v2/spot/trade/orderInfo"
+	endpointGetFills     = "/api/v2/spot/trade/fills"
+	endpointGetTradeRate = "/api/v2/common/trade-rate"
+
+	orderTypeLimit   = "limit"
+	orderTypeMarket  = "market"
+	timeInForceGTC   = "gtc"
+	businessTypeSpot = "spot"
+
+	errCodeOrderNotFound     = "43001"
+	errOrderNotFoundMessage  = "not exist"
+	errOrderDuplicateMessage = "duplicate"
+)
+
+func (a *adapter) PlaceOrder(
+	ctx context.Context,
+	order structs.BotOrderAdjusted,
+) (structs.CreateOrderResponse, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.CreateOrderResponse{}, errs.ErrAPIKeyNotSet
+	}
+
+	orderSide, err := mappers.GetOrderSide(order.Type)
+	if err != nil {
+		return structs.CreateOrderResponse{},
+			fmt.Errorf("get order side: %w", err)
+	}
+
+	params := map[string]any{
+		"symbol":    order.PairSymbol,
+		"side":      orderSide,
+		"orderType": orderTypeLimit,
+		"force":     timeInForceGTC,
+		"size":      order.Qty,
+		"price":     order.Price,
+	}
+	if order.IsMarketOrder {
+		params["orderType"] = orderTypeMarket
+		delete(params, "price")
+
+		if order.Type == consts.OrderSideBuy {
+			// market buy order size is set in quote asset
+			quoteSize, err := getMarketBuySize(order)
+			if err != nil {
+				return structs.CreateOrderResponse{},
+					fmt.Errorf("get market order size: %w", err)
+			}
+			params["size"] = quoteSize
+		}
+	}
+	if order.ClientOrderID != "" {
+		params["clientOid"] = order.ClientOrderID
+	}
+
+	var response mappers.PlacedOrder
+	if err := a.rest.post(ctx, endpointPlaceOrder, params, &response); err != nil {
+		if isAPIErrorMessage(err, errOrderDuplicateMessage) {
+			return structs.CreateOrderResponse{}, errs.ErrOrderDuplicate
+		}
+		return structs.CreateOrderResponse{}, fmt.Errorf("create: %w", err)
+	}
+	if response.OrderID == "" {
+		return structs.CreateOrderResponse{}, errors.New("order response is empty")
+	}
+
+	result, err := mappers.ConvertPlacedOrder(response, order, time.Now().UnixMilli())
+	if err != nil {
+		return structs.CreateOrderResponse{},
+			fmt.Errorf("convert: %w", err)
+	}
+	return result, nil
+}
+
+// getMarketBuySize - the order deposit or the qty by the estimated price
+func getMarketBuySize(order structs.BotOrderAdjusted) (string, error) {
+	if order.Deposit != "" {
+		return order.Deposit, nil
+	}
+
+	qty, err := decimal.NewFromString(order.Qty)
+	if err != nil {
+		return "", fmt.Errorf("parse qty: %w", err)
+	}
+
+	price, err := decimal.NewFromString(order.Price)
+	if err != nil {
+		return "", fmt.Errorf("parse price: %w", err)
+	}
+	if !price.IsPositive() {
+		return "", errors.New("price or deposit is not set")
+	}
+	return qty.Mul(price).String(), nil
+}
+
+func (a *adapter) GetOrderData(
+	pairSymbol string,
+	orderID int64,
+) (structs.OrderData, error) {
+	return a.getOrder(map[string]any{
+		"orderId": strconv.FormatInt(orderID, 10),
+	})
+}
+
+func (a *adapter) GetOrderByClientOrderID(
+	pairSymbol string,
+	clientOrderID string,
+) (structs.OrderData, error) {
+	return a.getOrder(map[string]any{
+		"clientOid": clientOrderID,
+	})
+}
+
+func (a *adapter) getOrder(params map[string]any) (structs.OrderData, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.OrderData{}, errs.ErrAPIKeyNotSet
+	}
+
+	var orders []mappers.Order
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetOrder,
+		params,
+		&orders,
+	); err != nil {
+		if isOrderNotFoundError(err) {
+			return structs.OrderData{}, errs.ErrOrderNotFound
+		}
+		return structs.OrderData{}, fmt.Errorf("get: %w", err)
+	}
+	if len(orders) == 0 {
+		return structs.OrderData{}, errs.ErrOrderNotFound
+	}
+
+	return mappers.ConvertOrderData(orders[0])
+}
+
+func (a *adapter) GetHistoryOrder(
+	pairSymbol string,
+	orderID int64,
+) (structs.OrderHistory, error) {
+	orderData, err := a.GetOrderData(pairSymbol, orderID)
+	if err != nil {
+		return structs.OrderHistory{}, fmt.Errorf("get order: %w", err)
+	}
+
+	fills, err := a.getOrderFills(pairSymbol, orderID)
+	if err != nil {
+		return structs.OrderHistory{}, fmt.Errorf("get fills: %w", err)
+	}
+
+	// bitget pair symbol has no delimiter
+	symbol, err := a.getSymbol(pairSymbol)
+	if err != nil {
+		return structs.OrderHistory{}, fmt.Errorf("get pair: %w", err)
+	}
+
+	fees, err := mappers.GetFeesFromFills(fills, symbol.BaseCoin, symbol.QuoteCoin)
+	if err != nil {
+		return structs.OrderHistory{}, fmt.Errorf("get fees: %w", err)
+	}
+
+	return structs.OrderHistory{
+		OrderData: orderData,
+		Fees:      fees,
+	}, nil
+}
+
+func (a *adapter) GetOrderExecFee(
+	baseAssetTicker string,
+	quoteAssetTicker string,
+	orderSide consts.OrderSide,
+	orderID int64,
+) (structs.OrderFees, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.OrderFees{}, errs.ErrAPIKeyNotSet
+	}
+
+	fills, err := a.getOrderFills(
+		a.GetPairSymbol(baseAssetTicker, quoteAssetTicker),
+		orderID,
+	)
+	if err != nil {
+		return structs.OrderFees{}, fmt.Errorf("get fills: %w", err)
+	}
+
+	return mappers.GetFeesFromFills(fills, baseAssetTicker, quoteAssetTicker)
+}
+
+func (a *adapter) getOrderFills(pairSymbol string, orderID int64) ([]mappers.Fill, error) {
+	var fills []mappers.Fill
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetFills,
+		map[string]any{
+			"symbol":  pairSymbol,
+			"orderId": strconv.FormatInt(orderID, 10),
+			"limit":   fillsPageLimit,
+		},
+		&fills,
+	); err != nil {
+		return nil, err
+	}
+	return fills, nil
+}
+
+func (a *adapter) GetTradeFees(pairSymbol string) (structs.TradeFees, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.TradeFees{}, errs.ErrAPIKeyNotSet
+	}
+
+	var rate mappers.TradeRate
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetTradeRate,
+		map[string]any{"symbol": pairSymbol, "businessType": businessTypeSpot},
+		&rate,
+	); err != nil {
+		return structs.TradeFees{}, fmt.Errorf("get: %w", err)
+	}
+
+	return mappers.ConvertTradeFees(pairSymbol, rate)
+}
+
+func isOrderNotFoundError(err error) bool {
+	return isAPIErrorCode(err, errCodeOrderNotFound) ||
+		isAPIErrorMessage(err, errOrderNotFoundMessage)
+}
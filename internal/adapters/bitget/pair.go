@@ -0,0 +1,146 @@
+package bitget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bitget/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	endpointGetSymbols  = "/api/v2/spot/public/symbols"
+	endpointGetTickers  = "/api/v2/spot/market/tickers"
+	endpointCancelOrder = "/api/v2/spot/trade/cancel-order"
+)
+
+func (a *adapter) GetPairData(pairSymbol string) (structs.ExchangePairData, error) {
+	symbol, err := a.getSymbol(pairSymbol)
+	if err != nil {
+		return structs.ExchangePairData{}, err
+	}
+	return mappers.ConvertPairData(symbol)
+}
+
+func (a *adapter) getSymbol(pairSymbol string) (mappers.Symbol, error) {
+	var symbols []mappers.Symbol
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetSymbols,
+		map[string]any{"symbol": pairSymbol},
+		&symbols,
+	); err != nil {
+		return mappers.Symbol{}, fmt.Errorf("get symbols: %w", err)
+	}
+	if len(symbols) == 0 {
+		return mappers.Symbol{}, errors.New("data not found")
+	}
+	return symbols[0], nil
+}
+
+func (a *adapter) GetPairLastPrice(pairSymbol string) (decimal.Decimal, error) {
+	var tickers []mappers.Ticker
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetTickers,
+		map[string]any{"symbol": pairSymbol},
+		&tickers,
+	); err != nil {
+		return decimal.Zero, fmt.Errorf("get ticker: %w", err)
+	}
+	if len(tickers) == 0 {
+		return decimal.Zero, fmt.Errorf("%q last price not found", pairSymbol)
+	}
+
+	lastPrice, err := decimal.NewFromString(tickers[0].LastPr)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("parse last price: %w", err)
+	}
+	return lastPrice, nil
+}
+
+func (a *adapter) GetPairs() ([]structs.ExchangePairData, error) {
+	var symbols []mappers.Symbol
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetSymbols,
+		nil,
+		&symbols,
+	); err != nil {
+		return nil, fmt.Errorf("get symbols: %w", err)
+	}
+
+	pairs, err := mappers.ConvertPairs(symbols)
+	if err != nil {
+		return nil, fmt.Errorf("convert: %w", err)
+	}
+	return pairs, nil
+}
+
+func (a *adapter) GetPairBalance(pair structs.PairSymbolData) (
+	structs.PairBalance,
+	error,
+) {
+	balances, err := a.GetAccountBalance()
+	if err != nil {
+		return structs.PairBalance{}, fmt.Errorf("get: %w", err)
+	}
+
+	return utils.FindPairBalance(balances, pair), nil
+}
+
+func (a *adapter) CancelPairOrder(
+	pairSymbol string,
+	orderID int64,
+	ctx context.Context,
+) error {
+	return a.cancelOrder(ctx, map[string]any{
+		"symbol":  pairSymbol,
+		"orderId": strconv.FormatInt(orderID, 10),
+	})
+}
+
+func (a *adapter) CancelPairOrderByClientOrderID(
+	pairSymbol string,
+	clientOrderID string,
+	ctx context.Context,
+) error {
+	return a.cancelOrder(ctx, map[string]any{
+		"symbol":    pairSymbol,
+		"clientOid": clientOrderID,
+	})
+}
+
+func (a *adapter) cancelOrder(ctx context.Context, params map[string]any) error {
+	if !a.isPrivateAPIAvailable() {
+		return errs.ErrAPIKeyNotSet
+	}
+
+	if err := a.rest.post(ctx, endpointCancelOrder, params, nil); err != nil {
+		if isOrderNotFoundError(err) {
+			return a.getCancelNotFoundError(params)
+		}
+		return fmt.Errorf("cancel: %w", err)
+	}
+	return nil
+}
+
+// getCancelNotFoundError - bitget returns the same error for the unknown
+// & the finished order, the order is read by the same ID to tell them apart
+func (a *adapter) getCancelNotFoundError(cancelParams map[string]any) error {
+	params := maps.Clone(cancelParams)
+	delete(params, "symbol")
+
+	order, err := a.getOrder(params)
+	if err == nil && order.Status == pkgStructs.OrderStatusFilled {
+		return errs.ErrOrderFilled
+	}
+	return errs.ErrOrderNotFound
+}
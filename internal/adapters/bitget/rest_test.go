@@ -0,0 +1,40 @@
+package bitget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestSign(t *testing.T) {
+	// when
+	signature := sign("secret", "1700000000000GET/api/v2/spot/account/assets")
+
+	// then
+	assert.Equal(t, "XEPL/9xwbYjyNhPp6yq4tEd7/ui4EUUpIWe5Ui7Sk4c=", signature)
+}
+
+func TestEncodeQuery(t *testing.T) {
+	// when
+	query := encodeQuery(map[string]any{"symbol": "BTCUSDT", "limit": 100})
+
+	// then
+	assert.Equal(t, "limit=100&symbol=BTCUSDT", query)
+}
+
+func TestIsOrderNotFoundError(t *testing.T) {
+	assert.True(t, isOrderNotFoundError(&APIError{Code: "43001"}))
+	assert.True(t, isOrderNotFoundError(&APIError{Code: "1", Message: "The order does not exist"}))
+	assert.False(t, isOrderNotFoundError(&APIError{Code: "40006"}))
+}
+
+func TestIntervalsSupportedByWebsocket(t *testing.T) {
+	for interval := range ourIntervalToBitget {
+		_, err := ConvertIntervalToBitgetWs(interval)
+		assert.NoError(t, err, interval)
+	}
+	_, err := ConvertIntervalToBitget(consts.Interval8hour)
+	assert.Error(t, err)
+}
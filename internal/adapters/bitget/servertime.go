@@ -0,0 +1,35 @@
+package bitget
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bitget/helpers/mappers"
+)
+
+const endpointGetServerTime = "/api/v2/public/time"
+
+func (a *adapter) GetServerTime() (time.Time, error) {
+	var data mappers.ServerTime
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetServerTime,
+		nil,
+		&data,
+	); err != nil {
+		return time.Time{}, fmt.Errorf("get server time: %w", err)
+	}
+
+	serverTime, err := mappers.ConvertServerTime(data)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("convert: %w", err)
+	}
+	return serverTime, nil
+}
+
+// SetServerTimeOffset - the offset is applied to the REST requests signature
+func (a *adapter) SetServerTimeOffset(offset time.Duration) error {
+	a.clock.SetOffset(offset)
+	return nil
+}
@@ -0,0 +1,100 @@
+package bitget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bitget/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	endpointTransfer = "/api/v2/spot/wallet/transfer"
+
+	errCodeInsufficientBalance = "43012"
+)
+
+// bitget account types: p2p is the funding account
+var transferAccountTypes = map[consts.AccountType]string{
+	consts.AccountTypeSpot:    "spot",
+	consts.AccountTypeFunding: "p2p",
+	consts.AccountTypeMargin:  "crossed_margin",
+}
+
+// GetAccountBalances - the margin account balances are not supported yet
+func (a *adapter) GetAccountBalances(accountType consts.AccountType) ([]structs.Balance, error) {
+	switch accountType {
+	default:
+		return nil, fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, accountType)
+	case consts.AccountTypeSpot:
+		return a.GetAccountBalance()
+	case consts.AccountTypeFunding:
+		return a.getBalances(endpointGetFundingAssets)
+	}
+}
+
+func (a *adapter) Transfer(
+	asset string,
+	amount decimal.Decimal,
+	fromAccount consts.AccountType,
+	toAccount consts.AccountType,
+) (structs.TransferResult, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.TransferResult{}, errs.ErrAPIKeyNotSet
+	}
+	if asset == "" {
+		return structs.TransferResult{}, errors.New("asset is not set")
+	}
+	if !amount.IsPositive() {
+		return structs.TransferResult{}, fmt.Errorf("invalid amount: %s", amount.String())
+	}
+
+	from, isExists := transferAccountTypes[fromAccount]
+	if !isExists {
+		return structs.TransferResult{},
+			fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, fromAccount)
+	}
+	to, isExists := transferAccountTypes[toAccount]
+	if !isExists {
+		return structs.TransferResult{},
+			fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, toAccount)
+	}
+	if from == to {
+		return structs.TransferResult{}, fmt.Errorf("transfer to the same account: %q", from)
+	}
+
+	var response mappers.TransferResult
+	if err := a.rest.post(
+		context.Background(),
+		endpointTransfer,
+		map[string]any{
+			"coin":     asset,
+			"amount":   amount.String(),
+			"fromType": from,
+			"toType":   to,
+		},
+		&response,
+	); err != nil {
+		if isAPIErrorCode(err, errCodeInsufficientBalance) {
+			return structs.TransferResult{},
+				fmt.Errorf("%w: %s", errs.ErrInsufficientBalance, err.Error())
+		}
+		return structs.TransferResult{}, fmt.Errorf("transfer: %w", err)
+	}
+	if response.TransferID == "" {
+		return structs.TransferResult{}, errors.New("transfer result not found")
+	}
+
+	return structs.TransferResult{
+		ID:          response.TransferID,
+		Asset:       asset,
+		Amount:      amount,
+		FromAccount: fromAccount,
+		ToAccount:   toAccount,
+	}, nil
+}
@@ -0,0 +1,278 @@
+package bitget
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bitget/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	tradeSubscriptionKey = "subscription"
+
+	channelCandlePrefix = "candle"
+	channelTrades       = "trade"
+	channelFills        = "fill"
+	instIDAll           = "default"
+)
+
+type CandleEventWorkerBitget struct {
+	workers.CandleWorker
+	wsURL  string
+	dialer *websocket.Dialer
+}
+
+func (a *adapter) CreateCandleWorker() *CandleEventWorkerBitget {
+	w := &CandleEventWorkerBitget{wsURL: a.ws.public, dialer: a.wsDialer}
+	w.CandleWorker.ExchangeTag = a.GetTag()
+	return w
+}
+
+type PublicTradeWorkerBitget struct {
+	workers.PublicTradeWorker
+	wsURL  string
+	dialer *websocket.Dialer
+}
+
+func (a *adapter) CreatePublicTradeWorker() *PublicTradeWorkerBitget {
+	w := &PublicTradeWorkerBitget{wsURL: a.ws.public, dialer: a.wsDialer}
+	w.PublicTradeWorker.ExchangeTag = a.GetTag()
+	return w
+}
+
+type TradeEventWorkerBitget struct {
+	workers.TradeEventWorker
+	creds  structs.APICredentials
+	wsURL  string
+	dialer *websocket.Dialer
+	now    func() time.Time // server time for the login signature
+}
+
+func (a *adapter) CreateTradeEventsWorker() *TradeEventWorkerBitget {
+	w := &TradeEventWorkerBitget{
+		creds:  a.creds,
+		wsURL:  a.ws.private,
+		dialer: a.wsDialer,
+		now:    a.clock.Now,
+	}
+	w.TradeEventWorker.ExchangeTag = a.GetTag()
+	return w
+}
+
+func (w *TradeEventWorkerBitget) SubscribeToTradeEventsPrivate(
+	eventCallback workers.TradeEventPrivateCallback,
+	errorHandler func(err error),
+) error {
+	if !w.creds.Keypair.IsSet() || w.creds.Keypair.Passphrase == "" {
+		return errs.ErrAPIKeyNotSet
+	}
+
+	if w.TradeEventWorker.IsSubscriptionExists(tradeSubscriptionKey) {
+		return nil
+	}
+
+	wsDone, wsStop, err := wsServe(
+		w.dialer,
+		w.wsURL,
+		wsArg{InstType: instTypeSpot, Channel: channelFills, InstID: instIDAll},
+		&w.creds.Keypair,
+		w.now,
+		func(message wsMessage) {
+			var fills []mappers.WsFill
+			if err := json.Unmarshal(message.Data, &fills); err != nil {
+				errorHandler(fmt.Errorf("decode fills: %w", err))
+				return
+			}
+
+			for _, fill := range fills {
+				event, err := mappers.ConvertFillEvent(fill)
+				if err != nil {
+					errorHandler(fmt.Errorf("convert: %w", err))
+					continue
+				}
+
+				eventCallback(event)
+			}
+		},
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	w.TradeEventWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		tradeSubscriptionKey,
+	)
+	return nil
+}
+
+func (w *CandleEventWorkerBitget) SubscribeToCandle(
+	pairSymbol string,
+	interval consts.Interval,
+	eventCallback func(event workers.CandleEvent),
+	errorHandler func(err error),
+) error {
+	bar, err := ConvertIntervalToBitgetWs(interval)
+	if err != nil {
+		return fmt.Errorf("convert interval: %w", err)
+	}
+
+	if w.CandleWorker.IsSubscriptionExists(pairSymbol, bar) {
+		return nil
+	}
+
+	duration := intervalBitgetToOur[ourIntervalToBitget[interval]].Duration
+	wsDone, wsStop, err := wsServe(
+		w.dialer,
+		w.wsURL,
+		wsArg{InstType: instTypeSpot, Channel: channelCandlePrefix + bar, InstID: pairSymbol},
+		nil,
+		nil,
+		func(message wsMessage) {
+			var candles []mappers.Candle
+			if err := json.Unmarshal(message.Data, &candles); err != nil {
+				errorHandler(fmt.Errorf("decode candles: %w", err))
+				return
+			}
+
+			for _, candle := range candles {
+				candleData, err := mappers.ConvertCandle(candle, interval, duration)
+				if err != nil {
+					errorHandler(fmt.Errorf("convert: %w", err))
+					continue
+				}
+
+				eventCallback(mappers.ConvertCandleEvent(
+					message.Arg.InstID,
+					candleData,
+					time.Now().UnixMilli(),
+				))
+			}
+		},
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	w.CandleWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		pairSymbol, bar,
+	)
+	return nil
+}
+
+func (w *PublicTradeWorkerBitget) SubscribeToPublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	if w.PublicTradeWorker.IsSubscriptionExists(pairSymbol) {
+		return nil
+	}
+
+	wsDone, wsStop, err := wsServe(
+		w.dialer,
+		w.wsURL,
+		wsArg{InstType: instTypeSpot, Channel: channelTrades, InstID: pairSymbol},
+		nil,
+		nil,
+		func(message wsMessage) {
+			var trades []mappers.WsTrade
+			if err := json.Unmarshal(message.Data, &trades); err != nil {
+				errorHandler(fmt.Errorf("decode trades: %w", err))
+				return
+			}
+
+			for _, trade := range trades {
+				event, err := mappers.ConvertPublicTradeEvent(message.Arg.InstID, trade)
+				if err != nil {
+					errorHandler(fmt.Errorf("convert: %w", err))
+					continue
+				}
+
+				eventCallback(event)
+			}
+		},
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	w.PublicTradeWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		pairSymbol,
+	)
+	return nil
+}
+
+func (a *adapter) SubscribeCandle(
+	pairSymbol string,
+	interval consts.Interval,
+	eventCallback func(event workers.CandleEvent),
+	errorHandler func(err error),
+) error {
+	return a.candleWorker.SubscribeToCandle(
+		pairSymbol,
+		interval,
+		eventCallback,
+		errorHandler,
+	)
+}
+
+func (a *adapter) SubscribeAccountTrades(
+	eventCallback workers.TradeEventPrivateCallback,
+	errorHandler func(err error),
+) error {
+	return a.tradeWorker.SubscribeToTradeEventsPrivate(
+		eventCallback, errorHandler,
+	)
+}
+
+func (a *adapter) SubscribePublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	return a.publicTradeWorker.SubscribeToPublicTrades(
+		pairSymbol,
+		eventCallback,
+		errorHandler,
+	)
+}
+
+func (a *adapter) UnsubscribeCandle(
+	pairSymbol string,
+	interval consts.Interval,
+) {
+	bar, err := ConvertIntervalToBitgetWs(interval)
+	if err != nil {
+		fmt.Printf(
+			"convert interval %q to bitget: %s\n",
+			interval, err.Error(),
+		)
+		return
+	}
+
+	a.candleWorker.Unsubscribe(pairSymbol, bar)
+}
+
+func (a *adapter) UnsubscribeAccountTrades() {
+	a.tradeWorker.UnsubscribeAll()
+}
+
+func (a *adapter) UnsubscribePublicTrades(pairSymbol string) {
+	a.publicTradeWorker.Unsubscribe(pairSymbol)
+}
@@ -0,0 +1,216 @@
+package bitget
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	wsBaseURL     = "wss://ws.bitget.com/v2/ws"
+	wsDemoBaseURL = "wss://wspap.bitget.com/v2/ws"
+	wsPublicPath  = "/public"
+	wsPrivatePath = "/private"
+
+	wsReadLimit    = 655350
+	wsPingInterval = time.Second * 30
+	wsPingMessage  = "ping"
+	wsPongMessage  = "pong"
+
+	wsOpLogin         = "login"
+	wsOpSubscribe     = "subscribe"
+	wsEventLogin      = "login"
+	wsEventError      = "error"
+	wsLoginSuccessful = "0"
+
+	// the login request signature: timestamp + method + path
+	wsLoginMethod = "GET"
+	wsLoginPath   = "/user/verify"
+)
+
+// wsEndpoints - websocket URLs by the channels type
+type wsEndpoints struct {
+	public  string
+	private string
+}
+
+// getWsEndpoints - demo trading has its own websocket host
+func getWsEndpoints(cfg config.Config) wsEndpoints {
+	baseURL := cfg.GetWsBaseURL(wsBaseURL, wsDemoBaseURL)
+	return wsEndpoints{
+		public:  baseURL + wsPublicPath,
+		private: baseURL + wsPrivatePath,
+	}
+}
+
+// wsArg - channel subscription args
+type wsArg struct {
+	InstType string `json:"instType"`
+	Channel  string `json:"channel"`
+	InstID   string `json:"instId"`
+}
+
+type wsLoginArg struct {
+	APIKey     string `json:"apiKey"`
+	Passphrase string `json:"passphrase"`
+	Timestamp  string `json:"timestamp"`
+	Sign       string `json:"sign"`
+}
+
+type wsRequest struct {
+	Op   string `json:"op"`
+	Args []any  `json:"args"`
+}
+
+// wsMessage - operation result or the channel push data.
+// The operation result code is a number
+type wsMessage struct {
+	Event  string          `json:"event"`
+	Code   json.Number     `json:"code"`
+	Msg    string          `json:"msg"`
+	Action string          `json:"action"`
+	Arg    wsArg           `json:"arg"`
+	Data   json.RawMessage `json:"data"`
+	Ts     int64           `json:"ts"`
+}
+
+// wsConnection - websocket connection with the writes lock:
+// the messages are sent by the ping loop & the read loop
+type wsConnection struct {
+	conn *websocket.Conn
+	mu   sync.Mutex
+}
+
+func (c *wsConnection) write(messageType int, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.WriteMessage(messageType, data)
+}
+
+func (c *wsConnection) writeJSON(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode: %w", err)
+	}
+	return c.write(websocket.TextMessage, data)
+}
+
+// wsServe - subscribe to the channel. The private channel is subscribed
+// after the login when the keypair is set, the login is signed at now() server time
+func wsServe(
+	dialer *websocket.Dialer,
+	wsURL string,
+	arg wsArg,
+	keypair *pkgStructs.APIKeypair,
+	now func() time.Time,
+	handler func(message wsMessage),
+	errorHandler func(err error),
+) (doneC, stopC chan struct{}, err error) {
+	conn, _, err := dialer.Dial(wsURL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial: %w", err)
+	}
+	wsConn := &wsConnection{conn: conn}
+
+	subscribeRequest := wsRequest{Op: wsOpSubscribe, Args: []any{arg}}
+	firstRequest := subscribeRequest
+	if keypair != nil {
+		firstRequest = getLoginRequest(*keypair, now())
+	}
+
+	if err := wsConn.writeJSON(firstRequest); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("send request: %w", err)
+	}
+
+	conn.SetReadLimit(wsReadLimit)
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+
+	go func() {
+		defer close(doneC)
+		var isStopped atomic.Bool
+
+		// await stop & keep alive
+		go func() {
+			ticker := time.NewTicker(wsPingInterval)
+			defer ticker.Stop()
+			defer conn.Close()
+
+			for {
+				select {
+				case <-stopC:
+					isStopped.Store(true)
+					return
+				case <-doneC:
+					return
+				case <-ticker.C:
+					if err := wsConn.write(
+						websocket.TextMessage, []byte(wsPingMessage),
+					); err != nil {
+						errorHandler(fmt.Errorf("ping: %w", err))
+					}
+				}
+			}
+		}()
+
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				if !isStopped.Load() {
+					errorHandler(err)
+				}
+				return
+			}
+
+			if string(data) == wsPongMessage {
+				continue
+			}
+
+			var message wsMessage
+			if err := json.Unmarshal(data, &message); err != nil {
+				errorHandler(fmt.Errorf("decode message: %w", err))
+				continue
+			}
+
+			switch message.Event {
+			case "":
+				handler(message)
+			case wsEventError:
+				errorHandler(fmt.Errorf("subscription error %s: %s", message.Code, message.Msg))
+			case wsEventLogin:
+				if message.Code.String() != wsLoginSuccessful {
+					errorHandler(fmt.Errorf("login error %s: %s", message.Code, message.Msg))
+					continue
+				}
+				if err := wsConn.writeJSON(subscribeRequest); err != nil {
+					errorHandler(fmt.Errorf("subscribe: %w", err))
+				}
+			}
+		}
+	}()
+	return doneC, stopC, nil
+}
+
+func getLoginRequest(keypair pkgStructs.APIKeypair, now time.Time) wsRequest {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	return wsRequest{
+		Op: wsOpLogin,
+		Args: []any{wsLoginArg{
+			APIKey:     keypair.Public,
+			Passphrase: keypair.Passphrase,
+			Timestamp:  timestamp,
+			Sign: sign(
+				keypair.Secret, timestamp+wsLoginMethod+wsLoginPath,
+			),
+		}},
+	}
+}
@@ -0,0 +1,176 @@
+package bybit
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hirokisan/bybit/v2"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/errs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+// SetAccountType - use classic spot (consts.AccountTypeSpot)
+// or unified (consts.AccountTypeUnified) trading account
+func (a *adapter) SetAccountType(accountType consts.AccountType) error {
+	switch accountType {
+	default:
+		return fmt.Errorf("unsupported trading account type: %q", accountType)
+	case consts.AccountTypeSpot:
+		a.accountType = bybit.AccountTypeV5SPOT
+	case consts.AccountTypeUnified:
+		a.accountType = bybit.AccountTypeV5UNIFIED
+	}
+	return nil
+}
+
+func (a *adapter) DetectAccountType() (consts.AccountType, error) {
+	accountType, err := a.detectAccountType()
+	if err != nil {
+		return "", fmt.Errorf("detect account type: %w", err)
+	}
+
+	a.accountType = accountType
+	return a.GetAccountType(), nil
+}
+
+func (a *adapter) GetAccountType() consts.AccountType {
+	if a.getAccountType() == bybit.AccountTypeV5SPOT {
+		return consts.AccountTypeSpot
+	}
+	return consts.AccountTypeUnified
+}
+
+// GetAccountBalances - consts.AccountTypeSpot is the trading account
+// whether it's classic or unified
+func (a *adapter) GetAccountBalances(accountType consts.AccountType) ([]structs.Balance, error) {
+	if accountType == consts.AccountTypeFunding {
+		return a.getFundBalances()
+	}
+
+	bybitAccountType, err := a.convertAccountType(accountType)
+	if err != nil {
+		return nil, err
+	}
+	return a.getWalletBalances(bybitAccountType)
+}
+
+func (a *adapter) Transfer(
+	asset string,
+	amount decimal.Decimal,
+	fromAccount consts.AccountType,
+	toAccount consts.AccountType,
+) (structs.TransferResult, error) {
+	if asset == "" {
+		return structs.TransferResult{}, errors.New("asset is not set")
+	}
+	if !amount.IsPositive() {
+		return structs.TransferResult{}, fmt.Errorf("invalid amount: %s", amount.String())
+	}
+
+	from, err := a.convertAccountType(fromAccount)
+	if err != nil {
+		return structs.TransferResult{}, err
+	}
+	to, err := a.convertAccountType(toAccount)
+	if err != nil {
+		return structs.TransferResult{}, err
+	}
+	if from == to {
+		return structs.TransferResult{}, fmt.Errorf("transfer to the same account: %q", from)
+	}
+
+	response, err := a.client.V5().Asset().CreateInternalTransfer(
+		bybit.V5CreateInternalTransferParam{
+			TransferID:      uuid.New().String(),
+			Coin:            bybit.Coin(asset),
+			Amount:          amount.String(),
+			FromAccountType: from,
+			ToAccountType:   to,
+		},
+	)
+	if err != nil {
+		return structs.TransferResult{}, fmt.Errorf("transfer: %w", errs.MapTransferError(err))
+	}
+
+	return structs.TransferResult{
+		ID:          response.Result.TransferID,
+		Asset:       asset,
+		Amount:      amount,
+		FromAccount: fromAccount,
+		ToAccount:   toAccount,
+	}, nil
+}
+
+// convertAccountType - the futures are traded in the unified account
+// or in the contract account for the classic accounts
+func (a *adapter) convertAccountType(accountType consts.AccountType) (bybit.AccountTypeV5, error) {
+	switch accountType {
+	default:
+		return "", fmt.Errorf("%w: %q", pkgErrs.ErrAccountTypeNotSupported, accountType)
+	case consts.AccountTypeSpot:
+		return a.getAccountType(), nil
+	case consts.AccountTypeUnified:
+		return bybit.AccountTypeV5UNIFIED, nil
+	case consts.AccountTypeFunding:
+		return bybit.AccountTypeV5FUND, nil
+	case consts.AccountTypeFutures:
+		if a.getAccountType() == bybit.AccountTypeV5UNIFIED {
+			return bybit.AccountTypeV5UNIFIED, nil
+		}
+		return bybit.AccountTypeV5CONTRACT, nil
+	}
+}
+
+// getAccountType - unified account is used until the type is detected
+func (a *adapter) getAccountType() bybit.AccountTypeV5 {
+	if a.accountType == "" {
+		return bybit.AccountTypeV5UNIFIED
+	}
+	return a.accountType
+}
+
+// getTradingAccountType - the account type holding the adapter category balances
+func (a *adapter) getTradingAccountType() bybit.AccountTypeV5 {
+	if !a.isLinear() {
+		return a.getAccountType()
+	}
+
+	accountType, _ := a.convertAccountType(consts.AccountTypeFutures)
+	return accountType
+}
+
+func (a *adapter) detectAccountType() (bybit.AccountTypeV5, error) {
+	response, err := a.client.V5().Account().GetAccountInfo()
+	if err != nil {
+		return "", fmt.Errorf("get account info: %w", err)
+	}
+	return mappers.GetTradingAccountType(response.Result.UnifiedMarginStatus), nil
+}
+
+func (a *adapter) getWalletBalances(accountType bybit.AccountTypeV5) ([]structs.Balance, error) {
+	response, err := a.client.V5().Account().GetWalletBalance(accountType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get wallet balance: %w", err)
+	}
+
+	if response == nil {
+		return nil, errors.New("get wallet balance: response is empty")
+	}
+	return mappers.ConvertAccountBalance(*response, accountType)
+}
+
+func (a *adapter) getFundBalances() ([]structs.Balance, error) {
+	response, err := a.client.V5().Asset().GetAllCoinsBalance(
+		bybit.V5GetAllCoinsBalanceParam{AccountType: bybit.AccountTypeV5FUND},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get fund balance: %w", err)
+	}
+	return mappers.ConvertFundBalances(response.Result)
+}
@@ -2,6 +2,7 @@ package bybit
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/hirokisan/bybit/v2"
@@ -9,14 +10,19 @@ import (
 	adp "github.com/matrixbotio/exchange-gates-lib/internal/adapters"
 	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
-	"github.com/matrixbotio/exchange-gates-lib/pkg/utils"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
 )
 
 const (
 	adapterName = "ByBit Spot"
 	adapterTag  = "bybit-spot"
+
+	linearAdapterName = "ByBit USDT Perpetual"
+	linearAdapterTag  = "bybit-linear"
 )
 
 type adapter struct {
@@ -24,20 +30,67 @@ type adapter struct {
 
 	client   *bybit.Client
 	wsClient *bybit.WebSocketClient
+	keypair  pkgStructs.APIKeypair
+	// restBaseURL - the client base URL for the requests sent by the adapter
+	restBaseURL string
+	// clock - the requests sent by the adapter are signed with the server time
+	clock *baseadp.ServerClock
+
+	// category - spot or linear perpetual contracts
+	category bybit.CategoryV5
+
+	// accountType - spot trading account type, detected on Connect when not set
+	accountType bybit.AccountTypeV5
+
+	candleWorker      *helpers.CandleEventWorkerBybit
+	tradeWorker       *TradeEventWorkerBybit
+	publicTradeWorker *helpers.PublicTradeWorkerBybit
+	positionWorker    *PositionWorkerBybit
+}
 
-	candleWorker *helpers.CandleEventWorkerBybit
-	tradeWorker  *TradeEventWorkerBybit
+func New(opts ...config.Option) adp.Adapter {
+	return newAdapter(
+		baseadp.NewAdapterBase(consts.ExchangeIDbybitSpot, adapterName, adapterTag),
+		bybit.CategoryV5Spot,
+		config.New(opts...),
+	)
 }
 
-func New() adp.Adapter {
-	return &adapter{
-		AdapterBase: baseadp.NewAdapterBase(
-			consts.ExchangeIDbybitSpot,
-			adapterName,
-			adapterTag,
+// NewLinear - USDT perpetual contracts adapter
+func NewLinear(opts ...config.Option) adp.FuturesAdapter {
+	return newAdapter(
+		baseadp.NewAdapterBase(
+			consts.ExchangeIDbybitLinear,
+			linearAdapterName,
+			linearAdapterTag,
 		),
-		client:   bybit.NewClient(),
-		wsClient: bybit.NewWebsocketClient(),
+		bybit.CategoryV5Linear,
+		config.New(opts...),
+	)
+}
+
+func newAdapter(
+	base baseadp.AdapterBase,
+	category bybit.CategoryV5,
+	cfg config.Config,
+) *adapter {
+	restBaseURL := cfg.GetRESTBaseURL(bybit.MainNetBaseURL, bybit.TestNetBaseURL)
+	// bybit client has no request timeout by default
+	client := bybit.NewClient().
+		WithBaseURL(restBaseURL).
+		WithHTTPClient(cfg.NewHTTPClient(0))
+
+	wsClient := bybit.NewWebsocketClient().
+		WithBaseURL(cfg.GetWsBaseURL(bybit.WebsocketBaseURL, bybit.TestWebsocketBaseURL)).
+		WithDialer(cfg.NewWsDialer())
+
+	return &adapter{
+		AdapterBase: base,
+		client:      client,
+		wsClient:    wsClient,
+		restBaseURL: restBaseURL,
+		clock:       baseadp.NewServerClock(),
+		category:    category,
 	}
 }
 
@@ -49,6 +102,29 @@ func (a *adapter) GetLimits() pkgStructs.ExchangeLimits {
 	}
 }
 
+// Capabilities - GetHistoryOrder is not implemented
+func (a *adapter) Capabilities() pkgStructs.AdapterCapabilities {
+	capabilities := pkgStructs.AdapterCapabilities{
+		OrderTypes:    []pkgStructs.OrderType{pkgStructs.OrderTypeLimit, pkgStructs.OrderTypeMarket},
+		TimeInForce:   []pkgStructs.TimeInForce{pkgStructs.TimeInForceGTC},
+		BatchOrders:   false,
+		OrderHistory:  false,
+		AccountTrades: true,
+		FeeLookup:     true,
+		Intervals:     a.GetSupportedIntervals(),
+		Streams: []pkgStructs.StreamType{
+			pkgStructs.StreamCandles,
+			pkgStructs.StreamPublicTrades,
+			pkgStructs.StreamAccountTrades,
+		},
+		OrderIDFormat: pkgStructs.OrderIDFormatNumeric,
+	}
+	if a.category == bybit.CategoryV5Linear {
+		capabilities.Streams = append(capabilities.Streams, pkgStructs.StreamPositions)
+	}
+	return capabilities
+}
+
 func (a *adapter) GetPairSymbol(baseTicker string, quoteTicker string) string {
 	return fmt.Sprintf("%s%s", baseTicker, quoteTicker)
 }
@@ -60,18 +136,51 @@ func (a *adapter) GenClientOrderID() string {
 func (a *adapter) Connect(credentials pkgStructs.APICredentials) error {
 	a.client.WithAuth(credentials.Keypair.Public, credentials.Keypair.Secret)
 	a.wsClient.WithAuth(credentials.Keypair.Public, credentials.Keypair.Secret)
+	a.keypair = credentials.Keypair
 
 	if err := a.client.SyncServerTime(); err != nil {
 		return fmt.Errorf("sync time: %w", err)
 	}
 
+	if a.accountType == "" && credentials.Keypair.IsSet() {
+		accountType, err := a.detectAccountType()
+		if err != nil {
+			// the key may have no account info permission,
+			// use DetectAccountType to get the error
+			accountType = bybit.AccountTypeV5UNIFIED
+		}
+		a.accountType = accountType
+	}
+
 	a.candleWorker = a.CreateCandleWorker()
 	a.tradeWorker = a.CreateTradeEventsWorker()
+	a.publicTradeWorker = a.CreatePublicTradeWorker()
+	a.positionWorker = a.CreatePositionWorker()
 	return nil
 }
 
-func (a *adapter) getAccountType() bybit.AccountTypeV5 {
-	return bybit.AccountTypeV5UNIFIED
+func (a *adapter) GetServerTime() (time.Time, error) {
+	response, err := a.client.NewTimeService().GetServerTime()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get server time: %w", err)
+	}
+
+	timeNano, err := strconv.ParseInt(response.Result.TimeNano, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse server time: %w", err)
+	}
+	return time.Unix(0, timeNano), nil
+}
+
+// SetServerTimeOffset - the offset is applied to the requests sent by the adapter.
+// Bybit client keeps its offset unexported & measures it by itself,
+// so its server time is re-synced with a request
+func (a *adapter) SetServerTimeOffset(offset time.Duration) error {
+	a.clock.SetOffset(offset)
+	if err := a.client.SyncServerTime(); err != nil {
+		return fmt.Errorf("sync time: %w", err)
+	}
+	return nil
 }
 
 func (a *adapter) CanTrade() (bool, error) {
@@ -80,6 +189,15 @@ func (a *adapter) CanTrade() (bool, error) {
 		return false, fmt.Errorf("get API key info: %w", err)
 	}
 
+	if a.isLinear() {
+		for _, permission := range response.Result.Permissions.ContractTrade {
+			if permission == "Order" {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
 	for _, permission := range response.Result.Permissions.Spot {
 		if permission == "SpotTrade" {
 			return true, nil
@@ -88,6 +206,14 @@ func (a *adapter) CanTrade() (bool, error) {
 	return false, nil
 }
 
+func (a *adapter) GetAPIKeyInfo() (pkgStructs.APIKeyInfo, error) {
+	response, err := a.client.V5().User().GetAPIKey()
+	if err != nil {
+		return pkgStructs.APIKeyInfo{}, fmt.Errorf("get API key info: %w", err)
+	}
+	return mappers.ConvertAPIKeyInfo(response.Result), nil
+}
+
 func (a *adapter) VerifyAPIKeys(keyPublic, keySecret string) error {
 	if err := a.Connect(pkgStructs.APICredentials{
 		Type: pkgStructs.APICredentialsTypeKeypair,
@@ -102,3 +228,7 @@ func (a *adapter) VerifyAPIKeys(keyPublic, keySecret string) error {
 	_, err := a.CanTrade()
 	return err
 }
+
+func (a *adapter) isLinear() bool {
+	return a.category == bybit.CategoryV5Linear
+}
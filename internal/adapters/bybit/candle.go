@@ -8,8 +8,16 @@ import (
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/mappers"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
 )
 
+func (a *adapter) GetSupportedIntervals() []consts.Interval {
+	return consts.FilterIntervals(func(interval consts.Interval) bool {
+		_, isExists := mappers.CandleIntervalsToBybit[interval]
+		return isExists
+	})
+}
+
 func (a *adapter) GetCandles(
 	limit int,
 	symbol string,
@@ -17,7 +25,7 @@ func (a *adapter) GetCandles(
 ) ([]workers.CandleData, error) {
 	bybitInterval, isExists := mappers.CandleIntervalsToBybit[interval]
 	if !isExists {
-		return nil, fmt.Errorf("interval %q not available", interval)
+		return nil, &pkgErrs.NotSupportedError{Feature: fmt.Sprintf("interval %q", interval)}
 	}
 
 	timeTo := time.Now()
@@ -28,7 +36,7 @@ func (a *adapter) GetCandles(
 	toTimestamp := timeTo.UnixMilli()
 
 	response, err := a.client.V5().Market().GetKline(bybit.V5GetKlineParam{
-		Category: bybit.CategoryV5Spot,
+		Category: a.category,
 		Symbol:   bybit.SymbolV5(symbol),
 		Interval: bybit.Interval(bybitInterval.Code),
 		Start:    &fromTimestamp,
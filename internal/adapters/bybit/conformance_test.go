@@ -0,0 +1,273 @@
+package bybit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/shopspring/decimal"
+
+	adp "github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/errs"
+	order_mappers "github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/mappers/order"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/adapter/adaptertest"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	testConformancePair = "BTCUSDT"
+
+	testRetCodeOrderNotFound = 110001
+	testRetCodeOrderInvalid  = 10001
+)
+
+// our status -> bybit order status
+var testOrderStatuses = map[consts.OrderStatus]bybit.OrderStatus{
+	pkgStructs.OrderStatusNew:       bybit.OrderStatusNew,
+	pkgStructs.OrderStatusFilled:    bybit.OrderStatusFilled,
+	pkgStructs.OrderStatusCancelled: bybit.OrderStatusCancelled,
+}
+
+func TestConformance(t *testing.T) {
+	adaptertest.Run(t, adaptertest.Config{
+		NewHarness: func(t *testing.T) adaptertest.Harness {
+			return newTestHarness(t, bybit.CategoryV5Spot, func(opts ...config.Option) adp.Adapter {
+				return New(opts...)
+			})
+		},
+		PairSymbol: testConformancePair,
+		OrderQty:   "0.001",
+		OrderPrice: "60000.5",
+	})
+}
+
+func TestConformanceLinear(t *testing.T) {
+	adaptertest.Run(t, adaptertest.Config{
+		NewHarness: func(t *testing.T) adaptertest.Harness {
+			return newTestHarness(t, bybit.CategoryV5Linear, func(opts ...config.Option) adp.Adapter {
+				return NewLinear(opts...)
+			})
+		},
+		PairSymbol: testConformancePair,
+		OrderQty:   "0.001",
+		OrderPrice: "60000.5",
+	})
+}
+
+func newTestHarness(
+	t *testing.T,
+	category bybit.CategoryV5,
+	newAdapter func(opts ...config.Option) adp.Adapter,
+) adaptertest.Harness {
+	exchange := adaptertest.NewExchange()
+	restServer := httptest.NewServer(newTestStandInHandler(exchange, category))
+	t.Cleanup(restServer.Close)
+	wsServer := adaptertest.NewWebsocketServer(t)
+
+	a := newAdapter(
+		config.WithRESTBaseURL(restServer.URL),
+		config.WithWsBaseURL(wsServer.URL()),
+	)
+	if err := a.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{
+			Public: "public",
+			Secret: "secret",
+		},
+	}); err != nil {
+		t.Fatalf("connect: %s", err)
+	}
+
+	return adaptertest.Harness{
+		Adapter:       a,
+		Exchange:      exchange,
+		WsConnections: wsServer.Connections,
+	}
+}
+
+// newTestStandInHandler - bybit V5 REST API over the stand-in exchange.
+// The history contains the finished orders only, the open ones are realtime
+func newTestStandInHandler(
+	exchange *adaptertest.Exchange,
+	category bybit.CategoryV5,
+) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /v3/public/time", func(w http.ResponseWriter, r *http.Request) {
+		writeTestResult(w, bybit.GetServerTimeResult{
+			TimeNano: strconv.FormatInt(time.Now().UnixNano(), 10),
+		})
+	})
+	mux.HandleFunc("GET /v5/account/info", func(w http.ResponseWriter, r *http.Request) {
+		writeTestResult(w, bybit.V5AccountInfoResult{
+			UnifiedMarginStatus: bybit.UnifiedMarginStatusUnifiedTrade,
+		})
+	})
+
+	mux.HandleFunc("GET /v5/market/instruments-info", func(w http.ResponseWriter, r *http.Request) {
+		if category == bybit.CategoryV5Linear {
+			writeTestResult(w, bybit.V5GetInstrumentsInfoLinearInverseResult{
+				Category: category,
+				List: []bybit.V5GetInstrumentsInfoLinearInverseItem{
+					newTestLinearInstrument(testConformancePair),
+				},
+			})
+			return
+		}
+
+		writeTestResult(w, bybit.V5GetInstrumentsInfoSpotResult{
+			Category: category,
+			List: []bybit.V5GetInstrumentsInfoSpotItem{{
+				Symbol:    testConformancePair,
+				BaseCoin:  "BTC",
+				QuoteCoin: "USDT",
+				Status:    bybit.InstrumentStatusTrading,
+				LotSizeFilter: bybit.SpotLotSizeFilterV5{
+					BasePrecision:  "0.000001",
+					QuotePrecision: "0.00000001",
+					MinOrderQty:    "0.000048",
+					MaxOrderQty:    "71.73956243",
+					MinOrderAmt:    "1",
+					MaxOrderAmt:    "2000000",
+				},
+				PriceFilter: bybit.SpotPriceFilterV5{TickSize: "0.01"},
+			}},
+		})
+	})
+
+	mux.HandleFunc("POST /v5/order/create", func(w http.ResponseWriter, r *http.Request) {
+		var request bybit.V5CreateOrderParam
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		order := adaptertest.Order{
+			PairSymbol: string(request.Symbol),
+			Side:       consts.OrderSide(strings.ToLower(string(request.Side))),
+			IsMarket:   request.OrderType == bybit.OrderTypeMarket,
+			Qty:        decimal.RequireFromString(request.Qty),
+		}
+		if request.OrderLinkID != nil {
+			order.ClientOrderID = *request.OrderLinkID
+		}
+		if request.Price != nil {
+			order.Price = decimal.RequireFromString(*request.Price)
+		}
+
+		order, err := exchange.PlaceOrder(order)
+		if err != nil {
+			writeTestError(w, testRetCodeOrderInvalid, errs.ErrMsgOrderDuplicate)
+			return
+		}
+		writeTestResult(w, bybit.V5CreateOrderResult{
+			OrderID:     strconv.FormatInt(order.ID, 10),
+			OrderLinkID: order.ClientOrderID,
+		})
+	})
+
+	getOrders := func(isOpen bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			result := bybit.V5GetOrdersResult{Category: category}
+
+			order, err := getTestOrder(
+				exchange,
+				r.URL.Query().Get("orderId"),
+				r.URL.Query().Get("orderLinkId"),
+			)
+			if err == nil && (order.Status == pkgStructs.OrderStatusNew) == isOpen {
+				result.List = append(result.List, convertTestOrder(order))
+			}
+			writeTestResult(w, result)
+		}
+	}
+	mux.HandleFunc("GET /v5/order/history", getOrders(false))
+	mux.HandleFunc("GET /v5/order/realtime", getOrders(true))
+
+	mux.HandleFunc("POST /v5/order/cancel", func(w http.ResponseWriter, r *http.Request) {
+		var request bybit.V5CancelOrderParam
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var orderID, clientOrderID string
+		if request.OrderID != nil {
+			orderID = *request.OrderID
+		}
+		if request.OrderLinkID != nil {
+			clientOrderID = *request.OrderLinkID
+		}
+
+		order, err := getTestOrder(exchange, orderID, clientOrderID)
+		if err != nil {
+			writeTestError(w, testRetCodeOrderNotFound, errs.ErrMsgOrderNotFound)
+			return
+		}
+		if order.Status == pkgStructs.OrderStatusFilled {
+			writeTestError(w, testRetCodeOrderNotFound, errs.ErrMsgOrderHasBeenFilled)
+			return
+		}
+		if _, err := exchange.CancelOrder(order.ID); err != nil {
+			writeTestError(w, testRetCodeOrderNotFound, errs.ErrMsgOrderNotFound)
+			return
+		}
+		writeTestResult(w, bybit.V5CancelOrderResult{
+			OrderID:     strconv.FormatInt(order.ID, 10),
+			OrderLinkID: order.ClientOrderID,
+		})
+	})
+	return mux
+}
+
+func getTestOrder(
+	exchange *adaptertest.Exchange,
+	orderID string,
+	clientOrderID string,
+) (adaptertest.Order, error) {
+	if clientOrderID != "" {
+		return exchange.GetOrderByClientOrderID(clientOrderID)
+	}
+
+	id, err := strconv.ParseInt(orderID, 10, 64)
+	if err != nil {
+		return adaptertest.Order{}, err
+	}
+	return exchange.GetOrder(id)
+}
+
+func convertTestOrder(order adaptertest.Order) bybit.V5GetOrder {
+	orderType := bybit.OrderTypeLimit
+	if order.IsMarket {
+		orderType = bybit.OrderTypeMarket
+	}
+
+	return bybit.V5GetOrder{
+		Symbol:      bybit.SymbolV5(order.PairSymbol),
+		OrderType:   orderType,
+		OrderLinkID: order.ClientOrderID,
+		OrderID:     strconv.FormatInt(order.ID, 10),
+		OrderStatus: testOrderStatuses[order.Status],
+		Price:       order.Price.String(),
+		Qty:         order.Qty.String(),
+		CumExecQty:  order.FilledQty.String(),
+		Side:        order_mappers.ConvertOrderSideToBybit(order.Side),
+		CreatedTime: strconv.FormatInt(order.CreatedTime, 10),
+		UpdatedTime: strconv.FormatInt(order.UpdatedTime, 10),
+	}
+}
+
+func writeTestError(w http.ResponseWriter, retCode int, retMsg string) {
+	_ = json.NewEncoder(w).Encode(map[string]any{"retCode": retCode, "retMsg": retMsg})
+}
+
+func writeTestResult(w http.ResponseWriter, result any) {
+	_ = json.NewEncoder(w).Encode(map[string]any{"retCode": 0, "retMsg": "OK", "result": result})
+}
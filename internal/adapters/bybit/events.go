@@ -17,6 +17,7 @@ const tradeSubscriptionKey = "subscription"
 type TradeEventWorkerBybit struct {
 	workers.TradeEventWorker
 	wsClient *bybit.WebSocketClient
+	category bybit.CategoryV5
 }
 
 func (w *TradeEventWorkerBybit) SubscribeToTradeEventsPrivate(
@@ -43,6 +44,10 @@ func (w *TradeEventWorkerBybit) SubscribeToTradeEventsPrivate(
 
 	handler := func(e bybit.V5WebsocketPrivateExecutionResponse) error {
 		for _, eventRaw := range e.Data {
+			if eventRaw.Category != w.category {
+				continue // the stream contains executions of all the categories
+			}
+
 			event, err := mappers.ParseTradeEventPrivate(eventRaw, e.CreationTime, w.ExchangeTag)
 			if err != nil {
 				return fmt.Errorf("parse trade event: %w", err)
@@ -0,0 +1,217 @@
+package bybit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hirokisan/bybit/v2"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/accessors"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/errs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	linearSettleCoin      = "USDT"
+	positionsPageLimit    = 200
+	endpointSetMarginMode = "/v5/account/set-margin-mode"
+)
+
+// unified account margin modes
+const (
+	unifiedMarginModeRegular  = "REGULAR_MARGIN"
+	unifiedMarginModeIsolated = "ISOLATED_MARGIN"
+)
+
+var errNotLinearAdapter = errors.New("available for linear contracts only")
+
+func (a *adapter) GetPositions(pairSymbol string) ([]structs.Position, error) {
+	if !a.isLinear() {
+		return nil, errNotLinearAdapter
+	}
+
+	limit := positionsPageLimit
+	param := bybit.V5GetPositionInfoParam{
+		Category: a.category,
+		Limit:    &limit,
+	}
+	if pairSymbol == "" {
+		settleCoin := bybit.Coin(linearSettleCoin)
+		param.SettleCoin = &settleCoin
+	} else {
+		param.Symbol = accessors.GetPairSymbolPointerV5(pairSymbol)
+	}
+
+	var result []structs.Position
+	for {
+		response, err := a.client.V5().Position().GetPositionInfo(param)
+		if err != nil {
+			return nil, fmt.Errorf("get positions: %w", err)
+		}
+
+		positions, err := mappers.ConvertPositions(response.Result.List)
+		if err != nil {
+			return nil, fmt.Errorf("convert: %w", err)
+		}
+		result = append(result, positions...)
+
+		if response.Result.NextPageCursor == "" {
+			return result, nil
+		}
+		param.Cursor = &response.Result.NextPageCursor
+	}
+}
+
+func (a *adapter) SetLeverage(pairSymbol string, leverage int) error {
+	if !a.isLinear() {
+		return errNotLinearAdapter
+	}
+	if leverage <= 0 {
+		return fmt.Errorf("invalid leverage: %d", leverage)
+	}
+
+	leverageFormatted := strconv.Itoa(leverage)
+	_, err := a.client.V5().Position().SetLeverage(bybit.V5SetLeverageParam{
+		Category:     a.category,
+		Symbol:       bybit.SymbolV5(pairSymbol),
+		BuyLeverage:  leverageFormatted,
+		SellLeverage: leverageFormatted,
+	})
+	if err != nil && !errs.IsRetCode(err, errs.RetCodeLeverageNotModified) {
+		return fmt.Errorf("set leverage: %w", err)
+	}
+	return nil
+}
+
+// SetMarginMode - the unified account has one margin mode for all the pairs,
+// it's set by SetAccountMarginMode
+func (a *adapter) SetMarginMode(pairSymbol string, mode consts.MarginMode) error {
+	if !a.isLinear() {
+		return errNotLinearAdapter
+	}
+
+	if a.getAccountType() == bybit.AccountTypeV5UNIFIED {
+		return &pkgErrs.NotSupportedError{Feature: "pair margin mode of the unified account"}
+	}
+
+	tradeMode := bybit.PositionMarginCross
+	switch mode {
+	default:
+		return fmt.Errorf("unknown margin mode: %q", mode)
+	case consts.MarginModeCross:
+	case consts.MarginModeIsolated:
+		tradeMode = bybit.PositionMarginIsolated
+	}
+
+	// bybit requires the leverage to be passed on margin mode switch
+	positions, err := a.client.V5().Position().GetPositionInfo(bybit.V5GetPositionInfoParam{
+		Category: a.category,
+		Symbol:   accessors.GetPairSymbolPointerV5(pairSymbol),
+	})
+	if err != nil {
+		return fmt.Errorf("get position: %w", err)
+	}
+	if len(positions.Result.List) == 0 {
+		return fmt.Errorf("position data not found for %q", pairSymbol)
+	}
+	leverage := positions.Result.List[0].Leverage
+
+	_, err = a.client.V5().Position().SwitchPositionMarginMode(
+		bybit.V5SwitchPositionMarginModeParam{
+			Category:     a.category,
+			Symbol:       bybit.SymbolV5(pairSymbol),
+			TradeMode:    tradeMode,
+			BuyLeverage:  leverage,
+			SellLeverage: leverage,
+		},
+	)
+	if err != nil && !errs.IsRetCode(err, errs.RetCodeMarginModeNotModified) {
+		return fmt.Errorf("switch margin mode: %w", err)
+	}
+	return nil
+}
+
+// SetAccountMarginMode - set the unified account margin mode,
+// it's applied to all the pairs & the spot margin trading
+func (a *adapter) SetAccountMarginMode(mode consts.MarginMode) error {
+	if a.getAccountType() != bybit.AccountTypeV5UNIFIED {
+		return &pkgErrs.NotSupportedError{Feature: "margin mode of the classic account"}
+	}
+
+	var marginMode string
+	switch mode {
+	default:
+		return fmt.Errorf("unknown margin mode: %q", mode)
+	case consts.MarginModeCross:
+		marginMode = unifiedMarginModeRegular
+	case consts.MarginModeIsolated:
+		marginMode = unifiedMarginModeIsolated
+	}
+
+	var response bybit.CommonV5Response
+	if err := a.postV5(endpointSetMarginMode, map[string]string{
+		"setMarginMode": marginMode,
+	}, &response); err != nil {
+		return fmt.Errorf("set account margin mode: %w", err)
+	}
+
+	if response.RetCode != 0 {
+		return fmt.Errorf("set account margin mode: %w", &bybit.ErrorResponse{
+			RetCode: response.RetCode,
+			RetMsg:  response.RetMsg,
+		})
+	}
+	return nil
+}
+
+func (a *adapter) GetFundingInfo(pairSymbol string) (structs.FundingInfo, error) {
+	if !a.isLinear() {
+		return structs.FundingInfo{}, errNotLinearAdapter
+	}
+
+	response, err := a.client.V5().Market().GetTickers(bybit.V5GetTickersParam{
+		Category: a.category,
+		Symbol:   accessors.GetPairSymbolPointerV5(pairSymbol),
+	})
+	if err != nil {
+		return structs.FundingInfo{}, fmt.Errorf("get ticker: %w", err)
+	}
+
+	if response.Result.LinearInverse == nil || len(response.Result.LinearInverse.List) == 0 {
+		return structs.FundingInfo{}, fmt.Errorf("ticker %q not found", pairSymbol)
+	}
+	return mappers.ConvertFundingInfo(response.Result.LinearInverse.List[0])
+}
+
+func (a *adapter) PlaceFuturesOrder(
+	_ context.Context,
+	order structs.BotOrderAdjusted,
+	params structs.FuturesOrderParams,
+) (structs.CreateOrderResponse, error) {
+	if !a.isLinear() {
+		return structs.CreateOrderResponse{}, errNotLinearAdapter
+	}
+	return a.placeOrder(order, params.ReduceOnly)
+}
+
+func (a *adapter) SubscribePositions(
+	eventCallback workers.PositionEventCallback,
+	errorHandler func(err error),
+) error {
+	if !a.isLinear() {
+		return errNotLinearAdapter
+	}
+	return a.positionWorker.SubscribeToPositions(eventCallback, errorHandler)
+}
+
+func (a *adapter) UnsubscribePositions() {
+	if a.positionWorker != nil {
+		a.positionWorker.UnsubscribeAll()
+	}
+}
@@ -8,11 +8,13 @@ import (
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/mappers"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
 )
 
 type CandleEventWorkerBybit struct {
 	workers.CandleWorker
 	WsClient *bybit.WebSocketClient
+	Category bybit.CategoryV5
 }
 
 func (w *CandleEventWorkerBybit) SubscribeToCandle(
@@ -31,7 +33,7 @@ func (w *CandleEventWorkerBybit) SubscribeToCandle(
 
 	bybitInterval, isExists := mappers.CandleIntervalsToBybit[interval]
 	if !isExists {
-		return fmt.Errorf("interval %q not available", interval)
+		return &errs.NotSupportedError{Feature: fmt.Sprintf("interval %q", interval)}
 	}
 
 	if w.CandleWorker.IsSubscriptionExists(pairSymbol, bybitInterval.Code) {
@@ -54,7 +56,7 @@ func (w *CandleEventWorkerBybit) SubscribeToCandle(
 		pairSymbol, bybitInterval.Code,
 	)
 
-	wsSrv, err := w.WsClient.V5().Public(bybit.CategoryV5Spot)
+	wsSrv, err := w.WsClient.V5().Public(w.Category)
 	if err != nil {
 		return fmt.Errorf("create candle events subscription service: %w", err)
 	}
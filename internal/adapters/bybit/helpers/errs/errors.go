@@ -10,4 +10,12 @@ const (
 	ErrMsgOrderNotFound               = "Order does not exist"
 	ErrMsgOrderCancellationInProgress = "Order cancellation in progress"
 	ErrMsgOrderDuplicate              = "Duplicate clientOrderId"
+	// lowercased, bybit uses different letter case in the messages
+	errMsgInsufficientBalance = "insufficient balance"
+)
+
+// bybit V5 return codes
+const (
+	RetCodeMarginModeNotModified = 110026
+	RetCodeLeverageNotModified   = 110043
 )
@@ -1,9 +1,12 @@
 package errs
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/hirokisan/bybit/v2"
+
 	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
 )
 
@@ -28,3 +31,23 @@ func MapCancelOrderError(orderIDFormatted string, pairSymbol string, err error)
 		)
 	}
 }
+
+func MapTransferError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if strings.Contains(strings.ToLower(err.Error()), errMsgInsufficientBalance) {
+		return fmt.Errorf("%w: %s", errs.ErrInsufficientBalance, err.Error())
+	}
+	return err
+}
+
+// IsRetCode - check bybit API error return code
+func IsRetCode(err error, retCode int) bool {
+	var responseErr *bybit.ErrorResponse
+	if !errors.As(err, &responseErr) {
+		return false
+	}
+	return responseErr.RetCode == retCode
+}
@@ -2,9 +2,12 @@ package errs
 
 import (
 	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"testing"
 
+	"github.com/hirokisan/bybit/v2"
+
 	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
 )
 
@@ -60,3 +63,47 @@ func TestHandleCancelOrderError(t *testing.T) {
 	// then
 	assert.Error(t, err)
 }
+
+func TestMapTransferErrorInsufficientBalance(t *testing.T) {
+	// given
+	var testErr = errors.New("131212: Insufficient balance")
+
+	// when
+	err := MapTransferError(testErr)
+
+	// then
+	assert.ErrorIs(t, err, pkgErrs.ErrInsufficientBalance)
+}
+
+func TestMapTransferErrorUnknown(t *testing.T) {
+	// given
+	var testErr = errors.New("some error")
+
+	// when
+	err := MapTransferError(testErr)
+
+	// then
+	assert.Equal(t, testErr, err)
+}
+
+func TestIsRetCode(t *testing.T) {
+	// given
+	testErr := fmt.Errorf("set leverage: %w", &bybit.ErrorResponse{
+		RetCode: RetCodeLeverageNotModified,
+		RetMsg:  "leverage not modified",
+	})
+
+	// when
+	isNotModified := IsRetCode(testErr, RetCodeLeverageNotModified)
+
+	// then
+	assert.True(t, isNotModified)
+}
+
+func TestIsRetCodeOtherError(t *testing.T) {
+	// when
+	isNotModified := IsRetCode(errors.New("timeout"), RetCodeLeverageNotModified)
+
+	// then
+	assert.False(t, isNotModified)
+}
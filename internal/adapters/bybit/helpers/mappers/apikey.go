@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"slices"
+
+	"github.com/hirokisan/bybit/v2"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	permissionSpotTrade        = "SpotTrade"
+	permissionContractOrder    = "Order"
+	permissionDerivativesTrade = "DerivativesTrade"
+	permissionWithdraw         = "Withdraw"
+
+	// ipsAny - the key is not bound to IP addresses
+	ipsAny = "*"
+)
+
+// ConvertAPIKeyInfo - spot margin trading isn't a separate key permission
+func ConvertAPIKeyInfo(data bybit.V5ApiKeyResult) pkgStructs.APIKeyInfo {
+	info := pkgStructs.APIKeyInfo{
+		Unknown:    []consts.APIKeyPermission{consts.APIKeyPermissionMarginTrade},
+		IsReadOnly: data.ReadOnly == 1,
+	}
+
+	if slices.Contains(data.Permissions.Spot, permissionSpotTrade) {
+		info.Permissions = append(info.Permissions, consts.APIKeyPermissionSpotTrade)
+	}
+	if slices.Contains(data.Permissions.ContractTrade, permissionContractOrder) ||
+		slices.Contains(data.Permissions.Derivatives, permissionDerivativesTrade) {
+		info.Permissions = append(info.Permissions, consts.APIKeyPermissionFuturesTrade)
+	}
+	if slices.Contains(data.Permissions.Wallet, permissionWithdraw) {
+		info.Permissions = append(info.Permissions, consts.APIKeyPermissionWithdraw)
+	}
+
+	if len(data.Ips) > 0 && !slices.Contains(data.Ips, ipsAny) {
+		info.IsIPRestricted = true
+		info.IPWhitelist = data.Ips
+	}
+	if !data.ExpiredAt.IsZero() {
+		info.ExpiresAt = data.ExpiredAt.UnixMilli()
+	}
+	return info
+}
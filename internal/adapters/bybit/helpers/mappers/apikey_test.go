@@ -0,0 +1,52 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertAPIKeyInfo(t *testing.T) {
+	// given
+	data := bybit.V5ApiKeyResult{
+		Ips:       []string{"1.2.3.4"},
+		ExpiredAt: time.UnixMilli(1700000000000),
+	}
+	data.Permissions.Spot = []string{permissionSpotTrade}
+	data.Permissions.Wallet = []string{"AccountTransfer", permissionWithdraw}
+
+	// when
+	info := ConvertAPIKeyInfo(data)
+
+	// then
+	assert.Equal(t, []consts.APIKeyPermission{
+		consts.APIKeyPermissionSpotTrade,
+		consts.APIKeyPermissionWithdraw,
+	}, info.Permissions)
+	assert.False(t, info.IsPermissionKnown(consts.APIKeyPermissionMarginTrade))
+	assert.True(t, info.IsIPRestricted)
+	assert.Equal(t, []string{"1.2.3.4"}, info.IPWhitelist)
+	assert.Equal(t, int64(1700000000000), info.ExpiresAt)
+	assert.False(t, info.IsReadOnly)
+}
+
+func TestConvertAPIKeyInfoReadOnly(t *testing.T) {
+	// given
+	data := bybit.V5ApiKeyResult{
+		ReadOnly: 1,
+		Ips:      []string{ipsAny},
+	}
+
+	// when
+	info := ConvertAPIKeyInfo(data)
+
+	// then
+	assert.Empty(t, info.Permissions)
+	assert.True(t, info.IsReadOnly)
+	assert.False(t, info.IsIPRestricted)
+	assert.Zero(t, info.ExpiresAt)
+}
@@ -2,10 +2,10 @@ package mappers
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/hirokisan/bybit/v2"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/shopspring/decimal"
 )
 
 func ConvertBalances(
@@ -30,26 +30,26 @@ func convertCoinData(
 	coinData bybit.V5WalletBalanceCoin,
 	tickerTag string,
 ) (structs.AssetBalance, error) {
-	var tickerFree float64
-	var tickerLocked float64
+	var tickerFree decimal.Decimal
+	var tickerLocked decimal.Decimal
 	var err error
 
 	if coinData.Locked != "" {
-		tickerLocked, err = strconv.ParseFloat(coinData.Locked, 64)
+		tickerLocked, err = decimal.NewFromString(coinData.Locked)
 		if err != nil {
 			return structs.AssetBalance{}, fmt.Errorf("parse locked balance: %w", err)
 		}
 	}
 
 	if coinData.Free != "" {
-		tickerFree, err = strconv.ParseFloat(coinData.Free, 64)
+		tickerFree, err = decimal.NewFromString(coinData.Free)
 	} else if coinData.Equity != "" {
-		equity, err := strconv.ParseFloat(coinData.Equity, 64)
+		equity, err := decimal.NewFromString(coinData.Equity)
 		if err != nil {
 			return structs.AssetBalance{}, fmt.Errorf("parse equity: %w", err)
 		}
 
-		tickerFree = equity - tickerLocked
+		tickerFree = equity.Sub(tickerLocked)
 	}
 	if err != nil {
 		return structs.AssetBalance{}, fmt.Errorf("parse free balance: %w", err)
@@ -22,8 +22,8 @@ func TestConvertCoinData(t *testing.T) {
 
 	// then
 	require.NoError(t, err)
-	assert.Equal(t, float64(10), balanceData.Free)
-	assert.Equal(t, float64(0), balanceData.Locked)
+	assert.Equal(t, "10", balanceData.Free.String())
+	assert.Equal(t, "0", balanceData.Locked.String())
 }
 
 func TestFindAndConvertAssetBalance(t *testing.T) {
@@ -42,8 +42,8 @@ func TestFindAndConvertAssetBalance(t *testing.T) {
 
 	// then
 	require.NoError(t, err)
-	assert.Equal(t, float64(10), balanceData.Free)
-	assert.Equal(t, float64(0), balanceData.Locked)
+	assert.Equal(t, "10", balanceData.Free.String())
+	assert.Equal(t, "0", balanceData.Locked.String())
 }
 
 func TestFindAndConvertAssetBalanceNotFound(t *testing.T) {
@@ -77,8 +77,8 @@ func TestConvertCoinDataParseError(t *testing.T) {
 
 	// then
 	require.NoError(t, err)
-	assert.Equal(t, float64(0), assetBalance.Free)
-	assert.Equal(t, float64(0), assetBalance.Locked)
+	assert.Equal(t, "0", assetBalance.Free.String())
+	assert.Equal(t, "0", assetBalance.Locked.String())
 }
 
 func TestConvertCoinDataParseError2(t *testing.T) {
@@ -94,6 +94,6 @@ func TestConvertCoinDataParseError2(t *testing.T) {
 
 	// then
 	require.NoError(t, err)
-	assert.Equal(t, float64(10), assetBalance.Free)
-	assert.Equal(t, float64(0), assetBalance.Locked)
+	assert.Equal(t, "10", assetBalance.Free.String())
+	assert.Equal(t, "0", assetBalance.Locked.String())
 }
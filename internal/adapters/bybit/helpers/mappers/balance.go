@@ -8,6 +8,7 @@ import (
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/accessors"
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/errs"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/shopspring/decimal"
 )
 
 func ConvertAccountBalance(
@@ -38,3 +39,51 @@ func ConvertAccountBalance(
 
 	return result, nil
 }
+
+// GetTradingAccountType - classic accounts trade spot in SPOT account,
+// the unified accounts trade in UNIFIED account
+func GetTradingAccountType(status bybit.UnifiedMarginStatus) bybit.AccountTypeV5 {
+	switch status {
+	case bybit.UnifiedMarginStatusRegular, bybit.UnifiedMarginStatusUnifiedMargin:
+		return bybit.AccountTypeV5SPOT
+	default:
+		return bybit.AccountTypeV5UNIFIED
+	}
+}
+
+func ConvertFundBalances(data bybit.V5GetAllCoinsBalanceResult) ([]structs.Balance, error) {
+	var result []structs.Balance
+	for _, coinData := range data.Balance {
+		if coinData == nil {
+			continue
+		}
+
+		total, err := parseOptionalDecimal(coinData.WalletBalance)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s wallet balance: %w", coinData.Coin, err)
+		}
+
+		free, err := parseOptionalDecimal(coinData.TransferBalance)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s transfer balance: %w", coinData.Coin, err)
+		}
+
+		if total.IsZero() {
+			continue
+		}
+
+		result = append(result, structs.Balance{
+			Asset:  string(coinData.Coin),
+			Free:   free,
+			Locked: total.Sub(free),
+		})
+	}
+	return result, nil
+}
+
+func parseOptionalDecimal(value string) (decimal.Decimal, error) {
+	if value == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(value)
+}
@@ -42,5 +42,64 @@ func TestConvertAccountBalance(t *testing.T) {
 	// then
 	require.NoError(t, err)
 	require.Len(t, balances, 1)
-	assert.Equal(t, float64(0.01), balances[0].Free)
+	assert.Equal(t, "0.01", balances[0].Free.String())
+}
+
+func TestGetTradingAccountType(t *testing.T) {
+	assert.Equal(t, bybit.AccountTypeV5SPOT,
+		GetTradingAccountType(bybit.UnifiedMarginStatusRegular))
+	assert.Equal(t, bybit.AccountTypeV5SPOT,
+		GetTradingAccountType(bybit.UnifiedMarginStatusUnifiedMargin))
+	assert.Equal(t, bybit.AccountTypeV5UNIFIED,
+		GetTradingAccountType(bybit.UnifiedMarginStatusUnifiedTrade))
+	assert.Equal(t, bybit.AccountTypeV5UNIFIED,
+		GetTradingAccountType(bybit.UnifiedMarginStatusUTAv2))
+}
+
+func TestConvertFundBalances(t *testing.T) {
+	// given
+	data := bybit.V5GetAllCoinsBalanceResult{
+		AccountType: bybit.AccountTypeV5FUND,
+		Balance: []*bybit.V5GetAllCoinsBalanceBalance{
+			{
+				Coin:            "USDT",
+				WalletBalance:   "150",
+				TransferBalance: "100",
+			},
+			{
+				Coin:            "BTC",
+				WalletBalance:   "0",
+				TransferBalance: "0",
+			},
+			nil,
+		},
+	}
+
+	// when
+	balances, err := ConvertFundBalances(data)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	assert.Equal(t, "USDT", balances[0].Asset)
+	assert.Equal(t, "100", balances[0].Free.String())
+	assert.Equal(t, "50", balances[0].Locked.String())
+}
+
+func TestConvertFundBalancesInvalid(t *testing.T) {
+	// given
+	data := bybit.V5GetAllCoinsBalanceResult{
+		Balance: []*bybit.V5GetAllCoinsBalanceBalance{
+			{
+				Coin:          "USDT",
+				WalletBalance: "wtf",
+			},
+		},
+	}
+
+	// when
+	_, err := ConvertFundBalances(data)
+
+	// then
+	require.Error(t, err)
 }
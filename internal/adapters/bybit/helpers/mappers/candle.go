@@ -11,18 +11,23 @@ import (
 	"github.com/hirokisan/bybit/v2"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/shopspring/decimal"
 )
 
 var CandleIntervalsToBybit = map[consts.Interval]IntervalData{
 	consts.Interval1min:   {"1", time.Minute},
+	consts.Interval3min:   {"3", time.Minute * 3},
 	consts.Interval5min:   {"5", time.Minute * 5},
 	consts.Interval15min:  {"15", time.Minute * 15},
 	consts.Interval30min:  {"30", time.Minute * 30},
 	consts.Interval1hour:  {"60", time.Hour},
-	consts.Interval4hour:  {"240", time.Hour * 240},
+	consts.Interval2hour:  {"120", time.Hour * 2},
+	consts.Interval4hour:  {"240", time.Hour * 4},
 	consts.Interval6hour:  {"360", time.Hour * 6},
 	consts.Interval12hour: {"720", time.Hour * 12},
 	consts.Interval1day:   {"D", time.Hour * 24},
+	consts.Interval1week:  {"W", time.Hour * 24 * 7},
+	consts.Interval1month: {"M", time.Hour * 24 * 31}, // the longest month
 }
 
 type IntervalData struct {
@@ -31,11 +36,11 @@ type IntervalData struct {
 }
 
 type CandleData struct {
-	Open   float64
-	Close  float64
-	High   float64
-	Low    float64
-	Volume float64
+	Open   decimal.Decimal
+	Close  decimal.Decimal
+	High   decimal.Decimal
+	Low    decimal.Decimal
+	Volume decimal.Decimal
 }
 
 type CandleTimeData struct {
@@ -47,7 +52,7 @@ func ParseCandle(open, close, high, low, volume string) (CandleData, error) {
 	if open == "" {
 		return CandleData{}, errors.New("'open' price is empty")
 	}
-	openPrice, err := strconv.ParseFloat(open, 64)
+	openPrice, err := decimal.NewFromString(open)
 	if err != nil {
 		return CandleData{}, fmt.Errorf("parse 'open' price: %w", err)
 	}
@@ -55,7 +60,7 @@ func ParseCandle(open, close, high, low, volume string) (CandleData, error) {
 	if close == "" {
 		return CandleData{}, errors.New("'close' price is empty")
 	}
-	closePrice, err := strconv.ParseFloat(close, 64)
+	closePrice, err := decimal.NewFromString(close)
 	if err != nil {
 		return CandleData{}, fmt.Errorf("parse 'close' price: %w", err)
 	}
@@ -63,7 +68,7 @@ func ParseCandle(open, close, high, low, volume string) (CandleData, error) {
 	if high == "" {
 		return CandleData{}, errors.New("'high' price is empty")
 	}
-	highPrice, err := strconv.ParseFloat(high, 64)
+	highPrice, err := decimal.NewFromString(high)
 	if err != nil {
 		return CandleData{}, fmt.Errorf("parse 'high' price: %w", err)
 	}
@@ -71,7 +76,7 @@ func ParseCandle(open, close, high, low, volume string) (CandleData, error) {
 	if low == "" {
 		return CandleData{}, errors.New("'low' price is empty")
 	}
-	lowPrice, err := strconv.ParseFloat(low, 64)
+	lowPrice, err := decimal.NewFromString(low)
 	if err != nil {
 		return CandleData{}, fmt.Errorf("parse 'low' price: %w", err)
 	}
@@ -79,7 +84,7 @@ func ParseCandle(open, close, high, low, volume string) (CandleData, error) {
 	if volume == "" {
 		return CandleData{}, errors.New("'volume' is empty")
 	}
-	volumeParsed, err := strconv.ParseFloat(volume, 64)
+	volumeParsed, err := decimal.NewFromString(volume)
 	if err != nil {
 		return CandleData{}, fmt.Errorf("parse 'volume': %w", err)
 	}
@@ -147,6 +152,11 @@ func ConvertHistoricalCandle(
 	if err != nil {
 		return workers.CandleData{}, fmt.Errorf("get candle time: %w", err)
 	}
+	if intervalCode == consts.Interval1month {
+		// months have different duration
+		timeData.EndTimeMs = time.UnixMilli(timeData.StartTimeMs).UTC().
+			AddDate(0, 1, 0).UnixMilli()
+	}
 
 	return workers.CandleData{
 		StartTime: timeData.StartTimeMs,
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hirokisan/bybit/v2"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -53,10 +54,45 @@ func TestConvertHistoricalCandle(t *testing.T) {
 
 	// then
 	require.NoError(t, err)
-	assert.Equal(t, float64(0.35), candleData.Open)
-	assert.Equal(t, float64(0.45), candleData.Close)
-	assert.Equal(t, float64(0.25), candleData.Low)
-	assert.Equal(t, float64(0.41), candleData.High)
+	assert.True(t, candleData.Open.Equal(decimal.NewFromFloat(0.35)))
+	assert.True(t, candleData.Close.Equal(decimal.NewFromFloat(0.45)))
+	assert.True(t, candleData.Low.Equal(decimal.NewFromFloat(0.25)))
+	assert.True(t, candleData.High.Equal(decimal.NewFromFloat(0.41)))
+}
+
+func TestConvertHistoricalCandleMonth(t *testing.T) {
+	// given
+	startTime := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	eventData := bybit.V5GetKlineItem{
+		StartTime: strconv.FormatInt(startTime.UnixMilli(), 10),
+		Open:      "0.35",
+		Close:     "0.45",
+		Low:       "0.25",
+		High:      "0.41",
+		Volume:    "125061",
+	}
+	interval := CandleIntervalsToBybit[consts.Interval1month]
+
+	// when
+	candleData, err := ConvertHistoricalCandle(
+		"BTCUSDT",
+		eventData,
+		interval.Duration,
+		consts.Interval1month,
+	)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, startTime.UnixMilli(), candleData.StartTime)
+	assert.Equal(
+		t,
+		time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC).UnixMilli(),
+		candleData.EndTime,
+	)
+}
+
+func TestCandleIntervalsDuration(t *testing.T) {
+	assert.Equal(t, time.Hour*4, CandleIntervalsToBybit[consts.Interval4hour].Duration)
 }
 
 func TestConvertWsCandle(t *testing.T) {
@@ -79,9 +115,9 @@ func TestConvertWsCandle(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, event.IsFinished)
 	assert.Equal(t, pairSymbol, event.Symbol)
-	assert.Equal(t, float64(0.35), event.Candle.Open)
-	assert.Equal(t, float64(0.45), event.Candle.Close)
-	assert.Equal(t, float64(0.25), event.Candle.Low)
-	assert.Equal(t, float64(0.41), event.Candle.High)
-	assert.Equal(t, float64(125061), event.Candle.Volume)
+	assert.True(t, event.Candle.Open.Equal(decimal.NewFromFloat(0.35)))
+	assert.True(t, event.Candle.Close.Equal(decimal.NewFromFloat(0.45)))
+	assert.True(t, event.Candle.Low.Equal(decimal.NewFromFloat(0.25)))
+	assert.True(t, event.Candle.High.Equal(decimal.NewFromFloat(0.41)))
+	assert.True(t, event.Candle.Volume.Equal(decimal.NewFromFloat(125061)))
 }
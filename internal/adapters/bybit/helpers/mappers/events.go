@@ -2,9 +2,11 @@ package mappers
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/hirokisan/bybit/v2"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
 )
 
@@ -26,13 +28,13 @@ func ParseTradeEventPrivate(
 	}
 
 	var err error
-	wEvent.Price, err = strconv.ParseFloat(event.ExecPrice, 64)
+	wEvent.Price, err = decimal.NewFromString(event.ExecPrice)
 	if err != nil {
 		return workers.TradeEventPrivate{},
 			fmt.Errorf("parse price: %w", err)
 	}
 
-	wEvent.Quantity, err = strconv.ParseFloat(event.ExecQty, 64)
+	wEvent.Quantity, err = decimal.NewFromString(event.ExecQty)
 	if err != nil {
 		return workers.TradeEventPrivate{},
 			fmt.Errorf("parse quantity: %w", err)
@@ -40,3 +42,38 @@ func ParseTradeEventPrivate(
 
 	return wEvent, nil
 }
+
+func ParsePublicTradeEvent(
+	event bybit.V5WebsocketPublicTradeData,
+	exchangeTag string,
+) (workers.PublicTradeEvent, error) {
+	price, err := decimal.NewFromString(event.Trade)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := decimal.NewFromString(event.Value)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse quantity: %w", err)
+	}
+
+	var takerSide consts.OrderSide
+	switch event.Side {
+	case bybit.SideBuy:
+		takerSide = consts.OrderSideBuy
+	case bybit.SideSell:
+		takerSide = consts.OrderSideSell
+	default:
+		return workers.PublicTradeEvent{}, fmt.Errorf("unknown taker side: %q", event.Side)
+	}
+
+	return workers.PublicTradeEvent{
+		ID:          event.ID,
+		Time:        int64(event.Timestamp),
+		ExchangeTag: exchangeTag,
+		Symbol:      string(event.Symbol),
+		Price:       price,
+		Quantity:    qty,
+		TakerSide:   takerSide,
+	}, nil
+}
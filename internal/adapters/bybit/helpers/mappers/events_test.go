@@ -0,0 +1,49 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePublicTradeEvent(t *testing.T) {
+	// given
+	event := bybit.V5WebsocketPublicTradeData{
+		Timestamp: 1672304486865,
+		Symbol:    "BTCUSDT",
+		Side:      bybit.SideBuy,
+		Value:     "0.001",
+		Trade:     "16578.50",
+		ID:        "20f43950-d8dd-5b31-9112-a178eb6023af",
+	}
+
+	// when
+	result, err := ParsePublicTradeEvent(event, "bybit-spot")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "20f43950-d8dd-5b31-9112-a178eb6023af", result.ID)
+	assert.Equal(t, int64(1672304486865), result.Time)
+	assert.Equal(t, "bybit-spot", result.ExchangeTag)
+	assert.Equal(t, "BTCUSDT", result.Symbol)
+	assert.Equal(t, "16578.5", result.Price.String())
+	assert.Equal(t, "0.001", result.Quantity.String())
+	assert.Equal(t, consts.OrderSideBuy, result.TakerSide)
+}
+
+func TestParsePublicTradeEventUnknownSide(t *testing.T) {
+	// given
+	event := bybit.V5WebsocketPublicTradeData{
+		Value: "0.001",
+		Trade: "16578.50",
+	}
+
+	// when
+	_, err := ParsePublicTradeEvent(event, "bybit-spot")
+
+	// then
+	require.ErrorContains(t, err, "unknown taker side")
+}
@@ -0,0 +1,257 @@
+package mappers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/conditions"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
+)
+
+// positionFields - position data fields common for REST & websocket
+type positionFields struct {
+	Symbol        bybit.SymbolV5
+	Side          bybit.Side
+	Size          string
+	EntryPrice    string
+	MarkPrice     string
+	LiqPrice      string
+	UnrealisedPnl string
+	Leverage      string
+	TradeMode     int
+	UpdatedTime   string
+}
+
+func ConvertLinearPairsData(
+	pairs *bybit.V5GetInstrumentsInfoLinearInverseResult,
+	exchangeID int,
+) ([]structs.ExchangePairData, error) {
+	var result []structs.ExchangePairData
+	for _, rawPairData := range pairs.List {
+		if rawPairData.ContractType != bybit.ContractTypeLinearPerpetual {
+			continue
+		}
+
+		pairData, err := convertLinearPairData(rawPairData, exchangeID)
+		if err != nil {
+			return nil, fmt.Errorf("convert %q: %w", rawPairData.Symbol, err)
+		}
+		result = append(result, pairData)
+	}
+	return result, nil
+}
+
+func convertLinearPairData(
+	rawPairData bybit.V5GetInstrumentsInfoLinearInverseItem,
+	exchangeID int,
+) (structs.ExchangePairData, error) {
+	pairData := structs.ExchangePairData{
+		ExchangeID:    exchangeID,
+		BaseAsset:     string(rawPairData.BaseCoin),
+		QuoteAsset:    string(rawPairData.QuoteCoin),
+		Symbol:        string(rawPairData.Symbol),
+		Status:        consts.PairDefaultStatus,
+		AllowedMargin: true,
+		AllowedSpot:   conditions.IsSpotTradingAvailable(rawPairData.Status),
+		InUse:         true,
+	}
+
+	var err error
+	pairData.QtyStep, err = decimal.NewFromString(rawPairData.LotSizeFilter.QtyStep)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse qty step: %w", err)
+	}
+	pairData.BasePrecision = utils.GetDecimalPrecision(pairData.QtyStep)
+
+	pairData.MinQty, err = decimal.NewFromString(rawPairData.LotSizeFilter.MinOrderQty)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse min qty: %w", err)
+	}
+
+	pairData.MaxQty, err = decimal.NewFromString(rawPairData.LotSizeFilter.MaxOrderQty)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse max qty: %w", err)
+	}
+
+	pairData.OriginalMinDeposit, err = parseOptionalDecimal(
+		rawPairData.LotSizeFilter.MinNotionalValue,
+	)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse min notional: %w", err)
+	}
+	pairData.MinDeposit = pairData.OriginalMinDeposit
+
+	pairData.PriceStep, err = decimal.NewFromString(rawPairData.PriceFilter.TickSize)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse price step: %w", err)
+	}
+	if pairData.PriceStep.IsZero() {
+		return structs.ExchangePairData{}, fmt.Errorf("price step is empty")
+	}
+	pairData.QuotePrecision = utils.GetDecimalPrecision(pairData.PriceStep)
+
+	pairData.MinPrice, err = decimal.NewFromString(rawPairData.PriceFilter.MinPrice)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse min price: %w", err)
+	}
+	return pairData, nil
+}
+
+// ConvertPositions - convert open positions, empty positions are skipped
+func ConvertPositions(list bybit.V5GetPositionInfoList) ([]structs.Position, error) {
+	var result []structs.Position
+	for _, item := range list {
+		position, err := convertPosition(positionFields{
+			Symbol:        item.Symbol,
+			Side:          item.Side,
+			Size:          item.Size,
+			EntryPrice:    item.AvgPrice,
+			MarkPrice:     item.MarkPrice,
+			LiqPrice:      item.LiqPrice,
+			UnrealisedPnl: item.UnrealisedPnl,
+			Leverage:      item.Leverage,
+			TradeMode:     item.TradeMode,
+			UpdatedTime:   item.UpdatedTime,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("convert %q position: %w", item.Symbol, err)
+		}
+
+		if position.Size.IsZero() {
+			continue
+		}
+		result = append(result, position)
+	}
+	return result, nil
+}
+
+// ConvertPositionEvent - closed position has zero size
+func ConvertPositionEvent(
+	data bybit.V5WebsocketPrivatePositionData,
+	eventTime int64,
+	exchangeTag string,
+) (workers.PositionEvent, error) {
+	position, err := convertPosition(positionFields{
+		Symbol:        data.Symbol,
+		Side:          data.Side,
+		Size:          data.Size,
+		EntryPrice:    data.EntryPrice,
+		MarkPrice:     data.MarkPrice,
+		LiqPrice:      data.LiqPrice,
+		UnrealisedPnl: data.UnrealisedPnl,
+		Leverage:      data.Leverage,
+		TradeMode:     data.TradeMode,
+		UpdatedTime:   data.UpdatedTime,
+	})
+	if err != nil {
+		return workers.PositionEvent{}, fmt.Errorf("convert %q position: %w", data.Symbol, err)
+	}
+
+	return workers.PositionEvent{
+		ExchangeTag: exchangeTag,
+		Time:        eventTime,
+		Position:    position,
+	}, nil
+}
+
+func convertPosition(data positionFields) (structs.Position, error) {
+	size, err := parseOptionalDecimal(data.Size)
+	if err != nil {
+		return structs.Position{}, fmt.Errorf("parse size: %w", err)
+	}
+
+	entryPrice, err := parseOptionalDecimal(data.EntryPrice)
+	if err != nil {
+		return structs.Position{}, fmt.Errorf("parse entry price: %w", err)
+	}
+
+	markPrice, err := parseOptionalDecimal(data.MarkPrice)
+	if err != nil {
+		return structs.Position{}, fmt.Errorf("parse mark price: %w", err)
+	}
+
+	liquidationPrice, err := parseOptionalDecimal(data.LiqPrice)
+	if err != nil {
+		return structs.Position{}, fmt.Errorf("parse liquidation price: %w", err)
+	}
+
+	unrealizedPnL, err := parseOptionalDecimal(data.UnrealisedPnl)
+	if err != nil {
+		return structs.Position{}, fmt.Errorf("parse unrealized PnL: %w", err)
+	}
+
+	leverage, err := parseOptionalDecimal(data.Leverage)
+	if err != nil {
+		return structs.Position{}, fmt.Errorf("parse leverage: %w", err)
+	}
+
+	var updatedTime int64
+	if data.UpdatedTime != "" {
+		updatedTime, err = strconv.ParseInt(data.UpdatedTime, 10, 64)
+		if err != nil {
+			return structs.Position{}, fmt.Errorf("parse updated time: %w", err)
+		}
+	}
+
+	side := consts.PositionSideLong
+	if data.Side == bybit.SideSell {
+		side = consts.PositionSideShort
+	}
+
+	marginMode := consts.MarginModeCross
+	if bybit.PositionMarginMode(data.TradeMode) == bybit.PositionMarginIsolated {
+		marginMode = consts.MarginModeIsolated
+	}
+
+	return structs.Position{
+		Symbol:           string(data.Symbol),
+		Side:             side,
+		Size:             size.Abs(),
+		EntryPrice:       entryPrice,
+		MarkPrice:        markPrice,
+		LiquidationPrice: liquidationPrice,
+		UnrealizedPnL:    unrealizedPnL,
+		Leverage:         int(leverage.IntPart()),
+		MarginMode:       marginMode,
+		UpdatedTime:      updatedTime,
+	}, nil
+}
+
+func ConvertFundingInfo(data bybit.V5GetTickersLinearInverseItem) (structs.FundingInfo, error) {
+	markPrice, err := decimal.NewFromString(data.MarkPrice)
+	if err != nil {
+		return structs.FundingInfo{}, fmt.Errorf("parse mark price: %w", err)
+	}
+
+	indexPrice, err := parseOptionalDecimal(data.IndexPrice)
+	if err != nil {
+		return structs.FundingInfo{}, fmt.Errorf("parse index price: %w", err)
+	}
+
+	fundingRate, err := parseOptionalDecimal(data.FundingRate)
+	if err != nil {
+		return structs.FundingInfo{}, fmt.Errorf("parse funding rate: %w", err)
+	}
+
+	var nextFundingTime int64
+	if data.NextFundingTime != "" {
+		nextFundingTime, err = strconv.ParseInt(data.NextFundingTime, 10, 64)
+		if err != nil {
+			return structs.FundingInfo{}, fmt.Errorf("parse next funding time: %w", err)
+		}
+	}
+
+	return structs.FundingInfo{
+		Symbol:          string(data.Symbol),
+		MarkPrice:       markPrice,
+		IndexPrice:      indexPrice,
+		FundingRate:     fundingRate,
+		NextFundingTime: nextFundingTime,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertPositions(t *testing.T) {
+	// given
+	list := bybit.V5GetPositionInfoList{
+		{
+			Symbol:        "BTCUSDT",
+			Side:          bybit.SideSell,
+			Size:          "0.015",
+			AvgPrice:      "64000.5",
+			MarkPrice:     "63950",
+			LiqPrice:      "70000",
+			UnrealisedPnl: "0.75",
+			Leverage:      "10",
+			TradeMode:     int(bybit.PositionMarginIsolated),
+			UpdatedTime:   "1700000000000",
+		},
+		{
+			Symbol:   "ETHUSDT",
+			Side:     bybit.SideNone,
+			Size:     "0",
+			Leverage: "5",
+		},
+	}
+
+	// when
+	positions, err := ConvertPositions(list)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, positions, 1)
+	assert.Equal(t, "BTCUSDT", positions[0].Symbol)
+	assert.Equal(t, consts.PositionSideShort, positions[0].Side)
+	assert.Equal(t, "0.015", positions[0].Size.String())
+	assert.Equal(t, "64000.5", positions[0].EntryPrice.String())
+	assert.Equal(t, "70000", positions[0].LiquidationPrice.String())
+	assert.Equal(t, 10, positions[0].Leverage)
+	assert.Equal(t, consts.MarginModeIsolated, positions[0].MarginMode)
+	assert.Equal(t, int64(1700000000000), positions[0].UpdatedTime)
+}
+
+func TestConvertPositionsInvalidSize(t *testing.T) {
+	// given
+	list := bybit.V5GetPositionInfoList{
+		{Symbol: "BTCUSDT", Size: "wrong"},
+	}
+
+	// when
+	_, err := ConvertPositions(list)
+
+	// then
+	require.ErrorContains(t, err, "parse size")
+}
+
+func TestConvertPositionEvent(t *testing.T) {
+	// given
+	data := bybit.V5WebsocketPrivatePositionData{
+		Symbol:    "BTCUSDT",
+		Side:      bybit.SideBuy,
+		Size:      "0",
+		Leverage:  "3",
+		TradeMode: int(bybit.PositionMarginCross),
+	}
+	eventTime := int64(1700000000001)
+
+	// when
+	event, err := ConvertPositionEvent(data, eventTime, "bybit-linear")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "bybit-linear", event.ExchangeTag)
+	assert.Equal(t, eventTime, event.Time)
+	assert.Equal(t, consts.PositionSideLong, event.Position.Side)
+	assert.True(t, event.Position.Size.IsZero())
+	assert.Equal(t, consts.MarginModeCross, event.Position.MarginMode)
+}
+
+func TestConvertFundingInfo(t *testing.T) {
+	// given
+	data := bybit.V5GetTickersLinearInverseItem{
+		Symbol:          "BTCUSDT",
+		MarkPrice:       "64000.1",
+		IndexPrice:      "64010",
+		FundingRate:     "0.0001",
+		NextFundingTime: "1700006400000",
+	}
+
+	// when
+	info, err := ConvertFundingInfo(data)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "BTCUSDT", info.Symbol)
+	assert.Equal(t, "64000.1", info.MarkPrice.String())
+	assert.Equal(t, "0.0001", info.FundingRate.String())
+	assert.Equal(t, int64(1700006400000), info.NextFundingTime)
+}
+
+func TestConvertFundingInfoEmptyMarkPrice(t *testing.T) {
+	// when
+	_, err := ConvertFundingInfo(bybit.V5GetTickersLinearInverseItem{Symbol: "BTCUSDT"})
+
+	// then
+	require.ErrorContains(t, err, "parse mark price")
+}
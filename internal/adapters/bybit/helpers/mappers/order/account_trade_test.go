@@ -0,0 +1,54 @@
+package order_mappers
+
+import (
+	"testing"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAccountTrade(t *testing.T) {
+	// given
+	data := bybit.V5GetExecutionListItem{
+		Symbol:      "LTCUSDT",
+		OrderID:     "1719321839593426432",
+		OrderLinkID: "test",
+		Side:        bybit.SideSell,
+		ExecFee:     "0.0082",
+		ExecID:      "2100000000053929178",
+		ExecPrice:   "82",
+		ExecQty:     "0.1",
+		ExecTime:    "1692119310600",
+		FeeCurrency: "USDT",
+		IsMaker:     true,
+	}
+
+	// when
+	trade, err := ConvertAccountTrade(data)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "2100000000053929178", trade.ID)
+	assert.Equal(t, int64(1719321839593426432), trade.OrderID)
+	assert.Equal(t, "test", trade.ClientOrderID)
+	assert.Equal(t, consts.OrderSideSell, trade.Side)
+	assert.Equal(t, "82", trade.Price.String())
+	assert.Equal(t, "0.1", trade.Qty.String())
+	assert.Equal(t, "0.0082", trade.Fee.String())
+	assert.Equal(t, "USDT", trade.FeeAsset)
+	assert.True(t, trade.IsMaker)
+	assert.Equal(t, int64(1692119310600), trade.Time)
+}
+
+func TestConvertAccountTradeInvalidOrderID(t *testing.T) {
+	// given
+	data := bybit.V5GetExecutionListItem{OrderID: "wtf"}
+
+	// when
+	_, err := ConvertAccountTrade(data)
+
+	// then
+	require.ErrorContains(t, err, "parse order id")
+}
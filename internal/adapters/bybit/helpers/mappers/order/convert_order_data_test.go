@@ -212,9 +212,9 @@ func TestConvertOrderData(t *testing.T) {
 	assert.Equal(t, string(rawOrder.Symbol), orderData.Symbol)
 	assert.Equal(t, consts.OrderSideSell, orderData.Side)
 	assert.Equal(t, int64(12345), orderData.OrderID)
-	assert.Equal(t, float64(0.35), orderData.AwaitQty)
-	assert.Equal(t, float64(80.156), orderData.Price)
-	assert.Equal(t, float64(0.1), orderData.FilledQty)
+	assert.Equal(t, "0.35", orderData.AwaitQty.String())
+	assert.Equal(t, "80.156", orderData.Price.String())
+	assert.Equal(t, "0.1", orderData.FilledQty.String())
 	assert.Equal(t, pkgStructs.OrderStatusNew, orderData.Status)
 }
 
@@ -41,9 +41,9 @@ func TestParseHistoryOrder(t *testing.T) {
 	assert.Equal(t, pairSymbol, orderData.Symbol)
 	assert.Equal(t, consts.OrderSideSell, orderData.Side)
 	assert.Equal(t, consts.OrderStatusNew, orderData.Status)
-	assert.Equal(t, float64(0.5), orderData.AwaitQty)
-	assert.Equal(t, float64(0.1), orderData.FilledQty)
-	assert.Equal(t, float64(82), orderData.Price)
+	assert.Equal(t, "0.5", orderData.AwaitQty.String())
+	assert.Equal(t, "0.1", orderData.FilledQty.String())
+	assert.Equal(t, "82", orderData.Price.String())
 	assert.Equal(t, int64(1692119310600), orderData.UpdatedTime)
 }
 
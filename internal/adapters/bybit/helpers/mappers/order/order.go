@@ -44,7 +44,7 @@ func ConvertOrderData(data bybit.V5GetOrder) (structs.OrderData, error) {
 	if data.Qty == "" {
 		return structs.OrderData{}, errors.New("order qty is empty")
 	}
-	awaitQty, err := strconv.ParseFloat(data.Qty, 64)
+	awaitQty, err := decimal.NewFromString(data.Qty)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("parse qty: %w", err)
 	}
@@ -52,7 +52,7 @@ func ConvertOrderData(data bybit.V5GetOrder) (structs.OrderData, error) {
 	if data.CumExecQty == "" {
 		return structs.OrderData{}, errors.New("order executed qty is empty")
 	}
-	filledQty, err := strconv.ParseFloat(data.CumExecQty, 64)
+	filledQty, err := decimal.NewFromString(data.CumExecQty)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("parse executed qty: %w", err)
 	}
@@ -60,7 +60,7 @@ func ConvertOrderData(data bybit.V5GetOrder) (structs.OrderData, error) {
 	if data.Price == "" {
 		return structs.OrderData{}, errors.New("order price is empty")
 	}
-	price, err := strconv.ParseFloat(data.Price, 64)
+	price, err := decimal.NewFromString(data.Price)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("parse price: %w", err)
 	}
@@ -141,18 +141,30 @@ func ConvertOrderSideToBybit(side consts.OrderSide) bybit.Side {
 	return bybit.Side(cases.Title(language.Und, cases.NoLower).String(string(side)))
 }
 
+// ParseOrderExecFee - fees without the fee currency in the response
+// are considered as paid in the received asset
 func ParseOrderExecFee(
 	orderExecData bybit.V5GetExecutionListResult,
 	orderSide consts.OrderSide,
+	baseAssetTicker string,
+	quoteAssetTicker string,
 ) (
 	structs.OrderFees,
 	error,
 ) {
-	orderFees := decimal.NewFromInt(0)
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.NewFromInt(0),
+		QuoteAsset: decimal.NewFromInt(0),
+	}
 	if len(orderExecData.List) == 0 {
 		return structs.OrderFees{}, nil
 	}
 
+	receivedAsset := quoteAssetTicker
+	if orderSide == consts.OrderSideBuy {
+		receivedAsset = baseAssetTicker
+	}
+
 	for _, execEventData := range orderExecData.List {
 		if execEventData.ExecFee == "" {
 			continue
@@ -163,18 +175,114 @@ func ParseOrderExecFee(
 			return structs.OrderFees{}, fmt.Errorf("parse fee: %w", err)
 		}
 
-		orderFees = orderFees.Add(execFee)
+		feeAsset := string(execEventData.FeeCurrency)
+		if feeAsset == "" {
+			feeAsset = receivedAsset
+		}
+
+		switch feeAsset {
+		case baseAssetTicker:
+			fees.BaseAsset = fees.BaseAsset.Add(execFee)
+		case quoteAssetTicker:
+			fees.QuoteAsset = fees.QuoteAsset.Add(execFee)
+		}
+
+		commission := structs.Commission{
+			TradeID: execEventData.ExecID,
+			Asset:   feeAsset,
+			Amount:  execFee,
+			Price:   decimal.Zero,
+		}
+		if execEventData.ExecPrice != "" {
+			commission.Price, err = decimal.NewFromString(execEventData.ExecPrice)
+			if err != nil {
+				return structs.OrderFees{}, fmt.Errorf("parse price: %w", err)
+			}
+		}
+		if execEventData.ExecTime != "" {
+			commission.Time, err = strconv.ParseInt(execEventData.ExecTime, 10, 64)
+			if err != nil {
+				return structs.OrderFees{}, fmt.Errorf("parse time: %w", err)
+			}
+		}
+		fees.Commissions = append(fees.Commissions, commission)
+	}
+	return fees, nil
+}
+
+func ConvertAccountTrade(data bybit.V5GetExecutionListItem) (structs.AccountTrade, error) {
+	orderID, err := strconv.ParseInt(data.OrderID, 10, 64)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse order id: %w", err)
 	}
 
-	if orderSide == consts.OrderSideBuy {
-		return structs.OrderFees{
-			BaseAsset:  orderFees,
-			QuoteAsset: decimal.NewFromInt(0),
-		}, nil
+	side, err := convertOrderType(data.Side)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("convert side: %w", err)
 	}
 
-	return structs.OrderFees{
-		BaseAsset:  decimal.NewFromInt(0),
-		QuoteAsset: orderFees,
+	price, err := decimal.NewFromString(data.ExecPrice)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := decimal.NewFromString(data.ExecQty)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	fee := decimal.Zero
+	if data.ExecFee != "" {
+		fee, err = decimal.NewFromString(data.ExecFee)
+		if err != nil {
+			return structs.AccountTrade{}, fmt.Errorf("parse fee: %w", err)
+		}
+	}
+
+	execTime, err := strconv.ParseInt(data.ExecTime, 10, 64)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse time: %w", err)
+	}
+
+	return structs.AccountTrade{
+		ID:            data.ExecID,
+		OrderID:       orderID,
+		ClientOrderID: data.OrderLinkID,
+		Symbol:        string(data.Symbol),
+		Side:          side,
+		Price:         price,
+		Qty:           qty,
+		Fee:           fee,
+		FeeAsset:      string(data.FeeCurrency),
+		IsMaker:       data.IsMaker,
+		Time:          execTime,
 	}, nil
 }
+
+func ConvertTradeFees(
+	pairSymbol string,
+	data bybit.V5GetFeeRateResult,
+) (structs.TradeFees, error) {
+	for _, item := range data.List {
+		if string(item.Symbol) != pairSymbol {
+			continue
+		}
+
+		maker, err := decimal.NewFromString(item.MakerFeeRate)
+		if err != nil {
+			return structs.TradeFees{}, fmt.Errorf("parse maker fee: %w", err)
+		}
+
+		taker, err := decimal.NewFromString(item.TakerFeeRate)
+		if err != nil {
+			return structs.TradeFees{}, fmt.Errorf("parse taker fee: %w", err)
+		}
+
+		return structs.TradeFees{
+			Symbol: pairSymbol,
+			Maker:  maker,
+			Taker:  taker,
+		}, nil
+	}
+	return structs.TradeFees{}, fmt.Errorf("fee for %q not found", pairSymbol)
+}
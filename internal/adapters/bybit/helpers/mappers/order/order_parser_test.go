@@ -10,6 +10,11 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+const (
+	testBaseAsset  = "LTC"
+	testQuoteAsset = "USDT"
+)
+
 func TestParseBuyOrderExecFee(t *testing.T) {
 	// given
 	orderSide := consts.OrderSideBuy
@@ -26,7 +31,7 @@ func TestParseBuyOrderExecFee(t *testing.T) {
 	}
 
 	// when
-	fees, err := ParseOrderExecFee(orderExecData, orderSide)
+	fees, err := ParseOrderExecFee(orderExecData, orderSide, testBaseAsset, testQuoteAsset)
 
 	// then
 	require.NoError(t, err)
@@ -50,7 +55,7 @@ func TestParseSellOrderExecFee(t *testing.T) {
 	}
 
 	// when
-	fees, err := ParseOrderExecFee(orderExecData, orderSide)
+	fees, err := ParseOrderExecFee(orderExecData, orderSide, testBaseAsset, testQuoteAsset)
 
 	// then
 	require.NoError(t, err)
@@ -72,10 +77,44 @@ func TestParseOrderExecFeeZero(t *testing.T) {
 	}
 
 	// when
-	fees, err := ParseOrderExecFee(orderExecData, orderSide)
+	fees, err := ParseOrderExecFee(orderExecData, orderSide, testBaseAsset, testQuoteAsset)
 
 	// then
 	require.NoError(t, err)
 	assert.Equal(t, decimal.NewFromFloat(float64(0)), fees.BaseAsset)
 	assert.Equal(t, decimal.NewFromFloat(float64(0)), fees.QuoteAsset)
 }
+
+func TestParseOrderExecFeeThirdAsset(t *testing.T) {
+	// given
+	orderSide := consts.OrderSideBuy
+	orderExecData := bybit.V5GetExecutionListResult{
+		List: []bybit.V5GetExecutionListItem{
+			{
+				ExecID:      "2100000000053929178",
+				ExecPrice:   "71.5",
+				ExecTime:    "1714521600000",
+				ExecFee:     "0.0003",
+				FeeCurrency: "MNT",
+			},
+			{
+				ExecFee:     "0.00002",
+				FeeCurrency: testBaseAsset,
+			},
+		},
+	}
+
+	// when
+	fees, err := ParseOrderExecFee(orderExecData, orderSide, testBaseAsset, testQuoteAsset)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.00002", fees.BaseAsset.String())
+	assert.True(t, fees.QuoteAsset.IsZero())
+	require.Len(t, fees.Commissions, 2)
+	assert.Equal(t, "MNT", fees.Commissions[0].Asset)
+	assert.Equal(t, "0.0003", fees.Commissions[0].Amount.String())
+	assert.Equal(t, "71.5", fees.Commissions[0].Price.String())
+	assert.Equal(t, int64(1714521600000), fees.Commissions[0].Time)
+	assert.Equal(t, testBaseAsset, fees.Commissions[1].Asset)
+}
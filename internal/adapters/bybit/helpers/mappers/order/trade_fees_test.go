@@ -0,0 +1,43 @@
+package order_mappers
+
+import (
+	"testing"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertTradeFees(t *testing.T) {
+	// given
+	data := bybit.V5GetFeeRateResult{
+		List: bybit.V5GetFeeRateList{
+			{Symbol: "BTCUSDT", TakerFeeRate: "0.002", MakerFeeRate: "0.002"},
+			{Symbol: "LTCUSDT", TakerFeeRate: "0.001", MakerFeeRate: "0.0008"},
+		},
+	}
+
+	// when
+	fees, err := ConvertTradeFees("LTCUSDT", data)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "LTCUSDT", fees.Symbol)
+	assert.Equal(t, "0.0008", fees.Maker.String())
+	assert.Equal(t, "0.001", fees.Taker.String())
+}
+
+func TestConvertTradeFeesNotFound(t *testing.T) {
+	// given
+	data := bybit.V5GetFeeRateResult{
+		List: bybit.V5GetFeeRateList{
+			{Symbol: "BTCUSDT", TakerFeeRate: "0.002", MakerFeeRate: "0.002"},
+		},
+	}
+
+	// when
+	_, err := ConvertTradeFees("LTCUSDT", data)
+
+	// then
+	require.ErrorContains(t, err, "not found")
+}
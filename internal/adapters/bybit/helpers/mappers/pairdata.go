@@ -2,14 +2,14 @@ package mappers
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/hirokisan/bybit/v2"
+	"github.com/shopspring/decimal"
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/conditions"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
-	"github.com/matrixbotio/exchange-gates-lib/pkg/utils"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
 )
 
 func ConvertPairsData(pairs *bybit.V5GetInstrumentsInfoSpotResult, exchangeID int) (
@@ -37,44 +37,43 @@ func ConvertPairsData(pairs *bybit.V5GetInstrumentsInfoSpotResult, exchangeID in
 			InUse:         true,
 		}
 
-		minBaseAmount, err := strconv.ParseFloat(rawPairData.LotSizeFilter.BasePrecision, 64)
+		minBaseAmount, err := decimal.NewFromString(rawPairData.LotSizeFilter.BasePrecision)
 		if err != nil {
 			return nil, fmt.Errorf("parse %q base precision: %w", pairSymbol, err)
 		}
 
-		minQuoteAmount, err := strconv.ParseFloat(rawPairData.LotSizeFilter.QuotePrecision, 64)
+		minQuoteAmount, err := decimal.NewFromString(rawPairData.LotSizeFilter.QuotePrecision)
 		if err != nil {
 			return nil, fmt.Errorf("parse %q quote precision: %w", pairSymbol, err)
 		}
 
-		pairData.BasePrecision = utils.GetFloatPrecision(minBaseAmount)
-		pairData.QuotePrecision = utils.GetFloatPrecision(minQuoteAmount)
+		pairData.BasePrecision = utils.GetDecimalPrecision(minBaseAmount)
+		pairData.QuotePrecision = utils.GetDecimalPrecision(minQuoteAmount)
 
-		pairData.MinQty, err = strconv.ParseFloat(rawPairData.LotSizeFilter.MinOrderQty, 64)
+		pairData.MinQty, err = decimal.NewFromString(rawPairData.LotSizeFilter.MinOrderQty)
 		if err != nil {
 			return nil, fmt.Errorf("parse %q min qty: %w", pairSymbol, err)
 		}
-		pairData.QtyStep = utils.GetValueStep(pairData.MinQty)
+		pairData.QtyStep = utils.GetDecimalValueStep(pairData.MinQty)
 
-		pairData.MaxQty, err = strconv.ParseFloat(rawPairData.LotSizeFilter.MaxOrderQty, 64)
+		pairData.MaxQty, err = decimal.NewFromString(rawPairData.LotSizeFilter.MaxOrderQty)
 		if err != nil {
 			return nil, fmt.Errorf("parse %q max qty: %w", pairSymbol, err)
 		}
 
-		pairData.OriginalMinDeposit, err = strconv.ParseFloat(
+		pairData.OriginalMinDeposit, err = decimal.NewFromString(
 			rawPairData.LotSizeFilter.MinOrderAmt,
-			64,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("parse %q min deposit: %w", pairSymbol, err)
 		}
 		pairData.MinDeposit = pairData.OriginalMinDeposit
 
-		pairData.PriceStep, err = strconv.ParseFloat(rawPairData.PriceFilter.TickSize, 64)
+		pairData.PriceStep, err = decimal.NewFromString(rawPairData.PriceFilter.TickSize)
 		if err != nil {
 			return nil, fmt.Errorf("parse price precision: %w", err)
 		}
-		if pairData.PriceStep == 0 {
+		if pairData.PriceStep.IsZero() {
 			return nil, fmt.Errorf("%q price step is empty", pairSymbol)
 		}
 		pairData.MinPrice = pairData.PriceStep
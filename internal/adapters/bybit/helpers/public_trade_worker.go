@@ -0,0 +1,91 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+type PublicTradeWorkerBybit struct {
+	workers.PublicTradeWorker
+	WsClient *bybit.WebSocketClient
+	Category bybit.CategoryV5
+}
+
+func (w *PublicTradeWorkerBybit) SubscribeToPublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	if w.PublicTradeWorker.IsSubscriptionExists(pairSymbol) {
+		return nil // already subscribed
+	}
+
+	wsStop := make(chan struct{}, 1)
+	wsDone := make(chan struct{}, 1)
+
+	wsSrv, err := w.WsClient.V5().Public(w.Category)
+	if err != nil {
+		return fmt.Errorf("create public trades subscription service: %w", err)
+	}
+
+	handler := func(response bybit.V5WebsocketPublicTradeResponse) error {
+		for _, eventRaw := range response.Data {
+			event, err := mappers.ParsePublicTradeEvent(eventRaw, w.ExchangeTag)
+			if err != nil {
+				return fmt.Errorf("parse public trade event: %w", err)
+			}
+
+			eventCallback(event)
+		}
+		return nil
+	}
+
+	unsubscribe, err := wsSrv.SubscribeTrade(
+		bybit.V5WebsocketPublicTradeParamKey{Symbol: bybit.SymbolV5(pairSymbol)},
+		handler,
+	)
+	if err != nil {
+		return fmt.Errorf("open public trades subscription: %w", err)
+	}
+
+	w.PublicTradeWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		pairSymbol,
+	)
+
+	go func() {
+		select {
+		case <-wsStop:
+			if err := unsubscribe(); err != nil {
+				errorHandler(fmt.Errorf("unsubscribe from public trades: %w", err))
+			}
+		case <-wsDone:
+		}
+	}()
+
+	wsErrHandler := func(isWebsocketClosed bool, err error) {
+		if !isWebsocketClosed {
+			_ = wsSrv.Close()
+		}
+
+		errorHandler(fmt.Errorf("bybit public trades subscription: %w", err))
+	}
+
+	go func() {
+		if err := wsSrv.Start(context.Background(), wsErrHandler); err != nil {
+			wsDone <- struct{}{}
+
+			errorHandler(fmt.Errorf(
+				"start public trades subscription: %w",
+				err,
+			))
+		}
+	}()
+
+	return nil
+}
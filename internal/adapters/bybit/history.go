@@ -0,0 +1,69 @@
+package bybit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hirokisan/bybit/v2"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/accessors"
+	order_mappers "github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/mappers/order"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+const (
+	tradesHistoryMaxWindow = time.Hour * 24 * 7
+	tradesHistoryPageLimit = 100
+)
+
+func (a *adapter) GetAccountTrades(
+	task structs.GetOrdersHistoryTask,
+) ([]structs.AccountTrade, error) {
+	windows, err := baseadp.SplitHistoryTask(task, tradesHistoryMaxWindow)
+	if err != nil {
+		return nil, fmt.Errorf("check task: %w", err)
+	}
+
+	ctx := baseadp.GetHistoryTaskContext(task)
+
+	var result []structs.AccountTrade
+	for _, window := range windows {
+		startTime := int(window.StartTime)
+		endTime := int(window.EndTime)
+		limit := tradesHistoryPageLimit
+		payload := bybit.V5GetExecutionParam{
+			Category:  a.category,
+			Symbol:    accessors.GetPairSymbolPointerV5(task.PairSymbol),
+			StartTime: &startTime,
+			EndTime:   &endTime,
+			Limit:     &limit,
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			response, err := a.client.V5().Execution().GetExecutionList(payload)
+			if err != nil {
+				return nil, fmt.Errorf("get execution list: %w", err)
+			}
+
+			for _, item := range response.Result.List {
+				trade, err := order_mappers.ConvertAccountTrade(item)
+				if err != nil {
+					return nil, fmt.Errorf("convert trade: %w", err)
+				}
+				result = append(result, trade)
+			}
+
+			if response.Result.NextPageCursor == "" || len(response.Result.List) == 0 {
+				break
+			}
+			cursor := response.Result.NextPageCursor
+			payload.Cursor = &cursor
+		}
+	}
+	return baseadp.SortAccountTrades(result), nil
+}
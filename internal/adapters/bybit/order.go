@@ -14,14 +14,14 @@ import (
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
 	pkgErrs "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
-	"github.com/matrixbotio/exchange-gates-lib/pkg/utils"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
 )
 
 func (a *adapter) GetOrderData(pairSymbol string, orderID int64) (structs.OrderData, error) {
 	orderIDFormatted := strconv.FormatInt(orderID, 10)
 
 	data, err := a.getOrderDataByParams(bybit.V5GetHistoryOrdersParam{
-		Category: bybit.CategoryV5Spot,
+		Category: a.category,
 		Symbol:   accessors.GetPairSymbolPointerV5(pairSymbol),
 		OrderID:  &orderIDFormatted,
 	})
@@ -40,7 +40,7 @@ func (a *adapter) GetOrderByClientOrderID(pairSymbol, clientOrderID string) (
 	error,
 ) {
 	data, err := a.getOrderDataByParams(bybit.V5GetHistoryOrdersParam{
-		Category:    bybit.CategoryV5Spot,
+		Category:    a.category,
 		Symbol:      accessors.GetPairSymbolPointerV5(pairSymbol),
 		OrderLinkID: &clientOrderID,
 	})
@@ -57,9 +57,16 @@ func (a *adapter) GetOrderByClientOrderID(pairSymbol, clientOrderID string) (
 func (a *adapter) PlaceOrder(
 	ctx context.Context,
 	order structs.BotOrderAdjusted,
+) (structs.CreateOrderResponse, error) {
+	return a.placeOrder(order, false)
+}
+
+func (a *adapter) placeOrder(
+	order structs.BotOrderAdjusted,
+	isReduceOnly bool,
 ) (structs.CreateOrderResponse, error) {
 	data := bybit.V5CreateOrderParam{
-		Category:    bybit.CategoryV5Spot,
+		Category:    a.category,
 		Symbol:      bybit.SymbolV5(order.PairSymbol),
 		Side:        order_mappers.ConvertOrderSideToBybit(order.Type),
 		OrderType:   bybit.OrderTypeLimit,
@@ -73,7 +80,13 @@ func (a *adapter) PlaceOrder(
 		data.Price = nil
 	}
 
-	response, err := a.client.V5().Order().CreateOrder(data)
+	var response *bybit.V5CreateOrderResponse
+	var err error
+	if isReduceOnly {
+		response, err = a.createReduceOnlyOrder(data)
+	} else {
+		response, err = a.client.V5().Order().CreateOrder(data)
+	}
 	if err != nil {
 		// if the order has already been placed, we will receive and return its data
 		if strings.Contains(err.Error(), errs.ErrMsgOrderDuplicate) {
@@ -89,7 +102,7 @@ func (a *adapter) PlaceOrder(
 	}
 
 	orderData, err := a.getOrderDataByParams(bybit.V5GetHistoryOrdersParam{
-		Category: bybit.CategoryV5Spot,
+		Category: a.category,
 		Symbol:   accessors.GetPairSymbolPointerV5(order.PairSymbol),
 		OrderID:  utils.StringPointer(strconv.FormatInt(orderID, 10)),
 	})
@@ -160,7 +173,7 @@ func (a *adapter) CancelPairOrder(pairSymbol string, orderID int64, ctx context.
 	orderIDFormatted := strconv.FormatInt(orderID, 10)
 
 	_, err := a.client.V5().Order().CancelOrder(bybit.V5CancelOrderParam{
-		Category: bybit.CategoryV5Spot,
+		Category: a.category,
 		Symbol:   bybit.SymbolV5(pairSymbol),
 		OrderID:  &orderIDFormatted,
 	})
@@ -177,7 +190,7 @@ func (a *adapter) CancelPairOrderByClientOrderID(
 	ctx context.Context,
 ) error {
 	_, err := a.client.V5().Order().CancelOrder(bybit.V5CancelOrderParam{
-		Category:    bybit.CategoryV5Spot,
+		Category:    a.category,
 		Symbol:      bybit.SymbolV5(pairSymbol),
 		OrderLinkID: &clientOrderID,
 	})
@@ -208,27 +221,53 @@ func (a *adapter) GetOrderExecFee(
 	orderIDFormatted := strconv.FormatInt(orderID, 10)
 
 	payload := bybit.V5GetExecutionParam{
-		Category: bybit.CategoryV5Spot,
+		Category: a.category,
 		Symbol:   accessors.GetPairSymbolPointerV5(pairSymbol),
 		OrderID:  &orderIDFormatted,
 	}
 
+	if a.isLinear() {
+		// contract fees are charged in the settle coin
+		orderSide = consts.OrderSideSell
+	}
+
 	orderExecData, err := a.client.V5().Execution().GetExecutionList(payload)
 	if err != nil {
 		return structs.OrderFees{}, fmt.Errorf("get order execution history: %w", err)
 	}
 
-	fees, err := order_mappers.ParseOrderExecFee(orderExecData.Result, orderSide)
+	fees, err := order_mappers.ParseOrderExecFee(
+		orderExecData.Result,
+		orderSide,
+		baseAssetTicker,
+		quoteAssetTicker,
+	)
 	if err != nil {
 		return structs.OrderFees{}, fmt.Errorf("parse order fees: %w", err)
 	}
 	return fees, nil
 }
 
+func (a *adapter) GetTradeFees(pairSymbol string) (structs.TradeFees, error) {
+	response, err := a.client.V5().Account().GetFeeRate(bybit.V5GetFeeRateParam{
+		Category: a.category,
+		Symbol:   accessors.GetPairSymbolPointerV5(pairSymbol),
+	})
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("get: %w", err)
+	}
+
+	fees, err := order_mappers.ConvertTradeFees(pairSymbol, response.Result)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("convert: %w", err)
+	}
+	return fees, nil
+}
+
 func (a *adapter) GetHistoryOrder(
 	pairSymbol string,
 	orderID int64,
 ) (structs.OrderHistory, error) {
 	// not emplemented yet
-	return structs.OrderHistory{}, nil
+	return structs.OrderHistory{}, &pkgErrs.NotSupportedError{Feature: "order history"}
 }
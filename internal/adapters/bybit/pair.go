@@ -1,23 +1,27 @@
 package bybit
 
 import (
+	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/hirokisan/bybit/v2"
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/accessors"
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/mappers"
 	order_mappers "github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/mappers/order"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/shopspring/decimal"
 )
 
+// linear instruments list is paginated, the default page size is 500
+const linearInstrumentsPageLimit = 1000
+
 func (a *adapter) GetPairData(pairSymbol string) (structs.ExchangePairData, error) {
-	response, err := a.getTradePairs(pairSymbol)
+	instruments, err := a.getTradePairs(pairSymbol)
 	if err != nil {
 		return structs.ExchangePairData{}, fmt.Errorf("get instruments info: %w", err)
 	}
 
-	pairsData, err := mappers.ConvertPairsData(response.Result.Spot, a.GetID())
+	pairsData, err := a.convertPairsData(instruments)
 	if err != nil {
 		return structs.ExchangePairData{}, fmt.Errorf("convert pairs: %w", err)
 	}
@@ -29,24 +33,23 @@ func (a *adapter) GetPairData(pairSymbol string) (structs.ExchangePairData, erro
 	return pairsData[0], nil
 }
 
-func (a *adapter) GetPairLastPrice(pairSymbol string) (float64, error) {
+func (a *adapter) GetPairLastPrice(pairSymbol string) (decimal.Decimal, error) {
 	response, err := a.client.V5().Market().GetTickers(bybit.V5GetTickersParam{
-		Category: bybit.CategoryV5Spot,
+		Category: a.category,
 		Symbol:   accessors.GetPairSymbolPointerV5(pairSymbol),
 	})
 	if err != nil {
-		return 0, fmt.Errorf("get %s last price: %w", pairSymbol, err)
+		return decimal.Zero, fmt.Errorf("get %s last price: %w", pairSymbol, err)
 	}
 
-	if len(response.Result.Spot.List) == 0 {
-		return 0, fmt.Errorf("last price for pair %q not found", pairSymbol)
+	lastPrice, err := a.getTickerLastPrice(response.Result)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("last price for pair %q: %w", pairSymbol, err)
 	}
 
-	lastPrice := response.Result.Spot.List[0].LastPrice
-
-	price, err := strconv.ParseFloat(lastPrice, 64)
+	price, err := decimal.NewFromString(lastPrice)
 	if err != nil {
-		return 0, fmt.Errorf(
+		return decimal.Zero, fmt.Errorf(
 			"parse last price for pair %q: %q: %w",
 			lastPrice, pairSymbol, err,
 		)
@@ -56,7 +59,7 @@ func (a *adapter) GetPairLastPrice(pairSymbol string) (float64, error) {
 
 func (a *adapter) GetPairOpenOrders(pairSymbol string) ([]structs.OrderData, error) {
 	response, err := a.client.V5().Order().GetOpenOrders(bybit.V5GetOpenOrdersParam{
-		Category: bybit.CategoryV5Spot,
+		Category: a.category,
 		Symbol:   accessors.GetPairSymbolPointerV5(pairSymbol),
 	})
 	if err != nil {
@@ -76,12 +79,12 @@ func (a *adapter) GetPairOpenOrders(pairSymbol string) ([]structs.OrderData, err
 }
 
 func (a *adapter) GetPairs() ([]structs.ExchangePairData, error) {
-	response, err := a.getTradePairs()
+	instruments, err := a.getTradePairs()
 	if err != nil {
 		return nil, fmt.Errorf("get instruments info: %w", err)
 	}
 
-	pairsData, err := mappers.ConvertPairsData(response.Result.Spot, a.GetID())
+	pairsData, err := a.convertPairsData(instruments)
 	if err != nil {
 		return nil, fmt.Errorf("convert pairs: %w", err)
 	}
@@ -90,18 +93,7 @@ func (a *adapter) GetPairs() ([]structs.ExchangePairData, error) {
 }
 
 func (a *adapter) GetAccountBalance() ([]structs.Balance, error) {
-	response, err := a.client.V5().Account().GetWalletBalance(
-		a.getAccountType(),
-		nil,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("get wallet balance: %w", err)
-	}
-
-	if response == nil {
-		return nil, fmt.Errorf("get wallet balance: response is empty")
-	}
-	return mappers.ConvertAccountBalance(*response, a.getAccountType())
+	return a.getWalletBalances(a.getTradingAccountType())
 }
 
 func (a *adapter) GetPairBalance(pair structs.PairSymbolData) (structs.PairBalance, error) {
@@ -121,17 +113,77 @@ func (a *adapter) GetPairBalance(pair structs.PairSymbolData) (structs.PairBalan
 	}, nil
 }
 
-func (a *adapter) getTradePairs(symbol ...string) (*bybit.V5GetInstrumentsInfoResponse, error) {
+func (a *adapter) getTradePairs(symbol ...string) (bybit.V5GetInstrumentsInfoResult, error) {
 	args := bybit.V5GetInstrumentsInfoParam{
-		Category: bybit.CategoryV5Spot,
+		Category: a.category,
 	}
 	if len(symbol) > 0 {
 		args.Symbol = accessors.GetPairSymbolPointerV5(symbol[0])
 	}
+	if a.isLinear() {
+		return a.getLinearTradePairs(args)
+	}
 
 	response, err := a.client.V5().Market().GetInstrumentsInfo(args)
 	if err != nil {
-		return nil, fmt.Errorf("get info: %w", err)
+		return bybit.V5GetInstrumentsInfoResult{}, fmt.Errorf("get info: %w", err)
+	}
+	return response.Result, nil
+}
+
+// getLinearTradePairs - all the linear instruments pages merged into one result
+func (a *adapter) getLinearTradePairs(
+	args bybit.V5GetInstrumentsInfoParam,
+) (bybit.V5GetInstrumentsInfoResult, error) {
+	limit := linearInstrumentsPageLimit
+	args.Limit = &limit
+
+	result := &bybit.V5GetInstrumentsInfoLinearInverseResult{Category: a.category}
+	for {
+		response, err := a.client.V5().Market().GetInstrumentsInfo(args)
+		if err != nil {
+			return bybit.V5GetInstrumentsInfoResult{}, fmt.Errorf("get info: %w", err)
+		}
+
+		page := response.Result.LinearInverse
+		if page == nil {
+			return bybit.V5GetInstrumentsInfoResult{}, errors.New("linear instruments data is empty")
+		}
+		result.List = append(result.List, page.List...)
+
+		if page.NextPageCursor == "" {
+			return bybit.V5GetInstrumentsInfoResult{LinearInverse: result}, nil
+		}
+		args.Cursor = &page.NextPageCursor
+	}
+}
+
+func (a *adapter) convertPairsData(
+	result bybit.V5GetInstrumentsInfoResult,
+) ([]structs.ExchangePairData, error) {
+	if a.isLinear() {
+		if result.LinearInverse == nil {
+			return nil, errors.New("linear instruments data is empty")
+		}
+		return mappers.ConvertLinearPairsData(result.LinearInverse, a.GetID())
+	}
+
+	if result.Spot == nil {
+		return nil, errors.New("spot instruments data is empty")
+	}
+	return mappers.ConvertPairsData(result.Spot, a.GetID())
+}
+
+func (a *adapter) getTickerLastPrice(result bybit.V5GetTickersResult) (string, error) {
+	if a.isLinear() {
+		if result.LinearInverse == nil || len(result.LinearInverse.List) == 0 {
+			return "", errors.New("ticker not found")
+		}
+		return result.LinearInverse.List[0].LastPrice, nil
+	}
+
+	if result.Spot == nil || len(result.Spot.List) == 0 {
+		return "", errors.New("ticker not found")
 	}
-	return response, nil
+	return result.Spot.List[0].LastPrice, nil
 }
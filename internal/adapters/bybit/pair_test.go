@@ -0,0 +1,67 @@
+package bybit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+)
+
+func TestGetPairsLinearPages(t *testing.T) {
+	// given
+	pages := map[string]bybit.V5GetInstrumentsInfoLinearInverseResult{
+		"": {
+			NextPageCursor: "next",
+			List:           []bybit.V5GetInstrumentsInfoLinearInverseItem{newTestLinearInstrument("BTCUSDT")},
+		},
+		"next": {
+			List: []bybit.V5GetInstrumentsInfoLinearInverseItem{newTestLinearInstrument("ETHUSDT")},
+		},
+	}
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+		assert.Equal(t, "1000", r.URL.Query().Get("limit"))
+
+		page := pages[cursor]
+		page.Category = bybit.CategoryV5Linear
+		_ = json.NewEncoder(w).Encode(map[string]any{"retCode": 0, "retMsg": "OK", "result": page})
+	}))
+	defer server.Close()
+
+	a := NewLinear(config.WithRESTBaseURL(server.URL))
+
+	// when
+	pairs, err := a.GetPairs()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "next"}, cursors)
+	require.Len(t, pairs, 2)
+	assert.Equal(t, "BTCUSDT", pairs[0].Symbol)
+	assert.Equal(t, "ETHUSDT", pairs[1].Symbol)
+}
+
+func newTestLinearInstrument(symbol string) bybit.V5GetInstrumentsInfoLinearInverseItem {
+	return bybit.V5GetInstrumentsInfoLinearInverseItem{
+		Symbol:       bybit.SymbolV5(symbol),
+		ContractType: bybit.ContractTypeLinearPerpetual,
+		Status:       bybit.InstrumentStatusTrading,
+		PriceFilter: bybit.LinearInversePriceFilterV5{
+			MinPrice: "0.1",
+			TickSize: "0.1",
+		},
+		LotSizeFilter: bybit.LinearInverseLotSizeFilterV5{
+			MinOrderQty: "0.001",
+			MaxOrderQty: "100",
+			QtyStep:     "0.001",
+		},
+	}
+}
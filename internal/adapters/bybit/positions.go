@@ -0,0 +1,112 @@
+package bybit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+const positionSubscriptionKey = "positions"
+
+// PositionWorkerBybit :
+type PositionWorkerBybit struct {
+	workers.PositionWorker
+	wsClient *bybit.WebSocketClient
+	category bybit.CategoryV5
+}
+
+func (w *PositionWorkerBybit) SubscribeToPositions(
+	eventCallback workers.PositionEventCallback,
+	errorHandler func(err error),
+) error {
+	if w.PositionWorker.IsSubscriptionExists(positionSubscriptionKey) {
+		return nil
+	}
+
+	wsStop := make(chan struct{}, 1)
+	wsDone := make(chan struct{}, 1)
+
+	pingActive := true
+
+	service, err := w.wsClient.V5().Private()
+	if err != nil {
+		return fmt.Errorf("failed to get private subscription service: %w", err)
+	}
+
+	if err := service.Subscribe(); err != nil {
+		return fmt.Errorf("init service: %w", err)
+	}
+
+	handler := func(e bybit.V5WebsocketPrivatePositionResponse) error {
+		for _, eventRaw := range e.Data {
+			if eventRaw.Category != w.category {
+				continue // the stream contains positions of all the categories
+			}
+
+			event, err := mappers.ConvertPositionEvent(eventRaw, e.CreationTime, w.ExchangeTag)
+			if err != nil {
+				return fmt.Errorf("parse position event: %w", err)
+			}
+
+			eventCallback(event)
+		}
+
+		return nil
+	}
+
+	unsubscribe, err := service.SubscribePosition(handler)
+	if err != nil {
+		return fmt.Errorf("subscribe to positions: %w", err)
+	}
+
+	// set unsibscriber & save subscription data
+	w.PositionWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		positionSubscriptionKey,
+	)
+
+	go func() {
+		for pingActive {
+			if err := service.Ping(); err != nil {
+				errorHandler(fmt.Errorf("ping: %w", err))
+			}
+
+			time.Sleep(pingTimeout)
+		}
+	}()
+
+	go func() {
+		select {
+		case <-wsStop:
+			if err := unsubscribe(); err != nil {
+				errorHandler(fmt.Errorf("unsubscribe from positions: %w", err))
+			}
+		case <-wsDone:
+		}
+
+		pingActive = false
+	}()
+
+	wsErrHandler := func(isWebsocketClosed bool, wsErr error) {
+		if !isWebsocketClosed {
+			_ = service.Close()
+		}
+
+		wsDone <- struct{}{}
+
+		errorHandler(fmt.Errorf("positions subscription: %w", wsErr))
+	}
+
+	go func() {
+		if err := service.Start(context.Background(), wsErrHandler); err != nil {
+			wsErrHandler(false, fmt.Errorf("start positions subscriber: %w", err))
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package bybit
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hirokisan/bybit/v2"
+)
+
+const endpointCreateOrder = "/v5/order/create"
+
+// reduceOnlyOrderParam - go-bybit sends the reduce only flag
+// with the wrong key (reduce_only), so the exchange ignores it
+type reduceOnlyOrderParam struct {
+	bybit.V5CreateOrderParam
+	ReduceOnly bool `json:"reduceOnly"`
+}
+
+func (a *adapter) createReduceOnlyOrder(
+	data bybit.V5CreateOrderParam,
+) (*bybit.V5CreateOrderResponse, error) {
+	data.ReduceOnly = nil
+
+	var response bybit.V5CreateOrderResponse
+	if err := a.postV5(endpointCreateOrder, reduceOnlyOrderParam{
+		V5CreateOrderParam: data,
+		ReduceOnly:         true,
+	}, &response); err != nil {
+		return nil, err
+	}
+
+	if response.RetCode != 0 {
+		return nil, &bybit.ErrorResponse{
+			RetCode: response.RetCode,
+			RetMsg:  response.RetMsg,
+		}
+	}
+	return &response, nil
+}
+
+// postV5 - send signed V5 POST request to the configured base URL.
+// The bybit client sends it with the configured http client
+func (a *adapter) postV5(endpoint string, param any, result any) error {
+	body, err := json.Marshal(param)
+	if err != nil {
+		return fmt.Errorf("encode request: %w", err)
+	}
+
+	req, err := http.NewRequest(
+		http.MethodPost, a.restBaseURL+endpoint, bytes.NewBuffer(body),
+	)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(a.clock.Now().UnixMilli(), 10)
+
+	h := hmac.New(sha256.New, []byte(a.keypair.Secret))
+	h.Write([]byte(timestamp + a.keypair.Public + string(body)))
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-BAPI-API-KEY", a.keypair.Public)
+	req.Header.Set("X-BAPI-TIMESTAMP", timestamp)
+	req.Header.Set("X-BAPI-SIGN", hex.EncodeToString(h.Sum(nil)))
+
+	return a.client.Request(req, result)
+}
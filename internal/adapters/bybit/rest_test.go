@@ -0,0 +1,157 @@
+package bybit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+func TestCreateReduceOnlyOrderBaseURL(t *testing.T) {
+	// given
+	var requestPath string
+	var requestBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPath = r.URL.Path
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&requestBody))
+		_, _ = w.Write([]byte(`{"retCode":0,"retMsg":"OK","result":{"orderId":"1"}}`))
+	}))
+	defer server.Close()
+
+	a := NewLinear(config.WithRESTBaseURL(server.URL)).(*adapter)
+	a.keypair = pkgStructs.APIKeypair{Public: "public", Secret: "secret"}
+
+	// when
+	response, err := a.createReduceOnlyOrder(bybit.V5CreateOrderParam{
+		Category: bybit.CategoryV5Linear,
+		Symbol:   "BTCUSDT",
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "1", response.Result.OrderID)
+	assert.Equal(t, endpointCreateOrder, requestPath)
+	assert.Equal(t, true, requestBody["reduceOnly"])
+}
+
+func TestCreateReduceOnlyOrderServerTime(t *testing.T) {
+	// given
+	var timestamp string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		timestamp = r.Header.Get("X-BAPI-TIMESTAMP")
+		_, _ = w.Write([]byte(`{"retCode":0,"retMsg":"OK","result":{"orderId":"1"}}`))
+	}))
+	defer server.Close()
+
+	a := NewLinear(config.WithRESTBaseURL(server.URL)).(*adapter)
+	a.keypair = pkgStructs.APIKeypair{Public: "public", Secret: "secret"}
+	a.clock.SetOffset(time.Hour)
+
+	// when
+	_, err := a.createReduceOnlyOrder(bybit.V5CreateOrderParam{
+		Category: bybit.CategoryV5Linear,
+		Symbol:   "BTCUSDT",
+	})
+
+	// then
+	require.NoError(t, err)
+	timestampMs, err := strconv.ParseInt(timestamp, 10, 64)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), time.UnixMilli(timestampMs), time.Minute)
+}
+
+func TestConnectAccountInfoDenied(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v3/public/time" {
+			_, _ = w.Write([]byte(`{"retCode":0,"retMsg":"OK","result":{"timeNano":"1700000000000000000"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"retCode":10005,"retMsg":"Permission denied"}`))
+	}))
+	defer server.Close()
+
+	a := New(config.WithRESTBaseURL(server.URL)).(*adapter)
+
+	// when
+	err := a.Connect(pkgStructs.APICredentials{
+		Type:    pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{Public: "public", Secret: "secret"},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.AccountTypeUnified, a.GetAccountType())
+
+	// when
+	_, err = a.DetectAccountType()
+
+	// then
+	require.Error(t, err)
+}
+
+func TestSetMarginModeUnifiedAccount(t *testing.T) {
+	// given
+	var requestsCount int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestsCount++
+	}))
+	defer server.Close()
+
+	a := NewLinear(config.WithRESTBaseURL(server.URL)).(*adapter)
+	a.accountType = bybit.AccountTypeV5UNIFIED
+
+	// when
+	err := a.SetMarginMode("BTCUSDT", consts.MarginModeIsolated)
+
+	// then
+	require.ErrorIs(t, err, errs.ErrNotSupported)
+	assert.Zero(t, requestsCount)
+}
+
+func TestSetAccountMarginMode(t *testing.T) {
+	// given
+	var requestPath string
+	var requestBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPath = r.URL.Path
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&requestBody))
+		_, _ = w.Write([]byte(`{"retCode":0,"retMsg":"OK","result":{}}`))
+	}))
+	defer server.Close()
+
+	a := NewLinear(config.WithRESTBaseURL(server.URL)).(*adapter)
+	a.accountType = bybit.AccountTypeV5UNIFIED
+	a.keypair = pkgStructs.APIKeypair{Public: "public", Secret: "secret"}
+
+	// when
+	err := a.SetAccountMarginMode(consts.MarginModeIsolated)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, endpointSetMarginMode, requestPath)
+	assert.Equal(t, unifiedMarginModeIsolated, requestBody["setMarginMode"])
+}
+
+func TestSetAccountMarginModeClassicAccount(t *testing.T) {
+	// given
+	a := NewLinear().(*adapter)
+	a.accountType = bybit.AccountTypeV5CONTRACT
+
+	// when
+	err := a.SetAccountMarginMode(consts.MarginModeCross)
+
+	// then
+	require.ErrorIs(t, err, errs.ErrNotSupported)
+}
@@ -12,6 +12,7 @@ import (
 func (a *adapter) CreateCandleWorker() *helpers.CandleEventWorkerBybit {
 	w := &helpers.CandleEventWorkerBybit{
 		WsClient: a.wsClient,
+		Category: a.category,
 	}
 	w.CandleWorker.ExchangeTag = a.GetTag()
 	return w
@@ -20,11 +21,30 @@ func (a *adapter) CreateCandleWorker() *helpers.CandleEventWorkerBybit {
 func (a *adapter) CreateTradeEventsWorker() *TradeEventWorkerBybit {
 	w := &TradeEventWorkerBybit{
 		wsClient: a.wsClient,
+		category: a.category,
 	}
 	w.TradeEventWorker.ExchangeTag = a.GetTag()
 	return w
 }
 
+func (a *adapter) CreatePositionWorker() *PositionWorkerBybit {
+	w := &PositionWorkerBybit{
+		wsClient: a.wsClient,
+		category: a.category,
+	}
+	w.PositionWorker.ExchangeTag = a.GetTag()
+	return w
+}
+
+func (a *adapter) CreatePublicTradeWorker() *helpers.PublicTradeWorkerBybit {
+	w := &helpers.PublicTradeWorkerBybit{
+		WsClient: a.wsClient,
+		Category: a.category,
+	}
+	w.PublicTradeWorker.ExchangeTag = a.GetTag()
+	return w
+}
+
 func (a *adapter) SubscribeCandle(
 	pairSymbol string,
 	interval consts.Interval,
@@ -48,6 +68,18 @@ func (a *adapter) SubscribeAccountTrades(
 	)
 }
 
+func (a *adapter) SubscribePublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	return a.publicTradeWorker.SubscribeToPublicTrades(
+		pairSymbol,
+		eventCallback,
+		errorHandler,
+	)
+}
+
 func (a *adapter) UnsubscribeCandle(
 	pairSymbol string,
 	interval consts.Interval,
@@ -64,3 +96,7 @@ func (a *adapter) UnsubscribeCandle(
 func (a *adapter) UnsubscribeAccountTrades() {
 	a.tradeWorker.UnsubscribeAll()
 }
+
+func (a *adapter) UnsubscribePublicTrades(pairSymbol string) {
+	a.publicTradeWorker.Unsubscribe(pairSymbol)
+}
@@ -0,0 +1,201 @@
+package config
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Config - adapter connection settings. Zero values mean the adapter defaults
+type Config struct {
+	// RESTBaseURL - REST API base URL, e.g. a local stand-in server
+	RESTBaseURL string
+	// WsBaseURL - websocket base URL, the adapter appends the streams path to it
+	WsBaseURL string
+	// Testnet - use the exchange testnet or demo trading endpoints.
+	// The base URLs set explicitly take precedence
+	Testnet bool
+	// Proxy - http, https or socks5 proxy URL
+	Proxy *url.URL
+	// LocalAddr - local IP address the connections are made from
+	LocalAddr net.IP
+	// HTTPClient - custom REST client. The proxy, the local address
+	// & the request timeout are not applied to it
+	HTTPClient *http.Client
+	// RequestTimeout - REST request timeout
+	RequestTimeout time.Duration
+	// BrokerID - broker source key or channel ID sent with the requests
+	BrokerID string
+	// OrderIDStore - numeric aliases of the non-numeric exchange order IDs,
+	// e.g. KuCoin. The adapter keeps the recent aliases in memory by default
+	OrderIDStore OrderIDStore
+}
+
+// OrderIDStore - numeric order ID alias -> exchange order ID.
+// A persistent store resolves the aliases saved before the restart
+type OrderIDStore interface {
+	Save(alias int64, exchangeOrderID string)
+	Get(alias int64) (exchangeOrderID string, isExists bool)
+}
+
+type Option func(*Config)
+
+func New(opts ...Option) Config {
+	cfg := Config{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+func WithRESTBaseURL(baseURL string) Option {
+	return func(c *Config) {
+		c.RESTBaseURL = baseURL
+	}
+}
+
+func WithWsBaseURL(baseURL string) Option {
+	return func(c *Config) {
+		c.WsBaseURL = baseURL
+	}
+}
+
+func WithTestnet() Option {
+	return func(c *Config) {
+		c.Testnet = true
+	}
+}
+
+func WithProxy(proxyURL *url.URL) Option {
+	return func(c *Config) {
+		c.Proxy = proxyURL
+	}
+}
+
+func WithLocalAddr(ip net.IP) Option {
+	return func(c *Config) {
+		c.LocalAddr = ip
+	}
+}
+
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Config) {
+		c.HTTPClient = client
+	}
+}
+
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		c.RequestTimeout = timeout
+	}
+}
+
+func WithBrokerID(brokerID string) Option {
+	return func(c *Config) {
+		c.BrokerID = brokerID
+	}
+}
+
+func WithOrderIDStore(store OrderIDStore) Option {
+	return func(c *Config) {
+		c.OrderIDStore = store
+	}
+}
+
+// GetRESTBaseURL - the URL set, the testnet or the mainnet one
+func (c Config) GetRESTBaseURL(mainnetURL, testnetURL string) string {
+	return getBaseURL(c.RESTBaseURL, c.Testnet, mainnetURL, testnetURL)
+}
+
+// GetWsBaseURL - the URL set, the testnet or the mainnet one
+func (c Config) GetWsBaseURL(mainnetURL, testnetURL string) string {
+	return getBaseURL(c.WsBaseURL, c.Testnet, mainnetURL, testnetURL)
+}
+
+func getBaseURL(baseURL string, isTestnet bool, mainnetURL, testnetURL string) string {
+	switch {
+	case baseURL != "":
+		return baseURL
+	case isTestnet:
+		return testnetURL
+	default:
+		return mainnetURL
+	}
+}
+
+func (c Config) GetRequestTimeout(defaultTimeout time.Duration) time.Duration {
+	if c.RequestTimeout > 0 {
+		return c.RequestTimeout
+	}
+	return defaultTimeout
+}
+
+func (c Config) GetBrokerID(defaultID string) string {
+	if c.BrokerID != "" {
+		return c.BrokerID
+	}
+	return defaultID
+}
+
+// NewHTTPClient - the custom client or the new one
+// with the proxy, the local address & the request timeout
+func (c Config) NewHTTPClient(defaultTimeout time.Duration) *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return &http.Client{
+		Transport: c.NewTransport(),
+		Timeout:   c.GetRequestTimeout(defaultTimeout),
+	}
+}
+
+// NewTransport - the custom client transport or the new one
+// with the proxy & the local address
+func (c Config) NewTransport() http.RoundTripper {
+	if c.HTTPClient != nil && c.HTTPClient.Transport != nil {
+		return c.HTTPClient.Transport
+	}
+	if !c.isNetworkSet() {
+		return http.DefaultTransport
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if c.Proxy != nil {
+		transport.Proxy = http.ProxyURL(c.Proxy)
+	}
+	if c.LocalAddr != nil {
+		transport.DialContext = c.newNetDialer().DialContext
+	}
+	return transport
+}
+
+// NewWsDialer - websocket dialer with the proxy & the local address
+func (c Config) NewWsDialer() *websocket.Dialer {
+	if !c.isNetworkSet() {
+		return websocket.DefaultDialer
+	}
+
+	dialer := *websocket.DefaultDialer
+	if c.Proxy != nil {
+		dialer.Proxy = http.ProxyURL(c.Proxy)
+	}
+	if c.LocalAddr != nil {
+		dialer.NetDialContext = c.newNetDialer().DialContext
+	}
+	return &dialer
+}
+
+func (c Config) isNetworkSet() bool {
+	return c.Proxy != nil || c.LocalAddr != nil
+}
+
+func (c Config) newNetDialer() *net.Dialer {
+	return &net.Dialer{
+		LocalAddr: &net.TCPAddr{IP: c.LocalAddr},
+		Timeout:   time.Second * 30,
+		KeepAlive: time.Second * 30,
+	}
+}
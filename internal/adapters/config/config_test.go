@@ -0,0 +1,115 @@
+package config
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMainnetURL = "https://api.exchange.com"
+	testTestnetURL = "https://testnet.exchange.com"
+)
+
+func TestGetRESTBaseURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		expected string
+	}{
+		{name: "default", expected: testMainnetURL},
+		{name: "testnet", opts: []Option{WithTestnet()}, expected: testTestnetURL},
+		{
+			name:     "url set",
+			opts:     []Option{WithTestnet(), WithRESTBaseURL("http://127.0.0.1:8080")},
+			expected: "http://127.0.0.1:8080",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// when
+			baseURL := New(test.opts...).GetRESTBaseURL(testMainnetURL, testTestnetURL)
+
+			// then
+			assert.Equal(t, test.expected, baseURL)
+		})
+	}
+}
+
+func TestNewHTTPClientDefault(t *testing.T) {
+	// when
+	client := New().NewHTTPClient(time.Second)
+
+	// then
+	assert.Equal(t, time.Second, client.Timeout)
+	assert.Equal(t, http.DefaultTransport, client.Transport)
+}
+
+func TestNewHTTPClientCustom(t *testing.T) {
+	// given
+	custom := &http.Client{}
+
+	// when
+	client := New(
+		WithHTTPClient(custom),
+		WithRequestTimeout(time.Minute),
+	).NewHTTPClient(time.Second)
+
+	// then
+	assert.Same(t, custom, client)
+}
+
+func TestNewHTTPClientProxy(t *testing.T) {
+	// given
+	proxyURL, err := url.Parse("socks5://127.0.0.1:1080")
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodGet, testMainnetURL, nil)
+	require.NoError(t, err)
+
+	// when
+	client := New(
+		WithProxy(proxyURL),
+		WithLocalAddr(net.ParseIP("127.0.0.1")),
+		WithRequestTimeout(time.Minute),
+	).NewHTTPClient(time.Second)
+
+	// then
+	assert.Equal(t, time.Minute, client.Timeout)
+	transport, isHTTPTransport := client.Transport.(*http.Transport)
+	require.True(t, isHTTPTransport)
+	assert.NotNil(t, transport.DialContext)
+
+	requestProxy, err := transport.Proxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, proxyURL, requestProxy)
+}
+
+func TestNewWsDialer(t *testing.T) {
+	// given
+	proxyURL, err := url.Parse("http://127.0.0.1:3128")
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodGet, testMainnetURL, nil)
+	require.NoError(t, err)
+
+	// when
+	dialer := New(WithProxy(proxyURL)).NewWsDialer()
+
+	// then
+	assert.NotSame(t, websocket.DefaultDialer, dialer)
+	requestProxy, err := dialer.Proxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, proxyURL, requestProxy)
+	assert.Nil(t, websocket.DefaultDialer.NetDialContext)
+}
+
+func TestGetBrokerID(t *testing.T) {
+	assert.Equal(t, "default", New().GetBrokerID("default"))
+	assert.Equal(t, "custom", New(WithBrokerID("custom")).GetBrokerID("default"))
+}
@@ -0,0 +1,83 @@
+package feescache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+// fee rates depend on the account VIP level & change rarely
+const defaultTTL = time.Hour
+
+// CachedAdapter - adapter decorator that caches account trade fee rates.
+// Fee rates are account specific: use one decorator per connected adapter
+type CachedAdapter struct {
+	adapters.Adapter
+
+	ttl time.Duration
+	now func() time.Time
+
+	mu   sync.RWMutex
+	fees map[string]feesEntry // symbol -> fee rates
+}
+
+type feesEntry struct {
+	data      structs.TradeFees
+	updatedAt time.Time
+}
+
+// New wraps the adapter with fee rates cache. Default TTL is used when ttl is not positive
+func New(adapter adapters.Adapter, ttl time.Duration) *CachedAdapter {
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+
+	return &CachedAdapter{
+		Adapter: adapter,
+		ttl:     ttl,
+		now:     time.Now,
+		fees:    map[string]feesEntry{},
+	}
+}
+
+// GetTradeFees returns cached fee rates, the rates are requested when outdated
+func (a *CachedAdapter) GetTradeFees(pairSymbol string) (structs.TradeFees, error) {
+	a.mu.RLock()
+	entry, isExists := a.fees[pairSymbol]
+	a.mu.RUnlock()
+
+	if isExists && a.now().Sub(entry.updatedAt) < a.ttl {
+		return entry.data, nil
+	}
+
+	fees, err := a.Adapter.GetTradeFees(pairSymbol)
+	if err != nil {
+		return structs.TradeFees{}, err
+	}
+
+	a.mu.Lock()
+	a.fees[pairSymbol] = feesEntry{
+		data:      fees,
+		updatedAt: a.now(),
+	}
+	a.mu.Unlock()
+	return fees, nil
+}
+
+// Invalidate removes pair fee rates from cache
+func (a *CachedAdapter) Invalidate(pairSymbol string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.fees, pairSymbol)
+}
+
+// InvalidateAll clears cache, e.g. when the account VIP level changed
+func (a *CachedAdapter) InvalidateAll() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.fees = map[string]feesEntry{}
+}
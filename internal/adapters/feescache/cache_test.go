@@ -0,0 +1,93 @@
+package feescache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+const testPairSymbol = "LTCUSDT"
+
+func getTestFees() structs.TradeFees {
+	return structs.TradeFees{
+		Symbol: testPairSymbol,
+		Maker:  decimal.NewFromFloat(0.001),
+		Taker:  decimal.NewFromFloat(0.002),
+	}
+}
+
+func TestGetTradeFeesCached(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetTradeFees(testPairSymbol).Return(getTestFees(), nil).Times(1)
+
+	c := New(a, time.Minute)
+
+	// when
+	_, err := c.GetTradeFees(testPairSymbol)
+	require.NoError(t, err)
+	fees, err := c.GetTradeFees(testPairSymbol)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, getTestFees(), fees)
+}
+
+func TestGetTradeFeesExpired(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetTradeFees(testPairSymbol).Return(getTestFees(), nil).Times(2)
+
+	now := time.Now()
+	c := New(a, time.Minute)
+	c.now = func() time.Time { return now }
+
+	// when
+	_, err := c.GetTradeFees(testPairSymbol)
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	_, err = c.GetTradeFees(testPairSymbol)
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestGetTradeFeesInvalidate(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetTradeFees(testPairSymbol).Return(getTestFees(), nil).Times(2)
+
+	c := New(a, time.Minute)
+
+	// when
+	_, err := c.GetTradeFees(testPairSymbol)
+	require.NoError(t, err)
+	c.Invalidate(testPairSymbol)
+	_, err = c.GetTradeFees(testPairSymbol)
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestGetTradeFeesError(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetTradeFees(testPairSymbol).
+		Return(structs.TradeFees{}, errors.New("exchange unavailable"))
+
+	c := New(a, time.Minute)
+
+	// when
+	_, err := c.GetTradeFees(testPairSymbol)
+
+	// then
+	require.ErrorContains(t, err, "exchange unavailable")
+}
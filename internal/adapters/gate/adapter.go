@@ -2,6 +2,7 @@ package gate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,13 +11,14 @@ import (
 
 	adp "github.com/matrixbotio/exchange-gates-lib/internal/adapters"
 	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/gate/helpers/mappers"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
 	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
 	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
 	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
-	"github.com/matrixbotio/exchange-gates-lib/pkg/utils"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
 )
 
 const (
@@ -24,7 +26,13 @@ const (
 	clientOrderIDFormat = "t-%s"
 	spotAccountType     = "spot"
 	channelID           = "matrixbot"
+	restBaseURL         = "https://api.gateio.ws/api/v4"
+	restTestnetBaseURL  = "https://api-testnet.gateapi.io/api/v4"
 	requestTimeout      = time.Second * 15
+
+	// the trade permission is checked by cancelling a missing order
+	canTradeProbePair    = "BTC_USDT"
+	canTradeProbeOrderID = "0"
 )
 
 type adapter struct {
@@ -33,14 +41,29 @@ type adapter struct {
 	creds  pkgStructs.APICredentials
 	client *gateapi.APIClient
 	auth   context.Context
+	clock  *baseadp.ServerClock
+	// timeout - REST request timeout
+	timeout time.Duration
 
-	candleWorker GateCandleWorker
-	tradeWorker  GateTradeWorker
+	candleWorker      GateCandleWorker
+	tradeWorker       GateTradeWorker
+	publicTradeWorker GatePublicTradeWorker
 }
 
-func New() adp.Adapter {
+func New(opts ...config.Option) adp.Adapter {
+	adapterCfg := config.New(opts...)
+	clock := baseadp.NewServerClock()
+
+	// the request timeout is set by the context, see timeout
+	httpClient := *adapterCfg.NewHTTPClient(0)
+	httpClient.Transport = newSigningTransport(httpClient.Transport, clock)
+
 	cfg := gateapi.NewConfiguration()
-	cfg.AddDefaultHeader("X-Gate-Channel-Id", channelID)
+	cfg.BasePath = adapterCfg.GetRESTBaseURL(restBaseURL, restTestnetBaseURL)
+	cfg.AddDefaultHeader("X-Gate-Channel-Id", adapterCfg.GetBrokerID(channelID))
+	cfg.HTTPClient = &httpClient
+
+	wsURL := adapterCfg.GetWsBaseURL(wsBaseURL, wsTestnetBaseURL)
 
 	return &adapter{
 		AdapterBase: baseadp.NewAdapterBase(
@@ -48,7 +71,12 @@ func New() adp.Adapter {
 			adapterName,
 			consts.GateAdapterTag,
 		),
-		client: gateapi.NewAPIClient(cfg),
+		client:            gateapi.NewAPIClient(cfg),
+		clock:             clock,
+		timeout:           adapterCfg.GetRequestTimeout(requestTimeout),
+		candleWorker:      GateCandleWorker{wsURL: wsURL},
+		tradeWorker:       GateTradeWorker{wsURL: wsURL},
+		publicTradeWorker: GatePublicTradeWorker{wsURL: wsURL},
 	}
 }
 
@@ -75,24 +103,57 @@ func (a *adapter) Connect(credentials pkgStructs.APICredentials) error {
 	return nil
 }
 
-func (a *adapter) getUID() (int64, error) {
-	ctx, ctxCancel := context.WithTimeout(a.auth, requestTimeout)
+func (a *adapter) getAccountDetail() (gateapi.AccountDetail, error) {
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
 	defer ctxCancel()
 
 	data, _, err := a.client.AccountApi.GetAccountDetail(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("get account data: %w", err)
+		return gateapi.AccountDetail{}, fmt.Errorf("get account data: %w", err)
 	}
-	return data.UserId, nil
+	return data, nil
 }
 
+// CanTrade - gate API v4 doesn't report the key permissions, so a missing
+// order is cancelled: the order is not found when the key is able to trade
 func (a *adapter) CanTrade() (bool, error) {
-	if _, err := a.getUID(); err != nil {
-		return false, fmt.Errorf("uid: %w", err)
+	if !a.creds.Keypair.IsSet() {
+		return false, errs.ErrAPIKeyNotSet
+	}
+
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
+	defer ctxCancel()
+
+	_, _, err := a.client.SpotApi.CancelOrder(
+		ctx,
+		canTradeProbeOrderID,
+		canTradeProbePair,
+		nil,
+	)
+	if mappers.IsTradeForbiddenErr(err) {
+		return false, nil
+	}
+
+	err = mappers.MapCancelOrderErr(err)
+	if err != nil && !errors.Is(err, errs.ErrOrderNotFound) {
+		return false, fmt.Errorf("cancel order: %w", err)
 	}
 	return true, nil
 }
 
+// GetAPIKeyInfo - gate API v4 reports the key IP whitelist only
+func (a *adapter) GetAPIKeyInfo() (pkgStructs.APIKeyInfo, error) {
+	if !a.creds.Keypair.IsSet() {
+		return pkgStructs.APIKeyInfo{}, errs.ErrAPIKeyNotSet
+	}
+
+	data, err := a.getAccountDetail()
+	if err != nil {
+		return pkgStructs.APIKeyInfo{}, err
+	}
+	return mappers.ConvertAccountDetail(data), nil
+}
+
 func (a *adapter) VerifyAPIKeys(keyPublic, keySecret string) error {
 	if err := a.Connect(pkgStructs.APICredentials{
 		Type: pkgStructs.APICredentialsTypeKeypair,
@@ -113,7 +174,7 @@ func (a *adapter) GetAccountBalance() ([]structs.Balance, error) {
 		return nil, errs.ErrAPIKeyNotSet
 	}
 
-	ctx, ctxCancel := context.WithTimeout(a.auth, requestTimeout)
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
 	defer ctxCancel()
 
 	data, _, err := a.client.SpotApi.ListSpotAccounts(ctx, nil)
@@ -155,6 +216,10 @@ func (a *adapter) GetCandles(
 	return result, nil
 }
 
+func (a *adapter) GetSupportedIntervals() []consts.Interval {
+	return consts.FilterIntervals(mappers.IsIntervalSupported)
+}
+
 func (a *adapter) GetLimits() pkgStructs.ExchangeLimits {
 	return pkgStructs.ExchangeLimits{
 		MaxConnectionsPerBatch:   50,
@@ -162,3 +227,22 @@ func (a *adapter) GetLimits() pkgStructs.ExchangeLimits {
 		MaxTopicsPerWebsocket:    30,
 	}
 }
+
+// Capabilities - market orders & GetHistoryOrder are not implemented
+func (a *adapter) Capabilities() pkgStructs.AdapterCapabilities {
+	return pkgStructs.AdapterCapabilities{
+		OrderTypes:    []pkgStructs.OrderType{pkgStructs.OrderTypeLimit},
+		TimeInForce:   []pkgStructs.TimeInForce{pkgStructs.TimeInForceGTC},
+		BatchOrders:   false,
+		OrderHistory:  false,
+		AccountTrades: true,
+		FeeLookup:     true,
+		Intervals:     a.GetSupportedIntervals(),
+		Streams: []pkgStructs.StreamType{
+			pkgStructs.StreamCandles,
+			pkgStructs.StreamPublicTrades,
+			pkgStructs.StreamAccountTrades,
+		},
+		OrderIDFormat: pkgStructs.OrderIDFormatNumeric,
+	}
+}
@@ -0,0 +1,111 @@
+package gate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/gate/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+func TestGetAPIKeyInfoKeyNotSet(t *testing.T) {
+	// given
+	a := New()
+
+	// when
+	_, err := a.GetAPIKeyInfo()
+
+	// then
+	require.ErrorIs(t, err, errs.ErrAPIKeyNotSet)
+}
+
+func TestCanTrade(t *testing.T) {
+	tests := []struct {
+		name     string
+		label    string
+		message  string
+		expected bool
+	}{
+		{
+			name:     "trade permission",
+			label:    testLabelOrderNotFound,
+			message:  mappers.ErrOrderNotActualMessage,
+			expected: true,
+		},
+		{
+			name:     "read-only key",
+			label:    "READ_ONLY",
+			message:  "API key is read-only",
+			expected: false,
+		},
+		{
+			name:     "no spot permission",
+			label:    "FORBIDDEN",
+			message:  "No permission",
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// given
+			a := newTestCanTradeAdapter(t, func(w http.ResponseWriter, r *http.Request) {
+				writeTestError(w, test.label, test.message)
+			})
+
+			// when
+			canTrade, err := a.CanTrade()
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, canTrade)
+		})
+	}
+}
+
+func TestCanTradeInvalidKey(t *testing.T) {
+	// given
+	a := newTestCanTradeAdapter(t, func(w http.ResponseWriter, r *http.Request) {
+		writeTestError(w, "INVALID_KEY", "Invalid key provided")
+	})
+
+	// when
+	_, err := a.CanTrade()
+
+	// then
+	require.ErrorContains(t, err, "INVALID_KEY")
+}
+
+func TestCanTradeKeyNotSet(t *testing.T) {
+	// given
+	a := New()
+
+	// when
+	_, err := a.CanTrade()
+
+	// then
+	require.ErrorIs(t, err, errs.ErrAPIKeyNotSet)
+}
+
+func newTestCanTradeAdapter(t *testing.T, cancelHandler http.HandlerFunc) *adapter {
+	mux := http.NewServeMux()
+	mux.HandleFunc("DELETE /spot/orders/"+canTradeProbeOrderID, cancelHandler)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	a := New(config.WithRESTBaseURL(server.URL)).(*adapter)
+	require.NoError(t, a.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{
+			Public: "public",
+			Secret: "secret",
+		},
+	}))
+	return a
+}
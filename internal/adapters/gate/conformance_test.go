@@ -0,0 +1,184 @@
+package gate
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gateio/gateapi-go/v6"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/gate/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/adapter/adaptertest"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	testConformancePair = "BTC_USDT"
+
+	testLabelOrderNotFound = "ORDER_NOT_FOUND"
+)
+
+// our status -> gate order status
+var testOrderStatuses = map[consts.OrderStatus]string{
+	pkgStructs.OrderStatusNew:       "open",
+	pkgStructs.OrderStatusFilled:    "closed",
+	pkgStructs.OrderStatusCancelled: "cancelled",
+}
+
+// TestConformance - gate order text is not unique
+func TestConformance(t *testing.T) {
+	adaptertest.Run(t, adaptertest.Config{
+		NewHarness:               newTestHarness,
+		PairSymbol:               testConformancePair,
+		OrderQty:                 "0.01",
+		OrderPrice:               "60000.5",
+		IsClientOrderIDNotUnique: true,
+	})
+}
+
+func newTestHarness(t *testing.T) adaptertest.Harness {
+	exchange := adaptertest.NewExchange()
+	restServer := httptest.NewServer(newTestStandInHandler(exchange))
+	t.Cleanup(restServer.Close)
+	wsServer := adaptertest.NewWebsocketServer(t)
+
+	a := New(
+		config.WithRESTBaseURL(restServer.URL),
+		config.WithWsBaseURL(wsServer.URL()),
+	)
+	if err := a.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{
+			Public: "public",
+			Secret: "secret",
+		},
+	}); err != nil {
+		t.Fatalf("connect: %s", err)
+	}
+
+	return adaptertest.Harness{
+		Adapter:       a,
+		Exchange:      exchange,
+		WsConnections: wsServer.Connections,
+	}
+}
+
+// newTestStandInHandler - gate REST API over the stand-in exchange
+func newTestStandInHandler(exchange *adaptertest.Exchange) http.Handler {
+	mux := http.NewServeMux()
+
+	pair := gateapi.CurrencyPair{
+		Id:              testConformancePair,
+		Base:            "BTC",
+		Quote:           "USDT",
+		MinBaseAmount:   "0.00001",
+		MaxBaseAmount:   "10000",
+		MinQuoteAmount:  "3",
+		AmountPrecision: 5,
+		Precision:       1,
+		TradeStatus:     "tradable",
+	}
+	mux.HandleFunc("GET /spot/currency_pairs/{pair}", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, pair)
+	})
+	mux.HandleFunc("GET /spot/currency_pairs", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, []gateapi.CurrencyPair{pair})
+	})
+
+	mux.HandleFunc("POST /spot/orders", func(w http.ResponseWriter, r *http.Request) {
+		var request gateapi.Order
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		side, _ := mappers.ConvertOrderSide(request.Side)
+		order, err := exchange.PlaceOrder(adaptertest.Order{
+			ClientOrderID: request.Text,
+			PairSymbol:    request.CurrencyPair,
+			Side:          side,
+			IsMarket:      request.Type == "market",
+			Qty:           decimal.RequireFromString(request.Amount),
+			Price:         decimal.RequireFromString(request.Price),
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeTestJSON(w, http.StatusCreated, convertTestOrder(order))
+	})
+
+	mux.HandleFunc("GET /spot/orders/{id}", func(w http.ResponseWriter, r *http.Request) {
+		order, err := getTestOrder(exchange, r.PathValue("id"))
+		if err != nil {
+			writeTestError(w, testLabelOrderNotFound, mappers.ErrOrderNotActualMessage)
+			return
+		}
+		writeTestJSON(w, http.StatusOK, convertTestOrder(order))
+	})
+
+	// the finished orders are not found, like on the exchange
+	mux.HandleFunc("DELETE /spot/orders/{id}", func(w http.ResponseWriter, r *http.Request) {
+		order, err := getTestOrder(exchange, r.PathValue("id"))
+		if err == nil {
+			order, err = exchange.CancelOrder(order.ID)
+		}
+		if err != nil {
+			writeTestError(w, testLabelOrderNotFound, mappers.ErrOrderNotActualMessage)
+			return
+		}
+		writeTestJSON(w, http.StatusOK, convertTestOrder(order))
+	})
+	return mux
+}
+
+// getTestOrder - the order ID or the client one prefixed with t-
+func getTestOrder(exchange *adaptertest.Exchange, id string) (adaptertest.Order, error) {
+	if strings.HasPrefix(id, "t-") {
+		return exchange.GetOrderByClientOrderID(id)
+	}
+
+	orderID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return adaptertest.Order{}, err
+	}
+	return exchange.GetOrder(orderID)
+}
+
+func convertTestOrder(order adaptertest.Order) gateapi.Order {
+	orderType := orderTypeLimit
+	if order.IsMarket {
+		orderType = "market"
+	}
+
+	return gateapi.Order{
+		Id:           strconv.FormatInt(order.ID, 10),
+		Text:         order.ClientOrderID,
+		CurrencyPair: order.PairSymbol,
+		Status:       testOrderStatuses[order.Status],
+		Type:         orderType,
+		Account:      spotAccountType,
+		Side:         string(order.Side),
+		Amount:       order.Qty.String(),
+		Price:        order.Price.String(),
+		FilledAmount: order.FilledQty.String(),
+		CreateTimeMs: order.CreatedTime,
+		UpdateTimeMs: order.UpdatedTime,
+	}
+}
+
+func writeTestError(w http.ResponseWriter, label string, message string) {
+	writeTestJSON(w, http.StatusNotFound, gateapi.GateAPIError{Label: label, Message: message})
+}
+
+func writeTestJSON(w http.ResponseWriter, status int, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -25,6 +25,13 @@ func getOrderSubsPayload() gateSubsPayload {
 	}
 }
 
+func getPublicTradesSubsPayload(pairSymbol string) gateSubsPayload {
+	return gateSubsPayload{
+		Channel: gatePublicTradeChannel,
+		Payload: []string{pairSymbol},
+	}
+}
+
 type gateUnsubscriber struct {
 	srv  *gate.WsService
 	data gateSubsPayload
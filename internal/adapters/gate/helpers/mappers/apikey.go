@@ -0,0 +1,17 @@
+package mappers
+
+import (
+	"github.com/gateio/gateapi-go/v6"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+// ConvertAccountDetail - the key permissions aren't reported
+func ConvertAccountDetail(data gateapi.AccountDetail) pkgStructs.APIKeyInfo {
+	return pkgStructs.APIKeyInfo{
+		Unknown:        consts.GetAPIKeyPermissions(),
+		IsIPRestricted: len(data.IpWhitelist) > 0,
+		IPWhitelist:    data.IpWhitelist,
+	}
+}
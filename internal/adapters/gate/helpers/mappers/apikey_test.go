@@ -0,0 +1,34 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/gateio/gateapi-go/v6"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertAccountDetail(t *testing.T) {
+	// given
+	data := gateapi.AccountDetail{IpWhitelist: []string{"1.2.3.4"}}
+
+	// when
+	info := ConvertAccountDetail(data)
+
+	// then
+	assert.Empty(t, info.Permissions)
+	assert.Equal(t, consts.GetAPIKeyPermissions(), info.Unknown)
+	assert.False(t, info.IsReadOnly)
+	assert.True(t, info.IsIPRestricted)
+	assert.Equal(t, []string{"1.2.3.4"}, info.IPWhitelist)
+}
+
+func TestConvertAccountDetailNoIPWhitelist(t *testing.T) {
+	// when
+	info := ConvertAccountDetail(gateapi.AccountDetail{})
+
+	// then
+	assert.False(t, info.IsIPRestricted)
+	assert.Empty(t, info.IPWhitelist)
+}
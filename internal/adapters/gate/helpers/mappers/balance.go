@@ -2,6 +2,7 @@ package mappers
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/gateio/gateapi-go/v6"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
@@ -34,7 +35,47 @@ func parseAssetBalance(balance gateapi.SpotAccount) (structs.Balance, error) {
 
 	return structs.Balance{
 		Asset:  balance.Currency,
-		Free:   assetFree.InexactFloat64(),
-		Locked: assetLocked.InexactFloat64(),
+		Free:   assetFree,
+		Locked: assetLocked,
 	}, nil
 }
+
+func ConvertFuturesBalance(account gateapi.FuturesAccount) ([]structs.Balance, error) {
+	total, err := decimal.NewFromString(account.Total)
+	if err != nil {
+		return nil, fmt.Errorf("total: %w", err)
+	}
+
+	available, err := decimal.NewFromString(account.Available)
+	if err != nil {
+		return nil, fmt.Errorf("available: %w", err)
+	}
+
+	return []structs.Balance{{
+		Asset:  strings.ToUpper(account.Currency),
+		Free:   available,
+		Locked: total.Sub(available),
+	}}, nil
+}
+
+func ConvertCrossMarginBalances(account gateapi.CrossMarginAccount) ([]structs.Balance, error) {
+	r := []structs.Balance{}
+	for asset, data := range account.Balances {
+		available, err := decimal.NewFromString(data.Available)
+		if err != nil {
+			return nil, fmt.Errorf("%s available: %w", asset, err)
+		}
+
+		freeze, err := decimal.NewFromString(data.Freeze)
+		if err != nil {
+			return nil, fmt.Errorf("%s freeze: %w", asset, err)
+		}
+
+		r = append(r, structs.Balance{
+			Asset:  asset,
+			Free:   available,
+			Locked: freeze,
+		})
+	}
+	return r, nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	gate "github.com/gateio/gatews/go"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
@@ -75,17 +76,25 @@ func ConvertCandle(rawData []string, interval consts.Interval) (workers.CandleDa
 		return workers.CandleData{}, fmt.Errorf("parse low price: %w", err)
 	}
 
+	startTime := timestampSeconds * 1000
 	return workers.CandleData{
-		EndTime:  timestampSeconds * 1000,
-		Interval: interval,
-		Open:     priceOpen.InexactFloat64(),
-		Close:    priceClose.InexactFloat64(),
-		High:     priceHigh.InexactFloat64(),
-		Low:      priceLow.InexactFloat64(),
-		Volume:   baseVolume.InexactFloat64(),
+		StartTime: startTime,
+		EndTime:   getCandleEndTime(startTime, interval),
+		Interval:  interval,
+		Open:      priceOpen,
+		Close:     priceClose,
+		High:      priceHigh,
+		Low:       priceLow,
+		Volume:    baseVolume,
 	}, nil
 }
 
+// getCandleEndTime - the last ms of the candle, gate sends the start time only
+func getCandleEndTime(startTimeMs int64, interval consts.Interval) int64 {
+	nextStart := workers.GetNextCandleStartTime(interval, time.UnixMilli(startTimeMs).UTC())
+	return nextStart.UnixMilli() - 1
+}
+
 func ParseCandleEvent(
 	event gate.SpotCandleUpdateMsg,
 	pairSymbol string,
@@ -121,18 +130,20 @@ func ParseCandleEvent(
 		return workers.CandleEvent{}, fmt.Errorf("parse volume: %w", err)
 	}
 
+	startTime := timestampSeconds * 1000
 	return workers.CandleEvent{
 		Symbol: pairSymbol,
 		Candle: workers.CandleData{
-			EndTime:  timestampSeconds,
-			Interval: interval,
-			Open:     priceOpen.InexactFloat64(),
-			Close:    priceClose.InexactFloat64(),
-			High:     priceHigh.InexactFloat64(),
-			Low:      priceLow.InexactFloat64(),
-			Volume:   baseVolume.InexactFloat64(),
+			StartTime: startTime,
+			EndTime:   getCandleEndTime(startTime, interval),
+			Interval:  interval,
+			Open:      priceOpen,
+			Close:     priceClose,
+			High:      priceHigh,
+			Low:       priceLow,
+			Volume:    baseVolume,
 		},
-		Time:       timestampSeconds,
+		Time:       startTime,
 		IsFinished: event.WindowClose,
 	}, nil
 }
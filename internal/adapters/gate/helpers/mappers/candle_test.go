@@ -0,0 +1,75 @@
+package mappers
+
+import (
+	"testing"
+
+	gate "github.com/gateio/gatews/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertCandle(t *testing.T) {
+	// given
+	rawData := []string{"1700000040", "20", "2.5", "3", "1", "2", "10", "true"}
+
+	// when
+	result, err := ConvertCandle(rawData, consts.Interval1min)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, int64(1700000040000), result.StartTime)
+	assert.Equal(t, int64(1700000099999), result.EndTime)
+	assert.Equal(t, "2", result.Open.String())
+	assert.Equal(t, "2.5", result.Close.String())
+	assert.Equal(t, "10", result.Volume.String())
+}
+
+func TestConvertCandleMonth(t *testing.T) {
+	// given
+	// 2024-02-01 00:00:00 UTC
+	rawData := []string{"1706745600", "20", "2.5", "3", "1", "2", "10", "true"}
+
+	// when
+	result, err := ConvertCandle(rawData, consts.Interval1month)
+
+	// then
+	require.NoError(t, err)
+	// 2024-03-01 00:00:00 UTC - 1ms
+	assert.Equal(t, int64(1709251199999), result.EndTime)
+}
+
+func TestConvertCandleInvalidLen(t *testing.T) {
+	// when
+	_, err := ConvertCandle([]string{"1700000040"}, consts.Interval1min)
+
+	// then
+	require.Error(t, err)
+}
+
+func TestParseCandleEvent(t *testing.T) {
+	// given
+	event := gate.SpotCandleUpdateMsg{
+		Time:        "1700000040",
+		Volume:      "10",
+		Close:       "2.5",
+		High:        "3",
+		Low:         "1",
+		Open:        "2",
+		Name:        "1m_BTC_USDT",
+		WindowClose: true,
+	}
+
+	// when
+	result, err := ParseCandleEvent(event, "BTC_USDT", consts.Interval1min)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "BTC_USDT", result.Symbol)
+	assert.Equal(t, int64(1700000040000), result.Candle.StartTime)
+	assert.Equal(t, int64(1700000099999), result.Candle.EndTime)
+	assert.Equal(t, int64(1700000040000), result.Time)
+	assert.Equal(t, "3", result.Candle.High.String())
+	assert.True(t, result.IsFinished)
+}
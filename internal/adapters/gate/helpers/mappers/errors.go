@@ -1,12 +1,19 @@
 package mappers
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
 )
 
-const ErrOrderNotActualMessage = "Order not found"
+const (
+	ErrOrderNotActualMessage = "Order not found"
+	errBalanceNotEnoughLabel = "BALANCE_NOT_ENOUGH"
+	errBorrowTooMuchLabel    = "BORROW_TOO_MUCH"
+	errReadOnlyLabel         = "READ_ONLY"
+	errForbiddenLabel        = "FORBIDDEN"
+)
 
 func MapCancelOrderErr(err error) error {
 	if err == nil {
@@ -18,3 +25,36 @@ func MapCancelOrderErr(err error) error {
 	}
 	return err
 }
+
+// IsTradeForbiddenErr - the key is read-only or lacks the spot trade permission
+func IsTradeForbiddenErr(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return strings.Contains(err.Error(), errReadOnlyLabel) ||
+		strings.Contains(err.Error(), errForbiddenLabel)
+}
+
+func MapTransferErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if strings.Contains(err.Error(), errBalanceNotEnoughLabel) {
+		return fmt.Errorf("%w: %s", errs.ErrInsufficientBalance, err.Error())
+	}
+	return err
+}
+
+// MapMarginLoanErr - borrow & repay errors: insufficient balance or the borrow limit
+func MapMarginLoanErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if strings.Contains(err.Error(), errBorrowTooMuchLabel) {
+		return fmt.Errorf("%w: %s", errs.ErrTransferLimitExceeded, err.Error())
+	}
+	return MapTransferErr(err)
+}
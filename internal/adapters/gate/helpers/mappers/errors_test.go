@@ -0,0 +1,47 @@
+package mappers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gateio/gateapi-go/v6"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+func TestMapMarginLoanErr(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected error
+	}{
+		{
+			name:     "insufficient balance",
+			err:      gateapi.GateAPIError{Label: "BALANCE_NOT_ENOUGH"},
+			expected: errs.ErrInsufficientBalance,
+		},
+		{
+			name:     "borrow limit",
+			err:      gateapi.GateAPIError{Label: "BORROW_TOO_MUCH"},
+			expected: errs.ErrTransferLimitExceeded,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.ErrorIs(t, MapMarginLoanErr(test.err), test.expected)
+		})
+	}
+}
+
+func TestMapMarginLoanErrOther(t *testing.T) {
+	// given
+	err := errors.New("timeout")
+
+	// when
+	mappedErr := MapMarginLoanErr(err)
+
+	// then
+	require.Equal(t, err, mappedErr)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
 )
 
 var intervalGateToOur = map[string]consts.Interval{
@@ -14,8 +15,11 @@ var intervalGateToOur = map[string]consts.Interval{
 	"1h":  consts.Interval1hour,
 	"4h":  consts.Interval4hour,
 	"6h":  consts.Interval6hour,
+	"8h":  consts.Interval8hour,
 	"12h": consts.Interval12hour,
 	"1d":  consts.Interval1day,
+	"7d":  consts.Interval1week,
+	"30d": consts.Interval1month, // calendar month
 }
 
 var ourIntervalToGate = func() map[consts.Interval]string {
@@ -29,7 +33,7 @@ var ourIntervalToGate = func() map[consts.Interval]string {
 func ConvertIntervalToGate(interval consts.Interval) (string, error) {
 	result, isExists := ourIntervalToGate[interval]
 	if !isExists {
-		return "", fmt.Errorf("unknown interval: %q", interval)
+		return "", &errs.NotSupportedError{Feature: fmt.Sprintf("interval %q", interval)}
 	}
 
 	return result, nil
@@ -43,3 +47,8 @@ func ConvertGateInterval(interval string) (consts.Interval, error) {
 
 	return result, nil
 }
+
+func IsIntervalSupported(interval consts.Interval) bool {
+	_, isExists := ourIntervalToGate[interval]
+	return isExists
+}
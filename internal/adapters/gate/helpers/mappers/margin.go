@@ -0,0 +1,121 @@
+package mappers
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gateio/gateapi-go/v6"
+	"github.com/shopspring/decimal"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+// gate margin risk rate levels: liquidation is triggered below 110%
+var (
+	marginCallLevel  = decimal.NewFromFloat(1.3)
+	liquidationLevel = decimal.NewFromFloat(1.1)
+)
+
+func ConvertCrossMarginAccount(account gateapi.CrossMarginAccount) (structs.MarginAccount, error) {
+	marginLevel, err := parseMarginRisk(account.Risk)
+	if err != nil {
+		return structs.MarginAccount{}, fmt.Errorf("risk: %w", err)
+	}
+
+	result := structs.MarginAccount{
+		Mode:        consts.MarginModeCross,
+		MarginLevel: marginLevel,
+		Risk:        baseadp.GetMarginRisk(marginLevel, marginCallLevel, liquidationLevel),
+	}
+
+	for asset, data := range account.Balances {
+		balance, err := convertMarginBalance(
+			asset, data.Available, data.Freeze, data.Borrowed, data.Interest,
+		)
+		if err != nil {
+			return structs.MarginAccount{}, err
+		}
+
+		if balance.Free.IsZero() && balance.Locked.IsZero() && balance.Borrowed.IsZero() {
+			continue
+		}
+		result.Balances = append(result.Balances, balance)
+	}
+
+	sort.Slice(result.Balances, func(i, j int) bool {
+		return result.Balances[i].Asset < result.Balances[j].Asset
+	})
+	return result, nil
+}
+
+func ConvertIsolatedMarginAccount(account gateapi.MarginAccount) (structs.MarginAccount, error) {
+	marginLevel, err := parseMarginRisk(account.Risk)
+	if err != nil {
+		return structs.MarginAccount{}, fmt.Errorf("risk: %w", err)
+	}
+
+	result := structs.MarginAccount{
+		Mode:        consts.MarginModeIsolated,
+		PairSymbol:  account.CurrencyPair,
+		MarginLevel: marginLevel,
+		Risk:        baseadp.GetMarginRisk(marginLevel, marginCallLevel, liquidationLevel),
+	}
+
+	for _, data := range []gateapi.MarginAccountCurrency{account.Base, account.Quote} {
+		balance, err := convertMarginBalance(
+			data.Currency, data.Available, data.Locked, data.Borrowed, data.Interest,
+		)
+		if err != nil {
+			return structs.MarginAccount{}, err
+		}
+		result.Balances = append(result.Balances, balance)
+	}
+	return result, nil
+}
+
+// parseMarginRisk - gate risk rate: total / (borrowed + interest), empty when there are no loans
+func parseMarginRisk(risk string) (decimal.Decimal, error) {
+	return parseOptionalDecimal(risk)
+}
+
+func convertMarginBalance(
+	asset, available, locked, borrowed, interest string,
+) (structs.MarginBalance, error) {
+	assetFree, err := parseOptionalDecimal(available)
+	if err != nil {
+		return structs.MarginBalance{}, fmt.Errorf("%s available: %w", asset, err)
+	}
+
+	assetLocked, err := parseOptionalDecimal(locked)
+	if err != nil {
+		return structs.MarginBalance{}, fmt.Errorf("%s locked: %w", asset, err)
+	}
+
+	assetBorrowed, err := parseOptionalDecimal(borrowed)
+	if err != nil {
+		return structs.MarginBalance{}, fmt.Errorf("%s borrowed: %w", asset, err)
+	}
+
+	assetInterest, err := parseOptionalDecimal(interest)
+	if err != nil {
+		return structs.MarginBalance{}, fmt.Errorf("%s interest: %w", asset, err)
+	}
+
+	return structs.MarginBalance{
+		Asset:    asset,
+		Free:     assetFree,
+		Locked:   assetLocked,
+		Borrowed: assetBorrowed,
+		Interest: assetInterest,
+		NetAsset: assetFree.Add(assetLocked).Sub(assetBorrowed).Sub(assetInterest),
+	}, nil
+}
+
+func parseOptionalDecimal(value string) (decimal.Decimal, error) {
+	if value == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(value)
+}
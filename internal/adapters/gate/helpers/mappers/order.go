@@ -60,9 +60,69 @@ func GetOrderFees(
 	if order.FeeCurrency == quoteTicker {
 		fees.QuoteAsset = feeValue
 	}
+
+	price := decimal.Zero
+	if order.AvgDealPrice != "" {
+		price, err = decimal.NewFromString(order.AvgDealPrice)
+		if err != nil {
+			return structs.OrderFees{}, fmt.Errorf("parse avg price: %w", err)
+		}
+	}
+
+	points, err := parseOptionalFee(order.PointFee)
+	if err != nil {
+		return structs.OrderFees{}, fmt.Errorf("parse %s fee: %w", pointFeeAsset, err)
+	}
+	fees.Points = points
+
+	commissions, err := parseCommissions(order.Fee, order.FeeCurrency, order.GtFee)
+	if err != nil {
+		return structs.OrderFees{}, err
+	}
+	for _, commission := range commissions {
+		commission.TradeID = order.Id
+		commission.Price = price
+		commission.Time = order.UpdateTimeMs
+		fees.Commissions = append(fees.Commissions, commission)
+	}
 	return fees, nil
 }
 
+// parseCommissions - non-zero fees of the order or trade: asset & amount.
+// GT deduction fee is paid in addition to the fee, unless the fee
+// is already charged in GT. Points are not commissions
+func parseCommissions(fee, feeCurrency, gtFee string) ([]structs.Commission, error) {
+	fees := []gateFee{{value: fee, asset: feeCurrency}}
+	if feeCurrency != gtTicker {
+		fees = append(fees, gateFee{value: gtFee, asset: gtTicker})
+	}
+
+	var commissions []structs.Commission
+	for _, fee := range fees {
+		amount, err := parseOptionalFee(fee.value)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s fee: %w", fee.asset, err)
+		}
+		if amount.IsZero() {
+			continue
+		}
+
+		commissions = append(commissions, structs.Commission{
+			Asset:  fee.asset,
+			Amount: amount,
+		})
+	}
+	return commissions, nil
+}
+
+// parseOptionalFee - gate omits the fees which are not charged
+func parseOptionalFee(value string) (decimal.Decimal, error) {
+	if value == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(value)
+}
+
 func ParseTimestamp(rawTs string) int64 {
 	timestampRaw, err := strconv.ParseFloat(rawTs, 64)
 	if err != nil {
@@ -93,8 +153,8 @@ func ParseOrderEvent(event gate.SpotUserTradesMsg) (
 		Symbol:        event.CurrencyPair,
 		OrderID:       event.OrderId,
 		ClientOrderID: event.Text,
-		Price:         price.InexactFloat64(),
-		Quantity:      qty.InexactFloat64(),
+		Price:         price,
+		Quantity:      qty,
 	}, nil
 }
 
@@ -160,3 +220,21 @@ Amount string `json:"amount,omitempty"`
 // Order price
 Price string `json:"price,omitempty"`
 */
+
+func ConvertTradeFees(pairSymbol string, fee gateapi.TradeFee) (structs.TradeFees, error) {
+	maker, err := decimal.NewFromString(fee.MakerFee)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("parse maker fee: %w", err)
+	}
+
+	taker, err := decimal.NewFromString(fee.TakerFee)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("parse taker fee: %w", err)
+	}
+
+	return structs.TradeFees{
+		Symbol: pairSymbol,
+		Maker:  maker,
+		Taker:  taker,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/gateio/gateapi-go/v6"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
+)
+
+func TestGetOrderFees(t *testing.T) {
+	tests := []struct {
+		name            string
+		order           gateapi.Order
+		wantBase        string
+		wantQuote       string
+		wantPoints      string
+		wantCommissions []structs.Commission
+	}{
+		{
+			name: "quote fee",
+			order: gateapi.Order{
+				Id:           "1",
+				Fee:          "0.1",
+				FeeCurrency:  "USDT",
+				AvgDealPrice: "100",
+				UpdateTimeMs: 1700000000000,
+			},
+			wantBase:   "0",
+			wantQuote:  "0.1",
+			wantPoints: "0",
+			wantCommissions: []structs.Commission{{
+				TradeID: "1",
+				Asset:   "USDT",
+				Amount:  decimal.RequireFromString("0.1"),
+				Price:   decimal.RequireFromString("100"),
+				Time:    1700000000000,
+			}},
+		},
+		{
+			name: "fee in GT not counted twice",
+			order: gateapi.Order{
+				Id:           "1",
+				Fee:          "0.02",
+				FeeCurrency:  "GT",
+				GtFee:        "0.02",
+				AvgDealPrice: "100",
+				UpdateTimeMs: 1700000000000,
+			},
+			wantBase:   "0",
+			wantQuote:  "0",
+			wantPoints: "0",
+			wantCommissions: []structs.Commission{{
+				TradeID: "1",
+				Asset:   "GT",
+				Amount:  decimal.RequireFromString("0.02"),
+				Price:   decimal.RequireFromString("100"),
+				Time:    1700000000000,
+			}},
+		},
+		{
+			name: "GT deduction in addition to the fee",
+			order: gateapi.Order{
+				Id:           "1",
+				Fee:          "0",
+				FeeCurrency:  "BTC",
+				GtFee:        "0.02",
+				AvgDealPrice: "100",
+				UpdateTimeMs: 1700000000000,
+			},
+			wantBase:   "0",
+			wantQuote:  "0",
+			wantPoints: "0",
+			wantCommissions: []structs.Commission{{
+				TradeID: "1",
+				Asset:   "GT",
+				Amount:  decimal.RequireFromString("0.02"),
+				Price:   decimal.RequireFromString("100"),
+				Time:    1700000000000,
+			}},
+		},
+		{
+			name: "points are not commissions",
+			order: gateapi.Order{
+				Id:           "1",
+				Fee:          "0",
+				FeeCurrency:  "USDT",
+				PointFee:     "0.5",
+				AvgDealPrice: "100",
+			},
+			wantBase:   "0",
+			wantQuote:  "0",
+			wantPoints: "0.5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			fees, err := GetOrderFees(tt.order, "BTC", "USDT")
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBase, fees.BaseAsset.String())
+			assert.Equal(t, tt.wantQuote, fees.QuoteAsset.String())
+			assert.Equal(t, tt.wantPoints, fees.Points.String())
+			assert.Equal(t, tt.wantCommissions, fees.Commissions)
+		})
+	}
+}
+
+func TestGetOrderFeesInvalidPointFee(t *testing.T) {
+	// given
+	order := gateapi.Order{Fee: "0", FeeCurrency: "USDT", PointFee: "x"}
+
+	// when
+	_, err := GetOrderFees(order, "BTC", "USDT")
+
+	// then
+	require.Error(t, err)
+}
+
+func TestGetOrderFeesPointsInQuote(t *testing.T) {
+	// given
+	order := gateapi.Order{
+		Fee:          "0.1",
+		FeeCurrency:  "USDT",
+		PointFee:     "0.5",
+		AvgDealPrice: "100",
+	}
+	fees, err := GetOrderFees(order, "BTC", "USDT")
+	require.NoError(t, err)
+
+	// when
+	result, err := utils.GetFeesInQuote(fees, "BTC", "USDT", nil)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.1", result.String())
+}
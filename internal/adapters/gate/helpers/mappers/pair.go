@@ -6,7 +6,7 @@ import (
 	"github.com/gateio/gateapi-go/v6"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
-	"github.com/matrixbotio/exchange-gates-lib/pkg/utils"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
 	"github.com/shopspring/decimal"
 )
 
@@ -54,17 +54,17 @@ func ConvertPair(
 		return structs.ExchangePairData{},
 			fmt.Errorf("parse min qty: %w", err)
 	}
-	r.MinQty = minQty.InexactFloat64()
+	r.MinQty = minQty
 
 	if data.MaxBaseAmount == "" {
-		r.MaxQty = defaultMaxQty
+		r.MaxQty = decimal.NewFromInt(defaultMaxQty)
 	} else {
 		maxQty, err := decimal.NewFromString(data.MaxBaseAmount)
 		if err != nil {
 			return structs.ExchangePairData{},
 				fmt.Errorf("parse max qty: %w", err)
 		}
-		r.MaxQty = maxQty.InexactFloat64()
+		r.MaxQty = maxQty
 	}
 
 	minAmount, err := decimal.NewFromString(data.MinQuoteAmount)
@@ -72,11 +72,10 @@ func ConvertPair(
 		return structs.ExchangePairData{},
 			fmt.Errorf("parse min amount: %w", err)
 	}
-	r.MinDeposit = minAmount.InexactFloat64()
+	r.MinDeposit = minAmount
 	r.OriginalMinDeposit = r.MinDeposit
 
-	minQtyPrecision := utils.GetFloatPrecision(minQty.InexactFloat64())
-	r.QtyStep = getValueStep(int32(minQtyPrecision))
+	r.QtyStep = utils.GetDecimalValueStep(minQty)
 
 	r.PriceStep = getValueStep(data.Precision)
 	r.MinPrice = r.PriceStep
@@ -84,12 +83,9 @@ func ConvertPair(
 	return r, nil
 }
 
-func getValueStep(precision int32) float64 {
+func getValueStep(precision int32) decimal.Decimal {
 	// 1 / 10^precision
-	return decimal.NewFromInt(1).
-		Div(decimal.NewFromInt(10).
-			Pow(decimal.NewFromInt32(precision)),
-		).InexactFloat64()
+	return decimal.New(1, -precision)
 }
 
 func parsePairStatus(gateStatus string) string {
@@ -0,0 +1,113 @@
+package mappers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gateio/gateapi-go/v6"
+	gate "github.com/gateio/gatews/go"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	gateMakerRole = "maker"
+	gtTicker      = "GT"
+	pointFeeAsset = "POINT"
+)
+
+// gateFee - raw fee value & the asset it's paid in
+type gateFee struct {
+	value string
+	asset string
+}
+
+func ParsePublicTradeEvent(event gate.SpotTradeMsg) (workers.PublicTradeEvent, error) {
+	price, err := decimal.NewFromString(event.Price)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("price: %w", err)
+	}
+
+	qty, err := decimal.NewFromString(event.Amount)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("qty: %w", err)
+	}
+
+	// gate trade side is the taker side
+	takerSide, err := ConvertOrderSide(event.Side)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("side: %w", err)
+	}
+
+	return workers.PublicTradeEvent{
+		ID:          strconv.FormatUint(event.Id, 10),
+		Time:        ParseTimestamp(event.CreateTimeMs),
+		ExchangeTag: consts.GateAdapterTag,
+		Symbol:      event.CurrencyPair,
+		Price:       price,
+		Quantity:    qty,
+		TakerSide:   takerSide,
+	}, nil
+}
+
+func ConvertAccountTrade(trade gateapi.Trade) (structs.AccountTrade, error) {
+	orderID, err := strconv.ParseInt(trade.OrderId, 10, 64)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("order id: %w", err)
+	}
+
+	side, err := ConvertOrderSide(trade.Side)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("side: %w", err)
+	}
+
+	price, err := decimal.NewFromString(trade.Price)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("price: %w", err)
+	}
+
+	qty, err := decimal.NewFromString(trade.Amount)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("qty: %w", err)
+	}
+
+	commissions, err := parseCommissions(trade.Fee, trade.FeeCurrency, trade.GtFee)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("fee: %w", err)
+	}
+
+	points, err := parseOptionalFee(trade.PointFee)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("%s fee: %w", pointFeeAsset, err)
+	}
+
+	result := structs.AccountTrade{
+		ID:            trade.Id,
+		OrderID:       orderID,
+		ClientOrderID: trade.Text,
+		Symbol:        trade.CurrencyPair,
+		Side:          side,
+		Price:         price,
+		Qty:           qty,
+		Fee:           decimal.Zero,
+		FeeAsset:      trade.FeeCurrency,
+		Points:        points,
+		IsMaker:       trade.Role == gateMakerRole,
+		Time:          ParseTimestamp(trade.CreateTimeMs),
+	}
+
+	for _, commission := range commissions {
+		commission.TradeID = trade.Id
+		commission.Price = price
+		commission.Time = result.Time
+		result.Commissions = append(result.Commissions, commission)
+	}
+	// the fee currency fee comes first, the GT deduction is the only one otherwise
+	if len(result.Commissions) > 0 {
+		result.Fee = result.Commissions[0].Amount
+		result.FeeAsset = result.Commissions[0].Asset
+	}
+	return result, nil
+}
@@ -0,0 +1,112 @@
+package mappers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gateio/gateapi-go/v6"
+	gate "github.com/gateio/gatews/go"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestParsePublicTradeEvent(t *testing.T) {
+	// given
+	payload := `{
+		"id": 309143071,
+		"create_time": 1700000000,
+		"create_time_ms": "1700000000123.456",
+		"side": "sell",
+		"currency_pair": "BTC_USDT",
+		"amount": "0.0015",
+		"price": "60000.12"
+	}`
+	var event gate.SpotTradeMsg
+	require.NoError(t, json.Unmarshal([]byte(payload), &event))
+
+	// when
+	result, err := ParsePublicTradeEvent(event)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "309143071", result.ID)
+	assert.Equal(t, int64(1700000000123), result.Time)
+	assert.Equal(t, consts.GateAdapterTag, result.ExchangeTag)
+	assert.Equal(t, "BTC_USDT", result.Symbol)
+	assert.Equal(t, "60000.12", result.Price.String())
+	assert.Equal(t, "0.0015", result.Quantity.String())
+	assert.Equal(t, consts.OrderSideSell, result.TakerSide)
+}
+
+func TestParsePublicTradeEventInvalidPrice(t *testing.T) {
+	// given
+	event := gate.SpotTradeMsg{Side: "buy", Amount: "1", Price: "invalid"}
+
+	// when
+	_, err := ParsePublicTradeEvent(event)
+
+	// then
+	require.Error(t, err)
+}
+
+func TestConvertAccountTradeFeeWithGTDeduction(t *testing.T) {
+	// given
+	trade := gateapi.Trade{
+		Id:           "10",
+		CreateTimeMs: "1700000000000",
+		CurrencyPair: "BTC_USDT",
+		Side:         "buy",
+		Role:         "maker",
+		Amount:       "0.1",
+		Price:        "100",
+		OrderId:      "1",
+		Fee:          "0.0001",
+		FeeCurrency:  "BTC",
+		GtFee:        "0.02",
+		PointFee:     "0.5",
+	}
+
+	// when
+	result, err := ConvertAccountTrade(trade)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.0001", result.Fee.String())
+	assert.Equal(t, "BTC", result.FeeAsset)
+	assert.Equal(t, "0.5", result.Points.String())
+	require.Len(t, result.Commissions, 2)
+	assert.Equal(t, "BTC", result.Commissions[0].Asset)
+	assert.Equal(t, "GT", result.Commissions[1].Asset)
+	assert.Equal(t, "0.02", result.Commissions[1].Amount.String())
+	assert.Equal(t, "10", result.Commissions[1].TradeID)
+	assert.True(t, result.Commissions[1].Price.Equal(decimal.NewFromInt(100)))
+	assert.Equal(t, int64(1700000000000), result.Commissions[1].Time)
+}
+
+func TestConvertAccountTradePointsOnly(t *testing.T) {
+	// given
+	trade := gateapi.Trade{
+		Id:           "10",
+		CreateTimeMs: "1700000000000",
+		Side:         "sell",
+		Amount:       "0.1",
+		Price:        "100",
+		OrderId:      "1",
+		Fee:          "0",
+		FeeCurrency:  "USDT",
+		PointFee:     "0.5",
+	}
+
+	// when
+	result, err := ConvertAccountTrade(trade)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, result.Fee.IsZero())
+	assert.Equal(t, "USDT", result.FeeAsset)
+	assert.Equal(t, "0.5", result.Points.String())
+	assert.Empty(t, result.Commissions)
+}
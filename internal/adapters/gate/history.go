@@ -0,0 +1,103 @@
+package gate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/antihax/optional"
+	"github.com/gateio/gateapi-go/v6"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/gate/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	tradesHistoryMaxWindow = time.Hour * 24 * 30
+	tradesHistoryPageLimit = 1000
+)
+
+func (a *adapter) GetAccountTrades(
+	task structs.GetOrdersHistoryTask,
+) ([]structs.AccountTrade, error) {
+	if !a.creds.Keypair.IsSet() {
+		return nil, errs.ErrAPIKeyNotSet
+	}
+
+	windows, err := baseadp.SplitHistoryTask(task, tradesHistoryMaxWindow)
+	if err != nil {
+		return nil, fmt.Errorf("check task: %w", err)
+	}
+
+	// keep the task cancellation & the adapter auth
+	ctx := context.WithValue(
+		baseadp.GetHistoryTaskContext(task),
+		gateapi.ContextGateAPIV4,
+		a.auth.Value(gateapi.ContextGateAPIV4),
+	)
+
+	var result []structs.AccountTrade
+	for _, window := range windows {
+		windowTrades, err := a.getWindowTrades(ctx, task.PairSymbol, window)
+		if err != nil {
+			return nil, fmt.Errorf("get trades: %w", err)
+		}
+		result = append(result, windowTrades...)
+	}
+	return baseadp.SortAccountTrades(result), nil
+}
+
+func (a *adapter) getWindowTrades(
+	ctx context.Context,
+	pairSymbol string,
+	window baseadp.TimeWindow,
+) ([]structs.AccountTrade, error) {
+	var result []structs.AccountTrade
+	for page := int32(1); ; page++ {
+		trades, err := a.getTradesPage(ctx, pairSymbol, window, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trade := range trades {
+			tradeConverted, err := mappers.ConvertAccountTrade(trade)
+			if err != nil {
+				return nil, fmt.Errorf("convert: %w", err)
+			}
+
+			// the time range is in seconds
+			if tradeConverted.Time < window.StartTime || tradeConverted.Time > window.EndTime {
+				continue
+			}
+			result = append(result, tradeConverted)
+		}
+
+		if len(trades) < tradesHistoryPageLimit {
+			return result, nil
+		}
+	}
+}
+
+func (a *adapter) getTradesPage(
+	ctx context.Context,
+	pairSymbol string,
+	window baseadp.TimeWindow,
+	page int32,
+) ([]gateapi.Trade, error) {
+	ctx, ctxCancel := context.WithTimeout(ctx, a.timeout)
+	defer ctxCancel()
+
+	trades, _, err := a.client.SpotApi.ListMyTrades(ctx, &gateapi.ListMyTradesOpts{
+		CurrencyPair: optional.NewString(pairSymbol),
+		Limit:        optional.NewInt32(tradesHistoryPageLimit),
+		Page:         optional.NewInt32(page),
+		From:         optional.NewInt64(window.StartTime / 1000),
+		To:           optional.NewInt64(window.EndTime/1000 + 1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get page %v: %w", page, err)
+	}
+	return trades, nil
+}
@@ -0,0 +1,171 @@
+package gate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antihax/optional"
+	"github.com/gateio/gateapi-go/v6"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/gate/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	isolatedMarginAccountType = "margin"
+	uniLoanTypeBorrow         = "borrow"
+	uniLoanTypeRepay          = "repay"
+)
+
+func (a *adapter) GetMarginAccount(
+	mode consts.MarginMode,
+	pairSymbol string,
+) (structs.MarginAccount, error) {
+	if !a.creds.Keypair.IsSet() {
+		return structs.MarginAccount{}, errs.ErrAPIKeyNotSet
+	}
+	if err := baseadp.CheckMarginMode(mode, pairSymbol); err != nil {
+		return structs.MarginAccount{}, err
+	}
+
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
+	defer ctxCancel()
+
+	if mode == consts.MarginModeCross {
+		data, _, err := a.client.MarginApi.GetCrossMarginAccount(ctx)
+		if err != nil {
+			return structs.MarginAccount{}, fmt.Errorf("get cross margin account: %w", err)
+		}
+		return mappers.ConvertCrossMarginAccount(data)
+	}
+
+	accounts, _, err := a.client.MarginApi.ListMarginAccounts(
+		ctx,
+		&gateapi.ListMarginAccountsOpts{CurrencyPair: optional.NewString(pairSymbol)},
+	)
+	if err != nil {
+		return structs.MarginAccount{}, fmt.Errorf("get isolated margin account: %w", err)
+	}
+	if len(accounts) == 0 {
+		return structs.MarginAccount{},
+			fmt.Errorf("isolated margin account not found for %q", pairSymbol)
+	}
+	return mappers.ConvertIsolatedMarginAccount(accounts[0])
+}
+
+func (a *adapter) MarginBorrow(task structs.MarginLoanTask) (structs.MarginLoanResult, error) {
+	if !a.creds.Keypair.IsSet() {
+		return structs.MarginLoanResult{}, errs.ErrAPIKeyNotSet
+	}
+	if err := baseadp.CheckMarginLoanTask(task); err != nil {
+		return structs.MarginLoanResult{}, fmt.Errorf("check task: %w", err)
+	}
+
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
+	defer ctxCancel()
+
+	result := getMarginLoanResult(task)
+	if task.Mode == consts.MarginModeIsolated {
+		if _, err := a.client.MarginUniApi.CreateUniLoan(
+			ctx, getUniLoanRequest(task, uniLoanTypeBorrow),
+		); err != nil {
+			return structs.MarginLoanResult{}, fmt.Errorf("borrow: %w", mappers.MapMarginLoanErr(err))
+		}
+		return result, nil
+	}
+
+	loan, _, err := a.client.MarginApi.CreateCrossMarginLoan(ctx, gateapi.CrossMarginLoan{
+		Currency: task.Asset,
+		Amount:   task.Amount.String(),
+	})
+	if err != nil {
+		return structs.MarginLoanResult{}, fmt.Errorf("borrow: %w", mappers.MapMarginLoanErr(err))
+	}
+
+	result.ID = loan.Id
+	return result, nil
+}
+
+func (a *adapter) MarginRepay(task structs.MarginLoanTask) (structs.MarginLoanResult, error) {
+	if !a.creds.Keypair.IsSet() {
+		return structs.MarginLoanResult{}, errs.ErrAPIKeyNotSet
+	}
+	if err := baseadp.CheckMarginLoanTask(task); err != nil {
+		return structs.MarginLoanResult{}, fmt.Errorf("check task: %w", err)
+	}
+
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
+	defer ctxCancel()
+
+	var err error
+	if task.Mode == consts.MarginModeIsolated {
+		_, err = a.client.MarginUniApi.CreateUniLoan(
+			ctx, getUniLoanRequest(task, uniLoanTypeRepay),
+		)
+	} else {
+		_, _, err = a.client.MarginApi.RepayCrossMarginLoan(ctx, gateapi.CrossMarginRepayRequest{
+			Currency: task.Asset,
+			Amount:   task.Amount.String(),
+		})
+	}
+	if err != nil {
+		return structs.MarginLoanResult{}, fmt.Errorf("repay: %w", mappers.MapMarginLoanErr(err))
+	}
+	return getMarginLoanResult(task), nil
+}
+
+func (a *adapter) PlaceMarginOrder(
+	_ context.Context,
+	order structs.BotOrderAdjusted,
+	params structs.MarginOrderParams,
+) (structs.CreateOrderResponse, error) {
+	if !a.creds.Keypair.IsSet() {
+		return structs.CreateOrderResponse{}, errs.ErrAPIKeyNotSet
+	}
+	if err := baseadp.CheckMarginMode(params.Mode, order.PairSymbol); err != nil {
+		return structs.CreateOrderResponse{}, err
+	}
+
+	accountType := crossMarginAccountType
+	if params.Mode == consts.MarginModeIsolated {
+		accountType = isolatedMarginAccountType
+	}
+	request := getOrderRequest(order, accountType)
+
+	switch params.SideEffect {
+	default:
+		return structs.CreateOrderResponse{},
+			fmt.Errorf("unknown margin side effect: %q", params.SideEffect)
+	case "", consts.MarginSideEffectNone:
+	case consts.MarginSideEffectAutoBorrow:
+		request.AutoBorrow = true
+	case consts.MarginSideEffectAutoRepay:
+		request.AutoRepay = true
+	}
+
+	return a.createOrder(order, request)
+}
+
+func getUniLoanRequest(task structs.MarginLoanTask, loanType string) gateapi.CreateUniLoan {
+	return gateapi.CreateUniLoan{
+		Currency:     task.Asset,
+		Type:         loanType,
+		Amount:       task.Amount.String(),
+		CurrencyPair: task.PairSymbol,
+	}
+}
+
+func getMarginLoanResult(task structs.MarginLoanTask) structs.MarginLoanResult {
+	result := structs.MarginLoanResult{
+		Mode:   task.Mode,
+		Asset:  task.Asset,
+		Amount: task.Amount,
+	}
+	if task.Mode == consts.MarginModeIsolated {
+		result.PairSymbol = task.PairSymbol
+	}
+	return result
+}
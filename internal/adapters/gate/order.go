@@ -2,11 +2,11 @@ package gate
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/antihax/optional"
 	"github.com/gateio/gateapi-go/v6"
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/gate/helpers/mappers"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
@@ -36,7 +36,7 @@ func (a *adapter) GetOrderByClientOrderID(
 		return structs.OrderData{}, errs.ErrAPIKeyNotSet
 	}
 
-	ctx, ctxCancel := context.WithTimeout(a.auth, requestTimeout)
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
 	defer ctxCancel()
 
 	data, _, err := a.client.SpotApi.GetOrder(
@@ -72,24 +72,24 @@ func (a *adapter) GetOrderByClientOrderID(
 		UpdatedTime:   data.UpdateTimeMs,
 	}
 
-	orderData.AwaitQty, err = strconv.ParseFloat(data.Amount, 64)
+	orderData.AwaitQty, err = decimal.NewFromString(data.Amount)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("parse qty: %w", err)
 	}
 
-	orderData.FilledQty, err = strconv.ParseFloat(data.FilledAmount, 64)
+	orderData.FilledQty, err = decimal.NewFromString(data.FilledAmount)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("parse filled qty: %w", err)
 	}
 
-	orderData.Price, err = strconv.ParseFloat(data.Price, 64)
+	orderData.Price, err = decimal.NewFromString(data.Price)
 	if err != nil {
 		return structs.OrderData{}, fmt.Errorf("parse price: %w", err)
 	}
 
 	orderData.Status = mappers.ConvertOrderStatus(data.Status)
 
-	if orderData.FilledQty > 0 && orderData.FilledQty < orderData.AwaitQty {
+	if orderData.IsPartiallyFilled() {
 		orderData.Status = consts.OrderStatusPartiallyFilled
 	}
 
@@ -104,18 +104,34 @@ func (a *adapter) PlaceOrder(
 		return structs.CreateOrderResponse{}, errs.ErrAPIKeyNotSet
 	}
 
-	ctx, ctxCancel := context.WithTimeout(a.auth, requestTimeout)
-	defer ctxCancel()
+	return a.createOrder(order, getOrderRequest(order, spotAccountType))
+}
 
-	response, _, err := a.client.SpotApi.CreateOrder(ctx, gateapi.Order{
+func getOrderRequest(order structs.BotOrderAdjusted, accountType string) gateapi.Order {
+	return gateapi.Order{
 		Text:         order.ClientOrderID,
 		CurrencyPair: order.PairSymbol,
 		Type:         orderTypeLimit,
-		Account:      spotAccountType,
+		Account:      accountType,
 		Side:         string(order.Type),
 		Amount:       order.Qty,
 		Price:        order.Price,
-	}, &gateapi.CreateOrderOpts{})
+	}
+}
+
+func (a *adapter) createOrder(
+	order structs.BotOrderAdjusted,
+	request gateapi.Order,
+) (structs.CreateOrderResponse, error) {
+	// the order is placed as limit one, market orders are not supported
+	if err := a.Capabilities().CheckOrder(order); err != nil {
+		return structs.CreateOrderResponse{}, err
+	}
+
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
+	defer ctxCancel()
+
+	response, _, err := a.client.SpotApi.CreateOrder(ctx, request, &gateapi.CreateOrderOpts{})
 	if err != nil {
 		return structs.CreateOrderResponse{}, fmt.Errorf("create order: %w", err)
 	}
@@ -138,8 +154,8 @@ func (a *adapter) PlaceOrder(
 	return structs.CreateOrderResponse{
 		OrderID:       orderID,
 		ClientOrderID: response.Text,
-		OrigQuantity:  qty.InexactFloat64(),
-		Price:         price.InexactFloat64(),
+		OrigQuantity:  qty,
+		Price:         price,
 		Symbol:        order.PairSymbol,
 		Type:          order.Type,
 		CreatedTime:   response.CreateTimeMs,
@@ -157,7 +173,7 @@ func (a *adapter) GetOrderExecFee(
 		return structs.OrderFees{}, errs.ErrAPIKeyNotSet
 	}
 
-	ctx, ctxCancel := context.WithTimeout(a.auth, requestTimeout)
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
 	defer ctxCancel()
 
 	pairSymbol := a.GetPairSymbol(baseAssetTicker, quoteAssetTicker)
@@ -183,6 +199,28 @@ func (a *adapter) GetOrderExecFee(
 	return fees, nil
 }
 
+func (a *adapter) GetTradeFees(pairSymbol string) (structs.TradeFees, error) {
+	if !a.creds.Keypair.IsSet() {
+		return structs.TradeFees{}, errs.ErrAPIKeyNotSet
+	}
+
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
+	defer ctxCancel()
+
+	data, _, err := a.client.WalletApi.GetTradeFee(ctx, &gateapi.GetTradeFeeOpts{
+		CurrencyPair: optional.NewString(pairSymbol),
+	})
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("get: %w", err)
+	}
+
+	fees, err := mappers.ConvertTradeFees(pairSymbol, data)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("convert: %w", err)
+	}
+	return fees, nil
+}
+
 func (a *adapter) GetHistoryOrder(
 	pairSymbol string,
 	orderID int64,
@@ -191,7 +229,7 @@ func (a *adapter) GetHistoryOrder(
 		return structs.OrderHistory{}, errs.ErrAPIKeyNotSet
 	}
 
-	ctx, ctxCancel := context.WithTimeout(a.auth, requestTimeout)
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
 	defer ctxCancel()
 
 	events, _, err := a.client.SpotApi.ListMyTrades(ctx, &gateapi.ListMyTradesOpts{
@@ -209,5 +247,5 @@ func (a *adapter) GetHistoryOrder(
 	return result, nil*/
 
 	// not ready yet
-	return structs.OrderHistory{}, errors.New("not implemented")
+	return structs.OrderHistory{}, &errs.NotSupportedError{Feature: "order history"}
 }
@@ -2,6 +2,7 @@ package gate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -9,14 +10,15 @@ import (
 	"github.com/antihax/optional"
 	"github.com/gateio/gateapi-go/v6"
 	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/gate/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
 	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
-	"github.com/matrixbotio/exchange-gates-lib/pkg/utils"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
 	"github.com/shopspring/decimal"
 )
 
 func (a *adapter) GetPairData(pairSymbol string) (structs.ExchangePairData, error) {
-	ctx, ctxCancel := context.WithTimeout(context.Background(), requestTimeout)
+	ctx, ctxCancel := context.WithTimeout(context.Background(), a.timeout)
 	defer ctxCancel()
 
 	data, _, err := a.client.SpotApi.GetCurrencyPair(ctx, pairSymbol)
@@ -33,7 +35,7 @@ func (a *adapter) GetPairData(pairSymbol string) (structs.ExchangePairData, erro
 	return result, nil
 }
 
-func (a *adapter) GetPairLastPrice(pairSymbol string) (float64, error) {
+func (a *adapter) GetPairLastPrice(pairSymbol string) (decimal.Decimal, error) {
 	tickers, _, err := a.client.SpotApi.ListTickers(
 		context.Background(),
 		&gateapi.ListTickersOpts{
@@ -41,19 +43,19 @@ func (a *adapter) GetPairLastPrice(pairSymbol string) (float64, error) {
 		},
 	)
 	if err != nil {
-		return 0, fmt.Errorf("get ticker: %w", err)
+		return decimal.Zero, fmt.Errorf("get ticker: %w", err)
 	}
 
 	if len(tickers) == 0 {
-		return 0, fmt.Errorf("ticker %q price not found", pairSymbol)
+		return decimal.Zero, fmt.Errorf("ticker %q price not found", pairSymbol)
 	}
 
 	lastPrice, err := decimal.NewFromString(tickers[0].Last)
 	if err != nil {
-		return 0, fmt.Errorf("parse price: %w", err)
+		return decimal.Zero, fmt.Errorf("parse price: %w", err)
 	}
 
-	return lastPrice.InexactFloat64(), nil
+	return lastPrice, nil
 }
 
 func (a *adapter) CancelPairOrder(
@@ -65,7 +67,7 @@ func (a *adapter) CancelPairOrder(
 		return errs.ErrAPIKeyNotSet
 	}
 
-	ctx, ctxCancel := context.WithTimeout(a.auth, requestTimeout)
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
 	defer ctxCancel()
 
 	_, _, err := a.client.SpotApi.CancelOrder(
@@ -74,7 +76,7 @@ func (a *adapter) CancelPairOrder(
 		pairSymbol,
 		nil,
 	)
-	return mappers.MapCancelOrderErr(err)
+	return a.mapCancelOrderErr(pairSymbol, strconv.FormatInt(orderID, 10), err)
 }
 
 func (a *adapter) CancelPairOrderByClientOrderID(
@@ -86,7 +88,7 @@ func (a *adapter) CancelPairOrderByClientOrderID(
 		return errs.ErrAPIKeyNotSet
 	}
 
-	ctx, ctxCancel := context.WithTimeout(a.auth, requestTimeout)
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
 	defer ctxCancel()
 
 	_, _, err := a.client.SpotApi.CancelOrder(
@@ -95,11 +97,27 @@ func (a *adapter) CancelPairOrderByClientOrderID(
 		pairSymbol,
 		nil,
 	)
-	return mappers.MapCancelOrderErr(err)
+	return a.mapCancelOrderErr(pairSymbol, clientOrderID, err)
+}
+
+// mapCancelOrderErr - the finished orders are not found for the cancel request,
+// so the order status is requested to tell the filled ones apart.
+// The order ID is the exchange or the client one
+func (a *adapter) mapCancelOrderErr(pairSymbol, orderID string, err error) error {
+	err = mappers.MapCancelOrderErr(err)
+	if !errors.Is(err, errs.ErrOrderNotFound) {
+		return err
+	}
+
+	order, getErr := a.GetOrderByClientOrderID(pairSymbol, orderID)
+	if getErr == nil && order.Status == consts.OrderStatusFilled {
+		return errs.ErrOrderFilled
+	}
+	return err
 }
 
 func (a *adapter) GetPairs() ([]structs.ExchangePairData, error) {
-	ctx, ctxCancel := context.WithTimeout(context.Background(), requestTimeout)
+	ctx, ctxCancel := context.WithTimeout(context.Background(), a.timeout)
 	defer ctxCancel()
 
 	pairs, _, err := a.client.SpotApi.ListCurrencyPairs(ctx)
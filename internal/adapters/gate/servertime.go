@@ -0,0 +1,128 @@
+package gate
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gateio/gateapi-go/v6"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+)
+
+const (
+	headerSign      = "SIGN"
+	headerTimestamp = "Timestamp"
+)
+
+func (a *adapter) GetServerTime() (time.Time, error) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), a.timeout)
+	defer ctxCancel()
+
+	data, _, err := a.client.SpotApi.GetSystemTime(ctx)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get server time: %w", err)
+	}
+	if data.ServerTime <= 0 {
+		return time.Time{}, fmt.Errorf("invalid time: %d", data.ServerTime)
+	}
+	return time.UnixMilli(data.ServerTime), nil
+}
+
+// SetServerTimeOffset - gateapi signs the requests with the local time,
+// they are signed again by signingTransport
+func (a *adapter) SetServerTimeOffset(offset time.Duration) error {
+	a.clock.SetOffset(offset)
+	return nil
+}
+
+// signingTransport - replaces the request timestamp with the server one
+// & signs the request again with the secret taken from the request context
+type signingTransport struct {
+	base  http.RoundTripper
+	clock *baseadp.ServerClock
+}
+
+func newSigningTransport(
+	base http.RoundTripper,
+	clock *baseadp.ServerClock,
+) *signingTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &signingTransport{
+		base:  base,
+		clock: clock,
+	}
+}
+
+func (t *signingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	auth, isSigned := req.Context().Value(gateapi.ContextGateAPIV4).(gateapi.GateAPIV4)
+	if !isSigned || t.clock.Offset() == 0 || req.Header.Get(headerSign) == "" {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := getRequestBody(req)
+	if err != nil {
+		return nil, fmt.Errorf("read request body: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(t.clock.Now().Unix(), 10)
+	sign, err := getRequestSign(req, body, auth.Secret, timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("sign: %w", err)
+	}
+
+	// the request must not be modified by the transport
+	req = req.Clone(req.Context())
+	req.Header.Set(headerSign, sign)
+	req.Header.Set(headerTimestamp, timestamp)
+	return t.base.RoundTrip(req)
+}
+
+func getRequestBody(req *http.Request) ([]byte, error) {
+	if req.GetBody == nil {
+		return nil, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+// getRequestSign - gate API v4 signature, the same as in gateapi
+func getRequestSign(
+	req *http.Request,
+	body []byte,
+	secret string,
+	timestamp string,
+) (string, error) {
+	rawQuery, err := url.QueryUnescape(req.URL.RawQuery)
+	if err != nil {
+		return "", fmt.Errorf("unescape query: %w", err)
+	}
+
+	payloadHash := sha512.Sum512(body)
+	message := fmt.Sprintf(
+		"%s\n%s\n%s\n%s\n%s",
+		req.Method,
+		req.URL.Path,
+		rawQuery,
+		hex.EncodeToString(payloadHash[:]),
+		timestamp,
+	)
+
+	mac := hmac.New(sha512.New, []byte(secret))
+	mac.Write([]byte(message))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
@@ -0,0 +1,96 @@
+package gate
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/antihax/optional"
+	"github.com/gateio/gateapi-go/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+)
+
+const testSecret = "secret"
+
+type signedRequest struct {
+	timestamp string
+	valid     bool
+}
+
+// newTestGateServer - the server checks the request signature
+func newTestGateServer(t *testing.T, requests chan<- signedRequest) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		sign, err := getRequestSign(r, body, testSecret, r.Header.Get(headerTimestamp))
+		require.NoError(t, err)
+
+		requests <- signedRequest{
+			timestamp: r.Header.Get(headerTimestamp),
+			valid:     sign == r.Header.Get(headerSign),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+}
+
+func sendTestSignedRequest(t *testing.T, serverURL string, clock *baseadp.ServerClock) {
+	cfg := gateapi.NewConfiguration()
+	cfg.BasePath = serverURL
+	cfg.HTTPClient = &http.Client{Transport: newSigningTransport(nil, clock)}
+
+	ctx := context.WithValue(
+		context.Background(),
+		gateapi.ContextGateAPIV4,
+		gateapi.GateAPIV4{Key: "key", Secret: testSecret},
+	)
+	_, _, err := gateapi.NewAPIClient(cfg).SpotApi.ListSpotAccounts(
+		ctx,
+		&gateapi.ListSpotAccountsOpts{Currency: optional.NewString("USDT")},
+	)
+	require.NoError(t, err)
+}
+
+func TestSigningTransportWithoutOffset(t *testing.T) {
+	// given
+	requests := make(chan signedRequest, 1)
+	srv := newTestGateServer(t, requests)
+	defer srv.Close()
+
+	// when
+	sendTestSignedRequest(t, srv.URL, baseadp.NewServerClock())
+
+	// then
+	request := <-requests
+	assert.True(t, request.valid)
+}
+
+func TestSigningTransportWithOffset(t *testing.T) {
+	// given
+	requests := make(chan signedRequest, 1)
+	srv := newTestGateServer(t, requests)
+	defer srv.Close()
+
+	offset := time.Hour
+	clock := baseadp.NewServerClock()
+	clock.SetOffset(offset)
+
+	// when
+	sendTestSignedRequest(t, srv.URL, clock)
+
+	// then
+	request := <-requests
+	assert.True(t, request.valid)
+
+	timestamp, err := strconv.ParseInt(request.timestamp, 10, 64)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Now().Add(offset).Unix(), timestamp, 5)
+}
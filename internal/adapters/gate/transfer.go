@@ -0,0 +1,114 @@
+package gate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gateio/gateapi-go/v6"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/gate/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	crossMarginAccountType = "cross_margin"
+	futuresAccountType     = "futures"
+	futuresSettle          = "usdt"
+)
+
+// gate has no separate funding account: deposits go to the spot account
+var transferAccountTypes = map[consts.AccountType]string{
+	consts.AccountTypeSpot:    spotAccountType,
+	consts.AccountTypeMargin:  crossMarginAccountType,
+	consts.AccountTypeFutures: futuresAccountType,
+}
+
+func (a *adapter) GetAccountBalances(accountType consts.AccountType) ([]structs.Balance, error) {
+	if !a.creds.Keypair.IsSet() {
+		return nil, errs.ErrAPIKeyNotSet
+	}
+
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
+	defer ctxCancel()
+
+	switch accountType {
+	default:
+		return nil, fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, accountType)
+	case consts.AccountTypeSpot:
+		return a.GetAccountBalance()
+	case consts.AccountTypeMargin:
+		data, _, err := a.client.MarginApi.GetCrossMarginAccount(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get cross margin account: %w", err)
+		}
+		return mappers.ConvertCrossMarginBalances(data)
+	case consts.AccountTypeFutures:
+		data, _, err := a.client.FuturesApi.ListFuturesAccounts(ctx, futuresSettle)
+		if err != nil {
+			return nil, fmt.Errorf("get futures account: %w", err)
+		}
+		return mappers.ConvertFuturesBalance(data)
+	}
+}
+
+func (a *adapter) Transfer(
+	asset string,
+	amount decimal.Decimal,
+	fromAccount consts.AccountType,
+	toAccount consts.AccountType,
+) (structs.TransferResult, error) {
+	if !a.creds.Keypair.IsSet() {
+		return structs.TransferResult{}, errs.ErrAPIKeyNotSet
+	}
+	if asset == "" {
+		return structs.TransferResult{}, errors.New("asset is not set")
+	}
+	if !amount.IsPositive() {
+		return structs.TransferResult{}, fmt.Errorf("invalid amount: %s", amount.String())
+	}
+
+	from, isExists := transferAccountTypes[fromAccount]
+	if !isExists {
+		return structs.TransferResult{},
+			fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, fromAccount)
+	}
+	to, isExists := transferAccountTypes[toAccount]
+	if !isExists {
+		return structs.TransferResult{},
+			fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, toAccount)
+	}
+	if from == to {
+		return structs.TransferResult{}, fmt.Errorf("transfer to the same account: %q", from)
+	}
+
+	transfer := gateapi.Transfer{
+		Currency: asset,
+		From:     from,
+		To:       to,
+		Amount:   amount.String(),
+	}
+	if from == futuresAccountType || to == futuresAccountType {
+		transfer.Settle = futuresSettle
+	}
+
+	ctx, ctxCancel := context.WithTimeout(a.auth, a.timeout)
+	defer ctxCancel()
+
+	response, _, err := a.client.WalletApi.Transfer(ctx, transfer)
+	if err != nil {
+		return structs.TransferResult{}, fmt.Errorf("transfer: %w", mappers.MapTransferErr(err))
+	}
+
+	return structs.TransferResult{
+		ID:          strconv.FormatInt(response.TxId, 10),
+		Asset:       asset,
+		Amount:      amount,
+		FromAccount: fromAccount,
+		ToAccount:   toAccount,
+	}, nil
+}
@@ -15,19 +15,30 @@ import (
 )
 
 const (
-	wsConnTimeout        = time.Second * 15
-	gateCandleChannel    = gate.ChannelSpotCandleStick
-	gateTradeChannel     = "spot.usertrades_v2"
-	tradeSubscriptionTag = "subscription"
+	wsConnTimeout          = time.Second * 15
+	wsBaseURL              = gate.BaseUrl
+	wsTestnetBaseURL       = "wss://ws-testnet.gate.com/v4/ws/spot"
+	wsApp                  = "spot"
+	gateCandleChannel      = gate.ChannelSpotCandleStick
+	gateTradeChannel       = "spot.usertrades_v2"
+	gatePublicTradeChannel = gate.ChannelSpotPublicTrade
+	tradeSubscriptionTag   = "subscription"
 )
 
 type GateCandleWorker struct {
 	workers.CandleWorker
+	wsURL string
 }
 
 type GateTradeWorker struct {
 	workers.TradeEventWorker
 	creds pkgStructs.APICredentials
+	wsURL string
+}
+
+type GatePublicTradeWorker struct {
+	workers.PublicTradeWorker
+	wsURL string
 }
 
 func (a *adapter) SubscribeCandle(
@@ -104,7 +115,11 @@ func (w *GateCandleWorker) SubscribeToCandle(
 	}
 
 	// setup new ws connection
-	srv, err := gate.NewWsService(context.Background(), nil, nil)
+	srv, err := gate.NewWsService(
+		context.Background(),
+		nil,
+		gate.NewConnConfFromOption(&gate.ConfOptions{App: wsApp, URL: w.wsURL}),
+	)
 	if err != nil {
 		return fmt.Errorf("conn: %w", err)
 	}
@@ -172,7 +187,8 @@ func (w *GateTradeWorker) SubscribeToTradeEventsPrivate(
 	}
 
 	cfg := gate.NewConnConfFromOption(&gate.ConfOptions{
-		App:    "spot",
+		App:    wsApp,
+		URL:    w.wsURL,
 		Key:    w.creds.Keypair.Public,
 		Secret: w.creds.Keypair.Secret,
 	})
@@ -224,6 +240,77 @@ func (w *GateTradeWorker) SubscribeToTradeEventsPrivate(
 	return nil
 }
 
+func (a *adapter) SubscribePublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	return a.publicTradeWorker.SubscribeToPublicTrades(
+		pairSymbol,
+		eventCallback,
+		errorHandler,
+	)
+}
+
+func (w *GatePublicTradeWorker) SubscribeToPublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	if w.PublicTradeWorker.IsSubscriptionExists(pairSymbol) {
+		return nil // already subscribed
+	}
+
+	// setup new ws connection
+	srv, err := gate.NewWsService(
+		context.Background(),
+		nil,
+		gate.NewConnConfFromOption(&gate.ConfOptions{App: wsApp, URL: w.wsURL}),
+	)
+	if err != nil {
+		return fmt.Errorf("conn: %w", err)
+	}
+
+	reqPayload := getPublicTradesSubsPayload(pairSymbol)
+
+	eventHandler := func(event gate.SpotTradeMsg) {
+		eventParsed, err := mappers.ParsePublicTradeEvent(event)
+		if err != nil {
+			errorHandler(fmt.Errorf("parse public trade: %s", err.Error()))
+			return
+		}
+
+		eventCallback(eventParsed)
+	}
+
+	// set event handler
+	srv.SetCallBack(
+		reqPayload.Channel,
+		getRawEventHandler(
+			eventHandler,
+			errorHandler,
+		),
+	)
+
+	// subscribe
+	go func() {
+		if err := srv.Subscribe(
+			reqPayload.Channel,
+			reqPayload.Payload,
+		); err != nil {
+			errorHandler(fmt.Errorf("subscribe: %w", err))
+		}
+	}()
+
+	// save subscription
+	w.PublicTradeWorker.Save(
+		getUnsubscriber(srv, reqPayload),
+		errorHandler,
+		pairSymbol,
+	)
+	return nil
+}
+
 func (a *adapter) UnsubscribeCandle(
 	pairSymbol string,
 	interval consts.Interval,
@@ -240,3 +327,7 @@ func (a *adapter) UnsubscribeCandle(
 func (a *adapter) UnsubscribeAccountTrades() {
 	a.tradeWorker.UnsubscribeAll()
 }
+
+func (a *adapter) UnsubscribePublicTrades(pairSymbol string) {
+	a.publicTradeWorker.Unsubscribe(pairSymbol)
+}
@@ -0,0 +1,84 @@
+package kucoin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/kucoin/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	endpointGetAPIKeyInfo = "/api/v1/user/api-key"
+	endpointGetAccounts   = "/api/v1/accounts"
+
+	accountTypeTrade   = "trade"
+	accountTypeFunding = "main"
+
+	errCodeAPIKeyInvalid     = "400003"
+	errCodePassphraseInvalid = "400004"
+	errCodeSignatureInvalid  = "400005"
+)
+
+func (a *adapter) CanTrade() (bool, error) {
+	info, err := a.GetAPIKeyInfo()
+	if err != nil {
+		return false, err
+	}
+	return info.HasPermission(pkgStructs.APIKeyPermissionSpotTrade), nil
+}
+
+// GetAPIKeyInfo - kucoin doesn't report the key expiry date
+func (a *adapter) GetAPIKeyInfo() (pkgStructs.APIKeyInfo, error) {
+	if !a.isPrivateAPIAvailable() {
+		return pkgStructs.APIKeyInfo{}, errs.ErrAPIKeyNotSet
+	}
+
+	var info mappers.APIKeyInfo
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetAPIKeyInfo,
+		nil,
+		&info,
+	); err != nil {
+		return pkgStructs.APIKeyInfo{}, mapAPIKeyError(err)
+	}
+	return mappers.ConvertAPIKeyInfo(info), nil
+}
+
+// GetAccountBalance - trading account balances
+func (a *adapter) GetAccountBalance() ([]structs.Balance, error) {
+	return a.getBalances(accountTypeTrade)
+}
+
+func (a *adapter) getBalances(accountType string) ([]structs.Balance, error) {
+	if !a.isPrivateAPIAvailable() {
+		return nil, errs.ErrAPIKeyNotSet
+	}
+
+	var accounts []mappers.Account
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetAccounts,
+		map[string]any{"type": accountType},
+		&accounts,
+	); err != nil {
+		return nil, fmt.Errorf("get balance: %w", mapAPIKeyError(err))
+	}
+
+	return mappers.ConvertBalances(accounts)
+}
+
+func mapAPIKeyError(err error) error {
+	if isAPIErrorCode(
+		err,
+		errCodeAPIKeyInvalid,
+		errCodePassphraseInvalid,
+		errCodeSignatureInvalid,
+	) {
+		return fmt.Errorf("%w: %s", errs.ErrAPIKeyInvalid, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,186 @@
+package kucoin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	adp "github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/kucoin/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	adapterName     = "KuCoin Spot"
+	symbolFormat    = "%s-%s"
+	symbolDelimiter = "-"
+
+	endpointGetCandles = "/api/v1/market/candles"
+	candlesMaxLimit    = 1500
+)
+
+var errPassphraseNotSet = fmt.Errorf(
+	"%w: passphrase is not set, call Connect with the full credentials",
+	errs.ErrAPIKeyNotSet,
+)
+
+type adapter struct {
+	baseadp.AdapterBase
+
+	rest     *restClient
+	cfg      config.Config
+	creds    pkgStructs.APICredentials
+	orderIDs *orderIDRegistry
+	clock    *baseadp.ServerClock
+
+	candleWorker      *CandleEventWorkerKuCoin
+	tradeWorker       *TradeEventWorkerKuCoin
+	publicTradeWorker *PublicTradeWorkerKuCoin
+}
+
+func New(opts ...config.Option) adp.Adapter {
+	cfg := config.New(opts...)
+	clock := baseadp.NewServerClock()
+	return &adapter{
+		AdapterBase: baseadp.NewAdapterBase(
+			consts.ExchangeIDkucoin,
+			adapterName,
+			consts.KuCoinAdapterTag,
+		),
+		rest:     newRestClient(pkgStructs.APICredentials{}, cfg, clock.Now),
+		cfg:      cfg,
+		clock:    clock,
+		orderIDs: newOrderIDRegistry(cfg.OrderIDStore),
+	}
+}
+
+func (a *adapter) GenClientOrderID() string {
+	return uuid.New().String()
+}
+
+func (a *adapter) GetPairSymbol(
+	baseTicker string,
+	quoteTicker string,
+) string {
+	return fmt.Sprintf(symbolFormat, baseTicker, quoteTicker)
+}
+
+func (a *adapter) GetLimits() pkgStructs.ExchangeLimits {
+	return pkgStructs.ExchangeLimits{
+		MaxConnectionsPerBatch:   30,
+		MaxConnectionsInDuration: time.Minute,
+		MaxTopicsPerWebsocket:    100,
+	}
+}
+
+// Capabilities - kucoin order IDs are strings, numeric aliases are used
+func (a *adapter) Capabilities() pkgStructs.AdapterCapabilities {
+	return pkgStructs.AdapterCapabilities{
+		OrderTypes:    []pkgStructs.OrderType{pkgStructs.OrderTypeLimit, pkgStructs.OrderTypeMarket},
+		TimeInForce:   []pkgStructs.TimeInForce{pkgStructs.TimeInForceGTC},
+		BatchOrders:   false,
+		OrderHistory:  true,
+		AccountTrades: true,
+		FeeLookup:     true,
+		Intervals:     a.GetSupportedIntervals(),
+		Streams: []pkgStructs.StreamType{
+			pkgStructs.StreamCandles,
+			pkgStructs.StreamPublicTrades,
+			pkgStructs.StreamAccountTrades,
+		},
+		OrderIDFormat: pkgStructs.OrderIDFormatAlias,
+	}
+}
+
+func (a *adapter) Connect(credentials pkgStructs.APICredentials) error {
+	// kucoin has no spot testnet, the stand-in URL could be set explicitly
+	if a.cfg.Testnet && a.cfg.RESTBaseURL == "" {
+		return &errs.NotSupportedError{Feature: "testnet"}
+	}
+
+	a.creds = credentials
+	a.rest = newRestClient(credentials, a.cfg, a.clock.Now)
+
+	a.candleWorker = a.CreateCandleWorker()
+	a.tradeWorker = a.CreateTradeEventsWorker()
+	a.publicTradeWorker = a.CreatePublicTradeWorker()
+	return nil
+}
+
+// VerifyAPIKeys - the passphrase is taken from the credentials set on Connect,
+// call Connect with the full credentials first
+func (a *adapter) VerifyAPIKeys(keyPublic, keySecret string) error {
+	if a.creds.Keypair.Passphrase == "" {
+		return errPassphraseNotSet
+	}
+
+	if err := a.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{
+			Public:     keyPublic,
+			Secret:     keySecret,
+			Passphrase: a.creds.Keypair.Passphrase,
+		},
+	}); err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+
+	_, err := a.GetAccountBalance()
+	return err
+}
+
+func (a *adapter) GetCandles(
+	limit int,
+	symbol string,
+	interval consts.Interval,
+) ([]workers.CandleData, error) {
+	candleType, err := ConvertIntervalToKuCoin(interval)
+	if err != nil {
+		return nil, fmt.Errorf("convert interval: %w", err)
+	}
+
+	if limit > candlesMaxLimit {
+		limit = candlesMaxLimit
+	}
+
+	// kucoin candles are requested by time range
+	duration := intervalKuCoinToOur[candleType].Duration
+	endTime := time.Now()
+	startTime := endTime.Add(-duration * time.Duration(limit))
+
+	var candles []mappers.Candle
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetCandles,
+		map[string]any{
+			"symbol":  symbol,
+			"type":    candleType,
+			"startAt": startTime.Unix(),
+			"endAt":   endTime.Unix(),
+		},
+		&candles,
+	); err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+
+	return mappers.ConvertCandles(candles, interval, duration)
+}
+
+func (a *adapter) GetSupportedIntervals() []consts.Interval {
+	return consts.FilterIntervals(func(interval consts.Interval) bool {
+		_, isSupported := ourIntervalToKuCoin[interval]
+		return isSupported
+	})
+}
+
+// isPrivateAPIAvailable - kucoin private endpoints require the passphrase
+func (a *adapter) isPrivateAPIAvailable() bool {
+	return a.creds.Keypair.IsSet() && a.creds.Keypair.Passphrase != ""
+}
@@ -0,0 +1,208 @@
+package kucoin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/kucoin/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/adapter/adaptertest"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	testConformancePair = "BTC-USDT"
+
+	// kucoin uses the same code for many errors, the message tells them apart
+	testErrCode                = "400100"
+	testErrOrderDuplicate      = "clientOid duplicate"
+	testErrOrderNotExist       = "order not exist."
+	testErrCancelOrderNotExist = "order_not_exist_or_not_allow_to_cancel"
+)
+
+func TestConformance(t *testing.T) {
+	adaptertest.Run(t, adaptertest.Config{
+		NewHarness: newTestHarness,
+		PairSymbol: testConformancePair,
+		OrderQty:   "0.01",
+		OrderPrice: "60000.5",
+	})
+}
+
+func newTestHarness(t *testing.T) adaptertest.Harness {
+	exchange := adaptertest.NewExchange()
+	restServer := httptest.NewServer(newTestStandInHandler(exchange))
+	t.Cleanup(restServer.Close)
+	// the topic is subscribed after the welcome message
+	wsServer := adaptertest.NewWebsocketServer(
+		t, adaptertest.WithGreeting(wsMessage{Type: wsMessageTypeWelcome}),
+	)
+
+	a := New(
+		config.WithRESTBaseURL(restServer.URL),
+		config.WithWsBaseURL(wsServer.URL()),
+	)
+	if err := a.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{
+			Public:     "public",
+			Secret:     "secret",
+			Passphrase: "passphrase",
+		},
+	}); err != nil {
+		t.Fatalf("connect: %s", err)
+	}
+
+	return adaptertest.Harness{
+		Adapter:       a,
+		Exchange:      exchange,
+		WsConnections: wsServer.Connections,
+	}
+}
+
+// newTestStandInHandler - kucoin REST API over the stand-in exchange
+func newTestStandInHandler(exchange *adaptertest.Exchange) http.Handler {
+	mux := http.NewServeMux()
+
+	symbol := mappers.Symbol{
+		Symbol:         testConformancePair,
+		BaseCurrency:   "BTC",
+		QuoteCurrency:  "USDT",
+		BaseMinSize:    "0.00001",
+		BaseMaxSize:    "10000",
+		BaseIncrement:  "0.00000001",
+		PriceIncrement: "0.1",
+		MinFunds:       "0.1",
+		EnableTrading:  true,
+	}
+	mux.HandleFunc("GET "+endpointGetSymbols, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, []mappers.Symbol{symbol})
+	})
+	mux.HandleFunc("GET "+endpointGetSymbols+"/{symbol}", func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, symbol)
+	})
+	mux.HandleFunc("GET "+endpointGetLevel1, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, mappers.Level1{Price: "60000"})
+	})
+
+	mux.HandleFunc("POST "+endpointBulletPublic, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, wsBullet{
+			Token:           "token",
+			InstanceServers: []wsInstanceServer{{Endpoint: "ws://localhost"}},
+		})
+	})
+
+	mux.HandleFunc("POST "+endpointOrders, func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		side, _ := mappers.ConvertOrderSide(request["side"])
+		order, err := exchange.PlaceOrder(adaptertest.Order{
+			ClientOrderID: request["clientOid"],
+			PairSymbol:    request["symbol"],
+			Side:          side,
+			IsMarket:      request["type"] == orderTypeMarket,
+			Qty:           decimal.RequireFromString(request["size"]),
+			Price:         decimal.RequireFromString(request["price"]),
+		})
+		if errors.Is(err, errs.ErrOrderDuplicate) {
+			writeTestError(w, testErrOrderDuplicate)
+			return
+		}
+
+		writeTestResponse(w, mappers.PlacedOrder{
+			OrderID:   strconv.FormatInt(order.ID, 10),
+			ClientOid: order.ClientOrderID,
+		})
+	})
+
+	getOrderByID := func(r *http.Request) (adaptertest.Order, error) {
+		orderID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+		if err != nil {
+			return adaptertest.Order{}, err
+		}
+		return exchange.GetOrder(orderID)
+	}
+	getOrderByClientOrderID := func(r *http.Request) (adaptertest.Order, error) {
+		return exchange.GetOrderByClientOrderID(r.PathValue("id"))
+	}
+
+	for endpoint, getOrder := range map[string]func(r *http.Request) (adaptertest.Order, error){
+		endpointOrders:      getOrderByID,
+		endpointClientOrder: getOrderByClientOrderID,
+	} {
+		mux.HandleFunc("GET "+endpoint+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			order, err := getOrder(r)
+			if err != nil {
+				writeTestError(w, testErrOrderNotExist)
+				return
+			}
+			writeTestResponse(w, convertTestOrder(order))
+		})
+
+		// kucoin doesn't tell the unknown order from the finished one on cancel
+		mux.HandleFunc("DELETE "+endpoint+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			order, err := getOrder(r)
+			if err == nil {
+				_, err = exchange.CancelOrder(order.ID)
+			}
+			if err != nil {
+				writeTestError(w, testErrCancelOrderNotExist)
+				return
+			}
+			writeTestResponse(w, map[string]any{
+				"cancelledOrderIds": []string{strconv.FormatInt(order.ID, 10)},
+			})
+		})
+	}
+	return mux
+}
+
+func convertTestOrder(order adaptertest.Order) mappers.Order {
+	side, _ := mappers.GetOrderSide(order.Side)
+	orderType := orderTypeLimit
+	if order.IsMarket {
+		orderType = orderTypeMarket
+	}
+
+	return mappers.Order{
+		ID:            strconv.FormatInt(order.ID, 10),
+		ClientOid:     order.ClientOrderID,
+		Symbol:        order.PairSymbol,
+		Type:          orderType,
+		Side:          side,
+		Price:         order.Price.String(),
+		Size:          order.Qty.String(),
+		DealSize:      order.FilledQty.String(),
+		DealFunds:     order.FilledQty.Mul(order.Price).String(),
+		Fee:           "0",
+		FeeCurrency:   "USDT",
+		IsActive:      order.Status == pkgStructs.OrderStatusNew,
+		CancelExist:   order.Status == pkgStructs.OrderStatusCancelled,
+		CreatedAt:     order.CreatedTime,
+		LastUpdatedAt: order.UpdatedTime,
+	}
+}
+
+func writeTestResponse(w http.ResponseWriter, data any) {
+	writeTestJSON(w, map[string]any{"code": restSuccessCode, "data": data})
+}
+
+func writeTestError(w http.ResponseWriter, message string) {
+	writeTestJSON(w, map[string]any{"code": testErrCode, "msg": message})
+}
+
+func writeTestJSON(w http.ResponseWriter, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,49 @@
+package mappers
+
+import (
+	"strings"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+// kucoin permission -> our permission. General is the read permission
+var apiKeyPermissionConvertor = map[string]consts.APIKeyPermission{
+	"Spot":       consts.APIKeyPermissionSpotTrade,
+	"Margin":     consts.APIKeyPermissionMarginTrade,
+	"Futures":    consts.APIKeyPermissionFuturesTrade,
+	"Withdrawal": consts.APIKeyPermissionWithdraw,
+}
+
+// permissionGeneral - the read permission granted to every key
+const permissionGeneral = "General"
+
+func ConvertAPIKeyInfo(data APIKeyInfo) pkgStructs.APIKeyInfo {
+	info := pkgStructs.APIKeyInfo{IsReadOnly: true}
+	for _, permission := range splitList(data.Permission) {
+		if permission == permissionGeneral {
+			continue
+		}
+		// transfers & earn permissions aren't read only as well
+		info.IsReadOnly = false
+
+		if converted, isExists := apiKeyPermissionConvertor[permission]; isExists {
+			info.Permissions = append(info.Permissions, converted)
+		}
+	}
+
+	info.IPWhitelist = splitList(data.IPWhitelist)
+	info.IsIPRestricted = len(info.IPWhitelist) > 0
+	return info
+}
+
+// splitList - comma separated values without spaces & empty ones
+func splitList(value string) []string {
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,42 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertAPIKeyInfo(t *testing.T) {
+	// given
+	data := APIKeyInfo{
+		Permission:  "General,Spot,Withdrawal",
+		IPWhitelist: "1.2.3.4",
+	}
+
+	// when
+	info := ConvertAPIKeyInfo(data)
+
+	// then
+	assert.Equal(t, []consts.APIKeyPermission{
+		consts.APIKeyPermissionSpotTrade,
+		consts.APIKeyPermissionWithdraw,
+	}, info.Permissions)
+	assert.False(t, info.IsReadOnly)
+	assert.True(t, info.IsIPRestricted)
+	assert.Equal(t, []string{"1.2.3.4"}, info.IPWhitelist)
+}
+
+func TestConvertAPIKeyInfoReadOnly(t *testing.T) {
+	// given
+	data := APIKeyInfo{Permission: "General"}
+
+	// when
+	info := ConvertAPIKeyInfo(data)
+
+	// then
+	assert.Empty(t, info.Permissions)
+	assert.True(t, info.IsReadOnly)
+	assert.False(t, info.IsIPRestricted)
+}
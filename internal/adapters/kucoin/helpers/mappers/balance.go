@@ -0,0 +1,51 @@
+package mappers
+
+import (
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+// Account - account asset balance
+type Account struct {
+	Currency  string `json:"currency"`
+	Type      string `json:"type"` // main, trade
+	Balance   string `json:"balance"`
+	Available string `json:"available"`
+	Holds     string `json:"holds"`
+}
+
+// APIKeyInfo - API key permissions
+type APIKeyInfo struct {
+	UID         int64  `json:"uid"`
+	APIKey      string `json:"apiKey"`
+	Permission  string `json:"permission"`  // e.g. "General,Spot"
+	IPWhitelist string `json:"ipWhitelist"` // comma separated
+}
+
+// TransferResult - internal transfer result
+type TransferResult struct {
+	OrderID string `json:"orderId"`
+}
+
+func ConvertBalances(accounts []Account) ([]structs.Balance, error) {
+	result := make([]structs.Balance, 0, len(accounts))
+	for _, account := range accounts {
+		free, err := parseDecimal(account.Available)
+		if err != nil {
+			return nil, fmt.Errorf("parse %q free: %w", account.Currency, err)
+		}
+
+		locked, err := parseDecimal(account.Holds)
+		if err != nil {
+			return nil, fmt.Errorf("parse %q locked: %w", account.Currency, err)
+		}
+
+		result = append(result, structs.Balance{
+			Asset:  account.Currency,
+			Free:   free,
+			Locked: locked,
+		})
+	}
+	return result, nil
+}
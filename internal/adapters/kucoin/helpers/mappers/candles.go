@@ -0,0 +1,81 @@
+package mappers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+const candleValuesMinCount = 6
+
+// Candle - [time (sec), open, close, high, low, volume, turnover]
+type Candle []string
+
+func ConvertCandle(
+	candle Candle,
+	interval consts.Interval,
+	duration time.Duration,
+) (workers.CandleData, error) {
+	if len(candle) < candleValuesMinCount {
+		return workers.CandleData{}, errors.New("invalid candle data")
+	}
+
+	startTimeSec, err := strconv.ParseInt(candle[0], 10, 64)
+	if err != nil {
+		return workers.CandleData{}, fmt.Errorf("parse start time: %w", err)
+	}
+	startTime := startTimeSec * int64(time.Second/time.Millisecond)
+
+	values, err := parseCandleValues(candle[1:6])
+	if err != nil {
+		return workers.CandleData{}, err
+	}
+
+	return workers.CandleData{
+		StartTime: startTime,
+		EndTime:   startTime + duration.Milliseconds() - 1,
+		Interval:  interval,
+		Open:      values[0],
+		Close:     values[1],
+		High:      values[2],
+		Low:       values[3],
+		Volume:    values[4],
+	}, nil
+}
+
+// ConvertCandles - kucoin candles are sorted from new to old
+func ConvertCandles(
+	candles []Candle,
+	interval consts.Interval,
+	duration time.Duration,
+) ([]workers.CandleData, error) {
+	result := make([]workers.CandleData, 0, len(candles))
+	for i := len(candles) - 1; i >= 0; i-- {
+		candle, err := ConvertCandle(candles[i], interval, duration)
+		if err != nil {
+			return nil, fmt.Errorf("convert candle: %w", err)
+		}
+		result = append(result, candle)
+	}
+	return result, nil
+}
+
+var candleValueNames = []string{"open", "close", "high", "low", "volume"}
+
+func parseCandleValues(values []string) ([]decimal.Decimal, error) {
+	result := make([]decimal.Decimal, 0, len(values))
+	for i, value := range values {
+		parsed, err := parseDecimal(value)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", candleValueNames[i], err)
+		}
+		result = append(result, parsed)
+	}
+	return result, nil
+}
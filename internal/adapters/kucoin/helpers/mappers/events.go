@@ -0,0 +1,120 @@
+package mappers
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+const orderEventTypeMatch = "match"
+
+// WsCandle - candles topic event
+type WsCandle struct {
+	Symbol  string `json:"symbol"`
+	Candles Candle `json:"candles"`
+	Time    int64  `json:"time"` // unix ns
+}
+
+// WsMatch - public trades topic event
+type WsMatch struct {
+	Symbol  string `json:"symbol"`
+	TradeID string `json:"tradeId"`
+	Side    string `json:"side"` // taker side
+	Price   string `json:"price"`
+	Size    string `json:"size"`
+	Time    string `json:"time"` // unix ns
+}
+
+// WsOrder - private orders topic event
+type WsOrder struct {
+	Symbol     string `json:"symbol"`
+	OrderID    string `json:"orderId"`
+	ClientOid  string `json:"clientOid"`
+	Type       string `json:"type"` // open, match, filled, canceled, update
+	TradeID    string `json:"tradeId"`
+	MatchPrice string `json:"matchPrice"`
+	MatchSize  string `json:"matchSize"`
+	Ts         int64  `json:"ts"` // unix ns
+}
+
+// IsFill - the order update is caused by the trade
+func (o WsOrder) IsFill() bool {
+	return o.Type == orderEventTypeMatch
+}
+
+// ConvertCandleEvent - kucoin doesn't mark the completed candles
+func ConvertCandleEvent(
+	event WsCandle,
+	interval consts.Interval,
+	duration time.Duration,
+) (workers.CandleEvent, error) {
+	candle, err := ConvertCandle(event.Candles, interval, duration)
+	if err != nil {
+		return workers.CandleEvent{}, err
+	}
+
+	return workers.CandleEvent{
+		Symbol: event.Symbol,
+		Candle: candle,
+		Time:   time.Duration(event.Time).Milliseconds(),
+	}, nil
+}
+
+func ConvertPublicTradeEvent(event WsMatch) (workers.PublicTradeEvent, error) {
+	price, err := parseDecimal(event.Price)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := parseDecimal(event.Size)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	takerSide, err := ConvertOrderSide(event.Side)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("convert side: %w", err)
+	}
+
+	tradeTime, err := strconv.ParseInt(event.Time, 10, 64)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse time: %w", err)
+	}
+
+	return workers.PublicTradeEvent{
+		ID:          event.TradeID,
+		Time:        time.Duration(tradeTime).Milliseconds(),
+		ExchangeTag: consts.KuCoinAdapterTag,
+		Symbol:      event.Symbol,
+		Price:       price,
+		Quantity:    qty,
+		TakerSide:   takerSide,
+	}, nil
+}
+
+// ConvertOrderEvent - the order ID is the numeric alias as in the order data
+func ConvertOrderEvent(event WsOrder) (workers.TradeEventPrivate, error) {
+	price, err := parseDecimal(event.MatchPrice)
+	if err != nil {
+		return workers.TradeEventPrivate{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := parseDecimal(event.MatchSize)
+	if err != nil {
+		return workers.TradeEventPrivate{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	return workers.TradeEventPrivate{
+		ID:            event.TradeID,
+		Time:          time.Duration(event.Ts).Milliseconds(),
+		ExchangeTag:   consts.KuCoinAdapterTag,
+		Symbol:        event.Symbol,
+		OrderID:       strconv.FormatInt(GetOrderIDAlias(event.OrderID), 10),
+		ClientOrderID: event.ClientOid,
+		Price:         price,
+		Quantity:      qty,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package mappers
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertCandles(t *testing.T) {
+	// given
+	candles := []Candle{
+		{"1700000060", "2", "2.5", "3", "1", "20", "50"},
+		{"1700000000", "1", "2", "2", "0.5", "10", "15"},
+	}
+
+	// when
+	result, err := ConvertCandles(candles, consts.Interval1min, time.Minute)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, int64(1700000000000), result[0].StartTime)
+	assert.Equal(t, int64(1700000059999), result[0].EndTime)
+	assert.Equal(t, "2", result[0].Close.String())
+	assert.Equal(t, "3", result[1].High.String())
+}
+
+func TestConvertOrderEvent(t *testing.T) {
+	// given
+	event := WsOrder{
+		Symbol:     "BTC-USDT",
+		OrderID:    "5bd6e9286d99522a52e458de",
+		ClientOid:  "test",
+		Type:       "match",
+		TradeID:    "5c35c02703aa673ceec2a168",
+		MatchPrice: "60000",
+		MatchSize:  "0.01",
+		Ts:         1700000000123456789,
+	}
+
+	// when
+	result, err := ConvertOrderEvent(event)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, event.IsFill())
+	assert.Equal(t, strconv.FormatInt(GetOrderIDAlias(event.OrderID), 10), result.OrderID)
+	assert.Equal(t, int64(1700000000123), result.Time)
+	assert.Equal(t, "0.01", result.Quantity.String())
+}
+
+func TestConvertPublicTradeEvent(t *testing.T) {
+	// given
+	event := WsMatch{
+		Symbol:  "BTC-USDT",
+		TradeID: "5c24c5da03aa673885cd67aa",
+		Side:    "buy",
+		Price:   "60000",
+		Size:    "0.01",
+		Time:    "1700000000123456789",
+	}
+
+	// when
+	result, err := ConvertPublicTradeEvent(event)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.OrderSideBuy, result.TakerSide)
+	assert.Equal(t, int64(1700000000123), result.Time)
+	assert.Equal(t, consts.KuCoinAdapterTag, result.ExchangeTag)
+}
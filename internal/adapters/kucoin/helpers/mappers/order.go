@@ -0,0 +1,301 @@
+package mappers
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	sideBuy  = "buy"
+	sideSell = "sell"
+
+	liquidityMaker = "maker"
+)
+
+// Order - spot order data
+type Order struct {
+	ID            string `json:"id"`
+	ClientOid     string `json:"clientOid"`
+	Symbol        string `json:"symbol"`
+	Type          string `json:"type"`
+	Side          string `json:"side"`
+	Price         string `json:"price"`
+	Size          string `json:"size"`
+	DealSize      string `json:"dealSize"`
+	DealFunds     string `json:"dealFunds"`
+	Fee           string `json:"fee"`
+	FeeCurrency   string `json:"feeCurrency"`
+	IsActive      bool   `json:"isActive"`
+	CancelExist   bool   `json:"cancelExist"`
+	CreatedAt     int64  `json:"createdAt"`
+	LastUpdatedAt int64  `json:"lastUpdatedAt"`
+}
+
+// PlacedOrder - order placement result
+type PlacedOrder struct {
+	OrderID   string `json:"orderId"`
+	ClientOid string `json:"clientOid"`
+}
+
+// OrdersPage - orders list page
+type OrdersPage struct {
+	CurrentPage int     `json:"currentPage"`
+	PageSize    int     `json:"pageSize"`
+	TotalNum    int     `json:"totalNum"`
+	TotalPage   int     `json:"totalPage"`
+	Items       []Order `json:"items"`
+}
+
+// FillsPage - account trades page
+type FillsPage struct {
+	CurrentPage int    `json:"currentPage"`
+	PageSize    int    `json:"pageSize"`
+	TotalNum    int    `json:"totalNum"`
+	TotalPage   int    `json:"totalPage"`
+	Items       []Fill `json:"items"`
+}
+
+// Fill - account trade
+type Fill struct {
+	Symbol      string `json:"symbol"`
+	TradeID     string `json:"tradeId"`
+	OrderID     string `json:"orderId"`
+	Side        string `json:"side"`
+	Liquidity   string `json:"liquidity"`
+	Price       string `json:"price"`
+	Size        string `json:"size"`
+	Fee         string `json:"fee"`
+	FeeCurrency string `json:"feeCurrency"`
+	CreatedAt   int64  `json:"createdAt"`
+}
+
+// TradeFee - account fee rates of the pair
+type TradeFee struct {
+	Symbol       string `json:"symbol"`
+	TakerFeeRate string `json:"takerFeeRate"`
+	MakerFeeRate string `json:"makerFeeRate"`
+}
+
+// GetOrderIDAlias - numeric alias of kucoin order ID: positive FNV-1a hash.
+// KuCoin order IDs are 24 hex chars and don't fit into int64
+func GetOrderIDAlias(orderID string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(orderID))
+	return int64(h.Sum64() & math.MaxInt64)
+}
+
+func ConvertOrderSide(side string) (consts.OrderSide, error) {
+	switch side {
+	default:
+		return "", fmt.Errorf("unknown: %q", side)
+	case "":
+		return "", errors.New("not set")
+	case sideBuy:
+		return consts.OrderSideBuy, nil
+	case sideSell:
+		return consts.OrderSideSell, nil
+	}
+}
+
+func GetOrderSide(side consts.OrderSide) (string, error) {
+	switch side {
+	default:
+		return "", fmt.Errorf("unknown: %q", side)
+	case consts.OrderSideBuy:
+		return sideBuy, nil
+	case consts.OrderSideSell:
+		return sideSell, nil
+	}
+}
+
+// GetOrderStatus - kucoin has no order status, only the active & cancel flags
+func GetOrderStatus(order Order, filledQty decimal.Decimal) consts.OrderStatus {
+	switch {
+	case order.IsActive && filledQty.IsPositive():
+		return pkgStructs.OrderStatusPartiallyFilled
+	case order.IsActive:
+		return pkgStructs.OrderStatusNew
+	case order.CancelExist && filledQty.IsPositive():
+		return pkgStructs.OrderStatusPartiallyFilledCancelled
+	case order.CancelExist:
+		return pkgStructs.OrderStatusCancelled
+	default:
+		return pkgStructs.OrderStatusFilled
+	}
+}
+
+func ConvertOrderData(data Order) (structs.OrderData, error) {
+	orderSide, err := ConvertOrderSide(data.Side)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("convert side: %w", err)
+	}
+
+	orderQty, err := parseDecimal(data.Size)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	orderFilledQty, err := parseDecimal(data.DealSize)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse filled qty: %w", err)
+	}
+
+	orderPrice, err := parseDecimal(data.Price)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse price: %w", err)
+	}
+	if orderPrice.IsZero() && orderFilledQty.IsPositive() {
+		// market order: use average price
+		dealFunds, err := parseDecimal(data.DealFunds)
+		if err != nil {
+			return structs.OrderData{}, fmt.Errorf("parse filled funds: %w", err)
+		}
+		orderPrice = dealFunds.Div(orderFilledQty)
+	}
+
+	updatedTime := data.LastUpdatedAt
+	if updatedTime == 0 {
+		updatedTime = data.CreatedAt
+	}
+
+	return structs.OrderData{
+		OrderID:         GetOrderIDAlias(data.ID),
+		ExchangeOrderID: data.ID,
+		ClientOrderID:   data.ClientOid,
+		Status:          GetOrderStatus(data, orderFilledQty),
+		AwaitQty:        orderQty,
+		FilledQty:       orderFilledQty,
+		Price:           orderPrice,
+		Symbol:          data.Symbol,
+		Side:            orderSide,
+		CreatedTime:     data.CreatedAt,
+		UpdatedTime:     updatedTime,
+	}, nil
+}
+
+// ConvertPlacedOrder - kucoin returns order ID only, the rest is taken from the task
+func ConvertPlacedOrder(
+	data PlacedOrder,
+	order structs.BotOrderAdjusted,
+	createdTime int64,
+) (structs.CreateOrderResponse, error) {
+	orderQty, err := parseDecimal(order.Qty)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	orderPrice, err := parseDecimal(order.Price)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	return structs.CreateOrderResponse{
+		OrderID:         GetOrderIDAlias(data.OrderID),
+		ExchangeOrderID: data.OrderID,
+		ClientOrderID:   order.ClientOrderID,
+		OrigQuantity:    orderQty,
+		Price:           orderPrice,
+		Symbol:          order.PairSymbol,
+		Type:            order.Type,
+		CreatedTime:     createdTime,
+		Status:          pkgStructs.OrderStatusNew,
+	}, nil
+}
+
+func ConvertAccountTrade(fill Fill, clientOrderID string) (structs.AccountTrade, error) {
+	side, err := ConvertOrderSide(fill.Side)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("convert side: %w", err)
+	}
+
+	price, err := parseDecimal(fill.Price)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := parseDecimal(fill.Size)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	fee, err := parseDecimal(fill.Fee)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse fee: %w", err)
+	}
+
+	return structs.AccountTrade{
+		ID:              fill.TradeID,
+		OrderID:         GetOrderIDAlias(fill.OrderID),
+		ExchangeOrderID: fill.OrderID,
+		ClientOrderID:   clientOrderID,
+		Symbol:          fill.Symbol,
+		Side:            side,
+		Price:           price,
+		Qty:             qty,
+		Fee:             fee,
+		FeeAsset:        fill.FeeCurrency,
+		IsMaker:         fill.Liquidity == liquidityMaker,
+		Time:            fill.CreatedAt,
+	}, nil
+}
+
+// GetFeesFromFills - order fees by the order fills
+func GetFeesFromFills(
+	fills []Fill,
+	baseAssetTicker string,
+	quoteAssetTicker string,
+) (structs.OrderFees, error) {
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.Zero,
+		QuoteAsset: decimal.Zero,
+	}
+
+	for _, fill := range fills {
+		trade, err := ConvertAccountTrade(fill, "")
+		if err != nil {
+			return structs.OrderFees{}, fmt.Errorf("convert fill: %w", err)
+		}
+
+		switch trade.FeeAsset {
+		case baseAssetTicker:
+			fees.BaseAsset = fees.BaseAsset.Add(trade.Fee)
+		case quoteAssetTicker:
+			fees.QuoteAsset = fees.QuoteAsset.Add(trade.Fee)
+		}
+
+		fees.Commissions = append(fees.Commissions, structs.Commission{
+			TradeID: trade.ID,
+			Asset:   trade.FeeAsset,
+			Amount:  trade.Fee,
+			Price:   trade.Price,
+			Time:    trade.Time,
+		})
+	}
+	return fees, nil
+}
+
+func ConvertTradeFees(rate TradeFee) (structs.TradeFees, error) {
+	maker, err := parseDecimal(rate.MakerFeeRate)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("parse maker fee: %w", err)
+	}
+
+	taker, err := parseDecimal(rate.TakerFeeRate)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("parse taker fee: %w", err)
+	}
+
+	return structs.TradeFees{
+		Symbol: rate.Symbol,
+		Maker:  maker,
+		Taker:  taker,
+	}, nil
+}
@@ -0,0 +1,102 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+func TestGetOrderIDAlias(t *testing.T) {
+	// given
+	orderID := "5bd6e9286d99522a52e458de"
+
+	// when
+	alias := GetOrderIDAlias(orderID)
+
+	// then
+	assert.Positive(t, alias)
+	assert.Equal(t, alias, GetOrderIDAlias(orderID))
+	assert.NotEqual(t, alias, GetOrderIDAlias("5bd6e9286d99522a52e458df"))
+}
+
+func TestConvertOrderData(t *testing.T) {
+	// given
+	order := Order{
+		ID:          "5bd6e9286d99522a52e458de",
+		ClientOid:   "test",
+		Symbol:      "BTC-USDT",
+		Type:        "limit",
+		Side:        "sell",
+		Price:       "60000",
+		Size:        "0.01",
+		DealSize:    "0.005",
+		IsActive:    false,
+		CancelExist: true,
+		CreatedAt:   1700000000000,
+	}
+
+	// when
+	data, err := ConvertOrderData(order)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, GetOrderIDAlias(order.ID), data.OrderID)
+	assert.Equal(t, order.ID, data.ExchangeOrderID)
+	assert.Equal(t, pkgStructs.OrderStatusPartiallyFilledCancelled, data.Status)
+	assert.Equal(t, consts.OrderSideSell, data.Side)
+	assert.Equal(t, int64(1700000000000), data.UpdatedTime)
+}
+
+func TestConvertOrderDataMarketOrderPrice(t *testing.T) {
+	// given
+	order := Order{
+		ID:        "5bd6e9286d99522a52e458de",
+		Side:      "buy",
+		Size:      "2",
+		DealSize:  "2",
+		DealFunds: "201",
+		IsActive:  false,
+	}
+
+	// when
+	data, err := ConvertOrderData(order)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, pkgStructs.OrderStatusFilled, data.Status)
+	assert.Equal(t, "100.5", data.Price.String())
+}
+
+func TestGetOrderStatus(t *testing.T) {
+	assert.Equal(
+		t, pkgStructs.OrderStatusNew,
+		GetOrderStatus(Order{IsActive: true}, decimal.Zero),
+	)
+	assert.Equal(
+		t, pkgStructs.OrderStatusCancelled,
+		GetOrderStatus(Order{CancelExist: true}, decimal.Zero),
+	)
+}
+
+func TestGetFeesFromFills(t *testing.T) {
+	// given
+	fills := []Fill{
+		{TradeID: "1", OrderID: "a", Side: "buy", Price: "10", Size: "1", Fee: "0.01", FeeCurrency: "USDT"},
+		{TradeID: "2", OrderID: "a", Side: "buy", Price: "10", Size: "1", Fee: "0.02", FeeCurrency: "USDT"},
+	}
+
+	// when
+	fees, err := GetFeesFromFills(fills, "ETH", "USDT")
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, fees.BaseAsset.IsZero())
+	assert.Equal(t, "0.03", fees.QuoteAsset.String())
+	assert.Len(t, fees.Commissions, 2)
+}
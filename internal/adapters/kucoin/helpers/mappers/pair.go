@@ -0,0 +1,103 @@
+package mappers
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
+)
+
+// Symbol - spot pair info
+type Symbol struct {
+	Symbol         string `json:"symbol"`
+	BaseCurrency   string `json:"baseCurrency"`
+	QuoteCurrency  string `json:"quoteCurrency"`
+	BaseMinSize    string `json:"baseMinSize"`
+	BaseMaxSize    string `json:"baseMaxSize"`
+	BaseIncrement  string `json:"baseIncrement"`
+	PriceIncrement string `json:"priceIncrement"`
+	MinFunds       string `json:"minFunds"`
+	EnableTrading  bool   `json:"enableTrading"`
+}
+
+// Level1 - pair best prices & the last price
+type Level1 struct {
+	Price string `json:"price"`
+}
+
+func ConvertPairStatus(enableTrading bool) string {
+	if enableTrading {
+		return consts.PairStatusTrading
+	}
+	return consts.PairStatusOffline
+}
+
+func ConvertPairData(data Symbol) (structs.ExchangePairData, error) {
+	qtyStep, err := parseDecimal(data.BaseIncrement)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse base increment: %w", err)
+	}
+
+	priceStep, err := parseDecimal(data.PriceIncrement)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse price increment: %w", err)
+	}
+
+	minQty, err := parseDecimal(data.BaseMinSize)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse min size: %w", err)
+	}
+
+	maxQty, err := parseDecimal(data.BaseMaxSize)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse max size: %w", err)
+	}
+
+	minFunds, err := parseDecimal(data.MinFunds)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse min funds: %w", err)
+	}
+
+	return structs.ExchangePairData{
+		ExchangeID:         consts.ExchangeIDkucoin,
+		BaseAsset:          data.BaseCurrency,
+		QuoteAsset:         data.QuoteCurrency,
+		BasePrecision:      utils.GetDecimalPrecision(qtyStep),
+		QuotePrecision:     utils.GetDecimalPrecision(priceStep),
+		Status:             ConvertPairStatus(data.EnableTrading),
+		Symbol:             data.Symbol,
+		MinQty:             minQty,
+		MaxQty:             maxQty,
+		OriginalMinDeposit: minFunds,
+		MinDeposit:         minFunds,
+		MinPrice:           priceStep,
+		QtyStep:            qtyStep,
+		PriceStep:          priceStep,
+		AllowedMargin:      false,
+		AllowedSpot:        true,
+		InUse:              true,
+	}, nil
+}
+
+func ConvertPairs(symbols []Symbol) ([]structs.ExchangePairData, error) {
+	result := make([]structs.ExchangePairData, 0, len(symbols))
+	for _, symbol := range symbols {
+		pairData, err := ConvertPairData(symbol)
+		if err != nil {
+			return nil, fmt.Errorf("convert %q: %w", symbol.Symbol, err)
+		}
+		result = append(result, pairData)
+	}
+	return result, nil
+}
+
+// parseDecimal - kucoin sends null or empty strings instead of zero values
+func parseDecimal(value string) (decimal.Decimal, error) {
+	if value == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(value)
+}
@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertPairData(t *testing.T) {
+	// given
+	symbol := Symbol{
+		Symbol:         "BTC-USDT",
+		BaseCurrency:   "BTC",
+		QuoteCurrency:  "USDT",
+		BaseMinSize:    "0.00001",
+		BaseMaxSize:    "10000000000",
+		BaseIncrement:  "0.00000001",
+		PriceIncrement: "0.1",
+		MinFunds:       "0.1",
+		EnableTrading:  true,
+	}
+
+	// when
+	pairData, err := ConvertPairData(symbol)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.ExchangeIDkucoin, pairData.ExchangeID)
+	assert.Equal(t, consts.PairStatusTrading, pairData.Status)
+	assert.Equal(t, 8, pairData.BasePrecision)
+	assert.Equal(t, 1, pairData.QuotePrecision)
+	assert.Equal(t, "0.00000001", pairData.QtyStep.String())
+	assert.Equal(t, "0.1", pairData.PriceStep.String())
+	assert.Equal(t, "0.1", pairData.MinDeposit.String())
+	assert.Equal(t, "0.00001", pairData.MinQty.String())
+}
+
+func TestConvertPairDataDisabled(t *testing.T) {
+	// when
+	pairData, err := ConvertPairData(Symbol{Symbol: "BTC-USDT"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.PairStatusOffline, pairData.Status)
+}
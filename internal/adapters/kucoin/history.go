@@ -0,0 +1,167 @@
+package kucoin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/kucoin/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	tradesHistoryMaxWindow = time.Hour * 24 * 7
+	fillsPageLimit         = 500
+	ordersPageLimit        = 500
+
+	orderStatusDone = "done"
+)
+
+func (a *adapter) GetAccountTrades(
+	task structs.GetOrdersHistoryTask,
+) ([]structs.AccountTrade, error) {
+	if !a.isPrivateAPIAvailable() {
+		return nil, errs.ErrAPIKeyNotSet
+	}
+
+	windows, err := baseadp.SplitHistoryTask(task, tradesHistoryMaxWindow)
+	if err != nil {
+		return nil, fmt.Errorf("check task: %w", err)
+	}
+
+	ctx := baseadp.GetHistoryTaskContext(task)
+
+	var fills []mappers.Fill
+	for _, window := range windows {
+		windowFills, err := a.getWindowFills(ctx, task.PairSymbol, window)
+		if err != nil {
+			return nil, fmt.Errorf("get trades: %w", err)
+		}
+		fills = append(fills, windowFills...)
+	}
+
+	clientOrderIDs, err := a.getClientOrderIDs(ctx, task.PairSymbol, windows, fills)
+	if err != nil {
+		return nil, fmt.Errorf("get orders: %w", err)
+	}
+
+	result := make([]structs.AccountTrade, 0, len(fills))
+	for _, fill := range fills {
+		// the trade order ID alias is resolved by the adapter
+		a.orderIDs.save(fill.OrderID)
+		trade, err := mappers.ConvertAccountTrade(fill, clientOrderIDs[fill.OrderID])
+		if err != nil {
+			return nil, fmt.Errorf("convert trade: %w", err)
+		}
+		result = append(result, trade)
+	}
+	return baseadp.SortAccountTrades(result), nil
+}
+
+func (a *adapter) getWindowFills(
+	ctx context.Context,
+	pairSymbol string,
+	window baseadp.TimeWindow,
+) ([]mappers.Fill, error) {
+	var result []mappers.Fill
+	for currentPage := 1; ; currentPage++ {
+		var page mappers.FillsPage
+		if err := a.rest.get(
+			ctx,
+			endpointGetFills,
+			map[string]any{
+				"symbol":      pairSymbol,
+				"startAt":     window.StartTime,
+				"endAt":       window.EndTime,
+				"pageSize":    fillsPageLimit,
+				"currentPage": currentPage,
+			},
+			&page,
+		); err != nil {
+			return nil, err
+		}
+
+		result = append(result, page.Items...)
+		if currentPage >= page.TotalPage {
+			return result, nil
+		}
+	}
+}
+
+// getClientOrderIDs - kucoin fills don't contain client order ID.
+// The done orders of the windows with fills are listed by pages, the orders
+// missing in the list, e.g. placed before the window or active, are requested one by one
+func (a *adapter) getClientOrderIDs(
+	ctx context.Context,
+	pairSymbol string,
+	windows []baseadp.TimeWindow,
+	fills []mappers.Fill,
+) (map[string]string, error) {
+	result := map[string]string{}
+	for _, window := range windows {
+		if !hasWindowFills(window, fills) {
+			continue
+		}
+
+		if err := a.getWindowClientOrderIDs(ctx, pairSymbol, window, result); err != nil {
+			return nil, fmt.Errorf("get orders: %w", err)
+		}
+	}
+
+	for _, fill := range fills {
+		if _, isExists := result[fill.OrderID]; isExists {
+			continue
+		}
+
+		order, err := a.getOrder(endpointOrders + "/" + fill.OrderID)
+		if err != nil {
+			return nil, fmt.Errorf("get order %v: %w", fill.OrderID, err)
+		}
+		result[fill.OrderID] = order.ClientOrderID
+	}
+	return result, nil
+}
+
+func (a *adapter) getWindowClientOrderIDs(
+	ctx context.Context,
+	pairSymbol string,
+	window baseadp.TimeWindow,
+	result map[string]string,
+) error {
+	for currentPage := 1; ; currentPage++ {
+		var page mappers.OrdersPage
+		if err := a.rest.get(
+			ctx,
+			endpointOrders,
+			map[string]any{
+				"symbol":      pairSymbol,
+				"status":      orderStatusDone,
+				"startAt":     window.StartTime,
+				"endAt":       window.EndTime,
+				"pageSize":    ordersPageLimit,
+				"currentPage": currentPage,
+			},
+			&page,
+		); err != nil {
+			return err
+		}
+
+		for _, order := range page.Items {
+			result[order.ID] = order.ClientOid
+		}
+		if currentPage >= page.TotalPage {
+			return nil
+		}
+	}
+}
+
+func hasWindowFills(window baseadp.TimeWindow, fills []mappers.Fill) bool {
+	for _, fill := range fills {
+		if fill.CreatedAt >= window.StartTime && fill.CreatedAt <= window.EndTime {
+			return true
+		}
+	}
+	return false
+}
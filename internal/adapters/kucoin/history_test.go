@@ -0,0 +1,91 @@
+package kucoin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/kucoin/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+func TestGetAccountTradesClientOrderIDs(t *testing.T) {
+	// given
+	var ordersListRequests, orderRequests int
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+endpointGetFills, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, mappers.FillsPage{TotalPage: 1, Items: []mappers.Fill{
+			getTestFill("1", "order-1", 1700000001000),
+			getTestFill("2", "order-1", 1700000002000),
+			getTestFill("3", "order-2", 1700000003000),
+		}})
+	})
+	mux.HandleFunc("GET "+endpointOrders, func(w http.ResponseWriter, r *http.Request) {
+		ordersListRequests++
+		writeTestResponse(w, mappers.OrdersPage{TotalPage: 1, Items: []mappers.Order{
+			{ID: "order-1", ClientOid: "client-1"},
+		}})
+	})
+	// the order placed before the task is requested by ID
+	mux.HandleFunc("GET "+endpointOrders+"/order-2", func(w http.ResponseWriter, r *http.Request) {
+		orderRequests++
+		writeTestResponse(w, mappers.Order{
+			ID:        "order-2",
+			ClientOid: "client-2",
+			Side:      "buy",
+			Size:      "1",
+			DealSize:  "1",
+			Price:     "100",
+			DealFunds: "100",
+			Fee:       "0",
+		})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	a := New(config.WithRESTBaseURL(server.URL))
+	require.NoError(t, a.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{
+			Public:     "public",
+			Secret:     "secret",
+			Passphrase: "passphrase",
+		},
+	}))
+
+	// when
+	trades, err := a.GetAccountTrades(structs.GetOrdersHistoryTask{
+		PairSymbol: "BTC-USDT",
+		StartTime:  1700000000000,
+		EndTime:    1700000010000,
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, trades, 3)
+	assert.Equal(t, "client-1", trades[0].ClientOrderID)
+	assert.Equal(t, "client-1", trades[1].ClientOrderID)
+	assert.Equal(t, "client-2", trades[2].ClientOrderID)
+	assert.Equal(t, 1, ordersListRequests)
+	assert.Equal(t, 1, orderRequests)
+}
+
+func getTestFill(tradeID, orderID string, createdAt int64) mappers.Fill {
+	return mappers.Fill{
+		Symbol:      "BTC-USDT",
+		TradeID:     tradeID,
+		OrderID:     orderID,
+		Side:        "buy",
+		Liquidity:   "maker",
+		Price:       "100",
+		Size:        "1",
+		Fee:         "0.1",
+		FeeCurrency: "USDT",
+		CreatedAt:   createdAt,
+	}
+}
@@ -0,0 +1,49 @@
+package kucoin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+type IntervalData struct {
+	Interval consts.Interval
+	Duration time.Duration
+}
+
+// kucoin candle type -> our interval
+var intervalKuCoinToOur = map[string]IntervalData{
+	"1min":   {consts.Interval1min, time.Minute},
+	"3min":   {consts.Interval3min, time.Minute * 3},
+	"5min":   {consts.Interval5min, time.Minute * 5},
+	"15min":  {consts.Interval15min, time.Minute * 15},
+	"30min":  {consts.Interval30min, time.Minute * 30},
+	"1hour":  {consts.Interval1hour, time.Hour},
+	"2hour":  {consts.Interval2hour, time.Hour * 2},
+	"4hour":  {consts.Interval4hour, time.Hour * 4},
+	"6hour":  {consts.Interval6hour, time.Hour * 6},
+	"8hour":  {consts.Interval8hour, time.Hour * 8},
+	"12hour": {consts.Interval12hour, time.Hour * 12},
+	"1day":   {consts.Interval1day, time.Hour * 24},
+	"1week":  {consts.Interval1week, time.Hour * 24 * 7},
+	"1month": {consts.Interval1month, time.Hour * 24 * 31},
+}
+
+var ourIntervalToKuCoin = func() map[consts.Interval]string {
+	r := map[consts.Interval]string{}
+	for candleType, data := range intervalKuCoinToOur {
+		r[data.Interval] = candleType
+	}
+	return r
+}()
+
+// ConvertIntervalToKuCoin - the candle type is the same for REST & websocket
+func ConvertIntervalToKuCoin(interval consts.Interval) (string, error) {
+	result, isExists := ourIntervalToKuCoin[interval]
+	if !isExists {
+		return "", &errs.NotSupportedError{Feature: fmt.Sprintf("interval %q", interval)}
+	}
+	return result, nil
+}
@@ -0,0 +1,234 @@
+package kucoin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/kucoin/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	endpointGetFills     = "/api/v1/fills"
+	endpointGetTradeFees = "/api/v1/trade-fees"
+
+	orderTypeLimit  = "limit"
+	orderTypeMarket = "market"
+
+	errOrderDuplicateMessage = "duplicate"
+)
+
+func (a *adapter) PlaceOrder(
+	ctx context.Context,
+	order structs.BotOrderAdjusted,
+) (structs.CreateOrderResponse, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.CreateOrderResponse{}, errs.ErrAPIKeyNotSet
+	}
+
+	orderSide, err := mappers.GetOrderSide(order.Type)
+	if err != nil {
+		return structs.CreateOrderResponse{},
+			fmt.Errorf("get order side: %w", err)
+	}
+
+	// the client order ID is required by kucoin
+	if order.ClientOrderID == "" {
+		order.ClientOrderID = a.GenClientOrderID()
+	}
+
+	params := map[string]any{
+		"clientOid": order.ClientOrderID,
+		"symbol":    order.PairSymbol,
+		"side":      orderSide,
+		"type":      orderTypeLimit,
+		"size":      order.Qty,
+		"price":     order.Price,
+	}
+	if order.IsMarketOrder {
+		params["type"] = orderTypeMarket
+		delete(params, "price")
+	}
+
+	var response mappers.PlacedOrder
+	if err := a.rest.post(ctx, endpointOrders, params, &response); err != nil {
+		if isAPIErrorMessage(err, errOrderDuplicateMessage) {
+			return structs.CreateOrderResponse{}, errs.ErrOrderDuplicate
+		}
+		return structs.CreateOrderResponse{}, fmt.Errorf("create: %w", err)
+	}
+	if response.OrderID == "" {
+		return structs.CreateOrderResponse{}, errors.New("order response is empty")
+	}
+	a.orderIDs.save(response.OrderID)
+
+	result, err := mappers.ConvertPlacedOrder(response, order, time.Now().UnixMilli())
+	if err != nil {
+		return structs.CreateOrderResponse{},
+			fmt.Errorf("convert: %w", err)
+	}
+	return result, nil
+}
+
+func (a *adapter) GetOrderData(
+	pairSymbol string,
+	orderID int64,
+) (structs.OrderData, error) {
+	kucoinOrderID, err := a.orderIDs.get(orderID)
+	if err != nil {
+		return structs.OrderData{}, err
+	}
+
+	return a.GetOrderDataByExchangeOrderID(pairSymbol, kucoinOrderID)
+}
+
+func (a *adapter) GetOrderDataByExchangeOrderID(
+	pairSymbol string,
+	exchangeOrderID string,
+) (structs.OrderData, error) {
+	return a.getOrder(endpointOrders + "/" + exchangeOrderID)
+}
+
+func (a *adapter) GetOrderByClientOrderID(
+	pairSymbol string,
+	clientOrderID string,
+) (structs.OrderData, error) {
+	return a.getOrder(endpointClientOrder + "/" + clientOrderID)
+}
+
+func (a *adapter) getOrder(endpoint string) (structs.OrderData, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.OrderData{}, errs.ErrAPIKeyNotSet
+	}
+
+	var order mappers.Order
+	if err := a.rest.get(context.Background(), endpoint, nil, &order); err != nil {
+		if isAPIErrorMessage(err, errOrderNotExistMessage) {
+			return structs.OrderData{}, errs.ErrOrderNotFound
+		}
+		return structs.OrderData{}, fmt.Errorf("get: %w", err)
+	}
+	if order.ID == "" {
+		return structs.OrderData{}, errs.ErrOrderNotFound
+	}
+	a.orderIDs.save(order.ID)
+
+	return mappers.ConvertOrderData(order)
+}
+
+func (a *adapter) GetHistoryOrder(
+	pairSymbol string,
+	orderID int64,
+) (structs.OrderHistory, error) {
+	kucoinOrderID, err := a.orderIDs.get(orderID)
+	if err != nil {
+		return structs.OrderHistory{}, err
+	}
+
+	return a.GetHistoryOrderByExchangeOrderID(pairSymbol, kucoinOrderID)
+}
+
+func (a *adapter) GetHistoryOrderByExchangeOrderID(
+	pairSymbol string,
+	exchangeOrderID string,
+) (structs.OrderHistory, error) {
+	orderData, err := a.GetOrderDataByExchangeOrderID(pairSymbol, exchangeOrderID)
+	if err != nil {
+		return structs.OrderHistory{}, fmt.Errorf("get order: %w", err)
+	}
+
+	fills, err := a.getOrderFills(orderData.ExchangeOrderID)
+	if err != nil {
+		return structs.OrderHistory{}, fmt.Errorf("get fills: %w", err)
+	}
+
+	baseAsset, quoteAsset := splitPairSymbol(pairSymbol)
+	fees, err := mappers.GetFeesFromFills(fills, baseAsset, quoteAsset)
+	if err != nil {
+		return structs.OrderHistory{}, fmt.Errorf("get fees: %w", err)
+	}
+
+	return structs.OrderHistory{
+		OrderData: orderData,
+		Fees:      fees,
+	}, nil
+}
+
+func (a *adapter) GetOrderExecFee(
+	baseAssetTicker string,
+	quoteAssetTicker string,
+	orderSide consts.OrderSide,
+	orderID int64,
+) (structs.OrderFees, error) {
+	kucoinOrderID, err := a.orderIDs.get(orderID)
+	if err != nil {
+		return structs.OrderFees{}, err
+	}
+
+	return a.GetOrderExecFeeByExchangeOrderID(baseAssetTicker, quoteAssetTicker, kucoinOrderID)
+}
+
+func (a *adapter) GetOrderExecFeeByExchangeOrderID(
+	baseAssetTicker string,
+	quoteAssetTicker string,
+	exchangeOrderID string,
+) (structs.OrderFees, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.OrderFees{}, errs.ErrAPIKeyNotSet
+	}
+
+	fills, err := a.getOrderFills(exchangeOrderID)
+	if err != nil {
+		return structs.OrderFees{}, fmt.Errorf("get fills: %w", err)
+	}
+
+	return mappers.GetFeesFromFills(fills, baseAssetTicker, quoteAssetTicker)
+}
+
+func (a *adapter) getOrderFills(orderID string) ([]mappers.Fill, error) {
+	var page mappers.FillsPage
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetFills,
+		map[string]any{
+			"orderId":  orderID,
+			"pageSize": fillsPageLimit,
+		},
+		&page,
+	); err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+func (a *adapter) GetTradeFees(pairSymbol string) (structs.TradeFees, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.TradeFees{}, errs.ErrAPIKeyNotSet
+	}
+
+	var rates []mappers.TradeFee
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetTradeFees,
+		map[string]any{"symbols": pairSymbol},
+		&rates,
+	); err != nil {
+		return structs.TradeFees{}, fmt.Errorf("get: %w", err)
+	}
+	if len(rates) == 0 {
+		return structs.TradeFees{}, errors.New("fee rates not found")
+	}
+
+	return mappers.ConvertTradeFees(rates[0])
+}
+
+// splitPairSymbol - "BTC-USDT" -> "BTC", "USDT"
+func splitPairSymbol(pairSymbol string) (string, string) {
+	baseAsset, quoteAsset, _ := strings.Cut(pairSymbol, symbolDelimiter)
+	return baseAsset, quoteAsset
+}
@@ -0,0 +1,88 @@
+package kucoin
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/kucoin/helpers/mappers"
+)
+
+// orderIDsMemoryLimit - the oldest aliases are evicted from the memory store
+const orderIDsMemoryLimit = 100000
+
+var errOrderIDAliasUnknown = errors.New(
+	"unknown order ID alias: the order was not placed or read by the adapter, " +
+		"use the exchange order ID or the client order ID",
+)
+
+// orderIDRegistry - numeric aliases of kucoin order IDs, the adapter interface
+// methods take int64 order ID
+type orderIDRegistry struct {
+	store config.OrderIDStore
+}
+
+func newOrderIDRegistry(store config.OrderIDStore) *orderIDRegistry {
+	if store == nil {
+		store = newMemoryOrderIDStore(orderIDsMemoryLimit)
+	}
+	return &orderIDRegistry{store: store}
+}
+
+// save - remember kucoin order ID & get its alias
+func (r *orderIDRegistry) save(orderID string) int64 {
+	alias := mappers.GetOrderIDAlias(orderID)
+	r.store.Save(alias, orderID)
+	return alias
+}
+
+// get - kucoin order ID by the alias
+func (r *orderIDRegistry) get(alias int64) (string, error) {
+	orderID, isExists := r.store.Get(alias)
+	if !isExists {
+		return "", fmt.Errorf("%w: %d", errOrderIDAliasUnknown, alias)
+	}
+	return orderID, nil
+}
+
+// memoryOrderIDStore - in-memory aliases limited by the size,
+// the oldest ones are evicted first
+type memoryOrderIDStore struct {
+	limit int
+
+	mu    sync.RWMutex
+	ids   map[int64]string
+	order []int64 // aliases in the saving order
+}
+
+func newMemoryOrderIDStore(limit int) *memoryOrderIDStore {
+	return &memoryOrderIDStore{
+		limit: limit,
+		ids:   map[int64]string{},
+	}
+}
+
+func (s *memoryOrderIDStore) Save(alias int64, exchangeOrderID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, isExists := s.ids[alias]; isExists {
+		return
+	}
+
+	s.ids[alias] = exchangeOrderID
+	s.order = append(s.order, alias)
+	if len(s.order) > s.limit {
+		delete(s.ids, s.order[0])
+		s.order = s.order[1:]
+	}
+}
+
+func (s *memoryOrderIDStore) Get(alias int64) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	orderID, isExists := s.ids[alias]
+	return orderID, isExists
+}
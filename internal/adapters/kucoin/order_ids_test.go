@@ -0,0 +1,81 @@
+package kucoin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	adp "github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+)
+
+func TestOrderIDRegistry(t *testing.T) {
+	// given
+	registry := newOrderIDRegistry(nil)
+	orderID := "5bd6e9286d99522a52e458de"
+
+	// when
+	alias := registry.save(orderID)
+	result, err := registry.get(alias)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, orderID, result)
+}
+
+func TestOrderIDRegistryUnknownAlias(t *testing.T) {
+	// when
+	_, err := newOrderIDRegistry(nil).get(1)
+
+	// then
+	assert.ErrorIs(t, err, errOrderIDAliasUnknown)
+}
+
+func TestOrderIDRegistryInjectedStore(t *testing.T) {
+	// given
+	store := newMemoryOrderIDStore(10)
+	orderID := "5bd6e9286d99522a52e458de"
+	alias := newOrderIDRegistry(store).save(orderID)
+
+	// when
+	// the new registry, e.g. after the restart, with the same store
+	result, err := newOrderIDRegistry(store).get(alias)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, orderID, result)
+}
+
+func TestMemoryOrderIDStoreEviction(t *testing.T) {
+	// given
+	store := newMemoryOrderIDStore(2)
+
+	// when
+	store.Save(1, "first")
+	store.Save(2, "second")
+	store.Save(3, "third")
+
+	// then
+	_, isExists := store.Get(1)
+	assert.False(t, isExists)
+	orderID, isExists := store.Get(3)
+	assert.True(t, isExists)
+	assert.Equal(t, "third", orderID)
+}
+
+func TestAdapterWithOrderIDStore(t *testing.T) {
+	// given
+	store := newMemoryOrderIDStore(10)
+	store.Save(1, "5bd6e9286d99522a52e458de")
+
+	// when
+	a := New(config.WithOrderIDStore(store))
+
+	// then
+	_, isExchangeOrderIDAdapter := a.(adp.ExchangeOrderIDAdapter)
+	assert.True(t, isExchangeOrderIDAdapter)
+	orderID, err := a.(*adapter).orderIDs.get(1)
+	require.NoError(t, err)
+	assert.Equal(t, "5bd6e9286d99522a52e458de", orderID)
+}
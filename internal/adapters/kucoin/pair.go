@@ -0,0 +1,143 @@
+package kucoin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/kucoin/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	endpointGetSymbols  = "/api/v2/symbols"
+	endpointGetLevel1   = "/api/v1/market/orderbook/level1"
+	endpointOrders      = "/api/v1/orders"
+	endpointClientOrder = "/api/v1/order/client-order"
+
+	errOrderNotExistMessage       = "not exist"
+	errCancelOrderNotExistMessage = "not_exist"
+)
+
+func (a *adapter) GetPairData(pairSymbol string) (structs.ExchangePairData, error) {
+	var symbol mappers.Symbol
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetSymbols+"/"+pairSymbol,
+		nil,
+		&symbol,
+	); err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("get symbol: %w", err)
+	}
+
+	return mappers.ConvertPairData(symbol)
+}
+
+func (a *adapter) GetPairLastPrice(pairSymbol string) (decimal.Decimal, error) {
+	var level1 mappers.Level1
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetLevel1,
+		map[string]any{"symbol": pairSymbol},
+		&level1,
+	); err != nil {
+		return decimal.Zero, fmt.Errorf("get ticker: %w", err)
+	}
+	if level1.Price == "" {
+		return decimal.Zero, fmt.Errorf("%q last price not found", pairSymbol)
+	}
+
+	lastPrice, err := decimal.NewFromString(level1.Price)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("parse last price: %w", err)
+	}
+	return lastPrice, nil
+}
+
+func (a *adapter) GetPairs() ([]structs.ExchangePairData, error) {
+	var symbols []mappers.Symbol
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetSymbols,
+		nil,
+		&symbols,
+	); err != nil {
+		return nil, fmt.Errorf("get symbols: %w", err)
+	}
+
+	pairs, err := mappers.ConvertPairs(symbols)
+	if err != nil {
+		return nil, fmt.Errorf("convert: %w", err)
+	}
+	return pairs, nil
+}
+
+func (a *adapter) GetPairBalance(pair structs.PairSymbolData) (
+	structs.PairBalance,
+	error,
+) {
+	balances, err := a.GetAccountBalance()
+	if err != nil {
+		return structs.PairBalance{}, fmt.Errorf("get: %w", err)
+	}
+
+	return utils.FindPairBalance(balances, pair), nil
+}
+
+func (a *adapter) CancelPairOrder(
+	pairSymbol string,
+	orderID int64,
+	ctx context.Context,
+) error {
+	kucoinOrderID, err := a.orderIDs.get(orderID)
+	if err != nil {
+		return err
+	}
+
+	return a.CancelPairOrderByExchangeOrderID(pairSymbol, kucoinOrderID, ctx)
+}
+
+func (a *adapter) CancelPairOrderByExchangeOrderID(
+	pairSymbol string,
+	exchangeOrderID string,
+	ctx context.Context,
+) error {
+	return a.cancelOrder(ctx, endpointOrders+"/"+exchangeOrderID)
+}
+
+func (a *adapter) CancelPairOrderByClientOrderID(
+	pairSymbol string,
+	clientOrderID string,
+	ctx context.Context,
+) error {
+	return a.cancelOrder(ctx, endpointClientOrder+"/"+clientOrderID)
+}
+
+func (a *adapter) cancelOrder(ctx context.Context, endpoint string) error {
+	if !a.isPrivateAPIAvailable() {
+		return errs.ErrAPIKeyNotSet
+	}
+
+	if err := a.rest.delete(ctx, endpoint, nil, nil); err != nil {
+		if isAPIErrorMessage(err, errOrderNotExistMessage) ||
+			isAPIErrorMessage(err, errCancelOrderNotExistMessage) {
+			return a.getCancelNotExistError(endpoint)
+		}
+		return err
+	}
+	return nil
+}
+
+// getCancelNotExistError - kucoin returns the same error for the unknown
+// & the finished order, the order is read to tell them apart.
+// The order & cancel endpoints share the path
+func (a *adapter) getCancelNotExistError(endpoint string) error {
+	order, err := a.getOrder(endpoint)
+	if err == nil && order.Status == pkgStructs.OrderStatusFilled {
+		return errs.ErrOrderFilled
+	}
+	return errs.ErrOrderNotFound
+}
@@ -0,0 +1,228 @@
+package kucoin
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	restBaseURL        = "https://api.kucoin.com"
+	restRequestTimeout = time.Second * 10
+	restSuccessCode    = "200000"
+	apiKeyVersion      = "2"
+)
+
+// APIError - KuCoin API error
+type APIError struct {
+	Code    string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("kucoin api error %s: %s", e.Code, e.Message)
+}
+
+// isAPIErrorMessage - check if the error is KuCoin API error
+// containing the message. KuCoin uses the same code for many errors
+func isAPIErrorMessage(err error, message string) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return strings.Contains(strings.ToLower(apiErr.Message), message)
+}
+
+// isAPIErrorCode - check if the error is KuCoin API error with one of the codes
+func isAPIErrorCode(err error, codes ...string) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	for _, code := range codes {
+		if apiErr.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+type restResponse struct {
+	Code string          `json:"code"`
+	Msg  string          `json:"msg"`
+	Data json.RawMessage `json:"data"`
+}
+
+// restClient - signed requests to KuCoin REST API
+type restClient struct {
+	httpClient *http.Client
+	baseURL    string
+	keyPublic  string
+	keySecret  string
+	passphrase string
+	now        func() time.Time
+
+	// the websocket servers are received from the REST API,
+	// the endpoint set overrides them
+	wsEndpoint string
+	wsDialer   *websocket.Dialer
+}
+
+// newRestClient - the signed requests timestamps are taken from the clock
+func newRestClient(
+	credentials pkgStructs.APICredentials,
+	cfg config.Config,
+	now func() time.Time,
+) *restClient {
+	return &restClient{
+		httpClient: cfg.NewHTTPClient(restRequestTimeout),
+		baseURL:    cfg.GetRESTBaseURL(restBaseURL, restBaseURL),
+		keyPublic:  credentials.Keypair.Public,
+		keySecret:  credentials.Keypair.Secret,
+		passphrase: credentials.Keypair.Passphrase,
+		now:        now,
+		wsEndpoint: cfg.WsBaseURL,
+		wsDialer:   cfg.NewWsDialer(),
+	}
+}
+
+// get - send GET request & decode response data
+func (c *restClient) get(
+	ctx context.Context,
+	endpoint string,
+	params map[string]any,
+	result any,
+) error {
+	return c.send(ctx, http.MethodGet, withQuery(endpoint, params), nil, result)
+}
+
+// post - send POST request with JSON body & decode response data
+func (c *restClient) post(
+	ctx context.Context,
+	endpoint string,
+	params map[string]any,
+	result any,
+) error {
+	// the request without params is sent with empty body
+	var body []byte
+	if params != nil {
+		var err error
+		if body, err = json.Marshal(params); err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+	}
+	return c.send(ctx, http.MethodPost, endpoint, body, result)
+}
+
+// delete - send DELETE request & decode response data
+func (c *restClient) delete(
+	ctx context.Context,
+	endpoint string,
+	params map[string]any,
+	result any,
+) error {
+	return c.send(ctx, http.MethodDelete, withQuery(endpoint, params), nil, result)
+}
+
+func (c *restClient) send(
+	ctx context.Context,
+	method string,
+	requestPath string,
+	body []byte,
+	result any,
+) error {
+	req, err := http.NewRequestWithContext(
+		ctx, method, c.baseURL+requestPath, bytes.NewReader(body),
+	)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// public endpoints don't require the signature
+	if c.keyPublic != "" {
+		timestamp := strconv.FormatInt(c.now().UnixMilli(), 10)
+		req.Header.Set("KC-API-KEY", c.keyPublic)
+		req.Header.Set("KC-API-SIGN", sign(
+			c.keySecret, timestamp+method+requestPath+string(body),
+		))
+		req.Header.Set("KC-API-TIMESTAMP", timestamp)
+		// the passphrase is signed since the API key version 2
+		req.Header.Set("KC-API-PASSPHRASE", sign(c.keySecret, c.passphrase))
+		req.Header.Set("KC-API-KEY-VERSION", apiKeyVersion)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+
+	response := restResponse{}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("http status %d, body: %s", resp.StatusCode, string(respBody))
+		}
+		return fmt.Errorf("decode response: %w", err)
+	}
+	if response.Code != restSuccessCode {
+		return &APIError{Code: response.Code, Message: response.Msg}
+	}
+
+	if result == nil || len(response.Data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(response.Data, result); err != nil {
+		return fmt.Errorf("decode response data: %w", err)
+	}
+	return nil
+}
+
+// sign - base64 encoded HMAC SHA256 signature
+func sign(secret, message string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(message))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// withQuery - endpoint with sorted query params. The same string is signed
+func withQuery(endpoint string, params map[string]any) string {
+	if len(params) == 0 {
+		return endpoint
+	}
+
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, key+"="+url.QueryEscape(fmt.Sprintf("%v", params[key])))
+	}
+	return endpoint + "?" + strings.Join(parts, "&")
+}
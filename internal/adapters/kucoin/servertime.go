@@ -0,0 +1,32 @@
+package kucoin
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const endpointGetServerTime = "/api/v1/timestamp"
+
+func (a *adapter) GetServerTime() (time.Time, error) {
+	// unix timestamp ms
+	var ts int64
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetServerTime,
+		nil,
+		&ts,
+	); err != nil {
+		return time.Time{}, fmt.Errorf("get server time: %w", err)
+	}
+	if ts <= 0 {
+		return time.Time{}, fmt.Errorf("invalid time: %d", ts)
+	}
+	return time.UnixMilli(ts), nil
+}
+
+// SetServerTimeOffset - the offset is applied to the REST requests signature
+func (a *adapter) SetServerTimeOffset(offset time.Duration) error {
+	a.clock.SetOffset(offset)
+	return nil
+}
@@ -0,0 +1,93 @@
+package kucoin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/kucoin/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	endpointTransfer = "/api/v2/accounts/inner-transfer"
+
+	errInsufficientBalanceMessage = "insufficient"
+)
+
+var transferAccountTypes = map[consts.AccountType]string{
+	consts.AccountTypeSpot:    accountTypeTrade,
+	consts.AccountTypeFunding: accountTypeFunding,
+}
+
+func (a *adapter) GetAccountBalances(accountType consts.AccountType) ([]structs.Balance, error) {
+	kucoinAccountType, isExists := transferAccountTypes[accountType]
+	if !isExists {
+		return nil, fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, accountType)
+	}
+	return a.getBalances(kucoinAccountType)
+}
+
+func (a *adapter) Transfer(
+	asset string,
+	amount decimal.Decimal,
+	fromAccount consts.AccountType,
+	toAccount consts.AccountType,
+) (structs.TransferResult, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.TransferResult{}, errs.ErrAPIKeyNotSet
+	}
+	if asset == "" {
+		return structs.TransferResult{}, errors.New("asset is not set")
+	}
+	if !amount.IsPositive() {
+		return structs.TransferResult{}, fmt.Errorf("invalid amount: %s", amount.String())
+	}
+
+	from, isExists := transferAccountTypes[fromAccount]
+	if !isExists {
+		return structs.TransferResult{},
+			fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, fromAccount)
+	}
+	to, isExists := transferAccountTypes[toAccount]
+	if !isExists {
+		return structs.TransferResult{},
+			fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, toAccount)
+	}
+	if from == to {
+		return structs.TransferResult{}, fmt.Errorf("transfer to the same account: %q", from)
+	}
+
+	var response mappers.TransferResult
+	if err := a.rest.post(
+		context.Background(),
+		endpointTransfer,
+		map[string]any{
+			"clientOid": uuid.New().String(),
+			"currency":  asset,
+			"amount":    amount.String(),
+			"from":      from,
+			"to":        to,
+		},
+		&response,
+	); err != nil {
+		if isAPIErrorMessage(err, errInsufficientBalanceMessage) {
+			return structs.TransferResult{},
+				fmt.Errorf("%w: %s", errs.ErrInsufficientBalance, err.Error())
+		}
+		return structs.TransferResult{}, fmt.Errorf("transfer: %w", err)
+	}
+
+	return structs.TransferResult{
+		ID:          response.OrderID,
+		Asset:       asset,
+		Amount:      amount,
+		FromAccount: fromAccount,
+		ToAccount:   toAccount,
+	}, nil
+}
@@ -0,0 +1,260 @@
+package kucoin
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/kucoin/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	tradeSubscriptionKey = "subscription"
+
+	topicCandlesFormat = "/market/candles:%s_%s"
+	topicMatchPrefix   = "/market/match:"
+	topicOrders        = "/spotMarket/tradeOrdersV2"
+)
+
+type CandleEventWorkerKuCoin struct {
+	workers.CandleWorker
+	rest *restClient
+}
+
+func (a *adapter) CreateCandleWorker() *CandleEventWorkerKuCoin {
+	w := &CandleEventWorkerKuCoin{rest: a.rest}
+	w.CandleWorker.ExchangeTag = a.GetTag()
+	return w
+}
+
+type PublicTradeWorkerKuCoin struct {
+	workers.PublicTradeWorker
+	rest *restClient
+}
+
+func (a *adapter) CreatePublicTradeWorker() *PublicTradeWorkerKuCoin {
+	w := &PublicTradeWorkerKuCoin{rest: a.rest}
+	w.PublicTradeWorker.ExchangeTag = a.GetTag()
+	return w
+}
+
+type TradeEventWorkerKuCoin struct {
+	workers.TradeEventWorker
+	rest     *restClient
+	creds    structs.APICredentials
+	orderIDs *orderIDRegistry
+}
+
+func (a *adapter) CreateTradeEventsWorker() *TradeEventWorkerKuCoin {
+	w := &TradeEventWorkerKuCoin{
+		rest:     a.rest,
+		creds:    a.creds,
+		orderIDs: a.orderIDs,
+	}
+	w.TradeEventWorker.ExchangeTag = a.GetTag()
+	return w
+}
+
+func (w *TradeEventWorkerKuCoin) SubscribeToTradeEventsPrivate(
+	eventCallback workers.TradeEventPrivateCallback,
+	errorHandler func(err error),
+) error {
+	if !w.creds.Keypair.IsSet() || w.creds.Keypair.Passphrase == "" {
+		return errs.ErrAPIKeyNotSet
+	}
+
+	if w.TradeEventWorker.IsSubscriptionExists(tradeSubscriptionKey) {
+		return nil
+	}
+
+	wsDone, wsStop, err := wsServe(
+		w.rest,
+		topicOrders,
+		true,
+		func(message wsMessage) {
+			var order mappers.WsOrder
+			if err := json.Unmarshal(message.Data, &order); err != nil {
+				errorHandler(fmt.Errorf("decode order: %w", err))
+				return
+			}
+			if !order.IsFill() {
+				return
+			}
+
+			// the order can be placed outside of the adapter
+			w.orderIDs.save(order.OrderID)
+
+			event, err := mappers.ConvertOrderEvent(order)
+			if err != nil {
+				errorHandler(fmt.Errorf("convert: %w", err))
+				return
+			}
+
+			eventCallback(event)
+		},
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	w.TradeEventWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		tradeSubscriptionKey,
+	)
+	return nil
+}
+
+func (w *CandleEventWorkerKuCoin) SubscribeToCandle(
+	pairSymbol string,
+	interval consts.Interval,
+	eventCallback func(event workers.CandleEvent),
+	errorHandler func(err error),
+) error {
+	candleType, err := ConvertIntervalToKuCoin(interval)
+	if err != nil {
+		return fmt.Errorf("convert interval: %w", err)
+	}
+
+	if w.CandleWorker.IsSubscriptionExists(pairSymbol, candleType) {
+		return nil
+	}
+
+	duration := intervalKuCoinToOur[candleType].Duration
+	wsDone, wsStop, err := wsServe(
+		w.rest,
+		fmt.Sprintf(topicCandlesFormat, pairSymbol, candleType),
+		false,
+		func(message wsMessage) {
+			var candle mappers.WsCandle
+			if err := json.Unmarshal(message.Data, &candle); err != nil {
+				errorHandler(fmt.Errorf("decode candle: %w", err))
+				return
+			}
+
+			event, err := mappers.ConvertCandleEvent(candle, interval, duration)
+			if err != nil {
+				errorHandler(fmt.Errorf("convert: %w", err))
+				return
+			}
+
+			eventCallback(event)
+		},
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	w.CandleWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		pairSymbol, candleType,
+	)
+	return nil
+}
+
+func (w *PublicTradeWorkerKuCoin) SubscribeToPublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	if w.PublicTradeWorker.IsSubscriptionExists(pairSymbol) {
+		return nil
+	}
+
+	wsDone, wsStop, err := wsServe(
+		w.rest,
+		topicMatchPrefix+pairSymbol,
+		false,
+		func(message wsMessage) {
+			var trade mappers.WsMatch
+			if err := json.Unmarshal(message.Data, &trade); err != nil {
+				errorHandler(fmt.Errorf("decode trade: %w", err))
+				return
+			}
+
+			event, err := mappers.ConvertPublicTradeEvent(trade)
+			if err != nil {
+				errorHandler(fmt.Errorf("convert: %w", err))
+				return
+			}
+
+			eventCallback(event)
+		},
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	w.PublicTradeWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		pairSymbol,
+	)
+	return nil
+}
+
+func (a *adapter) SubscribeCandle(
+	pairSymbol string,
+	interval consts.Interval,
+	eventCallback func(event workers.CandleEvent),
+	errorHandler func(err error),
+) error {
+	return a.candleWorker.SubscribeToCandle(
+		pairSymbol,
+		interval,
+		eventCallback,
+		errorHandler,
+	)
+}
+
+func (a *adapter) SubscribeAccountTrades(
+	eventCallback workers.TradeEventPrivateCallback,
+	errorHandler func(err error),
+) error {
+	return a.tradeWorker.SubscribeToTradeEventsPrivate(
+		eventCallback, errorHandler,
+	)
+}
+
+func (a *adapter) SubscribePublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	return a.publicTradeWorker.SubscribeToPublicTrades(
+		pairSymbol,
+		eventCallback,
+		errorHandler,
+	)
+}
+
+func (a *adapter) UnsubscribeCandle(
+	pairSymbol string,
+	interval consts.Interval,
+) {
+	candleType, err := ConvertIntervalToKuCoin(interval)
+	if err != nil {
+		fmt.Printf(
+			"convert interval %q to kucoin: %s\n",
+			interval, err.Error(),
+		)
+		return
+	}
+
+	a.candleWorker.Unsubscribe(pairSymbol, candleType)
+}
+
+func (a *adapter) UnsubscribeAccountTrades() {
+	a.tradeWorker.UnsubscribeAll()
+}
+
+func (a *adapter) UnsubscribePublicTrades(pairSymbol string) {
+	a.publicTradeWorker.Unsubscribe(pairSymbol)
+}
@@ -0,0 +1,205 @@
+package kucoin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	endpointBulletPublic  = "/api/v1/bullet-public"
+	endpointBulletPrivate = "/api/v1/bullet-private"
+
+	wsReadLimit           = 655350
+	wsDefaultPingInterval = time.Second * 18
+
+	wsMessageTypeWelcome   = "welcome"
+	wsMessageTypeMessage   = "message"
+	wsMessageTypeError     = "error"
+	wsMessageTypePing      = "ping"
+	wsMessageTypeSubscribe = "subscribe"
+)
+
+// wsBullet - websocket connection token & servers
+type wsBullet struct {
+	Token           string             `json:"token"`
+	InstanceServers []wsInstanceServer `json:"instanceServers"`
+}
+
+type wsInstanceServer struct {
+	Endpoint     string `json:"endpoint"`
+	PingInterval int64  `json:"pingInterval"` // ms
+}
+
+type wsRequest struct {
+	ID             string `json:"id"`
+	Type           string `json:"type"`
+	Topic          string `json:"topic,omitempty"`
+	PrivateChannel bool   `json:"privateChannel,omitempty"`
+	Response       bool   `json:"response,omitempty"`
+}
+
+// wsMessage - server message: welcome, ack, pong, error or the topic data
+type wsMessage struct {
+	ID      string          `json:"id"`
+	Type    string          `json:"type"`
+	Topic   string          `json:"topic"`
+	Subject string          `json:"subject"`
+	Code    json.Number     `json:"code"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// wsConnection - websocket connection with the writes lock:
+// the messages are sent by the ping loop & on subscribe
+type wsConnection struct {
+	conn *websocket.Conn
+	mu   sync.Mutex
+}
+
+func (c *wsConnection) writeJSON(v any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.WriteJSON(v)
+}
+
+// getBullet - kucoin websocket requires the token, the private token
+// is requested with the API key
+func (r *restClient) getBullet(isPrivate bool) (wsBullet, error) {
+	endpoint := endpointBulletPublic
+	if isPrivate {
+		endpoint = endpointBulletPrivate
+	}
+
+	var bullet wsBullet
+	if err := r.post(context.Background(), endpoint, nil, &bullet); err != nil {
+		return wsBullet{}, err
+	}
+	if bullet.Token == "" || len(bullet.InstanceServers) == 0 {
+		return wsBullet{}, errors.New("websocket token not received")
+	}
+	if r.wsEndpoint != "" {
+		bullet.InstanceServers[0].Endpoint = r.wsEndpoint
+	}
+	return bullet, nil
+}
+
+// wsServe - subscribe to the topic
+func wsServe(
+	rest *restClient,
+	topic string,
+	isPrivate bool,
+	handler func(message wsMessage),
+	errorHandler func(err error),
+) (doneC, stopC chan struct{}, err error) {
+	bullet, err := rest.getBullet(isPrivate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get token: %w", err)
+	}
+	server := bullet.InstanceServers[0]
+
+	pingInterval := wsDefaultPingInterval
+	if server.PingInterval > 0 {
+		pingInterval = time.Duration(server.PingInterval) * time.Millisecond
+	}
+
+	wsURL := server.Endpoint + "?" + url.Values{
+		"token":     {bullet.Token},
+		"connectId": {uuid.New().String()},
+	}.Encode()
+
+	conn, _, err := rest.wsDialer.Dial(wsURL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial: %w", err)
+	}
+	wsConn := &wsConnection{conn: conn}
+
+	// the topic is subscribed after the welcome message
+	var welcome wsMessage
+	if err := conn.ReadJSON(&welcome); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("read welcome message: %w", err)
+	}
+	if welcome.Type != wsMessageTypeWelcome {
+		conn.Close()
+		return nil, nil, fmt.Errorf("unexpected message: %q", welcome.Type)
+	}
+
+	if err := wsConn.writeJSON(wsRequest{
+		ID:             uuid.New().String(),
+		Type:           wsMessageTypeSubscribe,
+		Topic:          topic,
+		PrivateChannel: isPrivate,
+		Response:       true,
+	}); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("send request: %w", err)
+	}
+
+	conn.SetReadLimit(wsReadLimit)
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+
+	go func() {
+		defer close(doneC)
+		var isStopped atomic.Bool
+
+		// await stop & keep alive
+		go func() {
+			ticker := time.NewTicker(pingInterval)
+			defer ticker.Stop()
+			defer conn.Close()
+
+			for {
+				select {
+				case <-stopC:
+					isStopped.Store(true)
+					return
+				case <-doneC:
+					return
+				case <-ticker.C:
+					if err := wsConn.writeJSON(wsRequest{
+						ID:   strconv.FormatInt(time.Now().UnixMilli(), 10),
+						Type: wsMessageTypePing,
+					}); err != nil {
+						errorHandler(fmt.Errorf("ping: %w", err))
+					}
+				}
+			}
+		}()
+
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				if !isStopped.Load() {
+					errorHandler(err)
+				}
+				return
+			}
+
+			var message wsMessage
+			if err := json.Unmarshal(data, &message); err != nil {
+				errorHandler(fmt.Errorf("decode message: %w", err))
+				continue
+			}
+
+			switch message.Type {
+			case wsMessageTypeMessage:
+				handler(message)
+			case wsMessageTypeError:
+				errorHandler(fmt.Errorf(
+					"subscription error %s: %s", message.Code, string(message.Data),
+				))
+			}
+		}
+	}()
+	return doneC, stopC, nil
+}
@@ -12,11 +12,13 @@ package adapters
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	consts "github.com/matrixbotio/exchange-gates-lib/internal/consts"
 	structs "github.com/matrixbotio/exchange-gates-lib/internal/structs"
 	workers "github.com/matrixbotio/exchange-gates-lib/internal/workers"
 	structs0 "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+	decimal "github.com/shopspring/decimal"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -87,6 +89,20 @@ func (mr *MockAdapterMockRecorder) CancelPairOrderByClientOrderID(pairSymbol, cl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPairOrderByClientOrderID", reflect.TypeOf((*MockAdapter)(nil).CancelPairOrderByClientOrderID), pairSymbol, clientOrderID, ctx)
 }
 
+// Capabilities mocks base method.
+func (m *MockAdapter) Capabilities() structs0.AdapterCapabilities {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capabilities")
+	ret0, _ := ret[0].(structs0.AdapterCapabilities)
+	return ret0
+}
+
+// Capabilities indicates an expected call of Capabilities.
+func (mr *MockAdapterMockRecorder) Capabilities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capabilities", reflect.TypeOf((*MockAdapter)(nil).Capabilities))
+}
+
 // Connect mocks base method.
 func (m *MockAdapter) Connect(credentials structs0.APICredentials) error {
 	m.ctrl.T.Helper()
@@ -115,6 +131,21 @@ func (mr *MockAdapterMockRecorder) GenClientOrderID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenClientOrderID", reflect.TypeOf((*MockAdapter)(nil).GenClientOrderID))
 }
 
+// GetAPIKeyInfo mocks base method.
+func (m *MockAdapter) GetAPIKeyInfo() (structs0.APIKeyInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeyInfo")
+	ret0, _ := ret[0].(structs0.APIKeyInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeyInfo indicates an expected call of GetAPIKeyInfo.
+func (mr *MockAdapterMockRecorder) GetAPIKeyInfo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeyInfo", reflect.TypeOf((*MockAdapter)(nil).GetAPIKeyInfo))
+}
+
 // GetAccountBalance mocks base method.
 func (m *MockAdapter) GetAccountBalance() ([]structs.Balance, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +161,36 @@ func (mr *MockAdapterMockRecorder) GetAccountBalance() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalance", reflect.TypeOf((*MockAdapter)(nil).GetAccountBalance))
 }
 
+// GetAccountBalances mocks base method.
+func (m *MockAdapter) GetAccountBalances(accountType consts.AccountType) ([]structs.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBalances", accountType)
+	ret0, _ := ret[0].([]structs.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalances indicates an expected call of GetAccountBalances.
+func (mr *MockAdapterMockRecorder) GetAccountBalances(accountType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalances", reflect.TypeOf((*MockAdapter)(nil).GetAccountBalances), accountType)
+}
+
+// GetAccountTrades mocks base method.
+func (m *MockAdapter) GetAccountTrades(task structs.GetOrdersHistoryTask) ([]structs.AccountTrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountTrades", task)
+	ret0, _ := ret[0].([]structs.AccountTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTrades indicates an expected call of GetAccountTrades.
+func (mr *MockAdapterMockRecorder) GetAccountTrades(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTrades", reflect.TypeOf((*MockAdapter)(nil).GetAccountTrades), task)
+}
+
 // GetCandles mocks base method.
 func (m *MockAdapter) GetCandles(limit int, symbol string, interval consts.Interval) ([]workers.CandleData, error) {
 	m.ctrl.T.Helper()
@@ -278,10 +339,10 @@ func (mr *MockAdapterMockRecorder) GetPairData(pairSymbol any) *gomock.Call {
 }
 
 // GetPairLastPrice mocks base method.
-func (m *MockAdapter) GetPairLastPrice(pairSymbol string) (float64, error) {
+func (m *MockAdapter) GetPairLastPrice(pairSymbol string) (decimal.Decimal, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetPairLastPrice", pairSymbol)
-	ret0, _ := ret[0].(float64)
+	ret0, _ := ret[0].(decimal.Decimal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -321,6 +382,35 @@ func (mr *MockAdapterMockRecorder) GetPairs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPairs", reflect.TypeOf((*MockAdapter)(nil).GetPairs))
 }
 
+// GetServerTime mocks base method.
+func (m *MockAdapter) GetServerTime() (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerTime")
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerTime indicates an expected call of GetServerTime.
+func (mr *MockAdapterMockRecorder) GetServerTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerTime", reflect.TypeOf((*MockAdapter)(nil).GetServerTime))
+}
+
+// GetSupportedIntervals mocks base method.
+func (m *MockAdapter) GetSupportedIntervals() []consts.Interval {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportedIntervals")
+	ret0, _ := ret[0].([]consts.Interval)
+	return ret0
+}
+
+// GetSupportedIntervals indicates an expected call of GetSupportedIntervals.
+func (mr *MockAdapterMockRecorder) GetSupportedIntervals() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedIntervals", reflect.TypeOf((*MockAdapter)(nil).GetSupportedIntervals))
+}
+
 // GetTag mocks base method.
 func (m *MockAdapter) GetTag() string {
 	m.ctrl.T.Helper()
@@ -335,6 +425,21 @@ func (mr *MockAdapterMockRecorder) GetTag() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTag", reflect.TypeOf((*MockAdapter)(nil).GetTag))
 }
 
+// GetTradeFees mocks base method.
+func (m *MockAdapter) GetTradeFees(pairSymbol string) (structs.TradeFees, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTradeFees", pairSymbol)
+	ret0, _ := ret[0].(structs.TradeFees)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTradeFees indicates an expected call of GetTradeFees.
+func (mr *MockAdapterMockRecorder) GetTradeFees(pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTradeFees", reflect.TypeOf((*MockAdapter)(nil).GetTradeFees), pairSymbol)
+}
+
 // PlaceOrder mocks base method.
 func (m *MockAdapter) PlaceOrder(ctx context.Context, order structs.BotOrderAdjusted) (structs.CreateOrderResponse, error) {
 	m.ctrl.T.Helper()
@@ -350,6 +455,20 @@ func (mr *MockAdapterMockRecorder) PlaceOrder(ctx, order any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceOrder", reflect.TypeOf((*MockAdapter)(nil).PlaceOrder), ctx, order)
 }
 
+// SetServerTimeOffset mocks base method.
+func (m *MockAdapter) SetServerTimeOffset(offset time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetServerTimeOffset", offset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetServerTimeOffset indicates an expected call of SetServerTimeOffset.
+func (mr *MockAdapterMockRecorder) SetServerTimeOffset(offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServerTimeOffset", reflect.TypeOf((*MockAdapter)(nil).SetServerTimeOffset), offset)
+}
+
 // SubscribeAccountTrades mocks base method.
 func (m *MockAdapter) SubscribeAccountTrades(eventCallback workers.TradeEventPrivateCallback, errorHandler func(error)) error {
 	m.ctrl.T.Helper()
@@ -378,6 +497,35 @@ func (mr *MockAdapterMockRecorder) SubscribeCandle(pairSymbol, interval, eventCa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeCandle", reflect.TypeOf((*MockAdapter)(nil).SubscribeCandle), pairSymbol, interval, eventCallback, errorHandler)
 }
 
+// SubscribePublicTrades mocks base method.
+func (m *MockAdapter) SubscribePublicTrades(pairSymbol string, eventCallback workers.PublicTradeEventCallback, errorHandler func(error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribePublicTrades", pairSymbol, eventCallback, errorHandler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribePublicTrades indicates an expected call of SubscribePublicTrades.
+func (mr *MockAdapterMockRecorder) SubscribePublicTrades(pairSymbol, eventCallback, errorHandler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribePublicTrades", reflect.TypeOf((*MockAdapter)(nil).SubscribePublicTrades), pairSymbol, eventCallback, errorHandler)
+}
+
+// Transfer mocks base method.
+func (m *MockAdapter) Transfer(asset string, amount decimal.Decimal, fromAccount, toAccount consts.AccountType) (structs.TransferResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", asset, amount, fromAccount, toAccount)
+	ret0, _ := ret[0].(structs.TransferResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockAdapterMockRecorder) Transfer(asset, amount, fromAccount, toAccount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockAdapter)(nil).Transfer), asset, amount, fromAccount, toAccount)
+}
+
 // UnsubscribeAccountTrades mocks base method.
 func (m *MockAdapter) UnsubscribeAccountTrades() {
 	m.ctrl.T.Helper()
@@ -402,6 +550,18 @@ func (mr *MockAdapterMockRecorder) UnsubscribeCandle(pairSymbol, interval any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeCandle", reflect.TypeOf((*MockAdapter)(nil).UnsubscribeCandle), pairSymbol, interval)
 }
 
+// UnsubscribePublicTrades mocks base method.
+func (m *MockAdapter) UnsubscribePublicTrades(pairSymbol string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnsubscribePublicTrades", pairSymbol)
+}
+
+// UnsubscribePublicTrades indicates an expected call of UnsubscribePublicTrades.
+func (mr *MockAdapterMockRecorder) UnsubscribePublicTrades(pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribePublicTrades", reflect.TypeOf((*MockAdapter)(nil).UnsubscribePublicTrades), pairSymbol)
+}
+
 // VerifyAPIKeys mocks base method.
 func (m *MockAdapter) VerifyAPIKeys(keyPublic, keySecret string) error {
 	m.ctrl.T.Helper()
@@ -415,3 +575,928 @@ func (mr *MockAdapterMockRecorder) VerifyAPIKeys(keyPublic, keySecret any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAPIKeys", reflect.TypeOf((*MockAdapter)(nil).VerifyAPIKeys), keyPublic, keySecret)
 }
+
+// MockAccountTypeSelector is a mock of AccountTypeSelector interface.
+type MockAccountTypeSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountTypeSelectorMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountTypeSelectorMockRecorder is the mock recorder for MockAccountTypeSelector.
+type MockAccountTypeSelectorMockRecorder struct {
+	mock *MockAccountTypeSelector
+}
+
+// NewMockAccountTypeSelector creates a new mock instance.
+func NewMockAccountTypeSelector(ctrl *gomock.Controller) *MockAccountTypeSelector {
+	mock := &MockAccountTypeSelector{ctrl: ctrl}
+	mock.recorder = &MockAccountTypeSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountTypeSelector) EXPECT() *MockAccountTypeSelectorMockRecorder {
+	return m.recorder
+}
+
+// DetectAccountType mocks base method.
+func (m *MockAccountTypeSelector) DetectAccountType() (consts.AccountType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectAccountType")
+	ret0, _ := ret[0].(consts.AccountType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectAccountType indicates an expected call of DetectAccountType.
+func (mr *MockAccountTypeSelectorMockRecorder) DetectAccountType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectAccountType", reflect.TypeOf((*MockAccountTypeSelector)(nil).DetectAccountType))
+}
+
+// GetAccountType mocks base method.
+func (m *MockAccountTypeSelector) GetAccountType() consts.AccountType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountType")
+	ret0, _ := ret[0].(consts.AccountType)
+	return ret0
+}
+
+// GetAccountType indicates an expected call of GetAccountType.
+func (mr *MockAccountTypeSelectorMockRecorder) GetAccountType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountType", reflect.TypeOf((*MockAccountTypeSelector)(nil).GetAccountType))
+}
+
+// SetAccountType mocks base method.
+func (m *MockAccountTypeSelector) SetAccountType(accountType consts.AccountType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccountType", accountType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAccountType indicates an expected call of SetAccountType.
+func (mr *MockAccountTypeSelectorMockRecorder) SetAccountType(accountType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountType", reflect.TypeOf((*MockAccountTypeSelector)(nil).SetAccountType), accountType)
+}
+
+// MockExchangeOrderIDAdapter is a mock of ExchangeOrderIDAdapter interface.
+type MockExchangeOrderIDAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeOrderIDAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockExchangeOrderIDAdapterMockRecorder is the mock recorder for MockExchangeOrderIDAdapter.
+type MockExchangeOrderIDAdapterMockRecorder struct {
+	mock *MockExchangeOrderIDAdapter
+}
+
+// NewMockExchangeOrderIDAdapter creates a new mock instance.
+func NewMockExchangeOrderIDAdapter(ctrl *gomock.Controller) *MockExchangeOrderIDAdapter {
+	mock := &MockExchangeOrderIDAdapter{ctrl: ctrl}
+	mock.recorder = &MockExchangeOrderIDAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeOrderIDAdapter) EXPECT() *MockExchangeOrderIDAdapterMockRecorder {
+	return m.recorder
+}
+
+// CancelPairOrderByExchangeOrderID mocks base method.
+func (m *MockExchangeOrderIDAdapter) CancelPairOrderByExchangeOrderID(pairSymbol, exchangeOrderID string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPairOrderByExchangeOrderID", pairSymbol, exchangeOrderID, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPairOrderByExchangeOrderID indicates an expected call of CancelPairOrderByExchangeOrderID.
+func (mr *MockExchangeOrderIDAdapterMockRecorder) CancelPairOrderByExchangeOrderID(pairSymbol, exchangeOrderID, ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPairOrderByExchangeOrderID", reflect.TypeOf((*MockExchangeOrderIDAdapter)(nil).CancelPairOrderByExchangeOrderID), pairSymbol, exchangeOrderID, ctx)
+}
+
+// GetHistoryOrderByExchangeOrderID mocks base method.
+func (m *MockExchangeOrderIDAdapter) GetHistoryOrderByExchangeOrderID(pairSymbol, exchangeOrderID string) (structs.OrderHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryOrderByExchangeOrderID", pairSymbol, exchangeOrderID)
+	ret0, _ := ret[0].(structs.OrderHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryOrderByExchangeOrderID indicates an expected call of GetHistoryOrderByExchangeOrderID.
+func (mr *MockExchangeOrderIDAdapterMockRecorder) GetHistoryOrderByExchangeOrderID(pairSymbol, exchangeOrderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryOrderByExchangeOrderID", reflect.TypeOf((*MockExchangeOrderIDAdapter)(nil).GetHistoryOrderByExchangeOrderID), pairSymbol, exchangeOrderID)
+}
+
+// GetOrderDataByExchangeOrderID mocks base method.
+func (m *MockExchangeOrderIDAdapter) GetOrderDataByExchangeOrderID(pairSymbol, exchangeOrderID string) (structs.OrderData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderDataByExchangeOrderID", pairSymbol, exchangeOrderID)
+	ret0, _ := ret[0].(structs.OrderData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderDataByExchangeOrderID indicates an expected call of GetOrderDataByExchangeOrderID.
+func (mr *MockExchangeOrderIDAdapterMockRecorder) GetOrderDataByExchangeOrderID(pairSymbol, exchangeOrderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderDataByExchangeOrderID", reflect.TypeOf((*MockExchangeOrderIDAdapter)(nil).GetOrderDataByExchangeOrderID), pairSymbol, exchangeOrderID)
+}
+
+// GetOrderExecFeeByExchangeOrderID mocks base method.
+func (m *MockExchangeOrderIDAdapter) GetOrderExecFeeByExchangeOrderID(baseAssetTicker, quoteAssetTicker, exchangeOrderID string) (structs.OrderFees, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderExecFeeByExchangeOrderID", baseAssetTicker, quoteAssetTicker, exchangeOrderID)
+	ret0, _ := ret[0].(structs.OrderFees)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderExecFeeByExchangeOrderID indicates an expected call of GetOrderExecFeeByExchangeOrderID.
+func (mr *MockExchangeOrderIDAdapterMockRecorder) GetOrderExecFeeByExchangeOrderID(baseAssetTicker, quoteAssetTicker, exchangeOrderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderExecFeeByExchangeOrderID", reflect.TypeOf((*MockExchangeOrderIDAdapter)(nil).GetOrderExecFeeByExchangeOrderID), baseAssetTicker, quoteAssetTicker, exchangeOrderID)
+}
+
+// MockMarginAdapter is a mock of MarginAdapter interface.
+type MockMarginAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockMarginAdapterMockRecorder is the mock recorder for MockMarginAdapter.
+type MockMarginAdapterMockRecorder struct {
+	mock *MockMarginAdapter
+}
+
+// NewMockMarginAdapter creates a new mock instance.
+func NewMockMarginAdapter(ctrl *gomock.Controller) *MockMarginAdapter {
+	mock := &MockMarginAdapter{ctrl: ctrl}
+	mock.recorder = &MockMarginAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginAdapter) EXPECT() *MockMarginAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetMarginAccount mocks base method.
+func (m *MockMarginAdapter) GetMarginAccount(mode consts.MarginMode, pairSymbol string) (structs.MarginAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMarginAccount", mode, pairSymbol)
+	ret0, _ := ret[0].(structs.MarginAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMarginAccount indicates an expected call of GetMarginAccount.
+func (mr *MockMarginAdapterMockRecorder) GetMarginAccount(mode, pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarginAccount", reflect.TypeOf((*MockMarginAdapter)(nil).GetMarginAccount), mode, pairSymbol)
+}
+
+// MarginBorrow mocks base method.
+func (m *MockMarginAdapter) MarginBorrow(task structs.MarginLoanTask) (structs.MarginLoanResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarginBorrow", task)
+	ret0, _ := ret[0].(structs.MarginLoanResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarginBorrow indicates an expected call of MarginBorrow.
+func (mr *MockMarginAdapterMockRecorder) MarginBorrow(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarginBorrow", reflect.TypeOf((*MockMarginAdapter)(nil).MarginBorrow), task)
+}
+
+// MarginRepay mocks base method.
+func (m *MockMarginAdapter) MarginRepay(task structs.MarginLoanTask) (structs.MarginLoanResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarginRepay", task)
+	ret0, _ := ret[0].(structs.MarginLoanResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarginRepay indicates an expected call of MarginRepay.
+func (mr *MockMarginAdapterMockRecorder) MarginRepay(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarginRepay", reflect.TypeOf((*MockMarginAdapter)(nil).MarginRepay), task)
+}
+
+// PlaceMarginOrder mocks base method.
+func (m *MockMarginAdapter) PlaceMarginOrder(ctx context.Context, order structs.BotOrderAdjusted, params structs.MarginOrderParams) (structs.CreateOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceMarginOrder", ctx, order, params)
+	ret0, _ := ret[0].(structs.CreateOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceMarginOrder indicates an expected call of PlaceMarginOrder.
+func (mr *MockMarginAdapterMockRecorder) PlaceMarginOrder(ctx, order, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceMarginOrder", reflect.TypeOf((*MockMarginAdapter)(nil).PlaceMarginOrder), ctx, order, params)
+}
+
+// MockAccountMarginModeSetter is a mock of AccountMarginModeSetter interface.
+type MockAccountMarginModeSetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountMarginModeSetterMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountMarginModeSetterMockRecorder is the mock recorder for MockAccountMarginModeSetter.
+type MockAccountMarginModeSetterMockRecorder struct {
+	mock *MockAccountMarginModeSetter
+}
+
+// NewMockAccountMarginModeSetter creates a new mock instance.
+func NewMockAccountMarginModeSetter(ctrl *gomock.Controller) *MockAccountMarginModeSetter {
+	mock := &MockAccountMarginModeSetter{ctrl: ctrl}
+	mock.recorder = &MockAccountMarginModeSetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountMarginModeSetter) EXPECT() *MockAccountMarginModeSetterMockRecorder {
+	return m.recorder
+}
+
+// SetAccountMarginMode mocks base method.
+func (m *MockAccountMarginModeSetter) SetAccountMarginMode(mode consts.MarginMode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccountMarginMode", mode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAccountMarginMode indicates an expected call of SetAccountMarginMode.
+func (mr *MockAccountMarginModeSetterMockRecorder) SetAccountMarginMode(mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountMarginMode", reflect.TypeOf((*MockAccountMarginModeSetter)(nil).SetAccountMarginMode), mode)
+}
+
+// MockFuturesAdapter is a mock of FuturesAdapter interface.
+type MockFuturesAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockFuturesAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockFuturesAdapterMockRecorder is the mock recorder for MockFuturesAdapter.
+type MockFuturesAdapterMockRecorder struct {
+	mock *MockFuturesAdapter
+}
+
+// NewMockFuturesAdapter creates a new mock instance.
+func NewMockFuturesAdapter(ctrl *gomock.Controller) *MockFuturesAdapter {
+	mock := &MockFuturesAdapter{ctrl: ctrl}
+	mock.recorder = &MockFuturesAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFuturesAdapter) EXPECT() *MockFuturesAdapterMockRecorder {
+	return m.recorder
+}
+
+// CanTrade mocks base method.
+func (m *MockFuturesAdapter) CanTrade() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanTrade")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanTrade indicates an expected call of CanTrade.
+func (mr *MockFuturesAdapterMockRecorder) CanTrade() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanTrade", reflect.TypeOf((*MockFuturesAdapter)(nil).CanTrade))
+}
+
+// CancelPairOrder mocks base method.
+func (m *MockFuturesAdapter) CancelPairOrder(pairSymbol string, orderID int64, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPairOrder", pairSymbol, orderID, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPairOrder indicates an expected call of CancelPairOrder.
+func (mr *MockFuturesAdapterMockRecorder) CancelPairOrder(pairSymbol, orderID, ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPairOrder", reflect.TypeOf((*MockFuturesAdapter)(nil).CancelPairOrder), pairSymbol, orderID, ctx)
+}
+
+// CancelPairOrderByClientOrderID mocks base method.
+func (m *MockFuturesAdapter) CancelPairOrderByClientOrderID(pairSymbol, clientOrderID string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPairOrderByClientOrderID", pairSymbol, clientOrderID, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPairOrderByClientOrderID indicates an expected call of CancelPairOrderByClientOrderID.
+func (mr *MockFuturesAdapterMockRecorder) CancelPairOrderByClientOrderID(pairSymbol, clientOrderID, ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPairOrderByClientOrderID", reflect.TypeOf((*MockFuturesAdapter)(nil).CancelPairOrderByClientOrderID), pairSymbol, clientOrderID, ctx)
+}
+
+// Capabilities mocks base method.
+func (m *MockFuturesAdapter) Capabilities() structs0.AdapterCapabilities {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capabilities")
+	ret0, _ := ret[0].(structs0.AdapterCapabilities)
+	return ret0
+}
+
+// Capabilities indicates an expected call of Capabilities.
+func (mr *MockFuturesAdapterMockRecorder) Capabilities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capabilities", reflect.TypeOf((*MockFuturesAdapter)(nil).Capabilities))
+}
+
+// Connect mocks base method.
+func (m *MockFuturesAdapter) Connect(credentials structs0.APICredentials) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Connect", credentials)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Connect indicates an expected call of Connect.
+func (mr *MockFuturesAdapterMockRecorder) Connect(credentials any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockFuturesAdapter)(nil).Connect), credentials)
+}
+
+// GenClientOrderID mocks base method.
+func (m *MockFuturesAdapter) GenClientOrderID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenClientOrderID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GenClientOrderID indicates an expected call of GenClientOrderID.
+func (mr *MockFuturesAdapterMockRecorder) GenClientOrderID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenClientOrderID", reflect.TypeOf((*MockFuturesAdapter)(nil).GenClientOrderID))
+}
+
+// GetAPIKeyInfo mocks base method.
+func (m *MockFuturesAdapter) GetAPIKeyInfo() (structs0.APIKeyInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeyInfo")
+	ret0, _ := ret[0].(structs0.APIKeyInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeyInfo indicates an expected call of GetAPIKeyInfo.
+func (mr *MockFuturesAdapterMockRecorder) GetAPIKeyInfo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeyInfo", reflect.TypeOf((*MockFuturesAdapter)(nil).GetAPIKeyInfo))
+}
+
+// GetAccountBalance mocks base method.
+func (m *MockFuturesAdapter) GetAccountBalance() ([]structs.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBalance")
+	ret0, _ := ret[0].([]structs.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalance indicates an expected call of GetAccountBalance.
+func (mr *MockFuturesAdapterMockRecorder) GetAccountBalance() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalance", reflect.TypeOf((*MockFuturesAdapter)(nil).GetAccountBalance))
+}
+
+// GetAccountBalances mocks base method.
+func (m *MockFuturesAdapter) GetAccountBalances(accountType consts.AccountType) ([]structs.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBalances", accountType)
+	ret0, _ := ret[0].([]structs.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalances indicates an expected call of GetAccountBalances.
+func (mr *MockFuturesAdapterMockRecorder) GetAccountBalances(accountType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalances", reflect.TypeOf((*MockFuturesAdapter)(nil).GetAccountBalances), accountType)
+}
+
+// GetAccountTrades mocks base method.
+func (m *MockFuturesAdapter) GetAccountTrades(task structs.GetOrdersHistoryTask) ([]structs.AccountTrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountTrades", task)
+	ret0, _ := ret[0].([]structs.AccountTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTrades indicates an expected call of GetAccountTrades.
+func (mr *MockFuturesAdapterMockRecorder) GetAccountTrades(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTrades", reflect.TypeOf((*MockFuturesAdapter)(nil).GetAccountTrades), task)
+}
+
+// GetCandles mocks base method.
+func (m *MockFuturesAdapter) GetCandles(limit int, symbol string, interval consts.Interval) ([]workers.CandleData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCandles", limit, symbol, interval)
+	ret0, _ := ret[0].([]workers.CandleData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCandles indicates an expected call of GetCandles.
+func (mr *MockFuturesAdapterMockRecorder) GetCandles(limit, symbol, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandles", reflect.TypeOf((*MockFuturesAdapter)(nil).GetCandles), limit, symbol, interval)
+}
+
+// GetFundingInfo mocks base method.
+func (m *MockFuturesAdapter) GetFundingInfo(pairSymbol string) (structs.FundingInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFundingInfo", pairSymbol)
+	ret0, _ := ret[0].(structs.FundingInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFundingInfo indicates an expected call of GetFundingInfo.
+func (mr *MockFuturesAdapterMockRecorder) GetFundingInfo(pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFundingInfo", reflect.TypeOf((*MockFuturesAdapter)(nil).GetFundingInfo), pairSymbol)
+}
+
+// GetHistoryOrder mocks base method.
+func (m *MockFuturesAdapter) GetHistoryOrder(pairSymbol string, orderID int64) (structs.OrderHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryOrder", pairSymbol, orderID)
+	ret0, _ := ret[0].(structs.OrderHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryOrder indicates an expected call of GetHistoryOrder.
+func (mr *MockFuturesAdapterMockRecorder) GetHistoryOrder(pairSymbol, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryOrder", reflect.TypeOf((*MockFuturesAdapter)(nil).GetHistoryOrder), pairSymbol, orderID)
+}
+
+// GetID mocks base method.
+func (m *MockFuturesAdapter) GetID() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetID")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetID indicates an expected call of GetID.
+func (mr *MockFuturesAdapterMockRecorder) GetID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetID", reflect.TypeOf((*MockFuturesAdapter)(nil).GetID))
+}
+
+// GetLimits mocks base method.
+func (m *MockFuturesAdapter) GetLimits() structs0.ExchangeLimits {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLimits")
+	ret0, _ := ret[0].(structs0.ExchangeLimits)
+	return ret0
+}
+
+// GetLimits indicates an expected call of GetLimits.
+func (mr *MockFuturesAdapterMockRecorder) GetLimits() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLimits", reflect.TypeOf((*MockFuturesAdapter)(nil).GetLimits))
+}
+
+// GetName mocks base method.
+func (m *MockFuturesAdapter) GetName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetName indicates an expected call of GetName.
+func (mr *MockFuturesAdapterMockRecorder) GetName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetName", reflect.TypeOf((*MockFuturesAdapter)(nil).GetName))
+}
+
+// GetOrderByClientOrderID mocks base method.
+func (m *MockFuturesAdapter) GetOrderByClientOrderID(pairSymbol, clientOrderID string) (structs.OrderData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderByClientOrderID", pairSymbol, clientOrderID)
+	ret0, _ := ret[0].(structs.OrderData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderByClientOrderID indicates an expected call of GetOrderByClientOrderID.
+func (mr *MockFuturesAdapterMockRecorder) GetOrderByClientOrderID(pairSymbol, clientOrderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByClientOrderID", reflect.TypeOf((*MockFuturesAdapter)(nil).GetOrderByClientOrderID), pairSymbol, clientOrderID)
+}
+
+// GetOrderData mocks base method.
+func (m *MockFuturesAdapter) GetOrderData(pairSymbol string, orderID int64) (structs.OrderData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderData", pairSymbol, orderID)
+	ret0, _ := ret[0].(structs.OrderData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderData indicates an expected call of GetOrderData.
+func (mr *MockFuturesAdapterMockRecorder) GetOrderData(pairSymbol, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderData", reflect.TypeOf((*MockFuturesAdapter)(nil).GetOrderData), pairSymbol, orderID)
+}
+
+// GetOrderExecFee mocks base method.
+func (m *MockFuturesAdapter) GetOrderExecFee(baseAssetTicker, quoteAssetTicker string, orderSide consts.OrderSide, orderID int64) (structs.OrderFees, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderExecFee", baseAssetTicker, quoteAssetTicker, orderSide, orderID)
+	ret0, _ := ret[0].(structs.OrderFees)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderExecFee indicates an expected call of GetOrderExecFee.
+func (mr *MockFuturesAdapterMockRecorder) GetOrderExecFee(baseAssetTicker, quoteAssetTicker, orderSide, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderExecFee", reflect.TypeOf((*MockFuturesAdapter)(nil).GetOrderExecFee), baseAssetTicker, quoteAssetTicker, orderSide, orderID)
+}
+
+// GetPairBalance mocks base method.
+func (m *MockFuturesAdapter) GetPairBalance(pair structs.PairSymbolData) (structs.PairBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPairBalance", pair)
+	ret0, _ := ret[0].(structs.PairBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPairBalance indicates an expected call of GetPairBalance.
+func (mr *MockFuturesAdapterMockRecorder) GetPairBalance(pair any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPairBalance", reflect.TypeOf((*MockFuturesAdapter)(nil).GetPairBalance), pair)
+}
+
+// GetPairData mocks base method.
+func (m *MockFuturesAdapter) GetPairData(pairSymbol string) (structs.ExchangePairData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPairData", pairSymbol)
+	ret0, _ := ret[0].(structs.ExchangePairData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPairData indicates an expected call of GetPairData.
+func (mr *MockFuturesAdapterMockRecorder) GetPairData(pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPairData", reflect.TypeOf((*MockFuturesAdapter)(nil).GetPairData), pairSymbol)
+}
+
+// GetPairLastPrice mocks base method.
+func (m *MockFuturesAdapter) GetPairLastPrice(pairSymbol string) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPairLastPrice", pairSymbol)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPairLastPrice indicates an expected call of GetPairLastPrice.
+func (mr *MockFuturesAdapterMockRecorder) GetPairLastPrice(pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPairLastPrice", reflect.TypeOf((*MockFuturesAdapter)(nil).GetPairLastPrice), pairSymbol)
+}
+
+// GetPairSymbol mocks base method.
+func (m *MockFuturesAdapter) GetPairSymbol(baseTicker, quoteTicker string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPairSymbol", baseTicker, quoteTicker)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetPairSymbol indicates an expected call of GetPairSymbol.
+func (mr *MockFuturesAdapterMockRecorder) GetPairSymbol(baseTicker, quoteTicker any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPairSymbol", reflect.TypeOf((*MockFuturesAdapter)(nil).GetPairSymbol), baseTicker, quoteTicker)
+}
+
+// GetPairs mocks base method.
+func (m *MockFuturesAdapter) GetPairs() ([]structs.ExchangePairData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPairs")
+	ret0, _ := ret[0].([]structs.ExchangePairData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPairs indicates an expected call of GetPairs.
+func (mr *MockFuturesAdapterMockRecorder) GetPairs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPairs", reflect.TypeOf((*MockFuturesAdapter)(nil).GetPairs))
+}
+
+// GetPositions mocks base method.
+func (m *MockFuturesAdapter) GetPositions(pairSymbol string) ([]structs.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPositions", pairSymbol)
+	ret0, _ := ret[0].([]structs.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPositions indicates an expected call of GetPositions.
+func (mr *MockFuturesAdapterMockRecorder) GetPositions(pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPositions", reflect.TypeOf((*MockFuturesAdapter)(nil).GetPositions), pairSymbol)
+}
+
+// GetServerTime mocks base method.
+func (m *MockFuturesAdapter) GetServerTime() (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerTime")
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerTime indicates an expected call of GetServerTime.
+func (mr *MockFuturesAdapterMockRecorder) GetServerTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerTime", reflect.TypeOf((*MockFuturesAdapter)(nil).GetServerTime))
+}
+
+// GetSupportedIntervals mocks base method.
+func (m *MockFuturesAdapter) GetSupportedIntervals() []consts.Interval {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportedIntervals")
+	ret0, _ := ret[0].([]consts.Interval)
+	return ret0
+}
+
+// GetSupportedIntervals indicates an expected call of GetSupportedIntervals.
+func (mr *MockFuturesAdapterMockRecorder) GetSupportedIntervals() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedIntervals", reflect.TypeOf((*MockFuturesAdapter)(nil).GetSupportedIntervals))
+}
+
+// GetTag mocks base method.
+func (m *MockFuturesAdapter) GetTag() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTag")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetTag indicates an expected call of GetTag.
+func (mr *MockFuturesAdapterMockRecorder) GetTag() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTag", reflect.TypeOf((*MockFuturesAdapter)(nil).GetTag))
+}
+
+// GetTradeFees mocks base method.
+func (m *MockFuturesAdapter) GetTradeFees(pairSymbol string) (structs.TradeFees, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTradeFees", pairSymbol)
+	ret0, _ := ret[0].(structs.TradeFees)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTradeFees indicates an expected call of GetTradeFees.
+func (mr *MockFuturesAdapterMockRecorder) GetTradeFees(pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTradeFees", reflect.TypeOf((*MockFuturesAdapter)(nil).GetTradeFees), pairSymbol)
+}
+
+// PlaceFuturesOrder mocks base method.
+func (m *MockFuturesAdapter) PlaceFuturesOrder(ctx context.Context, order structs.BotOrderAdjusted, params structs.FuturesOrderParams) (structs.CreateOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceFuturesOrder", ctx, order, params)
+	ret0, _ := ret[0].(structs.CreateOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceFuturesOrder indicates an expected call of PlaceFuturesOrder.
+func (mr *MockFuturesAdapterMockRecorder) PlaceFuturesOrder(ctx, order, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceFuturesOrder", reflect.TypeOf((*MockFuturesAdapter)(nil).PlaceFuturesOrder), ctx, order, params)
+}
+
+// PlaceOrder mocks base method.
+func (m *MockFuturesAdapter) PlaceOrder(ctx context.Context, order structs.BotOrderAdjusted) (structs.CreateOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceOrder", ctx, order)
+	ret0, _ := ret[0].(structs.CreateOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceOrder indicates an expected call of PlaceOrder.
+func (mr *MockFuturesAdapterMockRecorder) PlaceOrder(ctx, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceOrder", reflect.TypeOf((*MockFuturesAdapter)(nil).PlaceOrder), ctx, order)
+}
+
+// SetLeverage mocks base method.
+func (m *MockFuturesAdapter) SetLeverage(pairSymbol string, leverage int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLeverage", pairSymbol, leverage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLeverage indicates an expected call of SetLeverage.
+func (mr *MockFuturesAdapterMockRecorder) SetLeverage(pairSymbol, leverage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLeverage", reflect.TypeOf((*MockFuturesAdapter)(nil).SetLeverage), pairSymbol, leverage)
+}
+
+// SetMarginMode mocks base method.
+func (m *MockFuturesAdapter) SetMarginMode(pairSymbol string, mode consts.MarginMode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMarginMode", pairSymbol, mode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMarginMode indicates an expected call of SetMarginMode.
+func (mr *MockFuturesAdapterMockRecorder) SetMarginMode(pairSymbol, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMarginMode", reflect.TypeOf((*MockFuturesAdapter)(nil).SetMarginMode), pairSymbol, mode)
+}
+
+// SetServerTimeOffset mocks base method.
+func (m *MockFuturesAdapter) SetServerTimeOffset(offset time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetServerTimeOffset", offset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetServerTimeOffset indicates an expected call of SetServerTimeOffset.
+func (mr *MockFuturesAdapterMockRecorder) SetServerTimeOffset(offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServerTimeOffset", reflect.TypeOf((*MockFuturesAdapter)(nil).SetServerTimeOffset), offset)
+}
+
+// SubscribeAccountTrades mocks base method.
+func (m *MockFuturesAdapter) SubscribeAccountTrades(eventCallback workers.TradeEventPrivateCallback, errorHandler func(error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeAccountTrades", eventCallback, errorHandler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeAccountTrades indicates an expected call of SubscribeAccountTrades.
+func (mr *MockFuturesAdapterMockRecorder) SubscribeAccountTrades(eventCallback, errorHandler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeAccountTrades", reflect.TypeOf((*MockFuturesAdapter)(nil).SubscribeAccountTrades), eventCallback, errorHandler)
+}
+
+// SubscribeCandle mocks base method.
+func (m *MockFuturesAdapter) SubscribeCandle(pairSymbol string, interval consts.Interval, eventCallback func(workers.CandleEvent), errorHandler func(error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeCandle", pairSymbol, interval, eventCallback, errorHandler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeCandle indicates an expected call of SubscribeCandle.
+func (mr *MockFuturesAdapterMockRecorder) SubscribeCandle(pairSymbol, interval, eventCallback, errorHandler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeCandle", reflect.TypeOf((*MockFuturesAdapter)(nil).SubscribeCandle), pairSymbol, interval, eventCallback, errorHandler)
+}
+
+// SubscribePositions mocks base method.
+func (m *MockFuturesAdapter) SubscribePositions(eventCallback workers.PositionEventCallback, errorHandler func(error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribePositions", eventCallback, errorHandler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribePositions indicates an expected call of SubscribePositions.
+func (mr *MockFuturesAdapterMockRecorder) SubscribePositions(eventCallback, errorHandler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribePositions", reflect.TypeOf((*MockFuturesAdapter)(nil).SubscribePositions), eventCallback, errorHandler)
+}
+
+// SubscribePublicTrades mocks base method.
+func (m *MockFuturesAdapter) SubscribePublicTrades(pairSymbol string, eventCallback workers.PublicTradeEventCallback, errorHandler func(error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribePublicTrades", pairSymbol, eventCallback, errorHandler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribePublicTrades indicates an expected call of SubscribePublicTrades.
+func (mr *MockFuturesAdapterMockRecorder) SubscribePublicTrades(pairSymbol, eventCallback, errorHandler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribePublicTrades", reflect.TypeOf((*MockFuturesAdapter)(nil).SubscribePublicTrades), pairSymbol, eventCallback, errorHandler)
+}
+
+// Transfer mocks base method.
+func (m *MockFuturesAdapter) Transfer(asset string, amount decimal.Decimal, fromAccount, toAccount consts.AccountType) (structs.TransferResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", asset, amount, fromAccount, toAccount)
+	ret0, _ := ret[0].(structs.TransferResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockFuturesAdapterMockRecorder) Transfer(asset, amount, fromAccount, toAccount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockFuturesAdapter)(nil).Transfer), asset, amount, fromAccount, toAccount)
+}
+
+// UnsubscribeAccountTrades mocks base method.
+func (m *MockFuturesAdapter) UnsubscribeAccountTrades() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnsubscribeAccountTrades")
+}
+
+// UnsubscribeAccountTrades indicates an expected call of UnsubscribeAccountTrades.
+func (mr *MockFuturesAdapterMockRecorder) UnsubscribeAccountTrades() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeAccountTrades", reflect.TypeOf((*MockFuturesAdapter)(nil).UnsubscribeAccountTrades))
+}
+
+// UnsubscribeCandle mocks base method.
+func (m *MockFuturesAdapter) UnsubscribeCandle(pairSymbol string, interval consts.Interval) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnsubscribeCandle", pairSymbol, interval)
+}
+
+// UnsubscribeCandle indicates an expected call of UnsubscribeCandle.
+func (mr *MockFuturesAdapterMockRecorder) UnsubscribeCandle(pairSymbol, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeCandle", reflect.TypeOf((*MockFuturesAdapter)(nil).UnsubscribeCandle), pairSymbol, interval)
+}
+
+// UnsubscribePositions mocks base method.
+func (m *MockFuturesAdapter) UnsubscribePositions() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnsubscribePositions")
+}
+
+// UnsubscribePositions indicates an expected call of UnsubscribePositions.
+func (mr *MockFuturesAdapterMockRecorder) UnsubscribePositions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribePositions", reflect.TypeOf((*MockFuturesAdapter)(nil).UnsubscribePositions))
+}
+
+// UnsubscribePublicTrades mocks base method.
+func (m *MockFuturesAdapter) UnsubscribePublicTrades(pairSymbol string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnsubscribePublicTrades", pairSymbol)
+}
+
+// UnsubscribePublicTrades indicates an expected call of UnsubscribePublicTrades.
+func (mr *MockFuturesAdapterMockRecorder) UnsubscribePublicTrades(pairSymbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribePublicTrades", reflect.TypeOf((*MockFuturesAdapter)(nil).UnsubscribePublicTrades), pairSymbol)
+}
+
+// VerifyAPIKeys mocks base method.
+func (m *MockFuturesAdapter) VerifyAPIKeys(keyPublic, keySecret string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAPIKeys", keyPublic, keySecret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyAPIKeys indicates an expected call of VerifyAPIKeys.
+func (mr *MockFuturesAdapterMockRecorder) VerifyAPIKeys(keyPublic, keySecret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAPIKeys", reflect.TypeOf((*MockFuturesAdapter)(nil).VerifyAPIKeys), keyPublic, keySecret)
+}
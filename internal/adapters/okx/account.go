@@ -0,0 +1,98 @@
+package okx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/okx/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	endpointGetAccountConfig  = "/api/v5/account/config"
+	endpointGetAccountBalance = "/api/v5/account/balance"
+	endpointGetFundingBalance = "/api/v5/asset/balances"
+
+	errCodeAPIKeyInvalid     = "50111"
+	errCodePassphraseInvalid = "50105"
+)
+
+func (a *adapter) CanTrade() (bool, error) {
+	info, err := a.GetAPIKeyInfo()
+	if err != nil {
+		return false, err
+	}
+	return info.HasPermission(pkgStructs.APIKeyPermissionSpotTrade), nil
+}
+
+// GetAPIKeyInfo - okx doesn't report the key expiry date
+func (a *adapter) GetAPIKeyInfo() (pkgStructs.APIKeyInfo, error) {
+	if !a.isPrivateAPIAvailable() {
+		return pkgStructs.APIKeyInfo{}, errs.ErrAPIKeyNotSet
+	}
+
+	var configs []mappers.AccountConfig
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetAccountConfig,
+		nil,
+		&configs,
+	); err != nil {
+		return pkgStructs.APIKeyInfo{}, mapAPIKeyError(err)
+	}
+	if len(configs) == 0 {
+		return pkgStructs.APIKeyInfo{}, errors.New("account config not found")
+	}
+
+	return mappers.ConvertAPIKeyInfo(configs[0]), nil
+}
+
+// GetAccountBalance - trading account balances
+func (a *adapter) GetAccountBalance() ([]structs.Balance, error) {
+	if !a.isPrivateAPIAvailable() {
+		return nil, errs.ErrAPIKeyNotSet
+	}
+
+	var balances []mappers.AccountBalance
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetAccountBalance,
+		nil,
+		&balances,
+	); err != nil {
+		return nil, fmt.Errorf("get balance: %w", mapAPIKeyError(err))
+	}
+	if len(balances) == 0 {
+		return nil, nil
+	}
+
+	return mappers.ConvertBalances(balances[0].Details)
+}
+
+func (a *adapter) getFundingBalance() ([]structs.Balance, error) {
+	if !a.isPrivateAPIAvailable() {
+		return nil, errs.ErrAPIKeyNotSet
+	}
+
+	var balances []mappers.AssetBalance
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetFundingBalance,
+		nil,
+		&balances,
+	); err != nil {
+		return nil, fmt.Errorf("get balance: %w", mapAPIKeyError(err))
+	}
+
+	return mappers.ConvertBalances(balances)
+}
+
+func mapAPIKeyError(err error) error {
+	if isAPIErrorCode(err, errCodeAPIKeyInvalid, errCodePassphraseInvalid) {
+		return fmt.Errorf("%w: %s", errs.ErrAPIKeyInvalid, err.Error())
+	}
+	return err
+}
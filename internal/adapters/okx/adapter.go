@@ -0,0 +1,182 @@
+package okx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+
+	adp "github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/okx/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	adapterName     = "OKX Spot"
+	symbolFormat    = "%s-%s"
+	symbolDelimiter = "-"
+	instTypeSpot    = "SPOT"
+
+	endpointGetCandles = "/api/v5/market/candles"
+	candlesMaxLimit    = 300
+)
+
+var errPassphraseNotSet = fmt.Errorf(
+	"%w: passphrase is not set, call Connect with the full credentials",
+	errs.ErrAPIKeyNotSet,
+)
+
+type adapter struct {
+	baseadp.AdapterBase
+
+	rest     *restClient
+	cfg      config.Config
+	ws       wsEndpoints
+	wsDialer *websocket.Dialer
+	creds    pkgStructs.APICredentials
+	clock    *baseadp.ServerClock
+
+	candleWorker      *CandleEventWorkerOKX
+	tradeWorker       *TradeEventWorkerOKX
+	publicTradeWorker *PublicTradeWorkerOKX
+}
+
+func New(opts ...config.Option) adp.Adapter {
+	cfg := config.New(opts...)
+	clock := baseadp.NewServerClock()
+	return &adapter{
+		AdapterBase: baseadp.NewAdapterBase(
+			consts.ExchangeIDokx,
+			adapterName,
+			consts.OKXAdapterTag,
+		),
+		rest:     newRestClient(pkgStructs.APICredentials{}, cfg, clock.Now),
+		clock:    clock,
+		cfg:      cfg,
+		ws:       getWsEndpoints(cfg),
+		wsDialer: cfg.NewWsDialer(),
+	}
+}
+
+// GenClientOrderID - okx client order ID is alphanumeric, up to 32 characters
+func (a *adapter) GenClientOrderID() string {
+	return strings.ReplaceAll(uuid.New().String(), "-", "")
+}
+
+func (a *adapter) GetPairSymbol(
+	baseTicker string,
+	quoteTicker string,
+) string {
+	return fmt.Sprintf(symbolFormat, baseTicker, quoteTicker)
+}
+
+func (a *adapter) GetLimits() pkgStructs.ExchangeLimits {
+	return pkgStructs.ExchangeLimits{
+		MaxConnectionsPerBatch:   3,
+		MaxConnectionsInDuration: time.Second,
+		MaxTopicsPerWebsocket:    100,
+	}
+}
+
+func (a *adapter) Capabilities() pkgStructs.AdapterCapabilities {
+	return pkgStructs.AdapterCapabilities{
+		OrderTypes:    []pkgStructs.OrderType{pkgStructs.OrderTypeLimit, pkgStructs.OrderTypeMarket},
+		TimeInForce:   []pkgStructs.TimeInForce{pkgStructs.TimeInForceGTC},
+		BatchOrders:   false,
+		OrderHistory:  true,
+		AccountTrades: true,
+		FeeLookup:     true,
+		Intervals:     a.GetSupportedIntervals(),
+		Streams: []pkgStructs.StreamType{
+			pkgStructs.StreamCandles,
+			pkgStructs.StreamPublicTrades,
+			pkgStructs.StreamAccountTrades,
+		},
+		OrderIDFormat: pkgStructs.OrderIDFormatNumeric,
+	}
+}
+
+func (a *adapter) Connect(credentials pkgStructs.APICredentials) error {
+	a.creds = credentials
+	a.rest = newRestClient(credentials, a.cfg, a.clock.Now)
+
+	a.candleWorker = a.CreateCandleWorker()
+	a.tradeWorker = a.CreateTradeEventsWorker()
+	a.publicTradeWorker = a.CreatePublicTradeWorker()
+	return nil
+}
+
+// VerifyAPIKeys - the passphrase is taken from the credentials set on Connect,
+// call Connect with the full credentials first
+func (a *adapter) VerifyAPIKeys(keyPublic, keySecret string) error {
+	if a.creds.Keypair.Passphrase == "" {
+		return errPassphraseNotSet
+	}
+
+	if err := a.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{
+			Public:     keyPublic,
+			Secret:     keySecret,
+			Passphrase: a.creds.Keypair.Passphrase,
+		},
+	}); err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+
+	_, err := a.GetAccountBalance()
+	return err
+}
+
+func (a *adapter) GetCandles(
+	limit int,
+	symbol string,
+	interval consts.Interval,
+) ([]workers.CandleData, error) {
+	bar, err := ConvertIntervalToOKX(interval)
+	if err != nil {
+		return nil, fmt.Errorf("convert interval: %w", err)
+	}
+
+	if limit > candlesMaxLimit {
+		limit = candlesMaxLimit
+	}
+
+	var candles []mappers.Candle
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetCandles,
+		map[string]any{
+			"instId": symbol,
+			"bar":    bar,
+			"limit":  limit,
+		},
+		&candles,
+	); err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+
+	return mappers.ConvertCandles(
+		candles, interval, intervalOKXToOur[bar].Duration,
+	)
+}
+
+func (a *adapter) GetSupportedIntervals() []consts.Interval {
+	return consts.FilterIntervals(func(interval consts.Interval) bool {
+		_, isSupported := ourIntervalToOKX[interval]
+		return isSupported
+	})
+}
+
+// isPrivateAPIAvailable - okx private endpoints require the passphrase
+func (a *adapter) isPrivateAPIAvailable() bool {
+	return a.creds.Keypair.IsSet() && a.creds.Keypair.Passphrase != ""
+}
@@ -0,0 +1,212 @@
+package okx
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/okx/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/adapter/adaptertest"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const testConformancePair = "BTC-USDT"
+
+// our status -> okx order state
+var testOrderStates = map[consts.OrderStatus]string{
+	pkgStructs.OrderStatusNew:       "live",
+	pkgStructs.OrderStatusFilled:    "filled",
+	pkgStructs.OrderStatusCancelled: "canceled",
+}
+
+func TestConformance(t *testing.T) {
+	adaptertest.Run(t, adaptertest.Config{
+		NewHarness: newTestHarness,
+		PairSymbol: testConformancePair,
+		OrderQty:   "0.01",
+		OrderPrice: "60000.5",
+	})
+}
+
+func newTestHarness(t *testing.T) adaptertest.Harness {
+	exchange := adaptertest.NewExchange()
+	restServer := httptest.NewServer(newTestStandInHandler(exchange))
+	t.Cleanup(restServer.Close)
+	wsServer := adaptertest.NewWebsocketServer(t)
+
+	a := New(
+		config.WithRESTBaseURL(restServer.URL),
+		config.WithWsBaseURL(wsServer.URL()),
+	)
+	if err := a.Connect(pkgStructs.APICredentials{
+		Type: pkgStructs.APICredentialsTypeKeypair,
+		Keypair: pkgStructs.APIKeypair{
+			Public:     "public",
+			Secret:     "secret",
+			Passphrase: "passphrase",
+		},
+	}); err != nil {
+		t.Fatalf("connect: %s", err)
+	}
+
+	return adaptertest.Harness{
+		Adapter:       a,
+		Exchange:      exchange,
+		WsConnections: wsServer.Connections,
+	}
+}
+
+// newTestStandInHandler - okx REST API over the stand-in exchange
+func newTestStandInHandler(exchange *adaptertest.Exchange) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET "+endpointGetInstruments, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, []mappers.Instrument{{
+			InstID:   testConformancePair,
+			BaseCcy:  "BTC",
+			QuoteCcy: "USDT",
+			LotSz:    "0.00000001",
+			TickSz:   "0.1",
+			MinSz:    "0.00001",
+			MaxLmtSz: "10000",
+			State:    "live",
+		}})
+	})
+
+	tickerHandler := func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, []mappers.Ticker{{InstID: testConformancePair, Last: "60000"}})
+	}
+	mux.HandleFunc("GET "+endpointGetTicker, tickerHandler)
+	mux.HandleFunc("GET "+endpointGetTickers, tickerHandler)
+
+	mux.HandleFunc("POST "+endpointOrder, func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		side, _ := mappers.ConvertOrderSide(request["side"])
+		order, err := exchange.PlaceOrder(adaptertest.Order{
+			ClientOrderID: request["clOrdId"],
+			PairSymbol:    request["instId"],
+			Side:          side,
+			IsMarket:      request["ordType"] == orderTypeMarket,
+			Qty:           decimal.RequireFromString(request["sz"]),
+			Price:         decimal.RequireFromString(request["px"]),
+		})
+		if errors.Is(err, errs.ErrOrderDuplicate) {
+			writeTestItemError(w, errCodeOrderDuplicate)
+			return
+		}
+
+		writeTestResponse(w, []mappers.PlacedOrder{{
+			OrdID:   strconv.FormatInt(order.ID, 10),
+			ClOrdID: order.ClientOrderID,
+			Ts:      strconv.FormatInt(order.CreatedTime, 10),
+		}})
+	})
+
+	mux.HandleFunc("GET "+endpointOrder, func(w http.ResponseWriter, r *http.Request) {
+		order, err := getTestOrder(exchange, r.URL.Query().Get("ordId"), r.URL.Query().Get("clOrdId"))
+		if err != nil {
+			writeTestError(w, errCodeOrderNotFound)
+			return
+		}
+		writeTestResponse(w, []mappers.Order{convertTestOrder(order)})
+	})
+
+	mux.HandleFunc("POST "+endpointCancelOrder, func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		order, err := getTestOrder(exchange, request["ordId"], request["clOrdId"])
+		if err == nil {
+			order, err = exchange.CancelOrder(order.ID)
+		}
+		switch {
+		case errors.Is(err, errs.ErrOrderFilled):
+			writeTestItemError(w, errCodeCancelOrderFinished)
+		case err != nil:
+			writeTestItemError(w, errCodeCancelFailed)
+		default:
+			writeTestResponse(w, []mappers.PlacedOrder{{
+				OrdID:   strconv.FormatInt(order.ID, 10),
+				ClOrdID: order.ClientOrderID,
+			}})
+		}
+	})
+	return mux
+}
+
+func getTestOrder(
+	exchange *adaptertest.Exchange,
+	orderID string,
+	clientOrderID string,
+) (adaptertest.Order, error) {
+	if clientOrderID != "" {
+		return exchange.GetOrderByClientOrderID(clientOrderID)
+	}
+
+	id, err := strconv.ParseInt(orderID, 10, 64)
+	if err != nil {
+		return adaptertest.Order{}, err
+	}
+	return exchange.GetOrder(id)
+}
+
+func convertTestOrder(order adaptertest.Order) mappers.Order {
+	side, _ := mappers.GetOrderSide(order.Side)
+	ordType := orderTypeLimit
+	if order.IsMarket {
+		ordType = orderTypeMarket
+	}
+
+	return mappers.Order{
+		InstID:    order.PairSymbol,
+		OrdID:     strconv.FormatInt(order.ID, 10),
+		ClOrdID:   order.ClientOrderID,
+		Px:        order.Price.String(),
+		Sz:        order.Qty.String(),
+		OrdType:   ordType,
+		Side:      side,
+		State:     testOrderStates[order.Status],
+		AccFillSz: order.FilledQty.String(),
+		AvgPx:     order.Price.String(),
+		CTime:     strconv.FormatInt(order.CreatedTime, 10),
+		UTime:     strconv.FormatInt(order.UpdatedTime, 10),
+	}
+}
+
+func writeTestResponse(w http.ResponseWriter, data any) {
+	writeTestJSON(w, map[string]any{"code": restSuccessCode, "msg": "", "data": data})
+}
+
+func writeTestError(w http.ResponseWriter, code string) {
+	writeTestJSON(w, map[string]any{"code": code, "msg": "error", "data": []any{}})
+}
+
+// writeTestItemError - the batch-like endpoints error
+func writeTestItemError(w http.ResponseWriter, code string) {
+	writeTestJSON(w, map[string]any{
+		"code": "1",
+		"msg":  "All operations failed",
+		"data": []restItemStatus{{SCode: code, SMsg: "error"}},
+	})
+}
+
+func writeTestJSON(w http.ResponseWriter, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,54 @@
+package mappers
+
+import (
+	"strings"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	permReadOnly = "read_only"
+	permTrade    = "trade"
+	permWithdraw = "withdraw"
+)
+
+// ConvertAPIKeyInfo - okx trade permission is common for spot, margin & futures
+func ConvertAPIKeyInfo(config AccountConfig) pkgStructs.APIKeyInfo {
+	info := pkgStructs.APIKeyInfo{}
+	for _, perm := range splitList(config.Perm) {
+		switch perm {
+		case permReadOnly:
+			info.IsReadOnly = true
+		case permTrade:
+			info.Permissions = append(
+				info.Permissions,
+				consts.APIKeyPermissionSpotTrade,
+				consts.APIKeyPermissionMarginTrade,
+				consts.APIKeyPermissionFuturesTrade,
+			)
+		case permWithdraw:
+			info.Permissions = append(info.Permissions, consts.APIKeyPermissionWithdraw)
+		}
+	}
+
+	// the read permission is always granted
+	if len(info.Permissions) > 0 {
+		info.IsReadOnly = false
+	}
+
+	info.IPWhitelist = splitList(config.IP)
+	info.IsIPRestricted = len(info.IPWhitelist) > 0
+	return info
+}
+
+// splitList - comma separated values without spaces & empty ones
+func splitList(value string) []string {
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
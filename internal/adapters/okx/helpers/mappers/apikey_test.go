@@ -0,0 +1,43 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertAPIKeyInfo(t *testing.T) {
+	// given
+	config := AccountConfig{
+		Perm: "read_only,trade",
+		IP:   "1.2.3.4, 5.6.7.8",
+	}
+
+	// when
+	info := ConvertAPIKeyInfo(config)
+
+	// then
+	assert.Equal(t, []consts.APIKeyPermission{
+		consts.APIKeyPermissionSpotTrade,
+		consts.APIKeyPermissionMarginTrade,
+		consts.APIKeyPermissionFuturesTrade,
+	}, info.Permissions)
+	assert.False(t, info.IsReadOnly)
+	assert.True(t, info.IsIPRestricted)
+	assert.Equal(t, []string{"1.2.3.4", "5.6.7.8"}, info.IPWhitelist)
+}
+
+func TestConvertAPIKeyInfoReadOnly(t *testing.T) {
+	// given
+	config := AccountConfig{Perm: "read_only"}
+
+	// when
+	info := ConvertAPIKeyInfo(config)
+
+	// then
+	assert.Empty(t, info.Permissions)
+	assert.True(t, info.IsReadOnly)
+	assert.False(t, info.IsIPRestricted)
+}
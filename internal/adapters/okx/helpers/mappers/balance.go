@@ -0,0 +1,53 @@
+package mappers
+
+import (
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+// AccountBalance - trading account balance
+type AccountBalance struct {
+	Details []AssetBalance `json:"details"`
+}
+
+// AssetBalance - trading or funding account asset balance
+type AssetBalance struct {
+	Ccy       string `json:"ccy"`
+	AvailBal  string `json:"availBal"`
+	FrozenBal string `json:"frozenBal"`
+}
+
+// AccountConfig - account & API key settings
+type AccountConfig struct {
+	UID  string `json:"uid"`
+	Perm string `json:"perm"` // e.g. "read_only,trade"
+	IP   string `json:"ip"`   // the key IP whitelist, comma separated
+}
+
+// TransferResult - internal transfer result
+type TransferResult struct {
+	TransID string `json:"transId"`
+}
+
+func ConvertBalances(balances []AssetBalance) ([]structs.Balance, error) {
+	result := make([]structs.Balance, 0, len(balances))
+	for _, balance := range balances {
+		free, err := parseDecimal(balance.AvailBal)
+		if err != nil {
+			return nil, fmt.Errorf("parse %q free: %w", balance.Ccy, err)
+		}
+
+		locked, err := parseDecimal(balance.FrozenBal)
+		if err != nil {
+			return nil, fmt.Errorf("parse %q locked: %w", balance.Ccy, err)
+		}
+
+		result = append(result, structs.Balance{
+			Asset:  balance.Ccy,
+			Free:   free,
+			Locked: locked,
+		})
+	}
+	return result, nil
+}
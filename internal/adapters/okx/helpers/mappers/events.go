@@ -0,0 +1,113 @@
+package mappers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+// WsTrade - public trades channel event
+type WsTrade struct {
+	InstID  string `json:"instId"`
+	TradeID string `json:"tradeId"`
+	Px      string `json:"px"`
+	Sz      string `json:"sz"`
+	Side    string `json:"side"` // taker side
+	Ts      string `json:"ts"`
+}
+
+// WsOrder - orders channel event, fill data is set for the trade events only
+type WsOrder struct {
+	Order
+	TradeID  string `json:"tradeId"`
+	FillPx   string `json:"fillPx"`
+	FillSz   string `json:"fillSz"`
+	FillTime string `json:"fillTime"`
+}
+
+// IsFill - the order update is caused by the trade
+func (o WsOrder) IsFill() bool {
+	return o.TradeID != "" && o.FillSz != "" && o.FillSz != "0"
+}
+
+func ConvertCandleEvent(
+	pairSymbol string,
+	candle Candle,
+	interval consts.Interval,
+	duration time.Duration,
+	eventTime int64,
+) (workers.CandleEvent, error) {
+	candleData, err := ConvertCandle(candle, interval, duration)
+	if err != nil {
+		return workers.CandleEvent{}, err
+	}
+
+	return workers.CandleEvent{
+		Symbol:     pairSymbol,
+		Candle:     candleData,
+		Time:       eventTime,
+		IsFinished: candle.IsConfirmed(),
+	}, nil
+}
+
+func ConvertPublicTradeEvent(event WsTrade) (workers.PublicTradeEvent, error) {
+	price, err := parseDecimal(event.Px)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := parseDecimal(event.Sz)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	takerSide, err := ConvertOrderSide(event.Side)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("convert side: %w", err)
+	}
+
+	tradeTime, err := parseTime(event.Ts)
+	if err != nil {
+		return workers.PublicTradeEvent{}, fmt.Errorf("parse time: %w", err)
+	}
+
+	return workers.PublicTradeEvent{
+		ID:          event.TradeID,
+		Time:        tradeTime,
+		ExchangeTag: consts.OKXAdapterTag,
+		Symbol:      event.InstID,
+		Price:       price,
+		Quantity:    qty,
+		TakerSide:   takerSide,
+	}, nil
+}
+
+func ConvertOrderEvent(event WsOrder) (workers.TradeEventPrivate, error) {
+	price, err := parseDecimal(event.FillPx)
+	if err != nil {
+		return workers.TradeEventPrivate{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := parseDecimal(event.FillSz)
+	if err != nil {
+		return workers.TradeEventPrivate{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	fillTime, err := parseTime(event.FillTime)
+	if err != nil {
+		return workers.TradeEventPrivate{}, fmt.Errorf("parse time: %w", err)
+	}
+
+	return workers.TradeEventPrivate{
+		ID:            event.TradeID,
+		Time:          fillTime,
+		ExchangeTag:   consts.OKXAdapterTag,
+		Symbol:        event.InstID,
+		OrderID:       event.OrdID,
+		ClientOrderID: event.ClOrdID,
+		Price:         price,
+		Quantity:      qty,
+	}, nil
+}
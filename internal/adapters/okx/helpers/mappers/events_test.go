@@ -0,0 +1,78 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertCandles(t *testing.T) {
+	// given
+	candles := []Candle{
+		{"1700000060000", "2", "3", "1", "2.5", "20", "0", "0", "0"},
+		{"1700000000000", "1", "2", "0.5", "2", "10", "0", "0", "1"},
+	}
+
+	// when
+	result, err := ConvertCandles(candles, consts.Interval1min, time.Minute)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, int64(1700000000000), result[0].StartTime)
+	assert.Equal(t, int64(1700000059999), result[0].EndTime)
+	assert.Equal(t, "2.5", result[1].Close.String())
+}
+
+func TestConvertCandleEventConfirmed(t *testing.T) {
+	// given
+	candle := Candle{"1700000000000", "1", "2", "0.5", "2", "10", "0", "0", "1"}
+
+	// when
+	event, err := ConvertCandleEvent(
+		"BTC-USDT", candle, consts.Interval1min, time.Minute, 1700000060000,
+	)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, event.IsFinished)
+	assert.Equal(t, "BTC-USDT", event.Symbol)
+}
+
+func TestConvertCandleInvalid(t *testing.T) {
+	// when
+	_, err := ConvertCandle(Candle{"1700000000000"}, consts.Interval1min, time.Minute)
+
+	// then
+	require.Error(t, err)
+}
+
+func TestWsOrderIsFill(t *testing.T) {
+	assert.True(t, WsOrder{TradeID: "1", FillSz: "0.1"}.IsFill())
+	assert.False(t, WsOrder{FillSz: "0"}.IsFill())
+}
+
+func TestConvertPublicTradeEvent(t *testing.T) {
+	// given
+	trade := WsTrade{
+		InstID:  "BTC-USDT",
+		TradeID: "130639474",
+		Px:      "42219.9",
+		Sz:      "0.12060306",
+		Side:    "sell",
+		Ts:      "1630048897897",
+	}
+
+	// when
+	event, err := ConvertPublicTradeEvent(trade)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.OrderSideSell, event.TakerSide)
+	assert.Equal(t, consts.OKXAdapterTag, event.ExchangeTag)
+	assert.Equal(t, int64(1630048897897), event.Time)
+}
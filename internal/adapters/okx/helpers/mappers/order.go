@@ -0,0 +1,315 @@
+package mappers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	sideBuy  = "buy"
+	sideSell = "sell"
+
+	execTypeMaker = "M"
+)
+
+// exchange const -> our const
+var orderStatusConvertor = map[string]consts.OrderStatus{
+	"live":             pkgStructs.OrderStatusNew,
+	"partially_filled": pkgStructs.OrderStatusPartiallyFilled,
+	"filled":           pkgStructs.OrderStatusFilled,
+	"canceled":         pkgStructs.OrderStatusCancelled,
+	"mmp_canceled":     pkgStructs.OrderStatusCancelled,
+}
+
+// Order - spot order data
+type Order struct {
+	InstID    string `json:"instId"`
+	OrdID     string `json:"ordId"`
+	ClOrdID   string `json:"clOrdId"`
+	Px        string `json:"px"`
+	Sz        string `json:"sz"`
+	OrdType   string `json:"ordType"`
+	Side      string `json:"side"`
+	State     string `json:"state"`
+	AccFillSz string `json:"accFillSz"`
+	AvgPx     string `json:"avgPx"`
+	Fee       string `json:"fee"`
+	FeeCcy    string `json:"feeCcy"`
+	CTime     string `json:"cTime"`
+	UTime     string `json:"uTime"`
+}
+
+// PlacedOrder - order placement result
+type PlacedOrder struct {
+	OrdID   string `json:"ordId"`
+	ClOrdID string `json:"clOrdId"`
+	Ts      string `json:"ts"`
+}
+
+// Fill - account trade
+type Fill struct {
+	InstID   string `json:"instId"`
+	TradeID  string `json:"tradeId"`
+	OrdID    string `json:"ordId"`
+	ClOrdID  string `json:"clOrdId"`
+	BillID   string `json:"billId"`
+	FillPx   string `json:"fillPx"`
+	FillSz   string `json:"fillSz"`
+	Side     string `json:"side"`
+	ExecType string `json:"execType"`
+	Fee      string `json:"fee"`
+	FeeCcy   string `json:"feeCcy"`
+	Ts       string `json:"ts"`
+}
+
+// TradeFee - account fee rates. Negative values are fees, positive are rebates
+type TradeFee struct {
+	Maker string `json:"maker"`
+	Taker string `json:"taker"`
+}
+
+func ConvertOrderStatus(state string) (consts.OrderStatus, error) {
+	result, isExists := orderStatusConvertor[state]
+	if !isExists {
+		return "", fmt.Errorf("unknown status: %q", state)
+	}
+	return result, nil
+}
+
+func ConvertOrderSide(side string) (consts.OrderSide, error) {
+	switch side {
+	default:
+		return "", fmt.Errorf("unknown: %q", side)
+	case "":
+		return "", errors.New("not set")
+	case sideBuy:
+		return consts.OrderSideBuy, nil
+	case sideSell:
+		return consts.OrderSideSell, nil
+	}
+}
+
+func GetOrderSide(side consts.OrderSide) (string, error) {
+	switch side {
+	default:
+		return "", fmt.Errorf("unknown: %q", side)
+	case consts.OrderSideBuy:
+		return sideBuy, nil
+	case consts.OrderSideSell:
+		return sideSell, nil
+	}
+}
+
+func ConvertOrderData(data Order) (structs.OrderData, error) {
+	orderID, err := strconv.ParseInt(data.OrdID, 10, 64)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse order ID: %w", err)
+	}
+
+	orderStatus, err := ConvertOrderStatus(data.State)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("convert status: %w", err)
+	}
+
+	orderSide, err := ConvertOrderSide(data.Side)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("convert side: %w", err)
+	}
+
+	orderPrice, err := parseDecimal(data.Px)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse price: %w", err)
+	}
+	if orderPrice.IsZero() {
+		// market order
+		orderPrice, err = parseDecimal(data.AvgPx)
+		if err != nil {
+			return structs.OrderData{}, fmt.Errorf("parse avg price: %w", err)
+		}
+	}
+
+	orderQty, err := parseDecimal(data.Sz)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	orderFilledQty, err := parseDecimal(data.AccFillSz)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse filled qty: %w", err)
+	}
+
+	createdTime, err := parseTime(data.CTime)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse created time: %w", err)
+	}
+
+	updatedTime, err := parseTime(data.UTime)
+	if err != nil {
+		return structs.OrderData{}, fmt.Errorf("parse updated time: %w", err)
+	}
+
+	return structs.OrderData{
+		OrderID:       orderID,
+		ClientOrderID: data.ClOrdID,
+		Status:        orderStatus,
+		AwaitQty:      orderQty,
+		FilledQty:     orderFilledQty,
+		Price:         orderPrice,
+		Symbol:        data.InstID,
+		Side:          orderSide,
+		CreatedTime:   createdTime,
+		UpdatedTime:   updatedTime,
+	}, nil
+}
+
+// ConvertPlacedOrder - okx returns order IDs only, the rest is taken from the task
+func ConvertPlacedOrder(
+	data PlacedOrder,
+	order structs.BotOrderAdjusted,
+) (structs.CreateOrderResponse, error) {
+	orderID, err := strconv.ParseInt(data.OrdID, 10, 64)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("parse order ID: %w", err)
+	}
+
+	orderQty, err := parseDecimal(order.Qty)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	orderPrice, err := parseDecimal(order.Price)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	createdTime, err := parseTime(data.Ts)
+	if err != nil {
+		return structs.CreateOrderResponse{}, fmt.Errorf("parse time: %w", err)
+	}
+
+	return structs.CreateOrderResponse{
+		OrderID:       orderID,
+		ClientOrderID: data.ClOrdID,
+		OrigQuantity:  orderQty,
+		Price:         orderPrice,
+		Symbol:        order.PairSymbol,
+		Type:          order.Type,
+		CreatedTime:   createdTime,
+		Status:        pkgStructs.OrderStatusNew,
+	}, nil
+}
+
+func ConvertAccountTrade(fill Fill) (structs.AccountTrade, error) {
+	orderID, err := strconv.ParseInt(fill.OrdID, 10, 64)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse order ID: %w", err)
+	}
+
+	side, err := ConvertOrderSide(fill.Side)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("convert side: %w", err)
+	}
+
+	price, err := parseDecimal(fill.FillPx)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse price: %w", err)
+	}
+
+	qty, err := parseDecimal(fill.FillSz)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse qty: %w", err)
+	}
+
+	fee, err := parseDecimal(fill.Fee)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse fee: %w", err)
+	}
+
+	tradeTime, err := parseTime(fill.Ts)
+	if err != nil {
+		return structs.AccountTrade{}, fmt.Errorf("parse time: %w", err)
+	}
+
+	return structs.AccountTrade{
+		ID:            fill.TradeID,
+		OrderID:       orderID,
+		ClientOrderID: fill.ClOrdID,
+		Symbol:        fill.InstID,
+		Side:          side,
+		Price:         price,
+		Qty:           qty,
+		// the charged fee is negative in the okx response
+		Fee:      fee.Neg(),
+		FeeAsset: fill.FeeCcy,
+		IsMaker:  fill.ExecType == execTypeMaker,
+		Time:     tradeTime,
+	}, nil
+}
+
+// GetFeesFromFills - order fees by the order fills
+func GetFeesFromFills(
+	fills []Fill,
+	baseAssetTicker string,
+	quoteAssetTicker string,
+) (structs.OrderFees, error) {
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.Zero,
+		QuoteAsset: decimal.Zero,
+	}
+
+	for _, fill := range fills {
+		trade, err := ConvertAccountTrade(fill)
+		if err != nil {
+			return structs.OrderFees{}, fmt.Errorf("convert fill: %w", err)
+		}
+
+		switch trade.FeeAsset {
+		case baseAssetTicker:
+			fees.BaseAsset = fees.BaseAsset.Add(trade.Fee)
+		case quoteAssetTicker:
+			fees.QuoteAsset = fees.QuoteAsset.Add(trade.Fee)
+		}
+
+		fees.Commissions = append(fees.Commissions, structs.Commission{
+			TradeID: trade.ID,
+			Asset:   trade.FeeAsset,
+			Amount:  trade.Fee,
+			Price:   trade.Price,
+			Time:    trade.Time,
+		})
+	}
+	return fees, nil
+}
+
+func ConvertTradeFees(pairSymbol string, rate TradeFee) (structs.TradeFees, error) {
+	maker, err := parseDecimal(rate.Maker)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("parse maker fee: %w", err)
+	}
+
+	taker, err := parseDecimal(rate.Taker)
+	if err != nil {
+		return structs.TradeFees{}, fmt.Errorf("parse taker fee: %w", err)
+	}
+
+	return structs.TradeFees{
+		Symbol: pairSymbol,
+		Maker:  maker.Neg(),
+		Taker:  taker.Neg(),
+	}, nil
+}
+
+// parseTime - unix timestamp ms in string
+func parseTime(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
@@ -0,0 +1,94 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+func TestConvertOrderDataMarketOrder(t *testing.T) {
+	// given
+	order := Order{
+		InstID:    "BTC-USDT",
+		OrdID:     "312269865356374016",
+		ClOrdID:   "test",
+		Px:        "",
+		Sz:        "0.01",
+		OrdType:   "market",
+		Side:      "buy",
+		State:     "filled",
+		AccFillSz: "0.01",
+		AvgPx:     "60000.5",
+		CTime:     "1700000000000",
+		UTime:     "1700000000100",
+	}
+
+	// when
+	data, err := ConvertOrderData(order)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, int64(312269865356374016), data.OrderID)
+	assert.Equal(t, pkgStructs.OrderStatusFilled, data.Status)
+	assert.Equal(t, consts.OrderSideBuy, data.Side)
+	assert.Equal(t, "60000.5", data.Price.String())
+	assert.True(t, data.IsFullFilled())
+	assert.Equal(t, int64(1700000000100), data.UpdatedTime)
+}
+
+func TestConvertOrderDataUnknownStatus(t *testing.T) {
+	// given
+	order := Order{OrdID: "1", State: "test", Side: "buy"}
+
+	// when
+	_, err := ConvertOrderData(order)
+
+	// then
+	require.Error(t, err)
+}
+
+func TestGetFeesFromFills(t *testing.T) {
+	// given
+	fills := []Fill{
+		{
+			TradeID: "1", OrdID: "10", Side: "buy", FillPx: "100",
+			FillSz: "1", Fee: "-0.001", FeeCcy: "ETH", Ts: "1700000000000",
+		},
+		{
+			TradeID: "2", OrdID: "10", Side: "buy", FillPx: "100",
+			FillSz: "1", Fee: "-0.002", FeeCcy: "ETH", Ts: "1700000000001",
+		},
+		{
+			TradeID: "3", OrdID: "10", Side: "buy", FillPx: "100",
+			FillSz: "1", Fee: "-0.5", FeeCcy: "OKB", Ts: "1700000000002",
+		},
+	}
+
+	// when
+	fees, err := GetFeesFromFills(fills, "ETH", "USDT")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.003", fees.BaseAsset.String())
+	assert.True(t, fees.QuoteAsset.IsZero())
+	require.Len(t, fees.Commissions, 3)
+	assert.Equal(t, "OKB", fees.Commissions[2].Asset)
+	assert.Equal(t, "0.5", fees.Commissions[2].Amount.String())
+}
+
+func TestConvertTradeFees(t *testing.T) {
+	// given
+	rate := TradeFee{Maker: "-0.0008", Taker: "-0.001"}
+
+	// when
+	fees, err := ConvertTradeFees("BTC-USDT", rate)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.0008", fees.Maker.String())
+	assert.Equal(t, "0.001", fees.Taker.String())
+}
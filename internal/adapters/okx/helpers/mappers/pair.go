@@ -0,0 +1,129 @@
+package mappers
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
+)
+
+// okx instrument state -> our pair status
+var pairStatusConvertor = map[string]string{
+	"live":    consts.PairStatusTrading,
+	"suspend": consts.PairStatusSuspended,
+	"preopen": consts.PairStatusPreOpen,
+	"expired": consts.PairStatusOffline,
+}
+
+// Instrument - spot instrument
+type Instrument struct {
+	InstID   string `json:"instId"`
+	BaseCcy  string `json:"baseCcy"`
+	QuoteCcy string `json:"quoteCcy"`
+	LotSz    string `json:"lotSz"`
+	TickSz   string `json:"tickSz"`
+	MinSz    string `json:"minSz"`
+	MaxLmtSz string `json:"maxLmtSz"`
+	State    string `json:"state"`
+}
+
+// Ticker - pair ticker
+type Ticker struct {
+	InstID string `json:"instId"`
+	Last   string `json:"last"`
+}
+
+func ConvertPairStatus(state string) string {
+	status, isExists := pairStatusConvertor[state]
+	if !isExists {
+		return consts.PairStatusUnknown
+	}
+	return status
+}
+
+// ConvertTickers - pair symbol -> last price
+func ConvertTickers(tickers []Ticker) (map[string]decimal.Decimal, error) {
+	result := map[string]decimal.Decimal{}
+	for _, ticker := range tickers {
+		lastPrice, err := parseDecimal(ticker.Last)
+		if err != nil {
+			return nil, fmt.Errorf("parse %q last price: %w", ticker.InstID, err)
+		}
+		result[ticker.InstID] = lastPrice
+	}
+	return result, nil
+}
+
+func ConvertPairData(
+	data Instrument,
+	lastPrice decimal.Decimal,
+) (structs.ExchangePairData, error) {
+	qtyStep, err := parseDecimal(data.LotSz)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse lot size: %w", err)
+	}
+
+	priceStep, err := parseDecimal(data.TickSz)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse tick size: %w", err)
+	}
+
+	minQty, err := parseDecimal(data.MinSz)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse min size: %w", err)
+	}
+
+	maxQty, err := parseDecimal(data.MaxLmtSz)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("parse max size: %w", err)
+	}
+
+	// okx has no min notional for spot pairs
+	minDeposit := minQty.Mul(lastPrice)
+
+	return structs.ExchangePairData{
+		ExchangeID:         consts.ExchangeIDokx,
+		BaseAsset:          data.BaseCcy,
+		QuoteAsset:         data.QuoteCcy,
+		BasePrecision:      utils.GetDecimalPrecision(qtyStep),
+		QuotePrecision:     utils.GetDecimalPrecision(priceStep),
+		Status:             ConvertPairStatus(data.State),
+		Symbol:             data.InstID,
+		MinQty:             minQty,
+		MaxQty:             maxQty,
+		OriginalMinDeposit: minDeposit,
+		MinDeposit:         minDeposit,
+		MinPrice:           priceStep,
+		QtyStep:            qtyStep,
+		PriceStep:          priceStep,
+		AllowedMargin:      false,
+		AllowedSpot:        true,
+		InUse:              true,
+	}, nil
+}
+
+func ConvertPairs(
+	instruments []Instrument,
+	lastPrices map[string]decimal.Decimal,
+) ([]structs.ExchangePairData, error) {
+	result := make([]structs.ExchangePairData, 0, len(instruments))
+	for _, instrument := range instruments {
+		pairData, err := ConvertPairData(instrument, lastPrices[instrument.InstID])
+		if err != nil {
+			return nil, fmt.Errorf("convert %q: %w", instrument.InstID, err)
+		}
+		result = append(result, pairData)
+	}
+	return result, nil
+}
+
+// parseDecimal - okx sends empty strings instead of zero values
+func parseDecimal(value string) (decimal.Decimal, error) {
+	if value == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(value)
+}
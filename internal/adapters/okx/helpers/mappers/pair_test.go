@@ -0,0 +1,55 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func TestConvertPairData(t *testing.T) {
+	// given
+	instrument := Instrument{
+		InstID:   "BTC-USDT",
+		BaseCcy:  "BTC",
+		QuoteCcy: "USDT",
+		LotSz:    "0.00000001",
+		TickSz:   "0.1",
+		MinSz:    "0.00001",
+		MaxLmtSz: "9999999999",
+		State:    "live",
+	}
+	lastPrice := decimal.NewFromInt(60000)
+
+	// when
+	pairData, err := ConvertPairData(instrument, lastPrice)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.ExchangeIDokx, pairData.ExchangeID)
+	assert.Equal(t, "BTC-USDT", pairData.Symbol)
+	assert.Equal(t, consts.PairStatusTrading, pairData.Status)
+	assert.Equal(t, 8, pairData.BasePrecision)
+	assert.Equal(t, 1, pairData.QuotePrecision)
+	assert.Equal(t, "0.00001", pairData.MinQty.String())
+	assert.Equal(t, "0.1", pairData.PriceStep.String())
+	assert.Equal(t, "0.6", pairData.MinDeposit.String())
+}
+
+func TestConvertPairDataInvalidLotSize(t *testing.T) {
+	// given
+	instrument := Instrument{InstID: "BTC-USDT", LotSz: "invalid"}
+
+	// when
+	_, err := ConvertPairData(instrument, decimal.Zero)
+
+	// then
+	require.Error(t, err)
+}
+
+func TestConvertPairStatusUnknown(t *testing.T) {
+	assert.Equal(t, consts.PairStatusUnknown, ConvertPairStatus("test"))
+}
@@ -0,0 +1,22 @@
+package mappers
+
+import (
+	"fmt"
+	"time"
+)
+
+// ServerTime - exchange server time
+type ServerTime struct {
+	Ts string `json:"ts"`
+}
+
+func ConvertServerTime(data ServerTime) (time.Time, error) {
+	ts, err := parseTime(data.Ts)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse time: %w", err)
+	}
+	if ts == 0 {
+		return time.Time{}, fmt.Errorf("invalid time: %q", data.Ts)
+	}
+	return time.UnixMilli(ts), nil
+}
@@ -0,0 +1,83 @@
+package okx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	baseadp "github.com/matrixbotio/exchange-gates-lib/internal/adapters/base"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/okx/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	// fills of the last 3 months
+	endpointGetFillsHistory = "/api/v5/trade/fills-history"
+
+	tradesHistoryMaxWindow = time.Hour * 24 * 7
+	tradesHistoryPageLimit = 100
+)
+
+func (a *adapter) GetAccountTrades(
+	task structs.GetOrdersHistoryTask,
+) ([]structs.AccountTrade, error) {
+	if !a.isPrivateAPIAvailable() {
+		return nil, errs.ErrAPIKeyNotSet
+	}
+
+	windows, err := baseadp.SplitHistoryTask(task, tradesHistoryMaxWindow)
+	if err != nil {
+		return nil, fmt.Errorf("check task: %w", err)
+	}
+
+	ctx := baseadp.GetHistoryTaskContext(task)
+
+	var result []structs.AccountTrade
+	for _, window := range windows {
+		fills, err := a.getWindowFills(ctx, task.PairSymbol, window)
+		if err != nil {
+			return nil, fmt.Errorf("get trades: %w", err)
+		}
+
+		for _, fill := range fills {
+			trade, err := mappers.ConvertAccountTrade(fill)
+			if err != nil {
+				return nil, fmt.Errorf("convert trade: %w", err)
+			}
+			result = append(result, trade)
+		}
+	}
+	return baseadp.SortAccountTrades(result), nil
+}
+
+// getWindowFills - okx fills are sorted from new to old,
+// the next page is requested by the last bill ID
+func (a *adapter) getWindowFills(
+	ctx context.Context,
+	pairSymbol string,
+	window baseadp.TimeWindow,
+) ([]mappers.Fill, error) {
+	params := map[string]any{
+		"instType": instTypeSpot,
+		"instId":   pairSymbol,
+		"begin":    window.StartTime,
+		"end":      window.EndTime,
+		"limit":    tradesHistoryPageLimit,
+	}
+
+	var result []mappers.Fill
+	for {
+		var fills []mappers.Fill
+		if err := a.rest.get(ctx, endpointGetFillsHistory, params, &fills); err != nil {
+			return nil, err
+		}
+
+		result = append(result, fills...)
+		if len(fills) < tradesHistoryPageLimit {
+			return result, nil
+		}
+
+		params["after"] = fills[len(fills)-1].BillID
+	}
+}
@@ -0,0 +1,49 @@
+package okx
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+type IntervalData struct {
+	Interval consts.Interval
+	Duration time.Duration
+}
+
+// okx bar -> our interval. Daily & longer bars are aligned to UTC
+var intervalOKXToOur = map[string]IntervalData{
+	"1m":     {consts.Interval1min, time.Minute},
+	"3m":     {consts.Interval3min, time.Minute * 3},
+	"5m":     {consts.Interval5min, time.Minute * 5},
+	"15m":    {consts.Interval15min, time.Minute * 15},
+	"30m":    {consts.Interval30min, time.Minute * 30},
+	"1H":     {consts.Interval1hour, time.Hour},
+	"2H":     {consts.Interval2hour, time.Hour * 2},
+	"4H":     {consts.Interval4hour, time.Hour * 4},
+	"6Hutc":  {consts.Interval6hour, time.Hour * 6},
+	"12Hutc": {consts.Interval12hour, time.Hour * 12},
+	"1Dutc":  {consts.Interval1day, time.Hour * 24},
+	"3Dutc":  {consts.Interval3day, time.Hour * 24 * 3},
+	"1Wutc":  {consts.Interval1week, time.Hour * 24 * 7},
+	"1Mutc":  {consts.Interval1month, time.Hour * 24 * 31},
+}
+
+var ourIntervalToOKX = func() map[consts.Interval]string {
+	r := map[consts.Interval]string{}
+	for bar, data := range intervalOKXToOur {
+		r[data.Interval] = bar
+	}
+	return r
+}()
+
+// ConvertIntervalToOKX - the bar is the same for REST & websocket
+func ConvertIntervalToOKX(interval consts.Interval) (string, error) {
+	result, isExists := ourIntervalToOKX[interval]
+	if !isExists {
+		return "", &errs.NotSupportedError{Feature: fmt.Sprintf("interval %q", interval)}
+	}
+	return result, nil
+}
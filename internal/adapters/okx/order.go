@@ -0,0 +1,221 @@
+package okx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/okx/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	endpointOrder        = "/api/v5/trade/order"
+	endpointGetFills     = "/api/v5/trade/fills"
+	endpointGetTradeFees = "/api/v5/account/trade-fee"
+
+	tradeModeCash      = "cash"
+	orderTypeLimit     = "limit"
+	orderTypeMarket    = "market"
+	targetCurrencyBase = "base_ccy"
+
+	errCodeOrderDuplicate = "51016"
+	errCodeOrderNotFound  = "51603"
+)
+
+func (a *adapter) PlaceOrder(
+	ctx context.Context,
+	order structs.BotOrderAdjusted,
+) (structs.CreateOrderResponse, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.CreateOrderResponse{}, errs.ErrAPIKeyNotSet
+	}
+
+	orderSide, err := mappers.GetOrderSide(order.Type)
+	if err != nil {
+		return structs.CreateOrderResponse{},
+			fmt.Errorf("get order side: %w", err)
+	}
+
+	params := map[string]any{
+		"instId":  order.PairSymbol,
+		"tdMode":  tradeModeCash,
+		"side":    orderSide,
+		"ordType": orderTypeLimit,
+		"sz":      order.Qty,
+		"px":      order.Price,
+	}
+	if order.IsMarketOrder {
+		// market order size is set in quote asset by default
+		params["ordType"] = orderTypeMarket
+		params["tgtCcy"] = targetCurrencyBase
+		delete(params, "px")
+	}
+	if order.ClientOrderID != "" {
+		params["clOrdId"] = order.ClientOrderID
+	}
+
+	var response []mappers.PlacedOrder
+	if err := a.rest.post(ctx, endpointOrder, params, &response); err != nil {
+		if isAPIErrorCode(err, errCodeOrderDuplicate) {
+			return structs.CreateOrderResponse{}, errs.ErrOrderDuplicate
+		}
+		return structs.CreateOrderResponse{}, fmt.Errorf("create: %w", err)
+	}
+	if len(response) == 0 {
+		return structs.CreateOrderResponse{}, errors.New("order response is empty")
+	}
+
+	result, err := mappers.ConvertPlacedOrder(response[0], order)
+	if err != nil {
+		return structs.CreateOrderResponse{},
+			fmt.Errorf("convert: %w", err)
+	}
+	return result, nil
+}
+
+func (a *adapter) GetOrderData(
+	pairSymbol string,
+	orderID int64,
+) (structs.OrderData, error) {
+	return a.getOrder(map[string]any{
+		"instId": pairSymbol,
+		"ordId":  strconv.FormatInt(orderID, 10),
+	})
+}
+
+func (a *adapter) GetOrderByClientOrderID(
+	pairSymbol string,
+	clientOrderID string,
+) (structs.OrderData, error) {
+	return a.getOrder(map[string]any{
+		"instId":  pairSymbol,
+		"clOrdId": clientOrderID,
+	})
+}
+
+func (a *adapter) getOrder(params map[string]any) (structs.OrderData, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.OrderData{}, errs.ErrAPIKeyNotSet
+	}
+
+	var orders []mappers.Order
+	if err := a.rest.get(
+		context.Background(),
+		endpointOrder,
+		params,
+		&orders,
+	); err != nil {
+		if isAPIErrorCode(err, errCodeOrderNotFound) {
+			return structs.OrderData{}, errs.ErrOrderNotFound
+		}
+		return structs.OrderData{}, fmt.Errorf("get: %w", err)
+	}
+	if len(orders) == 0 {
+		return structs.OrderData{}, errs.ErrOrderNotFound
+	}
+
+	return mappers.ConvertOrderData(orders[0])
+}
+
+func (a *adapter) GetHistoryOrder(
+	pairSymbol string,
+	orderID int64,
+) (structs.OrderHistory, error) {
+	orderData, err := a.GetOrderData(pairSymbol, orderID)
+	if err != nil {
+		return structs.OrderHistory{}, fmt.Errorf("get order: %w", err)
+	}
+
+	fills, err := a.getOrderFills(pairSymbol, orderID)
+	if err != nil {
+		return structs.OrderHistory{}, fmt.Errorf("get fills: %w", err)
+	}
+
+	baseAsset, quoteAsset := splitPairSymbol(pairSymbol)
+	fees, err := mappers.GetFeesFromFills(fills, baseAsset, quoteAsset)
+	if err != nil {
+		return structs.OrderHistory{}, fmt.Errorf("get fees: %w", err)
+	}
+
+	return structs.OrderHistory{
+		OrderData: orderData,
+		Fees:      fees,
+	}, nil
+}
+
+func (a *adapter) GetOrderExecFee(
+	baseAssetTicker string,
+	quoteAssetTicker string,
+	orderSide consts.OrderSide,
+	orderID int64,
+) (structs.OrderFees, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.OrderFees{}, errs.ErrAPIKeyNotSet
+	}
+
+	fills, err := a.getOrderFills(
+		a.GetPairSymbol(baseAssetTicker, quoteAssetTicker),
+		orderID,
+	)
+	if err != nil {
+		return structs.OrderFees{}, fmt.Errorf("get fills: %w", err)
+	}
+
+	return mappers.GetFeesFromFills(fills, baseAssetTicker, quoteAssetTicker)
+}
+
+// getOrderFills - the last 3 days fills, the older ones are in the fills history
+func (a *adapter) getOrderFills(pairSymbol string, orderID int64) ([]mappers.Fill, error) {
+	params := map[string]any{
+		"instType": instTypeSpot,
+		"instId":   pairSymbol,
+		"ordId":    strconv.FormatInt(orderID, 10),
+	}
+
+	var fills []mappers.Fill
+	if err := a.rest.get(context.Background(), endpointGetFills, params, &fills); err != nil {
+		return nil, err
+	}
+	if len(fills) > 0 {
+		return fills, nil
+	}
+
+	if err := a.rest.get(
+		context.Background(), endpointGetFillsHistory, params, &fills,
+	); err != nil {
+		return nil, fmt.Errorf("get history: %w", err)
+	}
+	return fills, nil
+}
+
+func (a *adapter) GetTradeFees(pairSymbol string) (structs.TradeFees, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.TradeFees{}, errs.ErrAPIKeyNotSet
+	}
+
+	var rates []mappers.TradeFee
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetTradeFees,
+		map[string]any{"instType": instTypeSpot, "instId": pairSymbol},
+		&rates,
+	); err != nil {
+		return structs.TradeFees{}, fmt.Errorf("get: %w", err)
+	}
+	if len(rates) == 0 {
+		return structs.TradeFees{}, errors.New("fee rates not found")
+	}
+
+	return mappers.ConvertTradeFees(pairSymbol, rates[0])
+}
+
+// splitPairSymbol - "BTC-USDT" -> "BTC", "USDT"
+func splitPairSymbol(pairSymbol string) (string, string) {
+	baseAsset, quoteAsset, _ := strings.Cut(pairSymbol, symbolDelimiter)
+	return baseAsset, quoteAsset
+}
@@ -0,0 +1,150 @@
+package okx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/okx/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
+)
+
+const (
+	endpointGetInstruments = "/api/v5/public/instruments"
+	endpointGetTicker      = "/api/v5/market/ticker"
+	endpointGetTickers     = "/api/v5/market/tickers"
+	endpointCancelOrder    = "/api/v5/trade/cancel-order"
+
+	errCodeCancelFailed        = "51400"
+	errCodeCancelledAlready    = "51401"
+	errCodeCancelOrderFinished = "51402"
+)
+
+func (a *adapter) GetPairData(pairSymbol string) (structs.ExchangePairData, error) {
+	var instruments []mappers.Instrument
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetInstruments,
+		map[string]any{"instType": instTypeSpot, "instId": pairSymbol},
+		&instruments,
+	); err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("get instruments: %w", err)
+	}
+	if len(instruments) == 0 {
+		return structs.ExchangePairData{}, errors.New("data not found")
+	}
+
+	lastPrices, err := a.getLastPrices(map[string]any{"instId": pairSymbol}, endpointGetTicker)
+	if err != nil {
+		return structs.ExchangePairData{}, fmt.Errorf("get ticker: %w", err)
+	}
+
+	return mappers.ConvertPairData(instruments[0], lastPrices[pairSymbol])
+}
+
+func (a *adapter) GetPairLastPrice(pairSymbol string) (decimal.Decimal, error) {
+	lastPrices, err := a.getLastPrices(map[string]any{"instId": pairSymbol}, endpointGetTicker)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("get ticker: %w", err)
+	}
+
+	lastPrice, isExists := lastPrices[pairSymbol]
+	if !isExists {
+		return decimal.Zero, fmt.Errorf("%q last price not found", pairSymbol)
+	}
+	return lastPrice, nil
+}
+
+func (a *adapter) GetPairs() ([]structs.ExchangePairData, error) {
+	var instruments []mappers.Instrument
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetInstruments,
+		map[string]any{"instType": instTypeSpot},
+		&instruments,
+	); err != nil {
+		return nil, fmt.Errorf("get instruments: %w", err)
+	}
+
+	lastPrices, err := a.getLastPrices(map[string]any{"instType": instTypeSpot}, endpointGetTickers)
+	if err != nil {
+		return nil, fmt.Errorf("get tickers: %w", err)
+	}
+
+	pairs, err := mappers.ConvertPairs(instruments, lastPrices)
+	if err != nil {
+		return nil, fmt.Errorf("convert: %w", err)
+	}
+	return pairs, nil
+}
+
+func (a *adapter) getLastPrices(
+	params map[string]any,
+	endpoint string,
+) (map[string]decimal.Decimal, error) {
+	var tickers []mappers.Ticker
+	if err := a.rest.get(context.Background(), endpoint, params, &tickers); err != nil {
+		return nil, err
+	}
+	return mappers.ConvertTickers(tickers)
+}
+
+func (a *adapter) GetPairBalance(pair structs.PairSymbolData) (
+	structs.PairBalance,
+	error,
+) {
+	balances, err := a.GetAccountBalance()
+	if err != nil {
+		return structs.PairBalance{}, fmt.Errorf("get: %w", err)
+	}
+
+	return utils.FindPairBalance(balances, pair), nil
+}
+
+func (a *adapter) CancelPairOrder(
+	pairSymbol string,
+	orderID int64,
+	ctx context.Context,
+) error {
+	return a.cancelOrder(ctx, map[string]any{
+		"instId": pairSymbol,
+		"ordId":  strconv.FormatInt(orderID, 10),
+	})
+}
+
+func (a *adapter) CancelPairOrderByClientOrderID(
+	pairSymbol string,
+	clientOrderID string,
+	ctx context.Context,
+) error {
+	return a.cancelOrder(ctx, map[string]any{
+		"instId":  pairSymbol,
+		"clOrdId": clientOrderID,
+	})
+}
+
+func (a *adapter) cancelOrder(ctx context.Context, params map[string]any) error {
+	if !a.isPrivateAPIAvailable() {
+		return errs.ErrAPIKeyNotSet
+	}
+
+	if err := a.rest.post(ctx, endpointCancelOrder, params, nil); err != nil {
+		return mapCancelOrderError(err)
+	}
+	return nil
+}
+
+func mapCancelOrderError(err error) error {
+	switch {
+	case isAPIErrorCode(err, errCodeCancelOrderFinished):
+		return errs.ErrOrderFilled
+	case isAPIErrorCode(err, errCodeCancelFailed, errCodeCancelledAlready, errCodeOrderNotFound):
+		return errs.ErrOrderNotFound
+	}
+	return err
+}
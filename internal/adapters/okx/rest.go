@@ -0,0 +1,225 @@
+package okx
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	restBaseURL        = "https://www.okx.com"
+	restRequestTimeout = time.Second * 10
+	restSuccessCode    = "0"
+	timestampFormat    = "2006-01-02T15:04:05.000Z"
+
+	// demo trading uses the same REST host with the header
+	demoTradingHeader = "x-simulated-trading"
+)
+
+// APIError - OKX API error, the code of the failed item
+// is used for the batch-like endpoints, e.g. order placement
+type APIError struct {
+	Code    string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("okx api error %s: %s", e.Code, e.Message)
+}
+
+// isAPIErrorCode - check if the error is OKX API error with one of the codes
+func isAPIErrorCode(err error, codes ...string) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	for _, code := range codes {
+		if apiErr.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+type restResponse struct {
+	Code string          `json:"code"`
+	Msg  string          `json:"msg"`
+	Data json.RawMessage `json:"data"`
+}
+
+// restItemStatus - result code of the data item
+type restItemStatus struct {
+	SCode string `json:"sCode"`
+	SMsg  string `json:"sMsg"`
+}
+
+// restClient - signed requests to OKX REST API
+type restClient struct {
+	httpClient *http.Client
+	baseURL    string
+	keyPublic  string
+	keySecret  string
+	passphrase string
+	isDemo     bool
+	now        func() time.Time
+}
+
+// newRestClient - the signed requests timestamps are taken from the clock
+func newRestClient(
+	credentials pkgStructs.APICredentials,
+	cfg config.Config,
+	now func() time.Time,
+) *restClient {
+	return &restClient{
+		httpClient: cfg.NewHTTPClient(restRequestTimeout),
+		baseURL:    cfg.GetRESTBaseURL(restBaseURL, restBaseURL),
+		keyPublic:  credentials.Keypair.Public,
+		keySecret:  credentials.Keypair.Secret,
+		passphrase: credentials.Keypair.Passphrase,
+		isDemo:     cfg.Testnet,
+		now:        now,
+	}
+}
+
+// get - send GET request & decode response data
+func (c *restClient) get(
+	ctx context.Context,
+	endpoint string,
+	params map[string]any,
+	result any,
+) error {
+	requestPath := endpoint
+	if query := encodeQuery(params); query != "" {
+		requestPath += "?" + query
+	}
+	return c.send(ctx, http.MethodGet, requestPath, nil, result)
+}
+
+// post - send POST request with JSON body & decode response data
+func (c *restClient) post(
+	ctx context.Context,
+	endpoint string,
+	params map[string]any,
+	result any,
+) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("encode request: %w", err)
+	}
+	return c.send(ctx, http.MethodPost, endpoint, body, result)
+}
+
+func (c *restClient) send(
+	ctx context.Context,
+	method string,
+	requestPath string,
+	body []byte,
+	result any,
+) error {
+	req, err := http.NewRequestWithContext(
+		ctx, method, c.baseURL+requestPath, bytes.NewReader(body),
+	)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.isDemo {
+		req.Header.Set(demoTradingHeader, "1")
+	}
+
+	// public endpoints don't require the signature
+	if c.keyPublic != "" {
+		timestamp := c.now().UTC().Format(timestampFormat)
+		req.Header.Set("OK-ACCESS-KEY", c.keyPublic)
+		req.Header.Set("OK-ACCESS-SIGN", sign(
+			c.keySecret, timestamp+method+requestPath+string(body),
+		))
+		req.Header.Set("OK-ACCESS-TIMESTAMP", timestamp)
+		req.Header.Set("OK-ACCESS-PASSPHRASE", c.passphrase)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+
+	response := restResponse{}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("http status %d, body: %s", resp.StatusCode, string(respBody))
+		}
+		return fmt.Errorf("decode response: %w", err)
+	}
+	if err := response.Error(); err != nil {
+		return err
+	}
+
+	if result == nil || len(response.Data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(response.Data, result); err != nil {
+		return fmt.Errorf("decode response data: %w", err)
+	}
+	return nil
+}
+
+// Error - response error. The item error is more specific than
+// the general one, e.g. "All operations failed"
+func (r restResponse) Error() error {
+	if r.Code == restSuccessCode {
+		return nil
+	}
+
+	var items []restItemStatus
+	if err := json.Unmarshal(r.Data, &items); err == nil {
+		for _, item := range items {
+			if item.SCode != "" && item.SCode != restSuccessCode {
+				return &APIError{Code: item.SCode, Message: item.SMsg}
+			}
+		}
+	}
+	return &APIError{Code: r.Code, Message: r.Msg}
+}
+
+// sign - base64 encoded HMAC SHA256 signature
+func sign(secret, message string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(message))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// encodeQuery - sorted query params. The same string is signed
+func encodeQuery(params map[string]any) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, key+"="+url.QueryEscape(fmt.Sprintf("%v", params[key])))
+	}
+	return strings.Join(parts, "&")
+}
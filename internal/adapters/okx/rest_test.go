@@ -0,0 +1,60 @@
+package okx
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+func TestSign(t *testing.T) {
+	// when
+	signature := sign("secret", "2020-12-08T09:08:57.715ZGET/api/v5/account/balance")
+
+	// then
+	assert.Equal(t, "5ktoTKif8DCJlIPb/3Kfd1A17bIRye6jpS9QBWj+9AU=", signature)
+}
+
+func TestEncodeQuery(t *testing.T) {
+	// when
+	query := encodeQuery(map[string]any{"instType": "SPOT", "instId": "BTC-USDT"})
+
+	// then
+	assert.Equal(t, "instId=BTC-USDT&instType=SPOT", query)
+}
+
+func TestResponseItemError(t *testing.T) {
+	// given
+	response := restResponse{
+		Code: "1",
+		Msg:  "All operations failed",
+		Data: json.RawMessage(`[{"ordId":"","sCode":"51016","sMsg":"Duplicated clOrdId"}]`),
+	}
+
+	// when
+	err := response.Error()
+
+	// then
+	require.Error(t, err)
+	assert.True(t, isAPIErrorCode(err, errCodeOrderDuplicate))
+}
+
+func TestMapCancelOrderError(t *testing.T) {
+	assert.ErrorIs(t, mapCancelOrderError(&APIError{Code: "51402"}), errs.ErrOrderFilled)
+	assert.ErrorIs(t, mapCancelOrderError(&APIError{Code: "51400"}), errs.ErrOrderNotFound)
+}
+
+func TestVerifyAPIKeysPassphraseNotSet(t *testing.T) {
+	// given
+	a := New()
+
+	// when
+	err := a.VerifyAPIKeys("public", "secret")
+
+	// then
+	require.ErrorIs(t, err, errs.ErrAPIKeyNotSet)
+	assert.ErrorContains(t, err, "passphrase")
+}
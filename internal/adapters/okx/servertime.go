@@ -0,0 +1,39 @@
+package okx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/okx/helpers/mappers"
+)
+
+const endpointGetServerTime = "/api/v5/public/time"
+
+func (a *adapter) GetServerTime() (time.Time, error) {
+	var data []mappers.ServerTime
+	if err := a.rest.get(
+		context.Background(),
+		endpointGetServerTime,
+		nil,
+		&data,
+	); err != nil {
+		return time.Time{}, fmt.Errorf("get server time: %w", err)
+	}
+	if len(data) == 0 {
+		return time.Time{}, errors.New("server time not found")
+	}
+
+	serverTime, err := mappers.ConvertServerTime(data[0])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("convert: %w", err)
+	}
+	return serverTime, nil
+}
+
+// SetServerTimeOffset - the offset is applied to the REST requests signature
+func (a *adapter) SetServerTimeOffset(offset time.Duration) error {
+	a.clock.SetOffset(offset)
+	return nil
+}
@@ -0,0 +1,98 @@
+package okx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/okx/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const (
+	endpointTransfer = "/api/v5/asset/transfer"
+
+	errCodeInsufficientBalance = "58350"
+)
+
+// okx account types: 6 - funding, 18 - trading
+var transferAccountTypes = map[consts.AccountType]string{
+	consts.AccountTypeSpot:    "18",
+	consts.AccountTypeFunding: "6",
+}
+
+func (a *adapter) GetAccountBalances(accountType consts.AccountType) ([]structs.Balance, error) {
+	switch accountType {
+	default:
+		return nil, fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, accountType)
+	case consts.AccountTypeSpot:
+		return a.GetAccountBalance()
+	case consts.AccountTypeFunding:
+		return a.getFundingBalance()
+	}
+}
+
+func (a *adapter) Transfer(
+	asset string,
+	amount decimal.Decimal,
+	fromAccount consts.AccountType,
+	toAccount consts.AccountType,
+) (structs.TransferResult, error) {
+	if !a.isPrivateAPIAvailable() {
+		return structs.TransferResult{}, errs.ErrAPIKeyNotSet
+	}
+	if asset == "" {
+		return structs.TransferResult{}, errors.New("asset is not set")
+	}
+	if !amount.IsPositive() {
+		return structs.TransferResult{}, fmt.Errorf("invalid amount: %s", amount.String())
+	}
+
+	from, isExists := transferAccountTypes[fromAccount]
+	if !isExists {
+		return structs.TransferResult{},
+			fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, fromAccount)
+	}
+	to, isExists := transferAccountTypes[toAccount]
+	if !isExists {
+		return structs.TransferResult{},
+			fmt.Errorf("%w: %q", errs.ErrAccountTypeNotSupported, toAccount)
+	}
+	if from == to {
+		return structs.TransferResult{}, fmt.Errorf("transfer to the same account: %q", from)
+	}
+
+	var response []mappers.TransferResult
+	if err := a.rest.post(
+		context.Background(),
+		endpointTransfer,
+		map[string]any{
+			"ccy":  asset,
+			"amt":  amount.String(),
+			"from": from,
+			"to":   to,
+		},
+		&response,
+	); err != nil {
+		if isAPIErrorCode(err, errCodeInsufficientBalance) {
+			return structs.TransferResult{},
+				fmt.Errorf("%w: %s", errs.ErrInsufficientBalance, err.Error())
+		}
+		return structs.TransferResult{}, fmt.Errorf("transfer: %w", err)
+	}
+	if len(response) == 0 {
+		return structs.TransferResult{}, errors.New("transfer result not found")
+	}
+
+	return structs.TransferResult{
+		ID:          response[0].TransID,
+		Asset:       asset,
+		Amount:      amount,
+		FromAccount: fromAccount,
+		ToAccount:   toAccount,
+	}, nil
+}
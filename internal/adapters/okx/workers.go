@@ -0,0 +1,283 @@
+package okx
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/okx/helpers/mappers"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const (
+	tradeSubscriptionKey = "subscription"
+
+	channelCandlePrefix = "candle"
+	channelTrades       = "trades"
+	channelOrders       = "orders"
+)
+
+type CandleEventWorkerOKX struct {
+	workers.CandleWorker
+	wsURL  string
+	dialer *websocket.Dialer
+}
+
+func (a *adapter) CreateCandleWorker() *CandleEventWorkerOKX {
+	w := &CandleEventWorkerOKX{wsURL: a.ws.business, dialer: a.wsDialer}
+	w.CandleWorker.ExchangeTag = a.GetTag()
+	return w
+}
+
+type PublicTradeWorkerOKX struct {
+	workers.PublicTradeWorker
+	wsURL  string
+	dialer *websocket.Dialer
+}
+
+func (a *adapter) CreatePublicTradeWorker() *PublicTradeWorkerOKX {
+	w := &PublicTradeWorkerOKX{wsURL: a.ws.public, dialer: a.wsDialer}
+	w.PublicTradeWorker.ExchangeTag = a.GetTag()
+	return w
+}
+
+type TradeEventWorkerOKX struct {
+	workers.TradeEventWorker
+	creds  structs.APICredentials
+	wsURL  string
+	dialer *websocket.Dialer
+	now    func() time.Time // server time for the login signature
+}
+
+func (a *adapter) CreateTradeEventsWorker() *TradeEventWorkerOKX {
+	w := &TradeEventWorkerOKX{
+		creds:  a.creds,
+		wsURL:  a.ws.private,
+		dialer: a.wsDialer,
+		now:    a.clock.Now,
+	}
+	w.TradeEventWorker.ExchangeTag = a.GetTag()
+	return w
+}
+
+func (w *TradeEventWorkerOKX) SubscribeToTradeEventsPrivate(
+	eventCallback workers.TradeEventPrivateCallback,
+	errorHandler func(err error),
+) error {
+	if !w.creds.Keypair.IsSet() || w.creds.Keypair.Passphrase == "" {
+		return errs.ErrAPIKeyNotSet
+	}
+
+	if w.TradeEventWorker.IsSubscriptionExists(tradeSubscriptionKey) {
+		return nil
+	}
+
+	wsDone, wsStop, err := wsServe(
+		w.dialer,
+		w.wsURL,
+		wsArg{Channel: channelOrders, InstType: instTypeSpot},
+		&w.creds.Keypair,
+		w.now,
+		func(message wsMessage) {
+			var orders []mappers.WsOrder
+			if err := json.Unmarshal(message.Data, &orders); err != nil {
+				errorHandler(fmt.Errorf("decode orders: %w", err))
+				return
+			}
+
+			for _, order := range orders {
+				if !order.IsFill() {
+					continue
+				}
+
+				event, err := mappers.ConvertOrderEvent(order)
+				if err != nil {
+					errorHandler(fmt.Errorf("convert: %w", err))
+					continue
+				}
+
+				eventCallback(event)
+			}
+		},
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	w.TradeEventWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		tradeSubscriptionKey,
+	)
+	return nil
+}
+
+func (w *CandleEventWorkerOKX) SubscribeToCandle(
+	pairSymbol string,
+	interval consts.Interval,
+	eventCallback func(event workers.CandleEvent),
+	errorHandler func(err error),
+) error {
+	bar, err := ConvertIntervalToOKX(interval)
+	if err != nil {
+		return fmt.Errorf("convert interval: %w", err)
+	}
+
+	if w.CandleWorker.IsSubscriptionExists(pairSymbol, bar) {
+		return nil
+	}
+
+	duration := intervalOKXToOur[bar].Duration
+	wsDone, wsStop, err := wsServe(
+		w.dialer,
+		w.wsURL,
+		wsArg{Channel: channelCandlePrefix + bar, InstID: pairSymbol},
+		nil,
+		nil,
+		func(message wsMessage) {
+			var candles []mappers.Candle
+			if err := json.Unmarshal(message.Data, &candles); err != nil {
+				errorHandler(fmt.Errorf("decode candles: %w", err))
+				return
+			}
+
+			for _, candle := range candles {
+				event, err := mappers.ConvertCandleEvent(
+					message.Arg.InstID,
+					candle,
+					interval,
+					duration,
+					time.Now().UnixMilli(),
+				)
+				if err != nil {
+					errorHandler(fmt.Errorf("convert: %w", err))
+					continue
+				}
+
+				eventCallback(event)
+			}
+		},
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	w.CandleWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		pairSymbol, bar,
+	)
+	return nil
+}
+
+func (w *PublicTradeWorkerOKX) SubscribeToPublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	if w.PublicTradeWorker.IsSubscriptionExists(pairSymbol) {
+		return nil
+	}
+
+	wsDone, wsStop, err := wsServe(
+		w.dialer,
+		w.wsURL,
+		wsArg{Channel: channelTrades, InstID: pairSymbol},
+		nil,
+		nil,
+		func(message wsMessage) {
+			var trades []mappers.WsTrade
+			if err := json.Unmarshal(message.Data, &trades); err != nil {
+				errorHandler(fmt.Errorf("decode trades: %w", err))
+				return
+			}
+
+			for _, trade := range trades {
+				event, err := mappers.ConvertPublicTradeEvent(trade)
+				if err != nil {
+					errorHandler(fmt.Errorf("convert: %w", err))
+					continue
+				}
+
+				eventCallback(event)
+			}
+		},
+		errorHandler,
+	)
+	if err != nil {
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	w.PublicTradeWorker.Save(
+		workers.CreateChannelsUnsubscriber(wsDone, wsStop),
+		errorHandler,
+		pairSymbol,
+	)
+	return nil
+}
+
+func (a *adapter) SubscribeCandle(
+	pairSymbol string,
+	interval consts.Interval,
+	eventCallback func(event workers.CandleEvent),
+	errorHandler func(err error),
+) error {
+	return a.candleWorker.SubscribeToCandle(
+		pairSymbol,
+		interval,
+		eventCallback,
+		errorHandler,
+	)
+}
+
+func (a *adapter) SubscribeAccountTrades(
+	eventCallback workers.TradeEventPrivateCallback,
+	errorHandler func(err error),
+) error {
+	return a.tradeWorker.SubscribeToTradeEventsPrivate(
+		eventCallback, errorHandler,
+	)
+}
+
+func (a *adapter) SubscribePublicTrades(
+	pairSymbol string,
+	eventCallback workers.PublicTradeEventCallback,
+	errorHandler func(err error),
+) error {
+	return a.publicTradeWorker.SubscribeToPublicTrades(
+		pairSymbol,
+		eventCallback,
+		errorHandler,
+	)
+}
+
+func (a *adapter) UnsubscribeCandle(
+	pairSymbol string,
+	interval consts.Interval,
+) {
+	bar, err := ConvertIntervalToOKX(interval)
+	if err != nil {
+		fmt.Printf(
+			"convert interval %q to okx: %s\n",
+			interval, err.Error(),
+		)
+		return
+	}
+
+	a.candleWorker.Unsubscribe(pairSymbol, bar)
+}
+
+func (a *adapter) UnsubscribeAccountTrades() {
+	a.tradeWorker.UnsubscribeAll()
+}
+
+func (a *adapter) UnsubscribePublicTrades(pairSymbol string) {
+	a.publicTradeWorker.Unsubscribe(pairSymbol)
+}
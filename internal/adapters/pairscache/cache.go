@@ -0,0 +1,274 @@
+package pairscache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+const (
+	defaultTTL             = time.Minute * 5
+	defaultRefreshInterval = time.Minute
+)
+
+type Config struct {
+	// TTL - how long the pair data is considered actual
+	TTL time.Duration
+	// RefreshInterval - full pairs list refresh interval, used in Run
+	RefreshInterval time.Duration
+	// OnStatusChanged is called when the pair status changed on refresh
+	OnStatusChanged func(oldData, newData structs.ExchangePairData)
+	// OnRefreshError is called when background refresh failed
+	OnRefreshError func(error)
+}
+
+// CachedAdapter - adapter decorator that caches exchange pairs data
+type CachedAdapter struct {
+	adapters.Adapter
+
+	cfg Config
+	now func() time.Time
+
+	mu        sync.RWMutex
+	pairs     map[string]pairEntry // symbol -> data
+	listTime  time.Time
+	isListSet bool
+
+	callsMu sync.Mutex
+	calls   map[string]*call // key -> in-flight request
+}
+
+type pairEntry struct {
+	data      structs.ExchangePairData
+	updatedAt time.Time
+}
+
+type call struct {
+	done chan struct{}
+	err  error
+}
+
+// pairsListCallKey - the pairs list call key, can't be a pair symbol
+const pairsListCallKey = "\x00pairs"
+
+func New(adapter adapters.Adapter, cfg Config) *CachedAdapter {
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultTTL
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = defaultRefreshInterval
+	}
+
+	return &CachedAdapter{
+		Adapter: adapter,
+		cfg:     cfg,
+		now:     time.Now,
+		pairs:   map[string]pairEntry{},
+		calls:   map[string]*call{},
+	}
+}
+
+// Run refreshes the full pairs list in background until the context is done
+func (a *CachedAdapter) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.cfg.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.refreshPairs(); err != nil && a.cfg.OnRefreshError != nil {
+			a.cfg.OnRefreshError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetPairData returns cached pair data, the last known data is used when the exchange is unreachable
+func (a *CachedAdapter) GetPairData(pairSymbol string) (structs.ExchangePairData, error) {
+	data, _, err := a.GetCachedPairData(pairSymbol)
+	return data, err
+}
+
+// GetCachedPairData returns pair data and the flag that the data is stale:
+// outdated data is returned when it could not be updated
+func (a *CachedAdapter) GetCachedPairData(pairSymbol string) (
+	structs.ExchangePairData,
+	bool,
+	error,
+) {
+	entry, isExists := a.getEntry(pairSymbol)
+	if isExists && !a.isExpired(entry.updatedAt) {
+		return entry.data, false, nil
+	}
+
+	err := a.do(pairSymbol, func() error {
+		return a.refreshPair(pairSymbol)
+	})
+	if err != nil {
+		if isExists {
+			return entry.data, true, nil
+		}
+		return structs.ExchangePairData{}, false, err
+	}
+
+	entry, _ = a.getEntry(pairSymbol)
+	return entry.data, false, nil
+}
+
+// GetPairs returns cached pairs list
+func (a *CachedAdapter) GetPairs() ([]structs.ExchangePairData, error) {
+	pairs, _, err := a.GetCachedPairs()
+	return pairs, err
+}
+
+// GetCachedPairs returns pairs list and the flag that the data is stale
+func (a *CachedAdapter) GetCachedPairs() ([]structs.ExchangePairData, bool, error) {
+	a.mu.RLock()
+	isListActual := a.isListSet && !a.isExpired(a.listTime)
+	isListSet := a.isListSet
+	a.mu.RUnlock()
+
+	if isListActual {
+		return a.getPairsList(), false, nil
+	}
+
+	if err := a.refreshPairs(); err != nil {
+		if isListSet {
+			return a.getPairsList(), true, nil
+		}
+		return nil, false, err
+	}
+	return a.getPairsList(), false, nil
+}
+
+// Invalidate removes pair data from cache. The pairs list is refreshed
+// on the next call, the rest pairs are kept as the stale data fallback
+func (a *CachedAdapter) Invalidate(pairSymbol string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.pairs, pairSymbol)
+	a.listTime = time.Time{}
+}
+
+// InvalidateAll clears cache
+func (a *CachedAdapter) InvalidateAll() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.pairs = map[string]pairEntry{}
+	a.isListSet = false
+}
+
+func (a *CachedAdapter) isExpired(updatedAt time.Time) bool {
+	return a.now().Sub(updatedAt) >= a.cfg.TTL
+}
+
+func (a *CachedAdapter) getEntry(pairSymbol string) (pairEntry, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	entry, isExists := a.pairs[pairSymbol]
+	return entry, isExists
+}
+
+func (a *CachedAdapter) getPairsList() []structs.ExchangePairData {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	result := make([]structs.ExchangePairData, 0, len(a.pairs))
+	for _, entry := range a.pairs {
+		result = append(result, entry.data)
+	}
+	return result
+}
+
+func (a *CachedAdapter) refreshPairs() error {
+	return a.do(pairsListCallKey, func() error {
+		pairs, err := a.Adapter.GetPairs()
+		if err != nil {
+			return fmt.Errorf("get pairs: %w", err)
+		}
+
+		updatedAt := a.now()
+		a.mu.Lock()
+		newPairs := make(map[string]pairEntry, len(pairs))
+		for _, pairData := range pairs {
+			newPairs[pairData.Symbol] = pairEntry{
+				data:      pairData,
+				updatedAt: updatedAt,
+			}
+		}
+		oldPairs := a.pairs
+		a.pairs = newPairs
+		a.listTime = updatedAt
+		a.isListSet = true
+		a.mu.Unlock()
+
+		for symbol, entry := range newPairs {
+			if oldEntry, isExists := oldPairs[symbol]; isExists {
+				a.checkStatusChanged(oldEntry.data, entry.data)
+			}
+		}
+		return nil
+	})
+}
+
+func (a *CachedAdapter) refreshPair(pairSymbol string) error {
+	pairData, err := a.Adapter.GetPairData(pairSymbol)
+	if err != nil {
+		return fmt.Errorf("get pair data: %w", err)
+	}
+
+	a.mu.Lock()
+	oldEntry, isExists := a.pairs[pairSymbol]
+	a.pairs[pairSymbol] = pairEntry{
+		data:      pairData,
+		updatedAt: a.now(),
+	}
+	a.mu.Unlock()
+
+	if isExists {
+		a.checkStatusChanged(oldEntry.data, pairData)
+	}
+	return nil
+}
+
+func (a *CachedAdapter) checkStatusChanged(oldData, newData structs.ExchangePairData) {
+	if oldData.Status == newData.Status {
+		return
+	}
+	if a.cfg.OnStatusChanged != nil {
+		a.cfg.OnStatusChanged(oldData, newData)
+	}
+}
+
+// do executes only one request for the key at a time,
+// concurrent callers wait for its result
+func (a *CachedAdapter) do(key string, fn func() error) error {
+	a.callsMu.Lock()
+	if c, isExists := a.calls[key]; isExists {
+		a.callsMu.Unlock()
+		<-c.done
+		return c.err
+	}
+
+	c := &call{done: make(chan struct{})}
+	a.calls[key] = c
+	a.callsMu.Unlock()
+
+	c.err = fn()
+
+	a.callsMu.Lock()
+	delete(a.calls, key)
+	a.callsMu.Unlock()
+	close(c.done)
+	return c.err
+}
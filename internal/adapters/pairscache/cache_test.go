@@ -0,0 +1,240 @@
+package pairscache
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+const testPairSymbol = "LTCUSDT"
+
+func getTestPairData(status string) structs.ExchangePairData {
+	return structs.ExchangePairData{
+		ExchangeID: consts.ExchangeIDbinanceSpot,
+		Symbol:     testPairSymbol,
+		BaseAsset:  "LTC",
+		QuoteAsset: "USDT",
+		Status:     status,
+	}
+}
+
+func TestGetPairDataCached(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetPairData(testPairSymbol).
+		Return(getTestPairData(consts.PairStatusTrading), nil).Times(1)
+
+	c := New(a, Config{TTL: time.Minute})
+
+	// when
+	_, err := c.GetPairData(testPairSymbol)
+	require.NoError(t, err)
+	data, isStale, err := c.GetCachedPairData(testPairSymbol)
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, isStale)
+	assert.Equal(t, testPairSymbol, data.Symbol)
+}
+
+func TestGetPairDataStale(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	gomock.InOrder(
+		a.EXPECT().GetPairData(testPairSymbol).
+			Return(getTestPairData(consts.PairStatusTrading), nil),
+		a.EXPECT().GetPairData(testPairSymbol).
+			Return(structs.ExchangePairData{}, errors.New("exchange unavailable")),
+	)
+
+	now := time.Now()
+	c := New(a, Config{TTL: time.Minute})
+	c.now = func() time.Time { return now }
+
+	_, err := c.GetPairData(testPairSymbol)
+	require.NoError(t, err)
+	now = now.Add(time.Hour)
+
+	// when
+	data, isStale, err := c.GetCachedPairData(testPairSymbol)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, isStale)
+	assert.Equal(t, testPairSymbol, data.Symbol)
+}
+
+func TestGetPairDataError(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetPairData(testPairSymbol).
+		Return(structs.ExchangePairData{}, errors.New("exchange unavailable"))
+
+	c := New(a, Config{})
+
+	// when
+	_, err := c.GetPairData(testPairSymbol)
+
+	// then
+	require.ErrorContains(t, err, "exchange unavailable")
+}
+
+func TestGetPairsStatusChanged(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	gomock.InOrder(
+		a.EXPECT().GetPairs().Return([]structs.ExchangePairData{
+			getTestPairData(consts.PairStatusTrading),
+		}, nil),
+		a.EXPECT().GetPairs().Return([]structs.ExchangePairData{
+			getTestPairData(consts.PairStatusSuspended),
+		}, nil),
+	)
+
+	var changedTo string
+	now := time.Now()
+	c := New(a, Config{
+		TTL: time.Minute,
+		OnStatusChanged: func(_, newData structs.ExchangePairData) {
+			changedTo = newData.Status
+		},
+	})
+	c.now = func() time.Time { return now }
+
+	_, err := c.GetPairs()
+	require.NoError(t, err)
+	now = now.Add(time.Hour)
+
+	// when
+	pairs, err := c.GetPairs()
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, pairs, 1)
+	assert.Equal(t, consts.PairStatusSuspended, pairs[0].Status)
+	assert.Equal(t, consts.PairStatusSuspended, changedTo)
+
+	data, err := c.GetPairData(testPairSymbol)
+	require.NoError(t, err)
+	assert.Equal(t, consts.PairStatusSuspended, data.Status)
+}
+
+func TestGetPairDataConcurrentMisses(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetPairData(testPairSymbol).
+		DoAndReturn(func(string) (structs.ExchangePairData, error) {
+			time.Sleep(time.Millisecond * 50)
+			return getTestPairData(consts.PairStatusTrading), nil
+		}).Times(1)
+
+	c := New(a, Config{TTL: time.Minute})
+
+	// when
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.GetPairData(testPairSymbol)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// then: the exchange was requested once
+}
+
+func TestGetPairDataEmptySymbolWhilePairsLoading(t *testing.T) {
+	// given
+	started := make(chan struct{})
+	release := make(chan struct{})
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetPairs().
+		DoAndReturn(func() ([]structs.ExchangePairData, error) {
+			close(started)
+			<-release
+			return nil, nil
+		})
+	a.EXPECT().GetPairData("").
+		Return(structs.ExchangePairData{}, errors.New("pair not found"))
+
+	c := New(a, Config{TTL: time.Minute})
+	go func() {
+		_, err := c.GetPairs()
+		assert.NoError(t, err)
+	}()
+	<-started
+	defer close(release)
+
+	// when
+	done := make(chan error)
+	go func() {
+		_, err := c.GetPairData("")
+		done <- err
+	}()
+
+	// then: the pair request doesn't wait for the pairs list
+	select {
+	case err := <-done:
+		require.ErrorContains(t, err, "pair not found")
+	case <-time.After(time.Second):
+		t.Fatal("pair data request joined the pairs list call")
+	}
+}
+
+func TestInvalidate(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetPairData(testPairSymbol).
+		Return(getTestPairData(consts.PairStatusTrading), nil).Times(2)
+
+	c := New(a, Config{TTL: time.Minute})
+	_, err := c.GetPairData(testPairSymbol)
+	require.NoError(t, err)
+
+	// when
+	c.Invalidate(testPairSymbol)
+	_, err = c.GetPairData(testPairSymbol)
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestInvalidateKeepsPairsFallback(t *testing.T) {
+	// given
+	otherPairData := getTestPairData(consts.PairStatusTrading)
+	otherPairData.Symbol = "BTCUSDT"
+
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	gomock.InOrder(
+		a.EXPECT().GetPairs().Return([]structs.ExchangePairData{
+			getTestPairData(consts.PairStatusTrading),
+			otherPairData,
+		}, nil),
+		a.EXPECT().GetPairs().Return(nil, errors.New("exchange unavailable")),
+	)
+
+	c := New(a, Config{TTL: time.Minute})
+	_, err := c.GetPairs()
+	require.NoError(t, err)
+
+	// when
+	c.Invalidate(testPairSymbol)
+	pairs, isStale, err := c.GetCachedPairs()
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, isStale)
+	require.Len(t, pairs, 1)
+	assert.Equal(t, otherPairData.Symbol, pairs[0].Symbol)
+}
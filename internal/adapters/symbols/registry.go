@@ -0,0 +1,110 @@
+package symbols
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+// Registry converts exchange pair symbols to the canonical pair data & back
+type Registry struct {
+	mu       sync.RWMutex
+	bySymbol map[string]structs.PairSymbolData // exchange symbol -> data
+	byAssets map[string]string                 // BASE/QUOTE -> exchange symbol
+}
+
+func New(pairs []structs.ExchangePairData) *Registry {
+	r := &Registry{}
+	r.Update(pairs)
+	return r
+}
+
+// Load creates registry from the adapter pairs list
+func Load(adapter adapters.Adapter) (*Registry, error) {
+	pairs, err := adapter.GetPairs()
+	if err != nil {
+		return nil, fmt.Errorf("get pairs: %w", err)
+	}
+	return New(pairs), nil
+}
+
+// Update replaces registry data with the new pairs list
+func (r *Registry) Update(pairs []structs.ExchangePairData) {
+	bySymbol := make(map[string]structs.PairSymbolData, len(pairs))
+	byAssets := make(map[string]string, len(pairs))
+
+	for _, pairData := range pairs {
+		if pairData.Symbol == "" || pairData.BaseAsset == "" || pairData.QuoteAsset == "" {
+			continue
+		}
+
+		bySymbol[pairData.Symbol] = structs.PairSymbolData{
+			BaseTicker:  pairData.BaseAsset,
+			QuoteTicker: pairData.QuoteAsset,
+			Symbol:      pairData.Symbol,
+		}
+		byAssets[getAssetsKey(pairData.BaseAsset, pairData.QuoteAsset)] = pairData.Symbol
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.bySymbol = bySymbol
+	r.byAssets = byAssets
+}
+
+// ParseSymbol - get base & quote tickers by exchange pair symbol
+func (r *Registry) ParseSymbol(exchangeSymbol string) (structs.PairSymbolData, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	data, isExists := r.bySymbol[exchangeSymbol]
+	if !isExists {
+		return structs.PairSymbolData{}, fmt.Errorf("pair %q not found", exchangeSymbol)
+	}
+	return data, nil
+}
+
+// GetExchangeSymbol - get exchange pair symbol by base & quote tickers
+func (r *Registry) GetExchangeSymbol(baseTicker, quoteTicker string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	symbol, isExists := r.byAssets[getAssetsKey(baseTicker, quoteTicker)]
+	if !isExists {
+		return "", fmt.Errorf("pair %s/%s not found", baseTicker, quoteTicker)
+	}
+	return symbol, nil
+}
+
+// EnrichCandleEvent sets event base & quote tickers
+func (r *Registry) EnrichCandleEvent(event *workers.CandleEvent) error {
+	data, err := r.ParseSymbol(event.Symbol)
+	if err != nil {
+		return err
+	}
+
+	event.BaseAsset = data.BaseTicker
+	event.QuoteAsset = data.QuoteTicker
+	return nil
+}
+
+// EnrichTradeEvent sets event base & quote tickers
+func (r *Registry) EnrichTradeEvent(event *workers.TradeEventPrivate) error {
+	data, err := r.ParseSymbol(event.Symbol)
+	if err != nil {
+		return err
+	}
+
+	event.BaseAsset = data.BaseTicker
+	event.QuoteAsset = data.QuoteTicker
+	return nil
+}
+
+func getAssetsKey(baseTicker, quoteTicker string) string {
+	return strings.ToUpper(baseTicker) + "/" + strings.ToUpper(quoteTicker)
+}
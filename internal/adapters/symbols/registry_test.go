@@ -0,0 +1,90 @@
+package symbols
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+func getTestPairs() []structs.ExchangePairData {
+	return []structs.ExchangePairData{
+		{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT"},
+		{Symbol: "BTCUSDC", BaseAsset: "BTC", QuoteAsset: "USDC"},
+		{Symbol: "USDCUSDT", BaseAsset: "USDC", QuoteAsset: "USDT"},
+	}
+}
+
+func TestParseSymbol(t *testing.T) {
+	// given
+	r := New(getTestPairs())
+
+	// when
+	data, err := r.ParseSymbol("USDCUSDT")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "USDC", data.BaseTicker)
+	assert.Equal(t, "USDT", data.QuoteTicker)
+	assert.Equal(t, "USDCUSDT", data.Symbol)
+}
+
+func TestParseSymbolNotFound(t *testing.T) {
+	// given
+	r := New(getTestPairs())
+
+	// when
+	_, err := r.ParseSymbol("LTCUSDT")
+
+	// then
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestGetExchangeSymbol(t *testing.T) {
+	// given
+	r := New([]structs.ExchangePairData{
+		{Symbol: "BTC_USDT", BaseAsset: "BTC", QuoteAsset: "USDT"},
+	})
+
+	// when
+	symbol, err := r.GetExchangeSymbol("btc", "usdt")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "BTC_USDT", symbol)
+}
+
+func TestEnrichEvents(t *testing.T) {
+	// given
+	r := New(getTestPairs())
+	candleEvent := workers.CandleEvent{Symbol: "BTCUSDC"}
+	tradeEvent := workers.TradeEventPrivate{Symbol: "BTCUSDT"}
+
+	// when
+	require.NoError(t, r.EnrichCandleEvent(&candleEvent))
+	require.NoError(t, r.EnrichTradeEvent(&tradeEvent))
+
+	// then
+	assert.Equal(t, "BTC", candleEvent.BaseAsset)
+	assert.Equal(t, "USDC", candleEvent.QuoteAsset)
+	assert.Equal(t, "BTC", tradeEvent.BaseAsset)
+	assert.Equal(t, "USDT", tradeEvent.QuoteAsset)
+}
+
+func TestLoadError(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetPairs().Return(nil, errors.New("exchange unavailable"))
+
+	// when
+	_, err := Load(a)
+
+	// then
+	require.ErrorContains(t, err, "exchange unavailable")
+}
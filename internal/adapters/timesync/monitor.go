@@ -0,0 +1,143 @@
+package timesync
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+const defaultInterval = time.Minute * 5
+
+type Config struct {
+	// Interval - server time re-sync interval, used in Run
+	Interval time.Duration
+	// MaxRTT - measurements with the longer round-trip are too inaccurate,
+	// they are rejected & the previous offset is kept. Zero means no limit
+	MaxRTT time.Duration
+	// OnSyncError is called when background sync failed
+	OnSyncError func(error)
+}
+
+// Measurement - exchange server clock offset measurement
+type Measurement struct {
+	// Offset - server time minus local time
+	Offset time.Duration
+	// RTT - server time request round-trip time
+	RTT time.Duration
+	// MeasuredAt - local time of the measurement
+	MeasuredAt time.Time
+}
+
+// SyncedAdapter - adapter decorator that measures the exchange server
+// clock drift & keeps the signed requests timestamps in sync with it
+type SyncedAdapter struct {
+	adapters.Adapter
+
+	cfg Config
+	now func() time.Time
+
+	mu         sync.RWMutex
+	last       Measurement
+	isMeasured bool
+}
+
+func New(adapter adapters.Adapter, cfg Config) *SyncedAdapter {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultInterval
+	}
+
+	return &SyncedAdapter{
+		Adapter: adapter,
+		cfg:     cfg,
+		now:     time.Now,
+	}
+}
+
+// Run re-syncs the server time in background until the context is done
+func (a *SyncedAdapter) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := a.Sync(); err != nil && a.cfg.OnSyncError != nil {
+			a.cfg.OnSyncError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync measures the server clock offset & applies it to the adapter.
+// The server time is considered taken in the middle of the round-trip
+func (a *SyncedAdapter) Sync() (Measurement, error) {
+	sentAt := a.now()
+	serverTime, err := a.Adapter.GetServerTime()
+	if err != nil {
+		return Measurement{}, fmt.Errorf("get server time: %w", err)
+	}
+	receivedAt := a.now()
+
+	rtt := receivedAt.Sub(sentAt)
+	if a.cfg.MaxRTT > 0 && rtt > a.cfg.MaxRTT {
+		return Measurement{}, fmt.Errorf("round-trip %s exceeds %s", rtt, a.cfg.MaxRTT)
+	}
+
+	measurement := Measurement{
+		Offset:     serverTime.Sub(sentAt.Add(rtt / 2)),
+		RTT:        rtt,
+		MeasuredAt: receivedAt,
+	}
+	if err := a.Adapter.SetServerTimeOffset(measurement.Offset); err != nil {
+		return Measurement{}, fmt.Errorf("set offset: %w", err)
+	}
+
+	a.mu.Lock()
+	a.last = measurement
+	a.isMeasured = true
+	a.mu.Unlock()
+	return measurement, nil
+}
+
+// Connect re-applies the measured offset, the adapters reset it on connect
+func (a *SyncedAdapter) Connect(credentials pkgStructs.APICredentials) error {
+	if err := a.Adapter.Connect(credentials); err != nil {
+		return err
+	}
+
+	measurement, isMeasured := a.LastMeasurement()
+	if !isMeasured {
+		return nil
+	}
+	if err := a.Adapter.SetServerTimeOffset(measurement.Offset); err != nil {
+		return fmt.Errorf("set offset: %w", err)
+	}
+	return nil
+}
+
+// Offset returns the last measured server time minus local time
+func (a *SyncedAdapter) Offset() time.Duration {
+	measurement, _ := a.LastMeasurement()
+	return measurement.Offset
+}
+
+// RTT returns the last measured server time request round-trip time
+func (a *SyncedAdapter) RTT() time.Duration {
+	measurement, _ := a.LastMeasurement()
+	return measurement.RTT
+}
+
+// LastMeasurement returns the last successful measurement
+// and the flag that the server time was measured at all
+func (a *SyncedAdapter) LastMeasurement() (Measurement, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.last, a.isMeasured
+}
@@ -0,0 +1,117 @@
+package timesync
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+// getTestClock - the clock moves forward by the step on each call
+func getTestClock(start time.Time, step time.Duration) func() time.Time {
+	now := start
+	return func() time.Time {
+		result := now
+		now = now.Add(step)
+		return result
+	}
+}
+
+func TestSync(t *testing.T) {
+	// given
+	localTime := time.UnixMilli(1700000000000)
+	rtt := time.Millisecond * 100
+	offset := time.Second * 2
+
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetServerTime().Return(localTime.Add(rtt/2).Add(offset), nil)
+	a.EXPECT().SetServerTimeOffset(offset).Return(nil)
+
+	s := New(a, Config{})
+	s.now = getTestClock(localTime, rtt)
+
+	// when
+	measurement, err := s.Sync()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, offset, measurement.Offset)
+	assert.Equal(t, rtt, measurement.RTT)
+	assert.Equal(t, localTime.Add(rtt), measurement.MeasuredAt)
+	assert.Equal(t, offset, s.Offset())
+	assert.Equal(t, rtt, s.RTT())
+}
+
+func TestSyncRTTExceeded(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetServerTime().Return(time.Now(), nil)
+
+	s := New(a, Config{MaxRTT: time.Second})
+	s.now = getTestClock(time.Now(), time.Second*2)
+
+	// when
+	_, err := s.Sync()
+
+	// then
+	require.ErrorContains(t, err, "round-trip")
+	_, isMeasured := s.LastMeasurement()
+	assert.False(t, isMeasured)
+}
+
+func TestSyncErrorKeepsLastMeasurement(t *testing.T) {
+	// given
+	localTime := time.UnixMilli(1700000000000)
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	gomock.InOrder(
+		a.EXPECT().GetServerTime().Return(localTime.Add(time.Second), nil),
+		a.EXPECT().SetServerTimeOffset(gomock.Any()).Return(nil),
+		a.EXPECT().GetServerTime().Return(time.Time{}, errors.New("exchange unavailable")),
+	)
+
+	s := New(a, Config{})
+	s.now = getTestClock(localTime, 0)
+	_, err := s.Sync()
+	require.NoError(t, err)
+
+	// when
+	_, err = s.Sync()
+
+	// then
+	require.ErrorContains(t, err, "exchange unavailable")
+	measurement, isMeasured := s.LastMeasurement()
+	assert.True(t, isMeasured)
+	assert.Equal(t, time.Second, measurement.Offset)
+}
+
+func TestConnectReappliesOffset(t *testing.T) {
+	// given
+	localTime := time.UnixMilli(1700000000000)
+	offset := -time.Second * 3
+	credentials := pkgStructs.APICredentials{}
+
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	gomock.InOrder(
+		a.EXPECT().GetServerTime().Return(localTime.Add(offset), nil),
+		a.EXPECT().SetServerTimeOffset(offset).Return(nil),
+		a.EXPECT().Connect(credentials).Return(nil),
+		a.EXPECT().SetServerTimeOffset(offset).Return(nil),
+	)
+
+	s := New(a, Config{})
+	s.now = getTestClock(localTime, 0)
+	_, err := s.Sync()
+	require.NoError(t, err)
+
+	// when
+	err = s.Connect(credentials)
+
+	// then
+	require.NoError(t, err)
+}
@@ -0,0 +1,196 @@
+package adapters
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	v1 "github.com/matrixbotio/exchange-gates-lib/internal/structs/v1"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+func OrderDataToV1(data structs.OrderData) v1.OrderData {
+	return v1.OrderData{
+		OrderID:         data.OrderID,
+		ClientOrderID:   data.ClientOrderID,
+		Status:          data.Status,
+		AwaitQty:        data.AwaitQty.InexactFloat64(),
+		FilledQty:       data.FilledQty.InexactFloat64(),
+		Price:           data.Price.InexactFloat64(),
+		Symbol:          data.Symbol,
+		Side:            data.Side,
+		CreatedTime:     data.CreatedTime,
+		UpdatedTime:     data.UpdatedTime,
+		ExchangeOrderID: data.ExchangeOrderID,
+	}
+}
+
+func OrderHistoryToV1(data structs.OrderHistory) v1.OrderHistory {
+	return v1.OrderHistory{
+		OrderData: OrderDataToV1(data.OrderData),
+		Fees:      data.Fees,
+	}
+}
+
+func CreateOrderResponseToV1(response structs.CreateOrderResponse) v1.CreateOrderResponse {
+	return v1.CreateOrderResponse{
+		OrderID:         response.OrderID,
+		ClientOrderID:   response.ClientOrderID,
+		OrigQuantity:    response.OrigQuantity.InexactFloat64(),
+		Price:           response.Price.InexactFloat64(),
+		Symbol:          response.Symbol,
+		Type:            response.Type,
+		CreatedTime:     response.CreatedTime,
+		Status:          response.Status,
+		ExchangeOrderID: response.ExchangeOrderID,
+	}
+}
+
+func BalancesToV1(balances []structs.Balance) []v1.Balance {
+	result := make([]v1.Balance, 0, len(balances))
+	for _, balance := range balances {
+		result = append(result, v1.Balance{
+			Asset:  balance.Asset,
+			Free:   balance.Free.InexactFloat64(),
+			Locked: balance.Locked.InexactFloat64(),
+		})
+	}
+	return result
+}
+
+func assetBalanceToV1(balance *structs.AssetBalance) *v1.AssetBalance {
+	if balance == nil {
+		return nil
+	}
+	return &v1.AssetBalance{
+		Ticker: balance.Ticker,
+		Free:   balance.Free.InexactFloat64(),
+		Locked: balance.Locked.InexactFloat64(),
+	}
+}
+
+func PairBalanceToV1(balance structs.PairBalance) v1.PairBalance {
+	return v1.PairBalance{
+		BaseAsset:  assetBalanceToV1(balance.BaseAsset),
+		QuoteAsset: assetBalanceToV1(balance.QuoteAsset),
+	}
+}
+
+func PairDataToV1(data structs.ExchangePairData) v1.ExchangePairData {
+	return v1.ExchangePairData{
+		ID:                 data.ID,
+		ExchangeID:         data.ExchangeID,
+		BaseAsset:          data.BaseAsset,
+		BasePrecision:      data.BasePrecision,
+		QuoteAsset:         data.QuoteAsset,
+		QuotePrecision:     data.QuotePrecision,
+		Status:             data.Status,
+		Symbol:             data.Symbol,
+		MinQty:             data.MinQty.InexactFloat64(),
+		MaxQty:             data.MaxQty.InexactFloat64(),
+		OriginalMinDeposit: data.OriginalMinDeposit.InexactFloat64(),
+		MinDeposit:         data.MinDeposit.InexactFloat64(),
+		MinPrice:           data.MinPrice.InexactFloat64(),
+		QtyStep:            data.QtyStep.InexactFloat64(),
+		PriceStep:          data.PriceStep.InexactFloat64(),
+		AllowedMargin:      data.AllowedMargin,
+		AllowedSpot:        data.AllowedSpot,
+		InUse:              data.InUse,
+	}
+}
+
+func PairsDataToV1(pairs []structs.ExchangePairData) []v1.ExchangePairData {
+	result := make([]v1.ExchangePairData, 0, len(pairs))
+	for _, pairData := range pairs {
+		result = append(result, PairDataToV1(pairData))
+	}
+	return result
+}
+
+// PairDataToV2 - the float values are converted by their shortest representation,
+// e.g. 0.1 -> "0.1"
+func PairDataToV2(data v1.ExchangePairData) structs.ExchangePairData {
+	return structs.ExchangePairData{
+		ID:                 data.ID,
+		ExchangeID:         data.ExchangeID,
+		BaseAsset:          data.BaseAsset,
+		BasePrecision:      data.BasePrecision,
+		QuoteAsset:         data.QuoteAsset,
+		QuotePrecision:     data.QuotePrecision,
+		Status:             data.Status,
+		Symbol:             data.Symbol,
+		MinQty:             decimal.NewFromFloat(data.MinQty),
+		MaxQty:             decimal.NewFromFloat(data.MaxQty),
+		OriginalMinDeposit: decimal.NewFromFloat(data.OriginalMinDeposit),
+		MinDeposit:         decimal.NewFromFloat(data.MinDeposit),
+		MinPrice:           decimal.NewFromFloat(data.MinPrice),
+		QtyStep:            decimal.NewFromFloat(data.QtyStep),
+		PriceStep:          decimal.NewFromFloat(data.PriceStep),
+		AllowedMargin:      data.AllowedMargin,
+		AllowedSpot:        data.AllowedSpot,
+		InUse:              data.InUse,
+	}
+}
+
+func AdjustedOrderToV2(order v1.BotOrderAdjusted) structs.BotOrderAdjusted {
+	return structs.BotOrderAdjusted{
+		PairSymbol:       order.PairSymbol,
+		Type:             order.Type,
+		Qty:              order.Qty,
+		Price:            order.Price,
+		Deposit:          order.Deposit,
+		ClientOrderID:    order.ClientOrderID,
+		IsMarketOrder:    order.IsMarketOrder,
+		MinQty:           decimal.NewFromFloat(order.MinQty),
+		MinQtyPassed:     order.MinQtyPassed,
+		MinDeposit:       decimal.NewFromFloat(order.MinDeposit),
+		MinDepositPassed: order.MinDepositPassed,
+	}
+}
+
+func CandleToV1(candle workers.CandleData) v1.CandleData {
+	return v1.CandleData{
+		StartTime: candle.StartTime,
+		EndTime:   candle.EndTime,
+		Interval:  candle.Interval,
+		Open:      candle.Open.InexactFloat64(),
+		Close:     candle.Close.InexactFloat64(),
+		High:      candle.High.InexactFloat64(),
+		Low:       candle.Low.InexactFloat64(),
+		Volume:    candle.Volume.InexactFloat64(),
+	}
+}
+
+func CandlesToV1(candles []workers.CandleData) []v1.CandleData {
+	result := make([]v1.CandleData, 0, len(candles))
+	for _, candle := range candles {
+		result = append(result, CandleToV1(candle))
+	}
+	return result
+}
+
+func CandleEventToV1(event workers.CandleEvent) v1.CandleEvent {
+	return v1.CandleEvent{
+		Symbol:       event.Symbol,
+		Candle:       CandleToV1(event.Candle),
+		Time:         event.Time,
+		IsFinished:   event.IsFinished,
+		BaseAsset:    event.BaseAsset,
+		QuoteAsset:   event.QuoteAsset,
+		IsBackfilled: event.IsBackfilled,
+	}
+}
+
+func TradeEventToV1(event workers.TradeEventPrivate) v1.TradeEventPrivate {
+	return v1.TradeEventPrivate{
+		ID:            event.ID,
+		Time:          event.Time,
+		ExchangeTag:   event.ExchangeTag,
+		Symbol:        event.Symbol,
+		OrderID:       event.OrderID,
+		ClientOrderID: event.ClientOrderID,
+		Price:         event.Price.InexactFloat64(),
+		Quantity:      event.Quantity.InexactFloat64(),
+		BaseAsset:     event.BaseAsset,
+		QuoteAsset:    event.QuoteAsset,
+	}
+}
@@ -0,0 +1,216 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	structs "github.com/matrixbotio/exchange-gates-lib/internal/structs/v1"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+type adapter struct {
+	adapter adapters.Adapter
+}
+
+// Wrap - v1 adapter on top of the decimal adapter.
+// The values are converted to float64 on the way out only
+func Wrap(a adapters.Adapter) Adapter {
+	return &adapter{adapter: a}
+}
+
+// Unwrap - get the decimal adapter of the v1 adapter created by Wrap
+func Unwrap(a Adapter) (adapters.Adapter, bool) {
+	wrapped, isWrapped := a.(*adapter)
+	if !isWrapped {
+		return nil, false
+	}
+	return wrapped.adapter, true
+}
+
+func (a *adapter) GetName() string {
+	return a.adapter.GetName()
+}
+
+func (a *adapter) GetTag() string {
+	return a.adapter.GetTag()
+}
+
+func (a *adapter) GetID() int {
+	return a.adapter.GetID()
+}
+
+func (a *adapter) GetPairSymbol(baseTicker string, quoteTicker string) string {
+	return a.adapter.GetPairSymbol(baseTicker, quoteTicker)
+}
+
+func (a *adapter) Connect(credentials pkgStructs.APICredentials) error {
+	return a.adapter.Connect(credentials)
+}
+
+func (a *adapter) CanTrade() (bool, error) {
+	return a.adapter.CanTrade()
+}
+
+func (a *adapter) VerifyAPIKeys(keyPublic, keySecret string) error {
+	return a.adapter.VerifyAPIKeys(keyPublic, keySecret)
+}
+
+func (a *adapter) GetAccountBalance() ([]structs.Balance, error) {
+	balances, err := a.adapter.GetAccountBalance()
+	if err != nil {
+		return nil, err
+	}
+	return BalancesToV1(balances), nil
+}
+
+func (a *adapter) GetLimits() pkgStructs.ExchangeLimits {
+	return a.adapter.GetLimits()
+}
+
+func (a *adapter) GetOrderData(pairSymbol string, orderID int64) (structs.OrderData, error) {
+	data, err := a.adapter.GetOrderData(pairSymbol, orderID)
+	if err != nil {
+		return structs.OrderData{}, err
+	}
+	return OrderDataToV1(data), nil
+}
+
+func (a *adapter) GetOrderByClientOrderID(
+	pairSymbol string,
+	clientOrderID string,
+) (structs.OrderData, error) {
+	data, err := a.adapter.GetOrderByClientOrderID(pairSymbol, clientOrderID)
+	if err != nil {
+		return structs.OrderData{}, err
+	}
+	return OrderDataToV1(data), nil
+}
+
+func (a *adapter) PlaceOrder(
+	ctx context.Context,
+	order structs.BotOrderAdjusted,
+) (structs.CreateOrderResponse, error) {
+	response, err := a.adapter.PlaceOrder(ctx, AdjustedOrderToV2(order))
+	if err != nil {
+		return structs.CreateOrderResponse{}, err
+	}
+	return CreateOrderResponseToV1(response), nil
+}
+
+func (a *adapter) GetOrderExecFee(
+	baseAssetTicker string,
+	quoteAssetTicker string,
+	orderSide consts.OrderSide,
+	orderID int64,
+) (structs.OrderFees, error) {
+	return a.adapter.GetOrderExecFee(baseAssetTicker, quoteAssetTicker, orderSide, orderID)
+}
+
+func (a *adapter) GenClientOrderID() string {
+	return a.adapter.GenClientOrderID()
+}
+
+func (a *adapter) GetHistoryOrder(
+	pairSymbol string,
+	orderID int64,
+) (structs.OrderHistory, error) {
+	data, err := a.adapter.GetHistoryOrder(pairSymbol, orderID)
+	if err != nil {
+		return structs.OrderHistory{}, err
+	}
+	return OrderHistoryToV1(data), nil
+}
+
+func (a *adapter) GetPairData(pairSymbol string) (structs.ExchangePairData, error) {
+	pairData, err := a.adapter.GetPairData(pairSymbol)
+	if err != nil {
+		return structs.ExchangePairData{}, err
+	}
+	return PairDataToV1(pairData), nil
+}
+
+func (a *adapter) GetPairLastPrice(pairSymbol string) (float64, error) {
+	price, err := a.adapter.GetPairLastPrice(pairSymbol)
+	if err != nil {
+		return 0, err
+	}
+	return price.InexactFloat64(), nil
+}
+
+func (a *adapter) CancelPairOrder(pairSymbol string, orderID int64, ctx context.Context) error {
+	return a.adapter.CancelPairOrder(pairSymbol, orderID, ctx)
+}
+
+func (a *adapter) CancelPairOrderByClientOrderID(
+	pairSymbol string,
+	clientOrderID string,
+	ctx context.Context,
+) error {
+	return a.adapter.CancelPairOrderByClientOrderID(pairSymbol, clientOrderID, ctx)
+}
+
+func (a *adapter) GetPairs() ([]structs.ExchangePairData, error) {
+	pairs, err := a.adapter.GetPairs()
+	if err != nil {
+		return nil, err
+	}
+	return PairsDataToV1(pairs), nil
+}
+
+func (a *adapter) GetPairBalance(pair structs.PairSymbolData) (structs.PairBalance, error) {
+	balance, err := a.adapter.GetPairBalance(pair)
+	if err != nil {
+		return structs.PairBalance{}, err
+	}
+	return PairBalanceToV1(balance), nil
+}
+
+func (a *adapter) SubscribeCandle(
+	pairSymbol string,
+	interval consts.Interval,
+	eventCallback func(event structs.CandleEvent),
+	errorHandler func(err error),
+) error {
+	return a.adapter.SubscribeCandle(
+		pairSymbol,
+		interval,
+		func(event workers.CandleEvent) {
+			eventCallback(CandleEventToV1(event))
+		},
+		errorHandler,
+	)
+}
+
+func (a *adapter) UnsubscribeCandle(pairSymbol string, interval consts.Interval) {
+	a.adapter.UnsubscribeCandle(pairSymbol, interval)
+}
+
+func (a *adapter) SubscribeAccountTrades(
+	eventCallback structs.TradeEventPrivateCallback,
+	errorHandler func(err error),
+) error {
+	return a.adapter.SubscribeAccountTrades(
+		func(event workers.TradeEventPrivate) {
+			eventCallback(TradeEventToV1(event))
+		},
+		errorHandler,
+	)
+}
+
+func (a *adapter) UnsubscribeAccountTrades() {
+	a.adapter.UnsubscribeAccountTrades()
+}
+
+func (a *adapter) GetCandles(
+	limit int,
+	symbol string,
+	interval consts.Interval,
+) ([]structs.CandleData, error) {
+	candles, err := a.adapter.GetCandles(limit, symbol, interval)
+	if err != nil {
+		return nil, err
+	}
+	return CandlesToV1(candles), nil
+}
@@ -0,0 +1,124 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	v1 "github.com/matrixbotio/exchange-gates-lib/internal/structs/v1"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+const testPairSymbol = "LTCUSDT"
+
+func TestWrapGetPairData(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	v2 := adapters.NewMockAdapter(ctrl)
+	v2.EXPECT().GetPairData(testPairSymbol).Return(structs.ExchangePairData{
+		Symbol:    testPairSymbol,
+		MinQty:    decimal.RequireFromString("0.001"),
+		QtyStep:   decimal.RequireFromString("0.001"),
+		PriceStep: decimal.RequireFromString("0.01"),
+	}, nil)
+
+	// when
+	pairData, err := Wrap(v2).GetPairData(testPairSymbol)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, testPairSymbol, pairData.Symbol)
+	assert.Equal(t, 0.001, pairData.MinQty)
+	assert.Equal(t, 0.001, pairData.QtyStep)
+	assert.Equal(t, 0.01, pairData.PriceStep)
+}
+
+func TestWrapPlaceOrder(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	v2 := adapters.NewMockAdapter(ctrl)
+	order := v1.BotOrderAdjusted{
+		PairSymbol: testPairSymbol,
+		Type:       consts.OrderSideBuy,
+		Qty:        "0.1",
+		Price:      "65.2",
+		MinQty:     0.01,
+	}
+
+	v2.EXPECT().PlaceOrder(gomock.Any(), gomock.Any()).DoAndReturn(func(
+		_ context.Context,
+		order structs.BotOrderAdjusted,
+	) (structs.CreateOrderResponse, error) {
+		assert.Equal(t, "0.1", order.Qty)
+		assert.Equal(t, "65.2", order.Price)
+		assert.Equal(t, "0.01", order.MinQty.String())
+		return structs.CreateOrderResponse{
+			OrderID:      1,
+			OrigQuantity: decimal.RequireFromString(order.Qty),
+			Price:        decimal.RequireFromString(order.Price),
+			Symbol:       order.PairSymbol,
+		}, nil
+	})
+
+	// when
+	response, err := Wrap(v2).PlaceOrder(context.Background(), order)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), response.OrderID)
+	assert.Equal(t, 0.1, response.OrigQuantity)
+	assert.Equal(t, 65.2, response.Price)
+}
+
+func TestWrapSubscribeAccountTrades(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	v2 := adapters.NewMockAdapter(ctrl)
+	v2.EXPECT().SubscribeAccountTrades(gomock.Any(), gomock.Any()).DoAndReturn(func(
+		eventCallback workers.TradeEventPrivateCallback,
+		_ func(err error),
+	) error {
+		eventCallback(workers.TradeEventPrivate{
+			Symbol:   testPairSymbol,
+			Price:    decimal.RequireFromString("65.2"),
+			Quantity: decimal.RequireFromString("0.1"),
+		})
+		return nil
+	})
+
+	var event v1.TradeEventPrivate
+
+	// when
+	err := Wrap(v2).SubscribeAccountTrades(
+		func(e v1.TradeEventPrivate) { event = e },
+		func(err error) {},
+	)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, testPairSymbol, event.Symbol)
+	assert.Equal(t, 65.2, event.Price)
+	assert.Equal(t, 0.1, event.Quantity)
+}
+
+func TestUnwrap(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	v2 := adapters.NewMockAdapter(ctrl)
+
+	// when
+	unwrapped, isWrapped := Unwrap(Wrap(v2))
+	_, isMockWrapped := Unwrap(NewMockAdapter(ctrl))
+
+	// then
+	assert.True(t, isWrapped)
+	assert.Same(t, v2, unwrapped)
+	assert.False(t, isMockWrapped)
+}
@@ -0,0 +1,460 @@
+package candlestore
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+// ErrCandlesGap - the store lags behind the exchange more than the requested candles
+var ErrCandlesGap = errors.New("candles gap")
+
+const (
+	segmentExt        = ".jsonl"
+	segmentTimeFormat = "2006-01"
+	tmpFileSuffix     = ".tmp"
+)
+
+// Store - persistent candles storage.
+// Candles are kept in append-only JSON lines files:
+// <root>/<exchange tag>/<symbol>/<interval>/<YYYY-MM>.jsonl,
+// one segment per month of the candle start time.
+// When the candle is stored several times the last record is used
+type Store struct {
+	rootDir string
+	now     func() time.Time
+
+	mu sync.RWMutex
+}
+
+func New(rootDir string) (*Store, error) {
+	if err := os.MkdirAll(rootDir, 0o755); err != nil {
+		return nil, fmt.Errorf("create store dir: %w", err)
+	}
+
+	return &Store{
+		rootDir: rootDir,
+		now:     time.Now,
+	}, nil
+}
+
+// Append saves candles. Existing candles with the same start time are replaced
+func (s *Store) Append(
+	exchangeTag string,
+	symbol string,
+	interval consts.Interval,
+	candles []workers.CandleData,
+) error {
+	if len(candles) == 0 {
+		return nil
+	}
+
+	segments := map[string]*bytes.Buffer{} // segment name -> records
+	for _, candle := range candles {
+		if candle.StartTime == 0 {
+			return errors.New("candle start time is not set")
+		}
+
+		record, err := json.Marshal(candle)
+		if err != nil {
+			return fmt.Errorf("encode candle: %w", err)
+		}
+
+		name := getSegmentName(candle.StartTime)
+		if _, isExists := segments[name]; !isExists {
+			segments[name] = &bytes.Buffer{}
+		}
+		segments[name].Write(record)
+		segments[name].WriteByte('\n')
+	}
+
+	dir, err := s.getDir(exchangeTag, symbol, interval)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create candles dir: %w", err)
+	}
+
+	for name, records := range segments {
+		if err := appendToFile(filepath.Join(dir, name), records.Bytes()); err != nil {
+			return fmt.Errorf("write segment %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Query returns candles with start time in [from, to) sorted by start time
+func (s *Store) Query(
+	exchangeTag string,
+	symbol string,
+	interval consts.Interval,
+	from time.Time,
+	to time.Time,
+) ([]workers.CandleData, error) {
+	dir, err := s.getDir(exchangeTag, symbol, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	segments, err := getSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fromMs, toMs := from.UnixMilli(), to.UnixMilli()
+	fromSegment, toSegment := getSegmentName(fromMs), getSegmentName(toMs)
+
+	var result []workers.CandleData
+	for _, name := range segments {
+		// segment names are sorted by time
+		if name < fromSegment {
+			continue
+		}
+		if name > toSegment {
+			break
+		}
+
+		candles, err := readSegment(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("read segment %s: %w", name, err)
+		}
+
+		for _, candle := range candles {
+			if candle.StartTime >= fromMs && candle.StartTime < toMs {
+				result = append(result, candle)
+			}
+		}
+	}
+	return result, nil
+}
+
+// GetLastCandle returns the stored candle with the latest start time
+func (s *Store) GetLastCandle(
+	exchangeTag string,
+	symbol string,
+	interval consts.Interval,
+) (workers.CandleData, bool, error) {
+	dir, err := s.getDir(exchangeTag, symbol, interval)
+	if err != nil {
+		return workers.CandleData{}, false, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	segments, err := getSegments(dir)
+	if err != nil {
+		return workers.CandleData{}, false, err
+	}
+
+	for i := len(segments) - 1; i >= 0; i-- {
+		candles, err := readSegment(filepath.Join(dir, segments[i]))
+		if err != nil {
+			return workers.CandleData{}, false, fmt.Errorf("read segment %s: %w", segments[i], err)
+		}
+		if len(candles) > 0 {
+			return candles[len(candles)-1], true, nil
+		}
+	}
+	return workers.CandleData{}, false, nil
+}
+
+// Compact rewrites segments without the replaced candle records
+func (s *Store) Compact(exchangeTag string, symbol string, interval consts.Interval) error {
+	dir, err := s.getDir(exchangeTag, symbol, interval)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments, err := getSegments(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range segments {
+		segmentPath := filepath.Join(dir, name)
+		candles, err := readSegment(segmentPath)
+		if err != nil {
+			return fmt.Errorf("read segment %s: %w", name, err)
+		}
+
+		var records bytes.Buffer
+		for _, candle := range candles {
+			record, err := json.Marshal(candle)
+			if err != nil {
+				return fmt.Errorf("encode candle: %w", err)
+			}
+			records.Write(record)
+			records.WriteByte('\n')
+		}
+
+		tmpPath := segmentPath + tmpFileSuffix
+		if err := os.WriteFile(tmpPath, records.Bytes(), 0o644); err != nil {
+			return fmt.Errorf("write segment %s: %w", name, err)
+		}
+		if err := os.Rename(tmpPath, segmentPath); err != nil {
+			return fmt.Errorf("replace segment %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Sync loads the latest finished candles from the exchange
+// & saves candles newer than the last stored one. Returns the number of saved candles.
+// ErrCandlesGap is returned & nothing is saved when the loaded candles
+// don't reach the last stored one, the limit should be increased
+func (s *Store) Sync(
+	adapter adapters.Adapter,
+	symbol string,
+	interval consts.Interval,
+	limit int,
+) (int, error) {
+	if err := consts.ValidateInterval(string(interval)); err != nil {
+		return 0, err
+	}
+
+	exchangeTag := adapter.GetTag()
+	lastCandle, isExists, err := s.GetLastCandle(exchangeTag, symbol, interval)
+	if err != nil {
+		return 0, fmt.Errorf("get last candle: %w", err)
+	}
+
+	candles, err := adapter.GetCandles(limit, symbol, interval)
+	if err != nil {
+		return 0, fmt.Errorf("get candles: %w", err)
+	}
+
+	if isExists {
+		if err := checkCandlesGap(interval, lastCandle, candles); err != nil {
+			return 0, err
+		}
+	}
+
+	now := s.now()
+	var newCandles []workers.CandleData
+	for _, candle := range candles {
+		if isExists && candle.StartTime <= lastCandle.StartTime {
+			continue
+		}
+
+		nextCandleStart := workers.GetNextCandleStartTime(
+			interval, time.UnixMilli(candle.StartTime),
+		)
+		if nextCandleStart.After(now) {
+			// candle is not finished yet
+			continue
+		}
+		newCandles = append(newCandles, candle)
+	}
+
+	sort.Slice(newCandles, func(i, j int) bool {
+		return newCandles[i].StartTime < newCandles[j].StartTime
+	})
+
+	if err := s.Append(exchangeTag, symbol, interval, newCandles); err != nil {
+		return 0, fmt.Errorf("save candles: %w", err)
+	}
+	return len(newCandles), nil
+}
+
+// HandleCandleEvent saves the finished candle from the live subscription.
+// Unfinished candle events are ignored
+func (s *Store) HandleCandleEvent(exchangeTag string, event workers.CandleEvent) error {
+	if !event.IsFinished {
+		return nil
+	}
+
+	return s.Append(
+		exchangeTag,
+		event.Symbol,
+		event.Candle.Interval,
+		[]workers.CandleData{event.Candle},
+	)
+}
+
+// WrapCandleCallback returns candle subscription callback
+// that saves finished candles before calling the event callback
+func (s *Store) WrapCandleCallback(
+	exchangeTag string,
+	eventCallback func(event workers.CandleEvent),
+	errorHandler func(err error),
+) func(event workers.CandleEvent) {
+	return func(event workers.CandleEvent) {
+		if err := s.HandleCandleEvent(exchangeTag, event); err != nil {
+			errorHandler(fmt.Errorf("save candle: %w", err))
+		}
+		eventCallback(event)
+	}
+}
+
+// checkCandlesGap - the oldest loaded candle must not be newer
+// than the candle next to the last stored one
+func checkCandlesGap(
+	interval consts.Interval,
+	lastCandle workers.CandleData,
+	candles []workers.CandleData,
+) error {
+	if len(candles) == 0 {
+		return nil
+	}
+
+	oldestStart := candles[0].StartTime
+	for _, candle := range candles[1:] {
+		oldestStart = min(oldestStart, candle.StartTime)
+	}
+
+	expectedStart := workers.GetNextCandleStartTime(
+		interval, time.UnixMilli(lastCandle.StartTime),
+	)
+	if oldestStart > expectedStart.UnixMilli() {
+		return fmt.Errorf(
+			"%w: the oldest loaded candle starts at %s, expected %s",
+			ErrCandlesGap,
+			time.UnixMilli(oldestStart).UTC().Format(time.RFC3339),
+			expectedStart.UTC().Format(time.RFC3339),
+		)
+	}
+	return nil
+}
+
+func (s *Store) getDir(
+	exchangeTag string,
+	symbol string,
+	interval consts.Interval,
+) (string, error) {
+	if err := checkDirName(exchangeTag); err != nil {
+		return "", fmt.Errorf("exchange tag: %w", err)
+	}
+	if err := checkDirName(symbol); err != nil {
+		return "", fmt.Errorf("symbol: %w", err)
+	}
+	if err := checkDirName(string(interval)); err != nil {
+		return "", fmt.Errorf("interval: %w", err)
+	}
+
+	return filepath.Join(
+		s.rootDir,
+		url.PathEscape(exchangeTag),
+		url.PathEscape(symbol),
+		getIntervalDirName(interval),
+	), nil
+}
+
+// checkDirName - the escaped name must not point outside the store dir.
+// url.PathEscape keeps the dots
+func checkDirName(name string) error {
+	switch name {
+	case "":
+		return errors.New("name is empty")
+	case ".", "..":
+		return fmt.Errorf("invalid name %q", name)
+	}
+	return nil
+}
+
+// getIntervalDirName - "1m" & "1M" dirs are the same on case-insensitive file systems
+func getIntervalDirName(interval consts.Interval) string {
+	if interval == consts.Interval1month {
+		return "1mo"
+	}
+	return url.PathEscape(string(interval))
+}
+
+func getSegmentName(startTimeMs int64) string {
+	return time.UnixMilli(startTimeMs).UTC().Format(segmentTimeFormat) + segmentExt
+}
+
+// getSegments returns sorted segment file names
+func getSegments(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read candles dir: %w", err)
+	}
+
+	var segments []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), segmentExt) {
+			segments = append(segments, entry.Name())
+		}
+	}
+	sort.Strings(segments)
+	return segments, nil
+}
+
+// readSegment returns unique segment candles sorted by start time
+func readSegment(segmentPath string) ([]workers.CandleData, error) {
+	data, err := os.ReadFile(segmentPath)
+	if err != nil {
+		return nil, err
+	}
+
+	candles := map[int64]workers.CandleData{}
+	for _, line := range bytes.Split(data, []byte{'\n'}) {
+		if len(line) == 0 {
+			continue
+		}
+
+		var candle workers.CandleData
+		if err := json.Unmarshal(line, &candle); err != nil {
+			// the record was not written completely, e.g. the process was killed
+			continue
+		}
+		candles[candle.StartTime] = candle
+	}
+
+	result := make([]workers.CandleData, 0, len(candles))
+	for _, candle := range candles {
+		result = append(result, candle)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartTime < result[j].StartTime
+	})
+	return result, nil
+}
+
+func appendToFile(filePath string, data []byte) error {
+	f, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return err
+	}
+
+	// don't glue the new record to the incomplete one
+	if info, err := f.Stat(); err == nil && info.Size() > 0 {
+		lastByte := make([]byte, 1)
+		if _, err := f.ReadAt(lastByte, info.Size()-1); err == nil && lastByte[0] != '\n' {
+			data = append([]byte{'\n'}, data...)
+		}
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
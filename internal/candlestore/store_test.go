@@ -0,0 +1,265 @@
+package candlestore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+const (
+	testExchangeTag = "binance-spot"
+	testSymbol      = "LTCUSDT"
+)
+
+func getTestCandle(startTime time.Time, price int64) workers.CandleData {
+	return workers.CandleData{
+		StartTime: startTime.UnixMilli(),
+		EndTime:   startTime.Add(time.Hour).UnixMilli() - 1,
+		Interval:  consts.Interval1hour,
+		Open:      decimal.NewFromInt(price),
+		Close:     decimal.NewFromInt(price + 1),
+		High:      decimal.NewFromInt(price + 2),
+		Low:       decimal.NewFromInt(price - 1),
+		Volume:    decimal.NewFromInt(10),
+	}
+}
+
+func TestAppendQuery(t *testing.T) {
+	// given
+	s, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	start := time.Date(2024, 4, 30, 22, 0, 0, 0, time.UTC)
+	require.NoError(t, s.Append(testExchangeTag, testSymbol, consts.Interval1hour,
+		[]workers.CandleData{
+			getTestCandle(start, 100),
+			getTestCandle(start.Add(time.Hour), 101),
+			getTestCandle(start.Add(time.Hour*2), 102),
+			getTestCandle(start.Add(time.Hour*3), 103),
+		},
+	))
+
+	// when
+	require.NoError(t, s.Append(testExchangeTag, testSymbol, consts.Interval1hour,
+		[]workers.CandleData{getTestCandle(start.Add(time.Hour*2), 200)},
+	))
+	candles, err := s.Query(
+		testExchangeTag, testSymbol, consts.Interval1hour,
+		start.Add(time.Hour), start.Add(time.Hour*3),
+	)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, candles, 2)
+	assert.Equal(t, "101", candles[0].Open.String())
+	assert.Equal(t, "200", candles[1].Open.String())
+}
+
+func TestQueryEmpty(t *testing.T) {
+	// given
+	s, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	// when
+	candles, err := s.Query(
+		testExchangeTag, testSymbol, consts.Interval1hour,
+		time.Unix(0, 0), time.Now(),
+	)
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, candles)
+}
+
+func TestIncompleteRecord(t *testing.T) {
+	// given
+	rootDir := t.TempDir()
+	s, err := New(rootDir)
+	require.NoError(t, err)
+
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, s.Append(testExchangeTag, testSymbol, consts.Interval1hour,
+		[]workers.CandleData{getTestCandle(start, 100)},
+	))
+
+	segmentPath := filepath.Join(
+		rootDir, testExchangeTag, testSymbol, string(consts.Interval1hour), "2024-05.jsonl",
+	)
+	f, err := os.OpenFile(segmentPath, os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"startTime":17145`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// when
+	require.NoError(t, s.Append(testExchangeTag, testSymbol, consts.Interval1hour,
+		[]workers.CandleData{getTestCandle(start.Add(time.Hour), 101)},
+	))
+	require.NoError(t, s.Compact(testExchangeTag, testSymbol, consts.Interval1hour))
+	lastCandle, isExists, err := s.GetLastCandle(
+		testExchangeTag, testSymbol, consts.Interval1hour,
+	)
+
+	// then
+	require.NoError(t, err)
+	require.True(t, isExists)
+	assert.Equal(t, "101", lastCandle.Open.String())
+
+	data, err := os.ReadFile(segmentPath)
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(data), "\n"))
+}
+
+func TestSync(t *testing.T) {
+	// given
+	s, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return start.Add(time.Hour*3 + time.Minute) }
+	require.NoError(t, s.Append(testExchangeTag, testSymbol, consts.Interval1hour,
+		[]workers.CandleData{getTestCandle(start, 100)},
+	))
+
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetTag().Return(testExchangeTag)
+	a.EXPECT().GetCandles(10, testSymbol, consts.Interval1hour).Return(
+		[]workers.CandleData{
+			getTestCandle(start.Add(time.Hour*3), 103), // in progress
+			getTestCandle(start.Add(time.Hour*2), 102),
+			getTestCandle(start.Add(time.Hour), 101),
+			getTestCandle(start, 100),
+		}, nil,
+	)
+
+	// when
+	savedCount, err := s.Sync(a, testSymbol, consts.Interval1hour, 10)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 2, savedCount)
+
+	candles, err := s.Query(
+		testExchangeTag, testSymbol, consts.Interval1hour,
+		start, start.Add(time.Hour*24),
+	)
+	require.NoError(t, err)
+	require.Len(t, candles, 3)
+	assert.Equal(t, start.Add(time.Hour*2).UnixMilli(), candles[2].StartTime)
+}
+
+func TestSyncError(t *testing.T) {
+	// given
+	s, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetTag().Return(testExchangeTag)
+	a.EXPECT().GetCandles(10, testSymbol, consts.Interval1hour).
+		Return(nil, errors.New("exchange unavailable"))
+
+	// when
+	_, err = s.Sync(a, testSymbol, consts.Interval1hour, 10)
+
+	// then
+	require.ErrorContains(t, err, "exchange unavailable")
+}
+
+func TestWrapCandleCallback(t *testing.T) {
+	// given
+	s, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	var eventsCount int
+	callback := s.WrapCandleCallback(
+		testExchangeTag,
+		func(workers.CandleEvent) { eventsCount++ },
+		func(err error) { t.Fatal(err) },
+	)
+
+	// when
+	callback(workers.CandleEvent{Symbol: testSymbol, Candle: getTestCandle(start, 100)})
+	callback(workers.CandleEvent{
+		Symbol:     testSymbol,
+		Candle:     getTestCandle(start, 101),
+		IsFinished: true,
+	})
+	callback(workers.CandleEvent{Symbol: testSymbol, Candle: getTestCandle(start.Add(time.Hour), 102)})
+
+	// then
+	assert.Equal(t, 3, eventsCount)
+
+	candles, err := s.Query(
+		testExchangeTag, testSymbol, consts.Interval1hour,
+		start, start.Add(time.Hour*24),
+	)
+	require.NoError(t, err)
+	require.Len(t, candles, 1)
+	assert.Equal(t, "101", candles[0].Open.String())
+}
+
+func TestSyncGap(t *testing.T) {
+	// given
+	s, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return start.Add(time.Hour*5 + time.Minute) }
+	require.NoError(t, s.Append(testExchangeTag, testSymbol, consts.Interval1hour,
+		[]workers.CandleData{getTestCandle(start, 100)},
+	))
+
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetTag().Return(testExchangeTag)
+	// the candle at start + 1h is not loaded
+	a.EXPECT().GetCandles(3, testSymbol, consts.Interval1hour).Return(
+		[]workers.CandleData{
+			getTestCandle(start.Add(time.Hour*4), 104),
+			getTestCandle(start.Add(time.Hour*3), 103),
+			getTestCandle(start.Add(time.Hour*2), 102),
+		}, nil,
+	)
+
+	// when
+	_, err = s.Sync(a, testSymbol, consts.Interval1hour, 3)
+
+	// then
+	require.ErrorIs(t, err, ErrCandlesGap)
+
+	candles, err := s.Query(
+		testExchangeTag, testSymbol, consts.Interval1hour,
+		start, start.Add(time.Hour*24),
+	)
+	require.NoError(t, err)
+	assert.Len(t, candles, 1)
+}
+
+func TestInvalidDirName(t *testing.T) {
+	// given
+	s, err := New(t.TempDir())
+	require.NoError(t, err)
+	candles := []workers.CandleData{getTestCandle(time.Now(), 100)}
+
+	for _, name := range []string{"", ".", ".."} {
+		// when
+		tagErr := s.Append(name, testSymbol, consts.Interval1hour, candles)
+		symbolErr := s.Append(testExchangeTag, name, consts.Interval1hour, candles)
+
+		// then
+		require.ErrorContains(t, tagErr, "exchange tag")
+		require.ErrorContains(t, symbolErr, "symbol")
+	}
+}
@@ -0,0 +1,18 @@
+package consts
+
+// AccountType - exchange account (wallet) type
+type AccountType string
+
+const (
+	// AccountTypeSpot - spot trading account.
+	// For Bybit it's the classic spot account or the unified trading account
+	AccountTypeSpot AccountType = "spot"
+	// AccountTypeFunding - funding account: deposits, withdrawals, P2P
+	AccountTypeFunding AccountType = "funding"
+	// AccountTypeUnified - Bybit unified trading account (UTA)
+	AccountTypeUnified AccountType = "unified"
+	// AccountTypeMargin - cross margin account
+	AccountTypeMargin AccountType = "margin"
+	// AccountTypeFutures - USDT margined perpetual futures account
+	AccountTypeFutures AccountType = "futures"
+)
@@ -0,0 +1,20 @@
+package consts
+
+// APIKeyPermission - API key permission granted on the exchange
+type APIKeyPermission string
+
+const (
+	APIKeyPermissionSpotTrade    APIKeyPermission = "spotTrade"
+	APIKeyPermissionMarginTrade  APIKeyPermission = "marginTrade"
+	APIKeyPermissionFuturesTrade APIKeyPermission = "futuresTrade"
+	APIKeyPermissionWithdraw     APIKeyPermission = "withdraw"
+)
+
+func GetAPIKeyPermissions() []APIKeyPermission {
+	return []APIKeyPermission{
+		APIKeyPermissionSpotTrade,
+		APIKeyPermissionMarginTrade,
+		APIKeyPermissionFuturesTrade,
+		APIKeyPermissionWithdraw,
+	}
+}
@@ -0,0 +1,38 @@
+package consts
+
+// OrderType - order type supported by the exchange
+type OrderType string
+
+const (
+	OrderTypeLimit  OrderType = "limit"
+	OrderTypeMarket OrderType = "market"
+)
+
+// TimeInForce - how long the limit order remains active
+type TimeInForce string
+
+const (
+	// TimeInForceGTC - good till cancelled
+	TimeInForceGTC TimeInForce = "GTC"
+)
+
+// StreamType - websocket stream available for the subscription
+type StreamType string
+
+const (
+	StreamCandles       StreamType = "candles"
+	StreamPublicTrades  StreamType = "publicTrades"
+	StreamAccountTrades StreamType = "accountTrades"
+	StreamPositions     StreamType = "positions"
+)
+
+// OrderIDFormat - the exchange order ID format
+type OrderIDFormat string
+
+const (
+	// OrderIDFormatNumeric - the exchange order ID is int64
+	OrderIDFormatNumeric OrderIDFormat = "numeric"
+	// OrderIDFormatAlias - the exchange order ID is a string,
+	// the numeric alias is used as the order ID
+	OrderIDFormatAlias OrderIDFormat = "alias"
+)
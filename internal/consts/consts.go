@@ -49,6 +49,11 @@ const (
 	ExchangeIDbybitSpot   = 2
 	ExchangeIDbingx       = 3
 	ExchangeIDgateSpot    = 4
+	ExchangeIDbinanceUSDM = 5
+	ExchangeIDbybitLinear = 6
+	ExchangeIDokx         = 7
+	ExchangeIDkucoin      = 8
+	ExchangeIDbitget      = 9
 )
 
 const (
@@ -56,6 +61,9 @@ const (
 	CheckOrdersTimeoutBybit   = time.Second * 15
 	CheckOrdersTimeoutBings   = time.Second * 40
 	CheckOrdersTimeoutGate    = time.Second * 15
+	CheckOrdersTimeoutOKX     = time.Second * 15
+	CheckOrdersTimeoutKuCoin  = time.Second * 20
+	CheckOrdersTimeoutBitget  = time.Second * 20
 )
 
 type OrderSide string
@@ -76,3 +84,7 @@ const (
 const BingXAdapterTag = "bingx-spot"
 const BinanceAdapterTag = "binance-spot"
 const GateAdapterTag = "gate-spot"
+const BinanceUSDMAdapterTag = "binance-usdm"
+const OKXAdapterTag = "okx-spot"
+const KuCoinAdapterTag = "kucoin-spot"
+const BitgetAdapterTag = "bitget-spot"
@@ -0,0 +1,9 @@
+package consts
+
+// PositionSide - futures position side
+type PositionSide string
+
+const (
+	PositionSideLong  PositionSide = "long"
+	PositionSideShort PositionSide = "short"
+)
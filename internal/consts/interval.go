@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 )
 
 type Interval string
@@ -14,29 +15,77 @@ var errIntervalNotSet = errors.New("not set")
 
 const (
 	Interval1min   Interval = "1m"
+	Interval3min   Interval = "3m"
 	Interval5min   Interval = "5m"
 	Interval15min  Interval = "15m"
 	Interval30min  Interval = "30m"
 	Interval1hour  Interval = "1h"
+	Interval2hour  Interval = "2h"
 	Interval4hour  Interval = "4h"
 	Interval6hour  Interval = "6h"
+	Interval8hour  Interval = "8h"
 	Interval12hour Interval = "12h"
 	Interval1day   Interval = "1d"
+	Interval3day   Interval = "3d"
+	Interval1week  Interval = "1w"
+	Interval1month Interval = "1M"
 )
 
 var allIntervals = GetIntervals()
 
+// month duration is not fixed
+var intervalDurations = map[Interval]time.Duration{
+	Interval1min:   time.Minute,
+	Interval3min:   time.Minute * 3,
+	Interval5min:   time.Minute * 5,
+	Interval15min:  time.Minute * 15,
+	Interval30min:  time.Minute * 30,
+	Interval1hour:  time.Hour,
+	Interval2hour:  time.Hour * 2,
+	Interval4hour:  time.Hour * 4,
+	Interval6hour:  time.Hour * 6,
+	Interval8hour:  time.Hour * 8,
+	Interval12hour: time.Hour * 12,
+	Interval1day:   time.Hour * 24,
+	Interval3day:   time.Hour * 24 * 3,
+	Interval1week:  time.Hour * 24 * 7,
+}
+
+// GetIntervalDuration returns false for intervals without fixed duration
+func GetIntervalDuration(interval Interval) (time.Duration, bool) {
+	duration, isExists := intervalDurations[interval]
+	return duration, isExists
+}
+
 func GetIntervals() []Interval {
 	return []Interval{
 		Interval1min,
+		Interval3min,
 		Interval5min,
 		Interval15min,
 		Interval30min,
 		Interval1hour,
+		Interval2hour,
 		Interval4hour,
 		Interval6hour,
+		Interval8hour,
+		Interval12hour,
 		Interval1day,
+		Interval3day,
+		Interval1week,
+		Interval1month,
+	}
+}
+
+// FilterIntervals returns intervals from the catalog that are present in the set
+func FilterIntervals(isSupported func(interval Interval) bool) []Interval {
+	var result []Interval
+	for _, interval := range GetIntervals() {
+		if isSupported(interval) {
+			result = append(result, interval)
+		}
 	}
+	return result
 }
 
 func ValidateInterval(interval string) error {
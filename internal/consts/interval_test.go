@@ -38,3 +38,31 @@ func TestValidateIntervalSuccess(t *testing.T) {
 	// then
 	require.NoError(t, err)
 }
+
+func TestValidateInterval12h(t *testing.T) {
+	// given
+	interval := "12h"
+
+	// when
+	err := ValidateInterval(interval)
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestFilterIntervals(t *testing.T) {
+	// given
+	supported := map[Interval]struct{}{
+		Interval1month: {},
+		Interval1min:   {},
+	}
+
+	// when
+	result := FilterIntervals(func(interval Interval) bool {
+		_, isExists := supported[interval]
+		return isExists
+	})
+
+	// then
+	require.Equal(t, []Interval{Interval1min, Interval1month}, result)
+}
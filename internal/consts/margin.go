@@ -0,0 +1,35 @@
+package consts
+
+// MarginMode - margin account mode
+type MarginMode string
+
+const (
+	// MarginModeCross - cross margin: the whole account balance is collateral
+	MarginModeCross MarginMode = "cross"
+	// MarginModeIsolated - isolated margin: collateral is isolated per pair
+	MarginModeIsolated MarginMode = "isolated"
+)
+
+// MarginSideEffect - margin order side effect
+type MarginSideEffect string
+
+const (
+	// MarginSideEffectNone - trade the available margin balance only
+	MarginSideEffectNone MarginSideEffect = "none"
+	// MarginSideEffectAutoBorrow - borrow the missing amount on order placement
+	MarginSideEffectAutoBorrow MarginSideEffect = "auto-borrow"
+	// MarginSideEffectAutoRepay - repay the debt with the order execution result
+	MarginSideEffectAutoRepay MarginSideEffect = "auto-repay"
+)
+
+// MarginRisk - margin account liquidation risk
+type MarginRisk string
+
+const (
+	// MarginRiskLow - margin level is above the margin call level
+	MarginRiskLow MarginRisk = "low"
+	// MarginRiskMarginCall - margin level reached the margin call level
+	MarginRiskMarginCall MarginRisk = "margin-call"
+	// MarginRiskLiquidation - margin level reached the liquidation level
+	MarginRiskLiquidation MarginRisk = "liquidation"
+)
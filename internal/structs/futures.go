@@ -0,0 +1,36 @@
+package structs
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+// Position - futures position
+type Position struct {
+	Symbol           string              `json:"symbol"`
+	Side             consts.PositionSide `json:"side"`
+	Size             decimal.Decimal     `json:"size"` // base asset qty, always positive
+	EntryPrice       decimal.Decimal     `json:"entryPrice"`
+	MarkPrice        decimal.Decimal     `json:"markPrice"`
+	LiquidationPrice decimal.Decimal     `json:"liquidationPrice"` // zero when unknown
+	UnrealizedPnL    decimal.Decimal     `json:"unrealizedPnL"`
+	Leverage         int                 `json:"leverage"`
+	MarginMode       consts.MarginMode   `json:"marginMode"`
+	UpdatedTime      int64               `json:"updatedTime"` // ms
+}
+
+// FundingInfo - perpetual contract mark price & funding rate
+type FundingInfo struct {
+	Symbol          string          `json:"symbol"`
+	MarkPrice       decimal.Decimal `json:"markPrice"`
+	IndexPrice      decimal.Decimal `json:"indexPrice"`
+	FundingRate     decimal.Decimal `json:"fundingRate"`
+	NextFundingTime int64           `json:"nextFundingTime"` // ms
+}
+
+// FuturesOrderParams - futures order placement params
+type FuturesOrderParams struct {
+	// ReduceOnly - the order can only reduce the position
+	ReduceOnly bool
+}
@@ -0,0 +1,53 @@
+package structs
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+// MarginBalance - margin account asset balance
+type MarginBalance struct {
+	Asset    string          `json:"asset"`
+	Free     decimal.Decimal `json:"free"`
+	Locked   decimal.Decimal `json:"locked"`
+	Borrowed decimal.Decimal `json:"borrowed"`
+	Interest decimal.Decimal `json:"interest"`
+	NetAsset decimal.Decimal `json:"netAsset"` // free + locked - borrowed - interest
+}
+
+// MarginAccount - margin account balances & risk data
+type MarginAccount struct {
+	Mode       consts.MarginMode `json:"mode"`
+	PairSymbol string            `json:"pairSymbol"` // isolated margin only
+	Balances   []MarginBalance   `json:"balances"`
+
+	// MarginLevel - total assets / total liabilities
+	MarginLevel decimal.Decimal `json:"marginLevel"`
+	// LiquidationPrice - isolated margin only, zero when unknown
+	LiquidationPrice decimal.Decimal   `json:"liquidationPrice"`
+	Risk             consts.MarginRisk `json:"risk"`
+}
+
+// MarginLoanTask - borrow or repay task
+type MarginLoanTask struct {
+	Mode       consts.MarginMode
+	PairSymbol string // required for isolated margin
+	Asset      string
+	Amount     decimal.Decimal
+}
+
+// MarginLoanResult - borrow or repay result
+type MarginLoanResult struct {
+	ID         string            `json:"id"`
+	Mode       consts.MarginMode `json:"mode"`
+	PairSymbol string            `json:"pairSymbol"`
+	Asset      string            `json:"asset"`
+	Amount     decimal.Decimal   `json:"amount"`
+}
+
+// MarginOrderParams - margin order placement params
+type MarginOrderParams struct {
+	Mode       consts.MarginMode
+	SideEffect consts.MarginSideEffect
+}
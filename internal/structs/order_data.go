@@ -1,19 +1,27 @@
 package structs
 
-import "github.com/matrixbotio/exchange-gates-lib/internal/consts"
+import (
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/shopspring/decimal"
+)
 
 // OrderData - the result of checking the data of the placed order
 type OrderData struct {
 	OrderID       int64              `json:"orderID"`
 	ClientOrderID string             `json:"clientOrderID"`
 	Status        consts.OrderStatus `json:"status"`      // used in bot.getOrderData
-	AwaitQty      float64            `json:"originalQty"` // initial order qty
-	FilledQty     float64            `json:"filledQty"`   // event executed qty
-	Price         float64            `json:"price"`
+	AwaitQty      decimal.Decimal    `json:"originalQty"` // initial order qty
+	FilledQty     decimal.Decimal    `json:"filledQty"`   // event executed qty
+	Price         decimal.Decimal    `json:"price"`
 	Symbol        string             `json:"symbol"`
 	Side          consts.OrderSide   `json:"type"`        // "buy" or "sell"
 	CreatedTime   int64              `json:"createdTime"` // unix ms
 	UpdatedTime   int64              `json:"updatedTime"` // unix ms
+
+	// optional
+	// ExchangeOrderID - non-numeric exchange order ID, e.g. KuCoin.
+	// OrderID is its numeric alias then
+	ExchangeOrderID string `json:"exchangeOrderID,omitempty"`
 }
 
 type OrderHistory struct {
@@ -34,13 +42,38 @@ func (data OrderData) IsExpired() bool {
 }
 
 func (data OrderData) IsPartiallyFilled() bool {
-	return data.FilledQty < data.AwaitQty && data.FilledQty > 0
+	return data.FilledQty.LessThan(data.AwaitQty) && data.FilledQty.IsPositive()
 }
 
 func (data OrderData) IsFullFilled() bool {
-	return data.FilledQty == data.AwaitQty
+	return data.FilledQty.Equal(data.AwaitQty)
 }
 
 func (data OrderData) IsPartiallyOrFullFilled() bool {
 	return data.IsPartiallyFilled() || data.IsFullFilled()
 }
+
+// AccountTrade - account order fill
+type AccountTrade struct {
+	ID            string           `json:"id"`
+	OrderID       int64            `json:"orderID"`
+	ClientOrderID string           `json:"clientOrderID"`
+	Symbol        string           `json:"symbol"`
+	Side          consts.OrderSide `json:"side"`
+	Price         decimal.Decimal  `json:"price"`
+	Qty           decimal.Decimal  `json:"qty"`
+	Fee           decimal.Decimal  `json:"fee"`
+	FeeAsset      string           `json:"feeAsset"`
+	IsMaker       bool             `json:"isMaker"`
+	Time          int64            `json:"time"` // unix ms
+
+	// Commissions - all the trade fees, set by the exchanges charging several
+	// fees per trade, e.g. Gate fee & GT deduction. Fee & FeeAsset are the first of them
+	Commissions []Commission `json:"commissions,omitempty"`
+	// Points - exchange points spent on the fee, e.g. Gate POINT.
+	// Points have no market price & are not included into Commissions
+	Points decimal.Decimal `json:"points"`
+
+	// optional
+	ExchangeOrderID string `json:"exchangeOrderID,omitempty"` // non-numeric exchange order ID
+}
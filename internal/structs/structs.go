@@ -21,10 +21,10 @@ type BotOrderAdjusted struct {
 	IsMarketOrder bool   `json:"isMarket"`
 
 	// calculated
-	MinQty           float64 `json:"minQty"`
-	MinQtyPassed     bool    `json:"minQtyPassed"`
-	MinDeposit       float64 `json:"minDeposit"`
-	MinDepositPassed bool    `json:"minDepositPassed"`
+	MinQty           decimal.Decimal `json:"minQty"`
+	MinQtyPassed     bool            `json:"minQtyPassed"`
+	MinDeposit       decimal.Decimal `json:"minDeposit"`
+	MinDepositPassed bool            `json:"minDepositPassed"`
 }
 
 func (o BotOrderAdjusted) IsEmpty() bool {
@@ -35,19 +35,24 @@ func (o BotOrderAdjusted) IsEmpty() bool {
 type CreateOrderResponse struct {
 	OrderID       int64              `json:"orderID"`
 	ClientOrderID string             `json:"clientOrderID"`
-	OrigQuantity  float64            `json:"originalQty"`
-	Price         float64            `json:"price"`
+	OrigQuantity  decimal.Decimal    `json:"originalQty"`
+	Price         decimal.Decimal    `json:"price"`
 	Symbol        string             `json:"symbol"`
 	Type          consts.OrderSide   `json:"orderRes"`
 	CreatedTime   int64              `json:"createdTime"` // unix timestamp ms
 	Status        consts.OrderStatus `json:"status"`
+
+	// optional
+	// ExchangeOrderID - non-numeric exchange order ID, e.g. KuCoin.
+	// OrderID is its numeric alias then
+	ExchangeOrderID string `json:"exchangeOrderID,omitempty"`
 }
 
 // Balance - Trading pair balance
 type Balance struct {
-	Asset  string  `json:"asset"`
-	Free   float64 `json:"free"`
-	Locked float64 `json:"locked"`
+	Asset  string          `json:"asset"`
+	Free   decimal.Decimal `json:"free"`
+	Locked decimal.Decimal `json:"locked"`
 }
 
 // AccountData & balances
@@ -64,9 +69,9 @@ type PairBalance struct {
 
 // AssetBalance - is a wraper for asset balance data
 type AssetBalance struct {
-	Ticker string  `json:"ticker"`
-	Free   float64 `json:"free"`
-	Locked float64 `json:"locked"`
+	Ticker string          `json:"ticker"`
+	Free   decimal.Decimal `json:"free"`
+	Locked decimal.Decimal `json:"locked"`
 }
 
 // PairSymbolData - contains pair symbol data
@@ -82,7 +87,7 @@ type APIPassword string
 // APIEmail - email authentication
 type APIEmail string
 
-// GetOrdersHistoryTask - data for GetPairOrdersHistory request
+// GetOrdersHistoryTask - data for GetAccountTrades request
 type GetOrdersHistoryTask struct {
 	// required
 	PairSymbol string
@@ -95,11 +100,44 @@ type GetOrdersHistoryTask struct {
 
 // SymbolPrice define symbol and price pair
 type SymbolPrice struct {
-	Symbol string  `json:"symbol"`
-	Price  float64 `json:"price"`
+	Symbol string          `json:"symbol"`
+	Price  decimal.Decimal `json:"price"`
 }
 
 type OrderFees struct {
 	BaseAsset  decimal.Decimal `json:"base"`
 	QuoteAsset decimal.Decimal `json:"quote"`
+	// Commissions - raw order trades fees, including the fees paid
+	// in a third asset, e.g. BNB or GT
+	Commissions []Commission `json:"commissions,omitempty"`
+	// Points - exchange points spent on the fees, e.g. Gate POINT.
+	// Points have no market price & are not included into Commissions
+	Points decimal.Decimal `json:"points"`
+}
+
+// Commission - order trade (fill) fee as it's charged by the exchange
+type Commission struct {
+	TradeID string          `json:"tradeID,omitempty"`
+	Asset   string          `json:"asset"`
+	Amount  decimal.Decimal `json:"amount"`
+	// Price - trade price in quote asset
+	Price decimal.Decimal `json:"price"`
+	// Time - trade time, unix timestamp ms
+	Time int64 `json:"time"`
+}
+
+// TransferResult - internal transfer between the account types
+type TransferResult struct {
+	ID          string             `json:"id"`
+	Asset       string             `json:"asset"`
+	Amount      decimal.Decimal    `json:"amount"`
+	FromAccount consts.AccountType `json:"fromAccount"`
+	ToAccount   consts.AccountType `json:"toAccount"`
+}
+
+// TradeFees - account fee rates for the pair, e.g. 0.001 is 0.1%
+type TradeFees struct {
+	Symbol string          `json:"symbol"`
+	Maker  decimal.Decimal `json:"maker"`
+	Taker  decimal.Decimal `json:"taker"`
 }
@@ -0,0 +1,53 @@
+package structs
+
+import "github.com/matrixbotio/exchange-gates-lib/internal/consts"
+
+// CandleEvent - changes in trading candles for a specific pair
+type CandleEvent struct {
+	// required
+	Symbol     string     `json:"symbol"`
+	Candle     CandleData `json:"candle"`
+	Time       int64      `json:"time"`
+	IsFinished bool
+
+	// optional
+	BaseAsset    string `json:"baseAsset"`
+	QuoteAsset   string `json:"quoteAsset"`
+	IsBackfilled bool   `json:"isBackfilled,omitempty"` // restored from REST API after the stream gap
+}
+
+// CandleData - trading candle
+type CandleData struct {
+	StartTime int64           `json:"startTime"`
+	EndTime   int64           `json:"endTime"`
+	Interval  consts.Interval `json:"interval"`
+	Open      float64         `json:"open"`
+	Close     float64         `json:"close"`
+	High      float64         `json:"high"`
+	Low       float64         `json:"low"`
+	Volume    float64         `json:"volume"`
+}
+
+type TradeEventPrivateCallback func(event TradeEventPrivate)
+
+type TradeEventPrivate struct {
+	ID            string  `json:"id,omitempty"`
+	Time          int64   `json:"time,omitempty"`
+	ExchangeTag   string  `json:"exchangeTag,omitempty"`
+	Symbol        string  `json:"symbol,omitempty"`
+	OrderID       string  `json:"orderID,omitempty"`
+	ClientOrderID string  `json:"clientOrderID,omitempty"`
+	Price         float64 `json:"price,omitempty"`
+	Quantity      float64 `json:"quantity,omitempty"`
+	BaseAsset     string  `json:"baseAsset,omitempty"`
+	QuoteAsset    string  `json:"quoteAsset,omitempty"`
+}
+
+type OrderEvent struct {
+	// required
+	APIKeyID string            `json:"apiKeyID"`
+	Data     TradeEventPrivate `json:"data"`
+
+	// optional
+	BotID string `json:"botID"`
+}
@@ -3,6 +3,7 @@ package workers
 
 import (
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/shopspring/decimal"
 )
 
 // CandleEvent - changes in trading candles for a specific pair
@@ -14,8 +15,9 @@ type CandleEvent struct {
 	IsFinished bool
 
 	// optional
-	BaseAsset  string `json:"baseAsset"`
-	QuoteAsset string `json:"quoteAsset"`
+	BaseAsset    string `json:"baseAsset"`
+	QuoteAsset   string `json:"quoteAsset"`
+	IsBackfilled bool   `json:"isBackfilled,omitempty"` // restored from REST API after the stream gap
 }
 
 // CandleData - trading candle
@@ -23,11 +25,11 @@ type CandleData struct {
 	StartTime int64           `json:"startTime"`
 	EndTime   int64           `json:"endTime"`
 	Interval  consts.Interval `json:"interval"`
-	Open      float64         `json:"open"`
-	Close     float64         `json:"close"`
-	High      float64         `json:"high"`
-	Low       float64         `json:"low"`
-	Volume    float64         `json:"volume"`
+	Open      decimal.Decimal `json:"open"`
+	Close     decimal.Decimal `json:"close"`
+	High      decimal.Decimal `json:"high"`
+	Low       decimal.Decimal `json:"low"`
+	Volume    decimal.Decimal `json:"volume"`
 }
 
 // PriceEvent - data on changes in trade data in the market
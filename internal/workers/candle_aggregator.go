@@ -0,0 +1,226 @@
+package workers
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+// CandleAggregator builds higher interval candles from the base interval candles stream
+type CandleAggregator struct {
+	baseInterval  consts.Interval
+	baseDuration  time.Duration
+	intervals     []consts.Interval
+	eventCallback func(event CandleEvent)
+
+	mu      sync.Mutex
+	buckets map[string]*candleBucket // symbol.interval -> candle in progress
+}
+
+// candleBucket - the interval candle in progress. Base candles before the last one
+// are merged into the running OHLCV, the last one can still be updated
+type candleBucket struct {
+	startTime  int64 // ms
+	endTime    int64 // next candle start time, ms
+	merged     *CandleData
+	last       CandleData
+	isFinished bool
+}
+
+// NewCandleAggregator - create aggregator. Each interval must be a multiple of the base interval
+func NewCandleAggregator(
+	baseInterval consts.Interval,
+	intervals []consts.Interval,
+	eventCallback func(event CandleEvent),
+) (*CandleAggregator, error) {
+	baseDuration, isFixed := consts.GetIntervalDuration(baseInterval)
+	if !isFixed {
+		return nil, fmt.Errorf("base interval %q is not supported", baseInterval)
+	}
+
+	for _, interval := range intervals {
+		if !isMultipleInterval(interval, baseDuration) {
+			return nil, fmt.Errorf(
+				"interval %q is not a multiple of %q", interval, baseInterval,
+			)
+		}
+	}
+
+	return &CandleAggregator{
+		baseInterval:  baseInterval,
+		baseDuration:  baseDuration,
+		intervals:     intervals,
+		eventCallback: eventCallback,
+		buckets:       map[string]*candleBucket{},
+	}, nil
+}
+
+func isMultipleInterval(interval consts.Interval, baseDuration time.Duration) bool {
+	switch interval {
+	case consts.Interval1week, consts.Interval1month:
+		// aligned to the day start
+		return (time.Hour*24)%baseDuration == 0
+	}
+
+	duration, isFixed := consts.GetIntervalDuration(interval)
+	return isFixed && duration > baseDuration && duration%baseDuration == 0
+}
+
+// HandleCandleEvent - process base interval candle event.
+// Events of other intervals are ignored
+func (a *CandleAggregator) HandleCandleEvent(event CandleEvent) {
+	if event.Candle.Interval != a.baseInterval {
+		return
+	}
+
+	a.mu.Lock()
+	var events []CandleEvent
+	for _, interval := range a.intervals {
+		events = append(events, a.handle(event, interval)...)
+	}
+	a.mu.Unlock()
+
+	for _, e := range events {
+		a.eventCallback(e)
+	}
+}
+
+// Reset removes all candles in progress
+func (a *CandleAggregator) Reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.buckets = map[string]*candleBucket{}
+}
+
+func (a *CandleAggregator) handle(event CandleEvent, interval consts.Interval) []CandleEvent {
+	baseStartTime := event.Candle.StartTime
+	baseEndTime := baseStartTime + a.baseDuration.Milliseconds()
+	// keep the exchange end time format, e.g. end time can be 1ms less than next candle start
+	endTimeOffset := event.Candle.EndTime - baseEndTime
+
+	candleStart := GetCandleStartTime(interval, time.UnixMilli(baseStartTime))
+	startTime := candleStart.UnixMilli()
+
+	var events []CandleEvent
+	key := getSubsKey(event.Symbol, string(interval))
+	bucket, isExists := a.buckets[key]
+	if isExists && bucket.startTime != startTime {
+		if startTime < bucket.startTime {
+			// outdated event
+			return nil
+		}
+
+		if !bucket.isFinished {
+			// the last base candle update was missed
+			bucket.isFinished = true
+			events = append(events, bucket.getEvent(event, interval, endTimeOffset))
+		}
+		isExists = false
+	}
+
+	if !isExists {
+		bucket = &candleBucket{
+			startTime: startTime,
+			endTime:   GetNextCandleStartTime(interval, candleStart).UnixMilli(),
+			last:      event.Candle,
+		}
+		a.buckets[key] = bucket
+	}
+
+	if !bucket.update(event.Candle) {
+		// outdated base candle update, it's already merged
+		return events
+	}
+	bucket.isFinished = event.IsFinished && baseEndTime >= bucket.endTime
+
+	return append(events, bucket.getEvent(event, interval, endTimeOffset))
+}
+
+// update - replace the last base candle or merge it when the next one starts.
+// Returns false for the base candles before the last one
+func (b *candleBucket) update(baseCandle CandleData) bool {
+	switch {
+	case baseCandle.StartTime < b.last.StartTime:
+		return false
+	case baseCandle.StartTime > b.last.StartTime:
+		b.merged = mergeCandles(b.merged, b.last)
+	}
+
+	b.last = baseCandle
+	return true
+}
+
+// mergeCandles - add the next candle to the merged one, which is nil for the first candle
+func mergeCandles(merged *CandleData, next CandleData) *CandleData {
+	if merged == nil {
+		return &next
+	}
+
+	result := *merged
+	result.Close = next.Close
+	if next.High.GreaterThan(result.High) {
+		result.High = next.High
+	}
+	if next.Low.LessThan(result.Low) {
+		result.Low = next.Low
+	}
+	result.Volume = result.Volume.Add(next.Volume)
+	return &result
+}
+
+func (b *candleBucket) getEvent(
+	baseEvent CandleEvent,
+	interval consts.Interval,
+	endTimeOffset int64,
+) CandleEvent {
+	candle := *mergeCandles(b.merged, b.last)
+	candle.StartTime = b.startTime
+	candle.EndTime = b.endTime + endTimeOffset
+	candle.Interval = interval
+
+	return CandleEvent{
+		Symbol:     baseEvent.Symbol,
+		Candle:     candle,
+		Time:       baseEvent.Time,
+		IsFinished: b.isFinished,
+		BaseAsset:  baseEvent.BaseAsset,
+		QuoteAsset: baseEvent.QuoteAsset,
+	}
+}
+
+// GetCandleStartTime returns the start of the interval candle containing the time.
+// Candles are aligned in UTC, weeks start on Monday
+func GetCandleStartTime(interval consts.Interval, t time.Time) time.Time {
+	t = t.UTC()
+
+	switch interval {
+	case consts.Interval1month:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case consts.Interval1week:
+		dayStart := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		daysFromMonday := (int(dayStart.Weekday()) + 6) % 7
+		return dayStart.AddDate(0, 0, -daysFromMonday)
+	}
+
+	duration, isFixed := consts.GetIntervalDuration(interval)
+	if !isFixed {
+		return t
+	}
+
+	// align to unix epoch
+	timestampMs := t.UnixMilli()
+	return time.UnixMilli(timestampMs - timestampMs%duration.Milliseconds()).UTC()
+}
+
+// GetNextCandleStartTime returns the start of the next interval candle
+func GetNextCandleStartTime(interval consts.Interval, candleStart time.Time) time.Time {
+	if interval == consts.Interval1month {
+		return candleStart.AddDate(0, 1, 0)
+	}
+
+	duration, _ := consts.GetIntervalDuration(interval)
+	return candleStart.Add(duration)
+}
@@ -0,0 +1,159 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+func getTestMinuteEvent(startTime time.Time, price int64, isFinished bool) CandleEvent {
+	return CandleEvent{
+		Symbol: "LTCUSDT",
+		Candle: CandleData{
+			StartTime: startTime.UnixMilli(),
+			EndTime:   startTime.Add(time.Minute).UnixMilli() - 1,
+			Interval:  consts.Interval1min,
+			Open:      decimal.NewFromInt(price),
+			Close:     decimal.NewFromInt(price + 1),
+			High:      decimal.NewFromInt(price + 2),
+			Low:       decimal.NewFromInt(price - 1),
+			Volume:    decimal.NewFromInt(10),
+		},
+		Time:       startTime.UnixMilli(),
+		IsFinished: isFinished,
+	}
+}
+
+func TestCandleAggregatorInvalidInterval(t *testing.T) {
+	// when
+	_, err := NewCandleAggregator(
+		consts.Interval3min,
+		[]consts.Interval{consts.Interval5min},
+		func(CandleEvent) {},
+	)
+
+	// then
+	require.ErrorContains(t, err, "is not a multiple")
+}
+
+func TestCandleAggregator(t *testing.T) {
+	// given
+	var events []CandleEvent
+	a, err := NewCandleAggregator(
+		consts.Interval1min,
+		[]consts.Interval{consts.Interval5min},
+		func(event CandleEvent) {
+			events = append(events, event)
+		},
+	)
+	require.NoError(t, err)
+
+	candleStart := time.Date(2024, 5, 1, 10, 5, 0, 0, time.UTC)
+
+	// when
+	for i := 0; i < 5; i++ {
+		minuteStart := candleStart.Add(time.Minute * time.Duration(i))
+		a.HandleCandleEvent(getTestMinuteEvent(minuteStart, int64(100+i), false))
+		a.HandleCandleEvent(getTestMinuteEvent(minuteStart, int64(100+i), true))
+	}
+
+	// then
+	require.Len(t, events, 10)
+	for _, event := range events[:9] {
+		assert.False(t, event.IsFinished)
+	}
+
+	last := events[9]
+	assert.True(t, last.IsFinished)
+	assert.Equal(t, consts.Interval5min, last.Candle.Interval)
+	assert.Equal(t, candleStart.UnixMilli(), last.Candle.StartTime)
+	assert.Equal(t, candleStart.Add(time.Minute*5).UnixMilli()-1, last.Candle.EndTime)
+	assert.Equal(t, "100", last.Candle.Open.String())
+	assert.Equal(t, "105", last.Candle.Close.String())
+	assert.Equal(t, "106", last.Candle.High.String())
+	assert.Equal(t, "99", last.Candle.Low.String())
+	assert.Equal(t, "50", last.Candle.Volume.String())
+}
+
+func TestCandleAggregatorMissedFinish(t *testing.T) {
+	// given
+	var events []CandleEvent
+	a, err := NewCandleAggregator(
+		consts.Interval1min,
+		[]consts.Interval{consts.Interval15min},
+		func(event CandleEvent) {
+			events = append(events, event)
+		},
+	)
+	require.NoError(t, err)
+
+	candleStart := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	// when
+	a.HandleCandleEvent(getTestMinuteEvent(candleStart.Add(time.Minute*14), 100, false))
+	a.HandleCandleEvent(getTestMinuteEvent(candleStart.Add(time.Minute*15), 110, false))
+
+	// then
+	require.Len(t, events, 3)
+	assert.True(t, events[1].IsFinished)
+	assert.Equal(t, candleStart.UnixMilli(), events[1].Candle.StartTime)
+	assert.False(t, events[2].IsFinished)
+	assert.Equal(t, candleStart.Add(time.Minute*15).UnixMilli(), events[2].Candle.StartTime)
+}
+
+func TestCandleAggregatorOutdatedBaseCandle(t *testing.T) {
+	// given
+	var events []CandleEvent
+	a, err := NewCandleAggregator(
+		consts.Interval1min,
+		[]consts.Interval{consts.Interval5min},
+		func(event CandleEvent) {
+			events = append(events, event)
+		},
+	)
+	require.NoError(t, err)
+
+	candleStart := time.Date(2024, 5, 1, 10, 5, 0, 0, time.UTC)
+	a.HandleCandleEvent(getTestMinuteEvent(candleStart, 100, true))
+	a.HandleCandleEvent(getTestMinuteEvent(candleStart.Add(time.Minute), 101, false))
+
+	// when
+	a.HandleCandleEvent(getTestMinuteEvent(candleStart, 200, true))
+
+	// then
+	require.Len(t, events, 2)
+	assert.Equal(t, "100", events[1].Candle.Open.String())
+	assert.Equal(t, "103", events[1].Candle.High.String())
+	assert.Equal(t, "20", events[1].Candle.Volume.String())
+}
+
+func TestGetCandleStartTime(t *testing.T) {
+	// given
+	ts := time.Date(2024, 5, 1, 10, 17, 31, 0, time.UTC) // wednesday
+
+	// then
+	assert.Equal(t,
+		time.Date(2024, 5, 1, 10, 15, 0, 0, time.UTC),
+		GetCandleStartTime(consts.Interval15min, ts),
+	)
+	assert.Equal(t,
+		time.Date(2024, 4, 29, 0, 0, 0, 0, time.UTC),
+		GetCandleStartTime(consts.Interval1week, ts),
+	)
+	assert.Equal(t,
+		time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		GetCandleStartTime(consts.Interval1month, ts),
+	)
+	assert.Equal(t,
+		time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		GetNextCandleStartTime(
+			consts.Interval1month,
+			GetCandleStartTime(consts.Interval1month, ts),
+		),
+	)
+}
@@ -0,0 +1,167 @@
+package workers
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+const maxBackfillCandles = 1000
+
+// CandleSource - exchange adapter candles methods
+type CandleSource interface {
+	SubscribeCandle(
+		pairSymbol string,
+		interval consts.Interval,
+		eventCallback func(event CandleEvent),
+		errorHandler func(err error),
+	) error
+	UnsubscribeCandle(pairSymbol string, interval consts.Interval)
+	GetCandles(limit int, symbol string, interval consts.Interval) ([]CandleData, error)
+}
+
+// CandleGapDetector restores candles missed in the stream via REST API
+type CandleGapDetector struct {
+	source CandleSource
+	now    func() time.Time
+
+	streams sync.Map // symbol.interval -> *candleStream
+}
+
+type candleStream struct {
+	mu                  sync.Mutex
+	lastFinishedStartMs int64
+	// gapCheckedStartMs - start of the candle the gap before which was
+	// backfilled or failed. The gap is requested once, not on every tick
+	gapCheckedStartMs int64
+}
+
+func NewCandleGapDetector(source CandleSource) *CandleGapDetector {
+	return &CandleGapDetector{
+		source: source,
+		now:    time.Now,
+	}
+}
+
+// SubscribeCandle - subscribe to candle events. Missed finished candles
+// are replayed in order with IsBackfilled flag before the live event.
+// The repeated subscription is no-op until unsubscribe
+func (d *CandleGapDetector) SubscribeCandle(
+	pairSymbol string,
+	interval consts.Interval,
+	eventCallback func(event CandleEvent),
+	errorHandler func(err error),
+) error {
+	key := getSubsKey(pairSymbol, string(interval))
+	stream := &candleStream{}
+	if _, isExists := d.streams.LoadOrStore(key, stream); isExists {
+		return nil // already subscribed
+	}
+
+	if err := d.source.SubscribeCandle(
+		pairSymbol,
+		interval,
+		func(event CandleEvent) {
+			d.handleEvent(stream, event, eventCallback, errorHandler)
+		},
+		errorHandler,
+	); err != nil {
+		d.streams.Delete(key)
+		return err
+	}
+	return nil
+}
+
+func (d *CandleGapDetector) UnsubscribeCandle(pairSymbol string, interval consts.Interval) {
+	d.source.UnsubscribeCandle(pairSymbol, interval)
+	d.streams.Delete(getSubsKey(pairSymbol, string(interval)))
+}
+
+func (d *CandleGapDetector) handleEvent(
+	stream *candleStream,
+	event CandleEvent,
+	eventCallback func(event CandleEvent),
+	errorHandler func(err error),
+) {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	if event.Candle.StartTime == 0 ||
+		consts.ValidateInterval(string(event.Candle.Interval)) != nil {
+		// candle time is unknown
+		eventCallback(event)
+		return
+	}
+
+	if stream.lastFinishedStartMs > 0 {
+		expectedStart := GetNextCandleStartTime(
+			event.Candle.Interval,
+			time.UnixMilli(stream.lastFinishedStartMs).UTC(),
+		)
+
+		if event.Candle.StartTime > expectedStart.UnixMilli() &&
+			event.Candle.StartTime > stream.gapCheckedStartMs {
+			stream.gapCheckedStartMs = event.Candle.StartTime
+
+			candles, err := d.getMissedCandles(event, expectedStart)
+			if err != nil {
+				errorHandler(fmt.Errorf("backfill %s %s candles: %w",
+					event.Symbol, event.Candle.Interval, err))
+			}
+
+			for _, candle := range candles {
+				eventCallback(CandleEvent{
+					Symbol:       event.Symbol,
+					Candle:       candle,
+					Time:         candle.EndTime,
+					IsFinished:   true,
+					BaseAsset:    event.BaseAsset,
+					QuoteAsset:   event.QuoteAsset,
+					IsBackfilled: true,
+				})
+				stream.lastFinishedStartMs = candle.StartTime
+			}
+		}
+	}
+
+	eventCallback(event)
+	if event.IsFinished && event.Candle.StartTime > stream.lastFinishedStartMs {
+		stream.lastFinishedStartMs = event.Candle.StartTime
+	}
+}
+
+// getMissedCandles returns candles between the expected candle start & the event candle
+func (d *CandleGapDetector) getMissedCandles(
+	event CandleEvent,
+	expectedStart time.Time,
+) ([]CandleData, error) {
+	interval := event.Candle.Interval
+
+	// the exchange returns the last candles, so we need all candles till now
+	limit := 1
+	for t := expectedStart; t.Before(d.now()) && limit < maxBackfillCandles; {
+		t = GetNextCandleStartTime(interval, t)
+		limit++
+	}
+
+	candles, err := d.source.GetCandles(limit, event.Symbol, interval)
+	if err != nil {
+		return nil, fmt.Errorf("get candles: %w", err)
+	}
+
+	var result []CandleData
+	for _, candle := range candles {
+		if candle.StartTime >= expectedStart.UnixMilli() &&
+			candle.StartTime < event.Candle.StartTime {
+			result = append(result, candle)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartTime < result[j].StartTime
+	})
+	return result, nil
+}
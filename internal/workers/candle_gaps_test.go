@@ -0,0 +1,190 @@
+package workers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+type testCandleSource struct {
+	callback      func(event CandleEvent)
+	candles       []CandleData
+	err           error
+	limit         int
+	subscribeErr  error
+	subscriptions int
+}
+
+func (s *testCandleSource) SubscribeCandle(
+	_ string,
+	_ consts.Interval,
+	eventCallback func(event CandleEvent),
+	_ func(err error),
+) error {
+	s.subscriptions++
+	if s.subscribeErr != nil {
+		return s.subscribeErr
+	}
+	s.callback = eventCallback
+	return nil
+}
+
+func (s *testCandleSource) UnsubscribeCandle(string, consts.Interval) {}
+
+func (s *testCandleSource) GetCandles(
+	limit int,
+	_ string,
+	_ consts.Interval,
+) ([]CandleData, error) {
+	s.limit = limit
+	return s.candles, s.err
+}
+
+func TestCandleGapDetectorBackfill(t *testing.T) {
+	// given
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	source := &testCandleSource{
+		// the exchange returns candles in reverse order
+		candles: []CandleData{
+			getTestMinuteEvent(start.Add(time.Minute*3), 103, true).Candle,
+			getTestMinuteEvent(start.Add(time.Minute*2), 102, true).Candle,
+			getTestMinuteEvent(start.Add(time.Minute), 101, true).Candle,
+			getTestMinuteEvent(start, 100, true).Candle,
+		},
+	}
+
+	d := NewCandleGapDetector(source)
+	d.now = func() time.Time { return start.Add(time.Minute * 3) }
+
+	var events []CandleEvent
+	require.NoError(t, d.SubscribeCandle(
+		"LTCUSDT",
+		consts.Interval1min,
+		func(event CandleEvent) { events = append(events, event) },
+		func(err error) { t.Fatal(err) },
+	))
+
+	// when
+	source.callback(getTestMinuteEvent(start, 100, true))
+	source.callback(getTestMinuteEvent(start.Add(time.Minute*3), 103, false))
+
+	// then
+	require.Len(t, events, 4)
+	assert.False(t, events[0].IsBackfilled)
+	assert.True(t, events[1].IsBackfilled)
+	assert.True(t, events[1].IsFinished)
+	assert.Equal(t, start.Add(time.Minute).UnixMilli(), events[1].Candle.StartTime)
+	assert.True(t, events[2].IsBackfilled)
+	assert.Equal(t, start.Add(time.Minute*2).UnixMilli(), events[2].Candle.StartTime)
+	assert.False(t, events[3].IsBackfilled)
+	assert.Equal(t, start.Add(time.Minute*3).UnixMilli(), events[3].Candle.StartTime)
+	assert.Equal(t, 3, source.limit)
+}
+
+func TestCandleGapDetectorNoGap(t *testing.T) {
+	// given
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	source := &testCandleSource{err: errors.New("must not be called")}
+	d := NewCandleGapDetector(source)
+
+	var events []CandleEvent
+	require.NoError(t, d.SubscribeCandle(
+		"LTCUSDT",
+		consts.Interval1min,
+		func(event CandleEvent) { events = append(events, event) },
+		func(err error) { t.Fatal(err) },
+	))
+
+	// when
+	source.callback(getTestMinuteEvent(start, 100, true))
+	source.callback(getTestMinuteEvent(start.Add(time.Minute), 101, false))
+	source.callback(getTestMinuteEvent(start.Add(time.Minute), 101, true))
+
+	// then
+	require.Len(t, events, 3)
+	assert.Equal(t, 0, source.limit)
+}
+
+func TestCandleGapDetectorBackfillError(t *testing.T) {
+	// given
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	source := &testCandleSource{err: errors.New("exchange unavailable")}
+	d := NewCandleGapDetector(source)
+
+	var events []CandleEvent
+	var backfillErrs []error
+	require.NoError(t, d.SubscribeCandle(
+		"LTCUSDT",
+		consts.Interval1min,
+		func(event CandleEvent) { events = append(events, event) },
+		func(err error) { backfillErrs = append(backfillErrs, err) },
+	))
+
+	// when
+	source.callback(getTestMinuteEvent(start, 100, true))
+	source.callback(getTestMinuteEvent(start.Add(time.Minute*5), 105, false))
+	source.callback(getTestMinuteEvent(start.Add(time.Minute*5), 106, false))
+	source.callback(getTestMinuteEvent(start.Add(time.Minute*5), 107, true))
+	source.callback(getTestMinuteEvent(start.Add(time.Minute*6), 108, false))
+
+	// then
+	// the failed gap is requested once, the stream moves past it
+	require.Len(t, backfillErrs, 1)
+	require.ErrorContains(t, backfillErrs[0], "exchange unavailable")
+	require.Len(t, events, 5)
+}
+
+func TestCandleGapDetectorResubscribe(t *testing.T) {
+	// given
+	source := &testCandleSource{}
+	d := NewCandleGapDetector(source)
+	subscribe := func() error {
+		return d.SubscribeCandle(
+			"LTCUSDT",
+			consts.Interval1min,
+			func(event CandleEvent) {},
+			func(err error) {},
+		)
+	}
+
+	// when
+	require.NoError(t, subscribe())
+	require.NoError(t, subscribe())
+
+	// then
+	assert.Equal(t, 1, source.subscriptions)
+
+	d.UnsubscribeCandle("LTCUSDT", consts.Interval1min)
+	require.NoError(t, subscribe())
+	assert.Equal(t, 2, source.subscriptions)
+}
+
+func TestCandleGapDetectorSubscribeError(t *testing.T) {
+	// given
+	source := &testCandleSource{subscribeErr: errors.New("dial failed")}
+	d := NewCandleGapDetector(source)
+	subscribe := func() error {
+		return d.SubscribeCandle(
+			"LTCUSDT",
+			consts.Interval1min,
+			func(event CandleEvent) {},
+			func(err error) {},
+		)
+	}
+
+	// when
+	err := subscribe()
+
+	// then
+	require.ErrorContains(t, err, "dial failed")
+
+	// the failed subscription is not kept
+	source.subscribeErr = nil
+	require.NoError(t, subscribe())
+	assert.Equal(t, 2, source.subscriptions)
+}
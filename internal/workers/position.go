@@ -0,0 +1,23 @@
+package workers
+
+import "github.com/matrixbotio/exchange-gates-lib/internal/structs"
+
+// PositionWorker - a worker based on account futures positions updates
+type PositionWorker struct {
+	workerBase
+	ExchangeTag string
+}
+
+type PositionEventCallback func(event PositionEvent)
+
+// GetExchangeTag - get worker exchange tag from exchange adapter
+func (w *PositionWorker) GetExchangeTag() string {
+	return w.ExchangeTag
+}
+
+// PositionEvent - account position update
+type PositionEvent struct {
+	ExchangeTag string           `json:"exchangeTag"`
+	Time        int64            `json:"time"` // ms
+	Position    structs.Position `json:"position"`
+}
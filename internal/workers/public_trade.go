@@ -0,0 +1,31 @@
+package workers
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+// PublicTradeWorker - a worker for pair public trades subscriptions
+type PublicTradeWorker struct {
+	workerBase
+	ExchangeTag string
+}
+
+type PublicTradeEventCallback func(event PublicTradeEvent)
+
+// GetExchangeTag - get worker exchange tag from exchange adapter
+func (w *PublicTradeWorker) GetExchangeTag() string {
+	return w.ExchangeTag
+}
+
+// PublicTradeEvent - pair market trade
+type PublicTradeEvent struct {
+	ID          string           `json:"id"`
+	Time        int64            `json:"time"` // trade time, ms
+	ExchangeTag string           `json:"exchangeTag"`
+	Symbol      string           `json:"symbol"`
+	Price       decimal.Decimal  `json:"price"`
+	Quantity    decimal.Decimal  `json:"quantity"`
+	TakerSide   consts.OrderSide `json:"takerSide"`
+}
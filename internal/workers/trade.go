@@ -1,6 +1,8 @@
 //go:generate mockgen -source=$GOFILE -destination=mock_$GOFILE -package=$GOPACKAGE
 package workers
 
+import "github.com/shopspring/decimal"
+
 // TradeEventWorker - a worker interface based on pair trade events
 type TradeEventWorker struct {
 	workerBase
@@ -15,14 +17,16 @@ func (w *TradeEventWorker) GetExchangeTag() string {
 }
 
 type TradeEventPrivate struct {
-	ID            string  `json:"id,omitempty"`
-	Time          int64   `json:"time,omitempty"`
-	ExchangeTag   string  `json:"exchangeTag,omitempty"`
-	Symbol        string  `json:"symbol,omitempty"`
-	OrderID       string  `json:"orderID,omitempty"`
-	ClientOrderID string  `json:"clientOrderID,omitempty"`
-	Price         float64 `json:"price,omitempty"`
-	Quantity      float64 `json:"quantity,omitempty"`
+	ID            string          `json:"id,omitempty"`
+	Time          int64           `json:"time,omitempty"`
+	ExchangeTag   string          `json:"exchangeTag,omitempty"`
+	Symbol        string          `json:"symbol,omitempty"`
+	OrderID       string          `json:"orderID,omitempty"`
+	ClientOrderID string          `json:"clientOrderID,omitempty"`
+	Price         decimal.Decimal `json:"price"`
+	Quantity      decimal.Decimal `json:"quantity"`
+	BaseAsset     string          `json:"baseAsset,omitempty"`
+	QuoteAsset    string          `json:"quoteAsset,omitempty"`
 }
 
 type OrderEvent struct {
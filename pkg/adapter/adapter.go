@@ -1,16 +1,50 @@
+// Package adapter - v1 adapters API with float64 prices, quantities & balances.
+//
+// The v1 adapter is a wrapper of the decimal adapter of pkg/adapter/v2,
+// the values are converted to float64 on the way out. New code should use
+// pkg/adapter/v2, ToV2 gets the v2 adapter of the v1 one to migrate step by step.
+// The v2 JSON values are strings, e.g. "0.1", the numbers of v1 JSON are parsed too
 package adapter
 
 import (
-	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	v1adapters "github.com/matrixbotio/exchange-gates-lib/internal/adapters/v1"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
-	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	structs "github.com/matrixbotio/exchange-gates-lib/internal/structs/v1"
 	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
 )
 
-type Adapter = adapters.Adapter
-type MockAdapter = adapters.MockAdapter
+type Adapter = v1adapters.Adapter
+type MockAdapter = v1adapters.MockAdapter
 
-var NewMockAdapter = adapters.NewMockAdapter
+var NewMockAdapter = v1adapters.NewMockAdapter
+
+// ToV2 - get the v2 adapter of the v1 adapter created by CreateAdapter
+var ToV2 = v1adapters.Unwrap
+
+// FromV2 - v1 adapter on top of the v2 one
+var FromV2 = v1adapters.Wrap
+
+// adapter connection options
+type (
+	Config = config.Config
+	Option = config.Option
+)
+
+var (
+	WithRESTBaseURL    = config.WithRESTBaseURL
+	WithWsBaseURL      = config.WithWsBaseURL
+	WithTestnet        = config.WithTestnet
+	WithProxy          = config.WithProxy
+	WithLocalAddr      = config.WithLocalAddr
+	WithHTTPClient     = config.WithHTTPClient
+	WithRequestTimeout = config.WithRequestTimeout
+	WithBrokerID       = config.WithBrokerID
+	WithOrderIDStore   = config.WithOrderIDStore
+)
+
+// OrderIDStore - persistent numeric aliases of the non-numeric exchange order IDs
+type OrderIDStore = config.OrderIDStore
 
 type (
 	AccountData          = structs.AccountData
@@ -31,26 +65,32 @@ type Interval = consts.Interval
 
 const (
 	Interval1min   = consts.Interval1min
+	Interval3min   = consts.Interval3min
 	Interval5min   = consts.Interval5min
 	Interval15min  = consts.Interval15min
 	Interval30min  = consts.Interval30min
 	Interval1hour  = consts.Interval1hour
+	Interval2hour  = consts.Interval2hour
 	Interval4hour  = consts.Interval4hour
 	Interval6hour  = consts.Interval6hour
+	Interval8hour  = consts.Interval8hour
 	Interval12hour = consts.Interval12hour
 	Interval1day   = consts.Interval1day
+	Interval3day   = consts.Interval3day
+	Interval1week  = consts.Interval1week
+	Interval1month = consts.Interval1month
 )
 
 var GetIntervals = consts.GetIntervals
 
 // events
 type (
-	TradeEventPrivate = workers.TradeEventPrivate
-	OrderEvent        = workers.OrderEvent
-	CandleEvent       = workers.CandleEvent
+	TradeEventPrivate = structs.TradeEventPrivate
+	OrderEvent        = structs.OrderEvent
+	CandleEvent       = structs.CandleEvent
 	PriceEvent        = workers.PriceEvent
 )
 
-type CandleData = workers.CandleData
+type CandleData = structs.CandleData
 
 const PairStatusTrading = consts.PairDefaultStatus
@@ -0,0 +1,139 @@
+package adaptertest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const firstOrderID int64 = 1000
+
+// Order - stand-in exchange order
+type Order struct {
+	ID            int64
+	ClientOrderID string
+	PairSymbol    string
+	Side          consts.OrderSide
+	IsMarket      bool
+	Qty           decimal.Decimal
+	Price         decimal.Decimal
+	FilledQty     decimal.Decimal
+	Status        consts.OrderStatus
+	CreatedTime   int64
+	UpdatedTime   int64
+}
+
+// Exchange - in-memory exchange state behind the stand-in server.
+// The adapter specific handlers translate the requests to its calls
+// & the errs errors to the exchange error responses
+type Exchange struct {
+	mu        sync.Mutex
+	nextID    int64
+	orders    map[int64]*Order
+	clientIDs map[string]int64 // client order ID -> order ID
+	now       func() time.Time
+}
+
+func NewExchange() *Exchange {
+	return &Exchange{
+		nextID:    firstOrderID,
+		orders:    map[int64]*Order{},
+		clientIDs: map[string]int64{},
+		now:       time.Now,
+	}
+}
+
+// PlaceOrder - errs.ErrOrderDuplicate is returned for the used client order ID.
+// Market orders are filled immediately
+func (e *Exchange) PlaceOrder(order Order) (Order, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if order.ClientOrderID != "" {
+		if _, isExists := e.clientIDs[order.ClientOrderID]; isExists {
+			return Order{}, errs.ErrOrderDuplicate
+		}
+	}
+
+	order.ID = e.nextID
+	e.nextID++
+	order.CreatedTime = e.now().UnixMilli()
+	order.UpdatedTime = order.CreatedTime
+	order.FilledQty = decimal.Zero
+	order.Status = consts.OrderStatusNew
+	if order.IsMarket {
+		order.FilledQty = order.Qty
+		order.Status = consts.OrderStatusFilled
+	}
+
+	e.orders[order.ID] = &order
+	if order.ClientOrderID != "" {
+		e.clientIDs[order.ClientOrderID] = order.ID
+	}
+	return order, nil
+}
+
+func (e *Exchange) GetOrder(orderID int64) (Order, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	order, isExists := e.orders[orderID]
+	if !isExists {
+		return Order{}, errs.ErrOrderNotFound
+	}
+	return *order, nil
+}
+
+func (e *Exchange) GetOrderByClientOrderID(clientOrderID string) (Order, error) {
+	e.mu.Lock()
+	orderID, isExists := e.clientIDs[clientOrderID]
+	e.mu.Unlock()
+
+	if !isExists {
+		return Order{}, errs.ErrOrderNotFound
+	}
+	return e.GetOrder(orderID)
+}
+
+// CancelOrder - errs.ErrOrderFilled is returned for the filled order,
+// errs.ErrOrderNotFound for the unknown or already cancelled one
+func (e *Exchange) CancelOrder(orderID int64) (Order, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	order, isExists := e.orders[orderID]
+	if !isExists {
+		return Order{}, errs.ErrOrderNotFound
+	}
+
+	switch order.Status {
+	case consts.OrderStatusFilled:
+		return Order{}, errs.ErrOrderFilled
+	case consts.OrderStatusCancelled:
+		return Order{}, errs.ErrOrderNotFound
+	}
+
+	order.Status = consts.OrderStatusCancelled
+	order.UpdatedTime = e.now().UnixMilli()
+	return *order, nil
+}
+
+// FillOrder - fill the rest of the order qty
+func (e *Exchange) FillOrder(orderID int64) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	order, isExists := e.orders[orderID]
+	if !isExists || order.Status == consts.OrderStatusCancelled {
+		return errs.ErrOrderNotFound
+	}
+
+	order.FilledQty = order.Qty
+	order.Status = consts.OrderStatusFilled
+	order.UpdatedTime = e.now().UnixMilli()
+	return nil
+}
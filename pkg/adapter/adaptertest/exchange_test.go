@@ -0,0 +1,57 @@
+package adaptertest
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+func TestExchangePlaceOrderDuplicate(t *testing.T) {
+	// given
+	exchange := NewExchange()
+	order := Order{ClientOrderID: "test", Qty: decimal.NewFromInt(1)}
+
+	_, err := exchange.PlaceOrder(order)
+	require.NoError(t, err)
+
+	// when
+	_, err = exchange.PlaceOrder(order)
+
+	// then
+	require.ErrorIs(t, err, errs.ErrOrderDuplicate)
+}
+
+func TestExchangeCancelFilledOrder(t *testing.T) {
+	// given
+	exchange := NewExchange()
+	order, err := exchange.PlaceOrder(Order{Qty: decimal.NewFromInt(1)})
+	require.NoError(t, err)
+	require.NoError(t, exchange.FillOrder(order.ID))
+
+	// when
+	_, err = exchange.CancelOrder(order.ID)
+
+	// then
+	require.ErrorIs(t, err, errs.ErrOrderFilled)
+}
+
+func TestExchangeMarketOrderFilled(t *testing.T) {
+	// given
+	exchange := NewExchange()
+
+	// when
+	order, err := exchange.PlaceOrder(Order{IsMarket: true, Qty: decimal.NewFromInt(2)})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, consts.OrderStatusFilled, order.Status)
+	assert.Equal(t, "2", order.FilledQty.String())
+
+	_, err = exchange.CancelOrder(order.ID + 1)
+	require.ErrorIs(t, err, errs.ErrOrderNotFound)
+}
@@ -0,0 +1,260 @@
+// Package adaptertest - conformance checks for the exchange adapters.
+// The adapter under test is connected to the local stand-in server:
+// the adapter specific handlers are backed by the in-memory Exchange
+package adaptertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+// Harness - the adapter under test backed by the stand-in exchange
+type Harness struct {
+	// Adapter - connected adapter sending the requests to the stand-in server
+	Adapter adapters.Adapter
+	// Exchange - stand-in exchange state
+	Exchange *Exchange
+	// WsConnections - the websocket connections opened to the stand-in server.
+	// Optional, the connections are not counted when it's not set
+	WsConnections func() int
+}
+
+// Config - conformance suite settings
+type Config struct {
+	// NewHarness - fresh adapter & stand-in exchange for each check
+	NewHarness func(t *testing.T) Harness
+	PairSymbol string
+	// OrderQty & OrderPrice - limit order values valid for the pair
+	OrderQty   string
+	OrderPrice string
+	// IsClientOrderIDNotUnique - the exchange accepts the repeated
+	// client order IDs, the duplicate check is skipped
+	IsClientOrderIDNotUnique bool
+}
+
+// Run - run the conformance checks as subtests
+func Run(t *testing.T, cfg Config) {
+	t.Run("PairData", func(t *testing.T) {
+		checkPairData(t, cfg)
+	})
+	t.Run("OrderRoundTrip", func(t *testing.T) {
+		checkOrderRoundTrip(t, cfg)
+	})
+	t.Run("CancelByClientOrderID", func(t *testing.T) {
+		checkCancelByClientOrderID(t, cfg)
+	})
+	t.Run("DuplicateClientOrderID", func(t *testing.T) {
+		checkDuplicateClientOrderID(t, cfg)
+	})
+	t.Run("CancelAfterFill", func(t *testing.T) {
+		checkCancelAfterFill(t, cfg)
+	})
+	t.Run("CandleSubscription", func(t *testing.T) {
+		checkCandleSubscription(t, cfg)
+	})
+}
+
+func checkPairData(t *testing.T, cfg Config) {
+	// given
+	h := cfg.NewHarness(t)
+
+	// when
+	pairData, err := h.Adapter.GetPairData(cfg.PairSymbol)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, cfg.PairSymbol, pairData.Symbol)
+	assertPairDataSane(t, pairData)
+
+	pairs, err := h.Adapter.GetPairs()
+	require.NoError(t, err)
+	require.NotEmpty(t, pairs)
+	for _, pair := range pairs {
+		assertPairDataSane(t, pair)
+	}
+}
+
+func assertPairDataSane(t *testing.T, pairData structs.ExchangePairData) {
+	assert.True(t, pairData.QtyStep.IsPositive(), "%s qty step", pairData.Symbol)
+	assert.True(t, pairData.PriceStep.IsPositive(), "%s price step", pairData.Symbol)
+	assert.False(t, pairData.MinQty.IsNegative(), "%s min qty", pairData.Symbol)
+	if pairData.MaxQty.IsPositive() {
+		assert.True(t, pairData.MinQty.LessThanOrEqual(pairData.MaxQty),
+			"%s min qty %s > max qty %s",
+			pairData.Symbol, pairData.MinQty, pairData.MaxQty,
+		)
+	}
+}
+
+func checkOrderRoundTrip(t *testing.T, cfg Config) {
+	// given
+	h := cfg.NewHarness(t)
+	order := newLimitOrder(h.Adapter, cfg)
+
+	// when
+	placed, err := h.Adapter.PlaceOrder(context.Background(), order)
+
+	// then
+	require.NoError(t, err)
+	assert.NotZero(t, placed.OrderID)
+	assert.Equal(t, order.ClientOrderID, placed.ClientOrderID)
+
+	orderData, err := h.Adapter.GetOrderData(cfg.PairSymbol, placed.OrderID)
+	require.NoError(t, err)
+	assert.Equal(t, placed.OrderID, orderData.OrderID)
+	assert.Equal(t, order.ClientOrderID, orderData.ClientOrderID)
+	assert.Equal(t, pkgStructs.OrderStatusNew, orderData.Status)
+	assert.Equal(t, consts.OrderSideBuy, orderData.Side)
+	assertDecimalEqual(t, cfg.OrderQty, orderData.AwaitQty, "qty")
+	assertDecimalEqual(t, cfg.OrderPrice, orderData.Price, "price")
+	assert.True(t, orderData.FilledQty.IsZero())
+
+	byClientOrderID, err := h.Adapter.GetOrderByClientOrderID(
+		cfg.PairSymbol, order.ClientOrderID,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, placed.OrderID, byClientOrderID.OrderID)
+
+	require.NoError(t, h.Adapter.CancelPairOrder(
+		cfg.PairSymbol, placed.OrderID, context.Background(),
+	))
+
+	orderData, err = h.Adapter.GetOrderData(cfg.PairSymbol, placed.OrderID)
+	require.NoError(t, err)
+	assert.Equal(t, pkgStructs.OrderStatusCancelled, orderData.Status)
+}
+
+func checkCancelByClientOrderID(t *testing.T, cfg Config) {
+	// given
+	h := cfg.NewHarness(t)
+	order := newLimitOrder(h.Adapter, cfg)
+
+	_, err := h.Adapter.PlaceOrder(context.Background(), order)
+	require.NoError(t, err)
+
+	// when
+	err = h.Adapter.CancelPairOrderByClientOrderID(
+		cfg.PairSymbol, order.ClientOrderID, context.Background(),
+	)
+
+	// then
+	require.NoError(t, err)
+
+	orderData, err := h.Exchange.GetOrderByClientOrderID(order.ClientOrderID)
+	require.NoError(t, err)
+	assert.Equal(t, pkgStructs.OrderStatusCancelled, orderData.Status)
+}
+
+func checkDuplicateClientOrderID(t *testing.T, cfg Config) {
+	if cfg.IsClientOrderIDNotUnique {
+		t.Skip("client order ID is not unique on the exchange")
+	}
+
+	// given
+	h := cfg.NewHarness(t)
+	order := newLimitOrder(h.Adapter, cfg)
+
+	_, err := h.Adapter.PlaceOrder(context.Background(), order)
+	require.NoError(t, err)
+
+	// when
+	_, err = h.Adapter.PlaceOrder(context.Background(), order)
+
+	// then
+	require.ErrorIs(t, err, errs.ErrOrderDuplicate)
+}
+
+func checkCancelAfterFill(t *testing.T, cfg Config) {
+	// given
+	h := cfg.NewHarness(t)
+	order := newLimitOrder(h.Adapter, cfg)
+
+	placed, err := h.Adapter.PlaceOrder(context.Background(), order)
+	require.NoError(t, err)
+	// the adapter order ID could be an alias of the exchange one
+	standInOrder, err := h.Exchange.GetOrderByClientOrderID(order.ClientOrderID)
+	require.NoError(t, err)
+	require.NoError(t, h.Exchange.FillOrder(standInOrder.ID))
+
+	orderData, err := h.Adapter.GetOrderData(cfg.PairSymbol, placed.OrderID)
+	require.NoError(t, err)
+	require.Equal(t, pkgStructs.OrderStatusFilled, orderData.Status)
+	require.True(t, orderData.IsFullFilled())
+
+	// when
+	err = h.Adapter.CancelPairOrder(cfg.PairSymbol, placed.OrderID, context.Background())
+
+	// then
+	require.ErrorIs(t, err, errs.ErrOrderFilled)
+}
+
+// checkCandleSubscription - the repeated subscribe & unsubscribe calls are no-op
+func checkCandleSubscription(t *testing.T, cfg Config) {
+	// given
+	h := cfg.NewHarness(t)
+	capabilities := h.Adapter.Capabilities()
+	if !capabilities.SupportsStream(pkgStructs.StreamCandles) ||
+		len(capabilities.Intervals) == 0 {
+		t.Skip("candles stream is not supported")
+	}
+
+	interval := capabilities.Intervals[0]
+	subscribe := func() error {
+		return h.Adapter.SubscribeCandle(
+			cfg.PairSymbol,
+			interval,
+			func(event workers.CandleEvent) {},
+			// the stand-in server doesn't push the events
+			func(err error) {},
+		)
+	}
+
+	// when
+	require.NoError(t, subscribe())
+	require.NoError(t, subscribe())
+
+	// then
+	assertWsConnections(t, h, 1)
+
+	h.Adapter.UnsubscribeCandle(cfg.PairSymbol, interval)
+	h.Adapter.UnsubscribeCandle(cfg.PairSymbol, interval)
+
+	require.NoError(t, subscribe())
+	assertWsConnections(t, h, 2)
+
+	h.Adapter.UnsubscribeCandle(cfg.PairSymbol, interval)
+}
+
+func assertWsConnections(t *testing.T, h Harness, expected int) {
+	if h.WsConnections == nil {
+		return
+	}
+	assert.Equal(t, expected, h.WsConnections(), "websocket connections")
+}
+
+func newLimitOrder(adapter adapters.Adapter, cfg Config) structs.BotOrderAdjusted {
+	return structs.BotOrderAdjusted{
+		PairSymbol:    cfg.PairSymbol,
+		Type:          consts.OrderSideBuy,
+		Qty:           cfg.OrderQty,
+		Price:         cfg.OrderPrice,
+		ClientOrderID: adapter.GenClientOrderID(),
+	}
+}
+
+func assertDecimalEqual(t *testing.T, expected string, actual decimal.Decimal, name string) {
+	assert.True(t, decimal.RequireFromString(expected).Equal(actual),
+		"%s: expected %s, actual %s", name, expected, actual,
+	)
+}
@@ -0,0 +1,73 @@
+package adaptertest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+// WebsocketServer - stand-in websocket server: the client messages are read
+// & dropped, nothing is pushed except the greeting. The opened connections are counted
+type WebsocketServer struct {
+	*httptest.Server
+	connections atomic.Int64
+	greeting    any
+}
+
+// WebsocketServerOption - stand-in websocket server option
+type WebsocketServerOption func(s *WebsocketServer)
+
+// WithGreeting - JSON message sent on connect,
+// e.g. the welcome message awaited by the client before subscribing
+func WithGreeting(message any) WebsocketServerOption {
+	return func(s *WebsocketServer) {
+		s.greeting = message
+	}
+}
+
+// NewWebsocketServer - the server is closed on the test cleanup
+func NewWebsocketServer(t *testing.T, opts ...WebsocketServerOption) *WebsocketServer {
+	s := &WebsocketServer{}
+	for _, opt := range opts {
+		opt(s)
+	}
+	upgrader := websocket.Upgrader{}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.connections.Add(1)
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		if s.greeting != nil {
+			if err := conn.WriteJSON(s.greeting); err != nil {
+				return
+			}
+		}
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(s.Server.Close)
+	return s
+}
+
+// URL - ws:// server URL
+func (s *WebsocketServer) URL() string {
+	return "ws" + strings.TrimPrefix(s.Server.URL, "http")
+}
+
+// Connections - the number of the opened connections
+func (s *WebsocketServer) Connections() int {
+	return int(s.connections.Load())
+}
@@ -1,36 +1,23 @@
 package adapter
 
 import (
-	"errors"
-
-	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance"
-	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/wrapper"
-	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bingx"
-	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit"
-	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/gate"
-	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	v1adapters "github.com/matrixbotio/exchange-gates-lib/internal/adapters/v1"
+	v2adapter "github.com/matrixbotio/exchange-gates-lib/pkg/adapter/v2"
 )
 
-func CreateAdapter(exchangeID int) (Adapter, error) {
-	switch exchangeID {
-	default:
-		return nil, errors.New("exchange not found")
-	case consts.ExchangeIDbinanceSpot:
-		return binance.New(wrapper.NewWrapper()), nil
-	case consts.ExchangeIDbybitSpot:
-		return bybit.New(), nil
-	case consts.ExchangeIDbingx:
-		return bingx.New(), nil
-	case consts.ExchangeIDgateSpot:
-		return gate.New(), nil
+// CreateAdapter - the options are applied on top of the exchange defaults
+func CreateAdapter(exchangeID int, opts ...Option) (Adapter, error) {
+	a, err := v2adapter.CreateAdapter(exchangeID, opts...)
+	if err != nil {
+		return nil, err
 	}
+	return v1adapters.Wrap(a), nil
 }
 
-func CreateAdapters() map[int]Adapter {
-	return map[int]Adapter{
-		consts.ExchangeIDbinanceSpot: binance.New(wrapper.NewWrapper()),
-		consts.ExchangeIDbybitSpot:   bybit.New(),
-		consts.ExchangeIDbingx:       bingx.New(),
-		consts.ExchangeIDgateSpot:    gate.New(),
+func CreateAdapters(opts ...Option) map[int]Adapter {
+	result := map[int]Adapter{}
+	for exchangeID, a := range v2adapter.CreateAdapters(opts...) {
+		result[exchangeID] = v1adapters.Wrap(a)
 	}
+	return result
 }
@@ -0,0 +1,220 @@
+// Package adapter - v2 adapters API: prices, quantities & balances are decimal
+package adapter
+
+import (
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/accounts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/config"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/feescache"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/pairscache"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/symbols"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/timesync"
+	"github.com/matrixbotio/exchange-gates-lib/internal/candlestore"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+type Adapter = adapters.Adapter
+type MockAdapter = adapters.MockAdapter
+
+var NewMockAdapter = adapters.NewMockAdapter
+
+// adapter connection options
+type (
+	Config = config.Config
+	Option = config.Option
+)
+
+var (
+	WithRESTBaseURL    = config.WithRESTBaseURL
+	WithWsBaseURL      = config.WithWsBaseURL
+	WithTestnet        = config.WithTestnet
+	WithProxy          = config.WithProxy
+	WithLocalAddr      = config.WithLocalAddr
+	WithHTTPClient     = config.WithHTTPClient
+	WithRequestTimeout = config.WithRequestTimeout
+	WithBrokerID       = config.WithBrokerID
+	WithOrderIDStore   = config.WithOrderIDStore
+)
+
+// OrderIDStore - persistent numeric aliases of the non-numeric exchange order IDs
+type OrderIDStore = config.OrderIDStore
+
+// ExchangeOrderIDAdapter - order methods by the non-numeric exchange order ID
+type ExchangeOrderIDAdapter = adapters.ExchangeOrderIDAdapter
+
+// account types
+type (
+	AccountType         = consts.AccountType
+	AccountTypeSelector = adapters.AccountTypeSelector
+)
+
+const (
+	AccountTypeSpot    = consts.AccountTypeSpot
+	AccountTypeFunding = consts.AccountTypeFunding
+	AccountTypeUnified = consts.AccountTypeUnified
+	AccountTypeMargin  = consts.AccountTypeMargin
+	AccountTypeFutures = consts.AccountTypeFutures
+)
+
+// margin trading
+type (
+	MarginAdapter           = adapters.MarginAdapter
+	AccountMarginModeSetter = adapters.AccountMarginModeSetter
+	MarginMode              = consts.MarginMode
+	MarginSideEffect        = consts.MarginSideEffect
+	MarginRisk              = consts.MarginRisk
+	MarginAccount           = structs.MarginAccount
+	MarginBalance           = structs.MarginBalance
+	MarginLoanTask          = structs.MarginLoanTask
+	MarginLoanResult        = structs.MarginLoanResult
+	MarginOrderParams       = structs.MarginOrderParams
+)
+
+const (
+	MarginModeCross            = consts.MarginModeCross
+	MarginModeIsolated         = consts.MarginModeIsolated
+	MarginSideEffectNone       = consts.MarginSideEffectNone
+	MarginSideEffectAutoBorrow = consts.MarginSideEffectAutoBorrow
+	MarginSideEffectAutoRepay  = consts.MarginSideEffectAutoRepay
+	MarginRiskLow              = consts.MarginRiskLow
+	MarginRiskMarginCall       = consts.MarginRiskMarginCall
+	MarginRiskLiquidation      = consts.MarginRiskLiquidation
+)
+
+// perpetual futures
+type (
+	FuturesAdapter     = adapters.FuturesAdapter
+	PositionSide       = consts.PositionSide
+	Position           = structs.Position
+	FundingInfo        = structs.FundingInfo
+	FuturesOrderParams = structs.FuturesOrderParams
+)
+
+const (
+	PositionSideLong  = consts.PositionSideLong
+	PositionSideShort = consts.PositionSideShort
+)
+
+// pairs cache
+type (
+	CachedAdapter    = pairscache.CachedAdapter
+	PairsCacheConfig = pairscache.Config
+)
+
+// NewPairsCache wraps the adapter with exchange pairs data cache
+var NewPairsCache = pairscache.New
+
+// trade fee rates cache
+type FeesCachedAdapter = feescache.CachedAdapter
+
+// NewFeesCache wraps the adapter with account trade fee rates cache
+var NewFeesCache = feescache.New
+
+// server time sync
+type (
+	SyncedAdapter         = timesync.SyncedAdapter
+	TimeSyncConfig        = timesync.Config
+	ServerTimeMeasurement = timesync.Measurement
+)
+
+// NewTimeSync wraps the adapter with server clock drift monitor
+var NewTimeSync = timesync.New
+
+// multi-account sessions with shared market data streams
+type (
+	AccountManager      = accounts.Manager
+	AccountSession      = accounts.Session
+	AccountManagerStats = accounts.Stats
+)
+
+var (
+	ErrSessionExists   = accounts.ErrSessionExists
+	ErrSessionNotFound = accounts.ErrSessionNotFound
+)
+
+// symbols registry
+type SymbolRegistry = symbols.Registry
+
+var (
+	NewSymbolRegistry  = symbols.New
+	LoadSymbolRegistry = symbols.Load
+)
+
+type (
+	AccountData          = structs.AccountData
+	Balance              = structs.Balance
+	SymbolPrice          = structs.SymbolPrice
+	OrderData            = structs.OrderData
+	BotOrderAdjusted     = structs.BotOrderAdjusted
+	CreateOrderResponse  = structs.CreateOrderResponse
+	ExchangePairData     = structs.ExchangePairData
+	GetOrdersHistoryTask = structs.GetOrdersHistoryTask
+	PairBalance          = structs.PairBalance
+	PairSymbolData       = structs.PairSymbolData
+	AssetBalance         = structs.AssetBalance
+	OrderFees            = structs.OrderFees
+	Commission           = structs.Commission
+	TradeFees            = structs.TradeFees
+	AccountTrade         = structs.AccountTrade
+	TransferResult       = structs.TransferResult
+)
+
+type Interval = consts.Interval
+
+const (
+	Interval1min   = consts.Interval1min
+	Interval3min   = consts.Interval3min
+	Interval5min   = consts.Interval5min
+	Interval15min  = consts.Interval15min
+	Interval30min  = consts.Interval30min
+	Interval1hour  = consts.Interval1hour
+	Interval2hour  = consts.Interval2hour
+	Interval4hour  = consts.Interval4hour
+	Interval6hour  = consts.Interval6hour
+	Interval8hour  = consts.Interval8hour
+	Interval12hour = consts.Interval12hour
+	Interval1day   = consts.Interval1day
+	Interval3day   = consts.Interval3day
+	Interval1week  = consts.Interval1week
+	Interval1month = consts.Interval1month
+)
+
+var GetIntervals = consts.GetIntervals
+
+// events
+type (
+	TradeEventPrivate = workers.TradeEventPrivate
+	OrderEvent        = workers.OrderEvent
+	CandleEvent       = workers.CandleEvent
+	PriceEvent        = workers.PriceEvent
+	PublicTradeEvent  = workers.PublicTradeEvent
+	PositionEvent     = workers.PositionEvent
+)
+
+type CandleData = workers.CandleData
+
+// candles aggregation
+type CandleAggregator = workers.CandleAggregator
+
+var NewCandleAggregator = workers.NewCandleAggregator
+
+// candles stream gaps backfill
+type (
+	CandleGapDetector = workers.CandleGapDetector
+	CandleSource      = workers.CandleSource
+)
+
+var NewCandleGapDetector = workers.NewCandleGapDetector
+
+// candles history storage
+type CandleStore = candlestore.Store
+
+var (
+	NewCandleStore = candlestore.New
+	// ErrCandlesGap - CandleStore.Sync limit doesn't reach the last stored candle
+	ErrCandlesGap = candlestore.ErrCandlesGap
+)
+
+const PairStatusTrading = consts.PairDefaultStatus
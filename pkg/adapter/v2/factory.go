@@ -0,0 +1,71 @@
+package adapter
+
+import (
+	"errors"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/accounts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binance/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm"
+	usdmWrapper "github.com/matrixbotio/exchange-gates-lib/internal/adapters/binanceusdm/wrapper"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bingx"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bitget"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/bybit"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/gate"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/kucoin"
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters/okx"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+// CreateAdapter - the options are applied on top of the exchange defaults
+func CreateAdapter(exchangeID int, opts ...Option) (Adapter, error) {
+	switch exchangeID {
+	default:
+		return nil, errors.New("exchange not found")
+	case consts.ExchangeIDbinanceSpot:
+		return binance.New(wrapper.NewWrapper(opts...), opts...), nil
+	case consts.ExchangeIDbybitSpot:
+		return bybit.New(opts...), nil
+	case consts.ExchangeIDbingx:
+		return bingx.New(opts...), nil
+	case consts.ExchangeIDgateSpot:
+		return gate.New(opts...), nil
+	case consts.ExchangeIDbinanceUSDM:
+		return binanceusdm.New(usdmWrapper.NewWrapper(opts...), opts...), nil
+	case consts.ExchangeIDbybitLinear:
+		return bybit.NewLinear(opts...), nil
+	case consts.ExchangeIDokx:
+		return okx.New(opts...), nil
+	case consts.ExchangeIDkucoin:
+		return kucoin.New(opts...), nil
+	case consts.ExchangeIDbitget:
+		return bitget.New(opts...), nil
+	}
+}
+
+func CreateAdapters(opts ...Option) map[int]Adapter {
+	return map[int]Adapter{
+		consts.ExchangeIDbinanceSpot: binance.New(wrapper.NewWrapper(opts...), opts...),
+		consts.ExchangeIDbybitSpot:   bybit.New(opts...),
+		consts.ExchangeIDbingx:       bingx.New(opts...),
+		consts.ExchangeIDgateSpot:    gate.New(opts...),
+		consts.ExchangeIDbinanceUSDM: binanceusdm.New(usdmWrapper.NewWrapper(opts...), opts...),
+		consts.ExchangeIDbybitLinear: bybit.NewLinear(opts...),
+		consts.ExchangeIDokx:         okx.New(opts...),
+		consts.ExchangeIDkucoin:      kucoin.New(opts...),
+		consts.ExchangeIDbitget:      bitget.New(opts...),
+	}
+}
+
+// NewAccountManager - account sessions of the exchange, every session
+// & the shared market data streams adapter are created with the options
+func NewAccountManager(exchangeID int, opts ...Option) (*AccountManager, error) {
+	if _, err := CreateAdapter(exchangeID, opts...); err != nil {
+		return nil, err
+	}
+
+	return accounts.New(func() Adapter {
+		a, _ := CreateAdapter(exchangeID, opts...)
+		return a
+	})
+}
@@ -1,6 +1,9 @@
 package errs
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrOrderFilled                 = errors.New("order has been filled")
@@ -10,7 +13,26 @@ var (
 	ErrMinimumTP                   = errors.New("minimum TP order not passed")
 	ErrAPIKeyInvalid               = errors.New("invalid API key: please check your API key or renew it")
 	ErrAPIKeyNotSet                = errors.New("please set your API key")
+	ErrAccountTypeNotSupported     = errors.New("account type is not supported by the exchange")
+	ErrInsufficientBalance         = errors.New("insufficient balance")
+	ErrTransferLimitExceeded       = errors.New("transfer amount exceeds the limit")
+	ErrNotSupported                = errors.New("not supported by the exchange")
+	ErrAPIKeyPermissionDenied      = errors.New("API key permission is not granted")
 
 	// ErrOrderDataNotActual returned when it is necessary to search for order data in history
 	ErrOrderDataNotActual = errors.New("order data not actual")
 )
+
+// NotSupportedError - the feature is outside of the adapter capabilities.
+// It matches ErrNotSupported with errors.Is
+type NotSupportedError struct {
+	Feature string
+}
+
+func (e *NotSupportedError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Feature, ErrNotSupported.Error())
+}
+
+func (e *NotSupportedError) Is(target error) bool {
+	return target == ErrNotSupported
+}
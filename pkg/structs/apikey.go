@@ -0,0 +1,43 @@
+package structs
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+// APIKeyInfo - API key permissions & restrictions as reported by the exchange
+type APIKeyInfo struct {
+	// Permissions - granted permissions
+	Permissions []APIKeyPermission `json:"permissions"`
+	// Unknown - permissions the exchange doesn't report, e.g. Gate API v4
+	// reports the IP whitelist only
+	Unknown []APIKeyPermission `json:"unknown,omitempty"`
+	// IsReadOnly - the key can't trade, transfer or withdraw.
+	// It's false when the permissions are unknown
+	IsReadOnly bool `json:"isReadOnly"`
+	// IsIPRestricted - the key works from the whitelisted IPs only
+	IsIPRestricted bool     `json:"isIPRestricted"`
+	IPWhitelist    []string `json:"ipWhitelist,omitempty"`
+	// ExpiresAt - unix timestamp ms. Zero when the key doesn't expire
+	// or the exchange doesn't report the expiry date
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+}
+
+func (i APIKeyInfo) HasPermission(permission APIKeyPermission) bool {
+	return slices.Contains(i.Permissions, permission)
+}
+
+func (i APIKeyInfo) IsPermissionKnown(permission APIKeyPermission) bool {
+	return !slices.Contains(i.Unknown, permission)
+}
+
+// CheckPermission - check the permission is granted.
+// The unknown permission is not checked, the exchange will reject the request
+func (i APIKeyInfo) CheckPermission(permission APIKeyPermission) error {
+	if !i.IsPermissionKnown(permission) || i.HasPermission(permission) {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", permission, errs.ErrAPIKeyPermissionDenied)
+}
@@ -0,0 +1,46 @@
+package structs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+func TestCheckPermissionGranted(t *testing.T) {
+	// given
+	info := APIKeyInfo{Permissions: []APIKeyPermission{APIKeyPermissionSpotTrade}}
+
+	// when
+	err := info.CheckPermission(APIKeyPermissionSpotTrade)
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestCheckPermissionDenied(t *testing.T) {
+	// given
+	info := APIKeyInfo{Permissions: []APIKeyPermission{APIKeyPermissionWithdraw}}
+
+	// when
+	err := info.CheckPermission(APIKeyPermissionSpotTrade)
+
+	// then
+	require.ErrorIs(t, err, errs.ErrAPIKeyPermissionDenied)
+	assert.Contains(t, err.Error(), string(APIKeyPermissionSpotTrade))
+}
+
+func TestCheckPermissionUnknown(t *testing.T) {
+	// given
+	info := APIKeyInfo{Unknown: []APIKeyPermission{APIKeyPermissionSpotTrade}}
+
+	// when
+	err := info.CheckPermission(APIKeyPermissionSpotTrade)
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, info.IsPermissionKnown(APIKeyPermissionSpotTrade))
+	assert.True(t, info.IsPermissionKnown(APIKeyPermissionWithdraw))
+}
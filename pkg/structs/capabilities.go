@@ -0,0 +1,70 @@
+package structs
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+// AdapterCapabilities - features supported by the exchange adapter.
+// The adapter returns errs.ErrNotSupported for anything outside it
+type AdapterCapabilities struct {
+	OrderTypes  []OrderType   `json:"orderTypes"`
+	TimeInForce []TimeInForce `json:"timeInForce"`
+	// BatchOrders - several orders are placed or cancelled by one request
+	BatchOrders bool `json:"batchOrders"`
+	// OrderHistory - order data with fees is available by GetHistoryOrder
+	OrderHistory bool `json:"orderHistory"`
+	// AccountTrades - account fills are available by GetAccountTrades
+	AccountTrades bool `json:"accountTrades"`
+	// FeeLookup - account fee rates & order fees are available
+	FeeLookup bool              `json:"feeLookup"`
+	Intervals []consts.Interval `json:"intervals"`
+	Streams   []StreamType      `json:"streams"`
+	// OrderIDFormat - how the exchange order ID is mapped to int64
+	OrderIDFormat OrderIDFormat `json:"orderIDFormat"`
+}
+
+func (c AdapterCapabilities) SupportsOrderType(orderType OrderType) bool {
+	return slices.Contains(c.OrderTypes, orderType)
+}
+
+func (c AdapterCapabilities) SupportsTimeInForce(timeInForce TimeInForce) bool {
+	return slices.Contains(c.TimeInForce, timeInForce)
+}
+
+func (c AdapterCapabilities) SupportsInterval(interval consts.Interval) bool {
+	return slices.Contains(c.Intervals, interval)
+}
+
+func (c AdapterCapabilities) SupportsStream(stream StreamType) bool {
+	return slices.Contains(c.Streams, stream)
+}
+
+// CheckOrder - check the order type is supported
+func (c AdapterCapabilities) CheckOrder(order structs.BotOrderAdjusted) error {
+	orderType := GetOrderType(order)
+	if !c.SupportsOrderType(orderType) {
+		return &errs.NotSupportedError{Feature: fmt.Sprintf("%s orders", orderType)}
+	}
+	return nil
+}
+
+// CheckInterval - check the candles interval is supported
+func (c AdapterCapabilities) CheckInterval(interval consts.Interval) error {
+	if !c.SupportsInterval(interval) {
+		return &errs.NotSupportedError{Feature: fmt.Sprintf("interval %q", interval)}
+	}
+	return nil
+}
+
+// GetOrderType - limit or market order type of the bot order
+func GetOrderType(order structs.BotOrderAdjusted) OrderType {
+	if order.IsMarketOrder {
+		return OrderTypeMarket
+	}
+	return OrderTypeLimit
+}
@@ -0,0 +1,58 @@
+package structs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+func TestCheckOrderMarketNotSupported(t *testing.T) {
+	// given
+	capabilities := AdapterCapabilities{OrderTypes: []OrderType{OrderTypeLimit}}
+
+	// when
+	err := capabilities.CheckOrder(structs.BotOrderAdjusted{IsMarketOrder: true})
+
+	// then
+	require.ErrorIs(t, err, errs.ErrNotSupported)
+	var notSupportedErr *errs.NotSupportedError
+	require.True(t, errors.As(err, &notSupportedErr))
+	assert.Equal(t, "market orders", notSupportedErr.Feature)
+}
+
+func TestCheckOrderLimit(t *testing.T) {
+	// given
+	capabilities := AdapterCapabilities{OrderTypes: []OrderType{OrderTypeLimit}}
+
+	// when
+	err := capabilities.CheckOrder(structs.BotOrderAdjusted{})
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestCheckInterval(t *testing.T) {
+	// given
+	capabilities := AdapterCapabilities{
+		Intervals: []consts.Interval{consts.Interval1min, consts.Interval1hour},
+	}
+
+	// then
+	require.NoError(t, capabilities.CheckInterval(consts.Interval1hour))
+	require.ErrorIs(t, capabilities.CheckInterval(consts.Interval8hour), errs.ErrNotSupported)
+}
+
+func TestSupportsStream(t *testing.T) {
+	// given
+	capabilities := AdapterCapabilities{Streams: []StreamType{StreamCandles}}
+
+	// then
+	assert.True(t, capabilities.SupportsStream(StreamCandles))
+	assert.False(t, capabilities.SupportsStream(StreamPositions))
+}
@@ -30,3 +30,31 @@ const (
 	BotStrategyLong  BotStrategy = "long"
 	BotStrategyShort BotStrategy = "short"
 )
+
+type (
+	OrderType     = consts.OrderType
+	TimeInForce   = consts.TimeInForce
+	StreamType    = consts.StreamType
+	OrderIDFormat = consts.OrderIDFormat
+)
+
+const (
+	OrderTypeLimit       = consts.OrderTypeLimit
+	OrderTypeMarket      = consts.OrderTypeMarket
+	TimeInForceGTC       = consts.TimeInForceGTC
+	StreamCandles        = consts.StreamCandles
+	StreamPublicTrades   = consts.StreamPublicTrades
+	StreamAccountTrades  = consts.StreamAccountTrades
+	StreamPositions      = consts.StreamPositions
+	OrderIDFormatNumeric = consts.OrderIDFormatNumeric
+	OrderIDFormatAlias   = consts.OrderIDFormatAlias
+)
+
+type APIKeyPermission = consts.APIKeyPermission
+
+const (
+	APIKeyPermissionSpotTrade    = consts.APIKeyPermissionSpotTrade
+	APIKeyPermissionMarginTrade  = consts.APIKeyPermissionMarginTrade
+	APIKeyPermissionFuturesTrade = consts.APIKeyPermissionFuturesTrade
+	APIKeyPermissionWithdraw     = consts.APIKeyPermissionWithdraw
+)
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	v1structs "github.com/matrixbotio/exchange-gates-lib/internal/structs/v1"
 )
 
 // APICredentialsTypeKeypair - public and private key pair
@@ -29,14 +30,16 @@ type WorkerChannels struct {
 
 // CheckOrdersResponse - data on checked and restored orders
 type CheckOrdersResponse struct {
-	ExecutedOrders  []structs.OrderData
-	CancelledOrders []structs.OrderData
+	ExecutedOrders  []v1structs.OrderData
+	CancelledOrders []v1structs.OrderData
 }
 
 // APIKeypair - data for authorization via public and private keys
 type APIKeypair struct {
 	Public string `json:"public"`
 	Secret string `json:"secret"`
+	// Passphrase - set on the API key creation, required by some exchanges, e.g. OKX
+	Passphrase string `json:"passphrase,omitempty"`
 }
 
 func (k APIKeypair) IsSet() bool {
@@ -0,0 +1,33 @@
+package structs
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+)
+
+// BotOrder - structure containing information about the order calculated by the bot
+type BotOrder struct {
+	// required
+	PairSymbol string           `json:"pair"`
+	Type       consts.OrderSide `json:"type"`
+	Qty        decimal.Decimal  `json:"qty"`
+	Price      decimal.Decimal  `json:"price"`
+	Deposit    decimal.Decimal  `json:"deposit"`
+
+	// optional
+	ClientOrderID string `json:"clientOrderID"`
+}
+
+func (o BotOrder) IsEmpty() bool {
+	return o.Qty.IsZero()
+}
+
+func (o BotOrder) String() string {
+	return fmt.Sprintf(
+		"order: %q price %s, qty %s, pair %q",
+		o.Type, o.Price.String(), o.Qty.String(), o.PairSymbol,
+	)
+}
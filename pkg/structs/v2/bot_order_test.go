@@ -0,0 +1,26 @@
+package structs
+
+import (
+	"testing"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBotOrderToString(t *testing.T) {
+	// given
+	order := BotOrder{
+		PairSymbol: "LTCUSDC",
+		Type:       consts.OrderSideBuy,
+		Qty:        decimal.NewFromFloat(0.1),
+		Price:      decimal.NewFromInt(65),
+	}
+
+	// when
+	result := order.String()
+
+	// then
+	assert.NotEmpty(t, result)
+	assert.Contains(t, result, order.PairSymbol)
+}
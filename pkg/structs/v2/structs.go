@@ -0,0 +1,81 @@
+// Package structs - v2 public structs: prices, quantities & balances are decimal.
+// The types without the float values are the same as in v1 pkg/structs
+package structs
+
+import (
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	v1 "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+)
+
+// CheckOrdersResponse - data on checked and restored orders
+type CheckOrdersResponse struct {
+	ExecutedOrders  []structs.OrderData
+	CancelledOrders []structs.OrderData
+}
+
+type (
+	APICredentialsType  = v1.APICredentialsType
+	APICredentials      = v1.APICredentials
+	APIKeypair          = v1.APIKeypair
+	APIKeyInfo          = v1.APIKeyInfo
+	APIKeyPermission    = v1.APIKeyPermission
+	WorkerChannels      = v1.WorkerChannels
+	BotStrategy         = v1.BotStrategy
+	ExchangeLimits      = v1.ExchangeLimits
+	AdapterCapabilities = v1.AdapterCapabilities
+	OrderStatus         = v1.OrderStatus
+	OrderSide           = v1.OrderSide
+	OrderType           = v1.OrderType
+	TimeInForce         = v1.TimeInForce
+	StreamType          = v1.StreamType
+	OrderIDFormat       = v1.OrderIDFormat
+)
+
+var (
+	APICredentialsTypeKeypair = v1.APICredentialsTypeKeypair
+	GetOrderType              = v1.GetOrderType
+)
+
+const (
+	OrderStatusNew                      = v1.OrderStatusNew
+	OrderStatusPartiallyFilled          = v1.OrderStatusPartiallyFilled
+	OrderStatusPartiallyFilledCancelled = v1.OrderStatusPartiallyFilledCancelled
+	OrderStatusFilled                   = v1.OrderStatusFilled
+	OrderStatusCancelled                = v1.OrderStatusCancelled
+	OrderStatusPendingCancel            = v1.OrderStatusPendingCancel
+	OrderStatusRejected                 = v1.OrderStatusRejected
+	OrderStatusExpired                  = v1.OrderStatusExpired
+	OrderStatusUnknown                  = v1.OrderStatusUnknown
+	OrderStatusUntriggered              = v1.OrderStatusUntriggered
+	OrderStatusTriggered                = v1.OrderStatusTriggered
+	OrderStatusDeactivated              = v1.OrderStatusDeactivated
+)
+
+const (
+	OrderSideBuy  = v1.OrderSideBuy
+	OrderSideSell = v1.OrderSideSell
+)
+
+const (
+	BotStrategyLong  = v1.BotStrategyLong
+	BotStrategyShort = v1.BotStrategyShort
+)
+
+const (
+	OrderTypeLimit       = v1.OrderTypeLimit
+	OrderTypeMarket      = v1.OrderTypeMarket
+	TimeInForceGTC       = v1.TimeInForceGTC
+	StreamCandles        = v1.StreamCandles
+	StreamPublicTrades   = v1.StreamPublicTrades
+	StreamAccountTrades  = v1.StreamAccountTrades
+	StreamPositions      = v1.StreamPositions
+	OrderIDFormatNumeric = v1.OrderIDFormatNumeric
+	OrderIDFormatAlias   = v1.OrderIDFormatAlias
+)
+
+const (
+	APIKeyPermissionSpotTrade    = v1.APIKeyPermissionSpotTrade
+	APIKeyPermissionMarginTrade  = v1.APIKeyPermissionMarginTrade
+	APIKeyPermissionFuturesTrade = v1.APIKeyPermissionFuturesTrade
+	APIKeyPermissionWithdraw     = v1.APIKeyPermissionWithdraw
+)
@@ -1,6 +1,6 @@
 package utils
 
-import "github.com/matrixbotio/exchange-gates-lib/internal/structs"
+import structs "github.com/matrixbotio/exchange-gates-lib/internal/structs/v1"
 
 func FindPairBalance(
 	balances []structs.Balance,
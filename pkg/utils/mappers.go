@@ -5,8 +5,9 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	structs "github.com/matrixbotio/exchange-gates-lib/internal/structs/v1"
 	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
+	v2structs "github.com/matrixbotio/exchange-gates-lib/pkg/structs/v2"
 )
 
 // OrderDataToTradeEvent data
@@ -80,3 +81,14 @@ func OrderToOrderResponse(order structs.BotOrderAdjusted, orderID int64) (
 	}
 	return data, nil
 }
+
+func botOrderToV1(order v2structs.BotOrder) pkgStructs.BotOrder {
+	return pkgStructs.BotOrder{
+		PairSymbol:    order.PairSymbol,
+		Type:          order.Type,
+		Qty:           order.Qty.InexactFloat64(),
+		Price:         order.Price.InexactFloat64(),
+		Deposit:       order.Deposit.InexactFloat64(),
+		ClientOrderID: order.ClientOrderID,
+	}
+}
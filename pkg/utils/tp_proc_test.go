@@ -4,7 +4,7 @@ import (
 	"testing"
 
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
-	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	structs "github.com/matrixbotio/exchange-gates-lib/internal/structs/v1"
 	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -614,43 +614,3 @@ func TestCalcTPShort2(t *testing.T) {
 	)
 	assert.NotEmpty(t, order.ClientOrderID)
 }
-
-func TestRoundQtyDown(t *testing.T) {
-	// given
-	pairData := structs.ExchangePairData{
-		Symbol:    "BNBUSDT",
-		QtyStep:   0.001,
-		PriceStep: 0.1,
-	}
-
-	val := decimal.NewFromFloat(0.00899325)
-
-	proc := NewCalcTPOrderProcessor().
-		PairData(pairData)
-
-		// when
-	result := proc.roundQtyDown(val)
-
-	// then
-	assert.Equal(t, 0.008, result.InexactFloat64())
-}
-
-func TestRoundQtyUp(t *testing.T) {
-	// given
-	pairData := structs.ExchangePairData{
-		Symbol:    "BNBUSDT",
-		QtyStep:   0.001,
-		PriceStep: 0.1,
-	}
-
-	val := decimal.NewFromFloat(0.00899325)
-
-	proc := NewCalcTPOrderProcessor().
-		PairData(pairData)
-
-		// when
-	result := proc.roundQtyUp(val)
-
-	// then
-	assert.Equal(t, 0.009, result.InexactFloat64())
-}
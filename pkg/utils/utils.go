@@ -1,72 +1,32 @@
+// Package utils - v1 utils with float64 prices & quantities.
+// See pkg/utils/v2 for the decimal ones
 package utils
 
 import (
-	"encoding/json"
-	"errors"
 	"fmt"
-	"log"
-	"math"
 	"strconv"
-	"time"
 
-	"github.com/google/uuid"
 	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
-	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	structs "github.com/matrixbotio/exchange-gates-lib/internal/structs/v1"
 	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs"
-	"github.com/shopspring/decimal"
+	v2utils "github.com/matrixbotio/exchange-gates-lib/pkg/utils/v2"
 )
 
-const (
-	defaultCheckOrdersTimeout = time.Second * 30
+// the utils without float values are the same in v1 & v2
+var (
+	GetCheckOrdersTimeout = v2utils.GetCheckOrdersTimeout
+	GenerateUUID          = v2utils.GenerateUUID
+	GetFloatPrecision     = v2utils.GetFloatPrecision
+	RoundFloatFloor       = v2utils.RoundFloatFloor
+	GetValueStep          = v2utils.GetValueStep
+	PrintObject           = v2utils.PrintObject
+	StringPointer         = v2utils.StringPointer
+	RoundAmount           = v2utils.RoundAmount
+	GetRandomString       = v2utils.GetRandomString
+	GenClientOrderID      = v2utils.GenClientOrderID
+	GetTPOrderType        = v2utils.GetTPOrderType
 )
 
-func GetCheckOrdersTimeout(exchangeID int) time.Duration {
-	switch exchangeID {
-	default:
-		return defaultCheckOrdersTimeout
-	case consts.ExchangeIDbinanceSpot:
-		return consts.CheckOrdersTimeoutBinance
-	case consts.ExchangeIDbybitSpot:
-		return consts.CheckOrdersTimeoutBybit
-	case consts.ExchangeIDbingx:
-		return consts.CheckOrdersTimeoutBings
-	case consts.ExchangeIDgateSpot:
-		return consts.CheckOrdersTimeoutGate
-	}
-}
-
-func GenerateUUID() string {
-	return uuid.New().String()
-}
-
-// GetFloatPrecision returns the number of decimal places in a float
-func GetFloatPrecision(value float64) int {
-	precision := 0
-	for {
-		rounded := math.Round(value*math.Pow(10, float64(precision))) / math.Pow(10, float64(precision))
-		if value == rounded {
-			break
-		}
-		precision++
-	}
-	return precision
-}
-
-func roundFloatToDecimal(val float64, precision int) decimal.Decimal {
-	return decimal.NewFromFloat(val).RoundFloor(int32(precision))
-}
-
-func RoundFloatFloor(val float64, precision int) (float64, error) {
-	if math.IsNaN(val) {
-		return 0, errors.New("value is NaN")
-	}
-	if math.IsInf(val, 0) {
-		return 0, errors.New("value is Inf")
-	}
-
-	return roundFloatToDecimal(val, precision).InexactFloat64(), nil
-}
-
 // ParseAdjustedOrder - parse rounded order to bot order
 func ParseAdjustedOrder(order structs.BotOrderAdjusted) (pkgStructs.BotOrder, error) {
 	resultOrder := pkgStructs.BotOrder{
@@ -109,39 +69,3 @@ func GetDefaultPairData() structs.ExchangePairData {
 		AllowedSpot:   true,
 	}
 }
-
-func GetValueStep(minValue float64) float64 {
-	precision := GetFloatPrecision(minValue)
-	divisor := decimal.NewFromFloat(math.Pow(10, float64(precision)))
-	valueStep, _ := decimal.NewFromInt(1).Div(divisor).Float64()
-	return valueStep
-}
-
-func PrintObject(o any) {
-	data, err := json.MarshalIndent(o, "", "	")
-	if err != nil {
-		log.Fatalln(err)
-	}
-
-	fmt.Println(string(data))
-}
-
-func StringPointer(val string) *string {
-	return &val
-}
-
-func RoundAmount(
-	amount decimal.Decimal,
-	strategy string,
-	basePrecision int,
-	quotePrecision int,
-) decimal.Decimal {
-	var assetPrecision int
-	if strategy == string(pkgStructs.BotStrategyLong) {
-		assetPrecision = quotePrecision
-	} else {
-		assetPrecision = basePrecision
-	}
-
-	return amount.RoundFloor(int32(assetPrecision))
-}
@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+)
+
+// historyCandlesLimit - candles count available by one request on all the exchanges
+const historyCandlesLimit = 300
+
+// AssetPriceGetter returns the asset price in quote asset at the time, unix timestamp ms
+type AssetPriceGetter func(asset, quoteAsset string, timeMs int64) (decimal.Decimal, error)
+
+// NewLastPriceGetter returns the price getter that uses the current pair price,
+// the time is ignored. Suitable for the recently filled orders only,
+// see NewHistoryPriceGetter for the price at the fill time
+func NewLastPriceGetter(adapter adapters.Adapter) AssetPriceGetter {
+	return func(asset, quoteAsset string, _ int64) (decimal.Decimal, error) {
+		price, err := adapter.GetPairLastPrice(adapter.GetPairSymbol(asset, quoteAsset))
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("get %s%s price: %w", asset, quoteAsset, err)
+		}
+		return price, nil
+	}
+}
+
+// NewHistoryPriceGetter returns the price getter that uses the close price
+// of the pair candle containing the time. The smallest supported interval
+// covering the time by one candles request is used, e.g. 1m for the last 5 hours
+func NewHistoryPriceGetter(adapter adapters.Adapter) AssetPriceGetter {
+	return func(asset, quoteAsset string, timeMs int64) (decimal.Decimal, error) {
+		pairSymbol := adapter.GetPairSymbol(asset, quoteAsset)
+
+		interval, duration, limit, err := getHistoryInterval(
+			adapter.GetSupportedIntervals(),
+			time.Since(time.UnixMilli(timeMs)),
+		)
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("get %s price: %w", pairSymbol, err)
+		}
+
+		candles, err := adapter.GetCandles(limit, pairSymbol, interval)
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("get %s candles: %w", pairSymbol, err)
+		}
+
+		for _, candle := range candles {
+			if candle.StartTime <= timeMs && timeMs < candle.StartTime+duration.Milliseconds() {
+				return candle.Close, nil
+			}
+		}
+		return decimal.Zero, fmt.Errorf("%s %s candle not found at %d", pairSymbol, interval, timeMs)
+	}
+}
+
+// getHistoryInterval - the smallest interval covering the time ago by one candles request
+func getHistoryInterval(intervals []consts.Interval, ago time.Duration) (
+	consts.Interval,
+	time.Duration,
+	int,
+	error,
+) {
+	intervals = slices.Clone(intervals)
+	slices.SortFunc(intervals, func(a, b consts.Interval) int {
+		durationA, _ := consts.GetIntervalDuration(a)
+		durationB, _ := consts.GetIntervalDuration(b)
+		return cmp.Compare(durationA, durationB)
+	})
+
+	for _, interval := range intervals {
+		duration, isFixed := consts.GetIntervalDuration(interval)
+		if !isFixed {
+			continue
+		}
+
+		// the candle of the time & the current one
+		limit := int(max(ago, 0)/duration) + 2
+		if limit <= historyCandlesLimit {
+			return interval, duration, limit, nil
+		}
+	}
+	return "", 0, 0, fmt.Errorf("no candles interval covering %s ago", ago)
+}
+
+// GetFeesInQuote returns the order fees in quote asset equivalent.
+// Base asset fees are converted by the trade price,
+// the third asset fees are converted by the price getter.
+// The price getter is optional when all fees are paid in the pair assets.
+// Exchange points have no market price & are not included
+func GetFeesInQuote(
+	fees structs.OrderFees,
+	baseAsset string,
+	quoteAsset string,
+	getPrice AssetPriceGetter,
+) (decimal.Decimal, error) {
+	if len(fees.Commissions) == 0 {
+		if !fees.BaseAsset.IsZero() {
+			return decimal.Zero, errors.New("base asset fees can't be converted without commissions data")
+		}
+		return fees.QuoteAsset, nil
+	}
+
+	result := decimal.Zero
+	for _, commission := range fees.Commissions {
+		switch commission.Asset {
+		case quoteAsset:
+			result = result.Add(commission.Amount)
+		case baseAsset:
+			result = result.Add(commission.Amount.Mul(commission.Price))
+		default:
+			if getPrice == nil {
+				return decimal.Zero, fmt.Errorf(
+					"price getter is not set to convert %s fee", commission.Asset,
+				)
+			}
+
+			price, err := getPrice(commission.Asset, quoteAsset, commission.Time)
+			if err != nil {
+				return decimal.Zero, fmt.Errorf("get %s price: %w", commission.Asset, err)
+			}
+			result = result.Add(commission.Amount.Mul(price))
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,210 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/internal/workers"
+)
+
+const (
+	testFeesBaseAsset  = "LTC"
+	testFeesQuoteAsset = "USDT"
+	testFeesTradeTime  = int64(1714521600000)
+)
+
+func getTestCommissions() []structs.Commission {
+	return []structs.Commission{
+		{
+			Asset:  testFeesBaseAsset,
+			Amount: decimal.NewFromFloat(0.001),
+			Price:  decimal.NewFromInt(80),
+			Time:   testFeesTradeTime,
+		},
+		{
+			Asset:  testFeesQuoteAsset,
+			Amount: decimal.NewFromFloat(0.05),
+			Price:  decimal.NewFromInt(81),
+			Time:   testFeesTradeTime,
+		},
+		{
+			Asset:  "BNB",
+			Amount: decimal.NewFromFloat(0.0002),
+			Price:  decimal.NewFromInt(81),
+			Time:   testFeesTradeTime,
+		},
+	}
+}
+
+func TestGetFeesInQuote(t *testing.T) {
+	// given
+	fees := structs.OrderFees{
+		BaseAsset:   decimal.NewFromFloat(0.001),
+		QuoteAsset:  decimal.NewFromFloat(0.05),
+		Commissions: getTestCommissions(),
+	}
+
+	var requestedTime int64
+	getPrice := func(asset, quoteAsset string, timeMs int64) (decimal.Decimal, error) {
+		requestedTime = timeMs
+		return decimal.NewFromInt(500), nil
+	}
+
+	// when
+	result, err := GetFeesInQuote(fees, testFeesBaseAsset, testFeesQuoteAsset, getPrice)
+
+	// then
+	require.NoError(t, err)
+	// 0.001 * 80 + 0.05 + 0.0002 * 500
+	assert.Equal(t, "0.23", result.String())
+	assert.Equal(t, testFeesTradeTime, requestedTime)
+}
+
+func TestGetFeesInQuotePriceGetterNotSet(t *testing.T) {
+	// given
+	fees := structs.OrderFees{
+		Commissions: getTestCommissions(),
+	}
+
+	// when
+	_, err := GetFeesInQuote(fees, testFeesBaseAsset, testFeesQuoteAsset, nil)
+
+	// then
+	require.ErrorContains(t, err, "price getter is not set")
+}
+
+func TestGetFeesInQuotePriceError(t *testing.T) {
+	// given
+	fees := structs.OrderFees{
+		Commissions: getTestCommissions(),
+	}
+	getPrice := func(asset, quoteAsset string, timeMs int64) (decimal.Decimal, error) {
+		return decimal.Zero, errors.New("pair not found")
+	}
+
+	// when
+	_, err := GetFeesInQuote(fees, testFeesBaseAsset, testFeesQuoteAsset, getPrice)
+
+	// then
+	require.ErrorContains(t, err, "pair not found")
+}
+
+func TestGetFeesInQuoteNoCommissions(t *testing.T) {
+	// given
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.Zero,
+		QuoteAsset: decimal.NewFromFloat(0.05),
+	}
+
+	// when
+	result, err := GetFeesInQuote(fees, testFeesBaseAsset, testFeesQuoteAsset, nil)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.05", result.String())
+}
+
+func TestGetFeesInQuoteNoCommissionsBaseAsset(t *testing.T) {
+	// given
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.NewFromFloat(0.001),
+		QuoteAsset: decimal.Zero,
+	}
+
+	// when
+	_, err := GetFeesInQuote(fees, testFeesBaseAsset, testFeesQuoteAsset, nil)
+
+	// then
+	require.Error(t, err)
+}
+
+func TestLastPriceGetter(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetPairSymbol("BNB", testFeesQuoteAsset).Return("BNBUSDT")
+	a.EXPECT().GetPairLastPrice("BNBUSDT").Return(decimal.NewFromInt(500), nil)
+
+	getPrice := NewLastPriceGetter(a)
+
+	// when
+	price, err := getPrice("BNB", testFeesQuoteAsset, testFeesTradeTime)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "500", price.String())
+}
+
+func TestHistoryPriceGetter(t *testing.T) {
+	// given
+	tradeTime := time.Now().Add(-90 * time.Minute).Truncate(time.Minute).Add(30 * time.Second)
+	candleStart := tradeTime.Truncate(time.Minute).UnixMilli()
+
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetPairSymbol("BNB", testFeesQuoteAsset).Return("BNBUSDT")
+	a.EXPECT().GetSupportedIntervals().
+		Return([]consts.Interval{consts.Interval1hour, consts.Interval1min})
+	a.EXPECT().GetCandles(gomock.Any(), "BNBUSDT", consts.Interval1min).
+		DoAndReturn(func(limit int, _ string, _ consts.Interval) ([]workers.CandleData, error) {
+			assert.GreaterOrEqual(t, limit, 91)
+			return []workers.CandleData{
+				{StartTime: candleStart - time.Minute.Milliseconds(), Close: decimal.NewFromInt(490)},
+				{StartTime: candleStart, Close: decimal.NewFromInt(500)},
+				{StartTime: candleStart + time.Minute.Milliseconds(), Close: decimal.NewFromInt(510)},
+			}, nil
+		})
+
+	getPrice := NewHistoryPriceGetter(a)
+
+	// when
+	price, err := getPrice("BNB", testFeesQuoteAsset, tradeTime.UnixMilli())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "500", price.String())
+}
+
+func TestHistoryPriceGetterBiggerInterval(t *testing.T) {
+	// given
+	tradeTime := time.Now().Add(-48 * time.Hour)
+
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetPairSymbol("BNB", testFeesQuoteAsset).Return("BNBUSDT")
+	a.EXPECT().GetSupportedIntervals().
+		Return([]consts.Interval{consts.Interval1min, consts.Interval5min, consts.Interval1hour})
+	a.EXPECT().GetCandles(50, "BNBUSDT", consts.Interval1hour).Return(nil, nil)
+
+	getPrice := NewHistoryPriceGetter(a)
+
+	// when
+	_, err := getPrice("BNB", testFeesQuoteAsset, tradeTime.UnixMilli())
+
+	// then
+	require.ErrorContains(t, err, "candle not found")
+}
+
+func TestHistoryPriceGetterTooOld(t *testing.T) {
+	// given
+	tradeTime := time.Now().Add(-365 * 24 * time.Hour)
+
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetPairSymbol("BNB", testFeesQuoteAsset).Return("BNBUSDT")
+	a.EXPECT().GetSupportedIntervals().
+		Return([]consts.Interval{consts.Interval1min, consts.Interval1hour})
+
+	getPrice := NewHistoryPriceGetter(a)
+
+	// when
+	_, err := getPrice("BNB", testFeesQuoteAsset, tradeTime.UnixMilli())
+
+	// then
+	require.ErrorContains(t, err, "no candles interval")
+}
@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs/v2"
+	"github.com/shopspring/decimal"
+)
+
+// OrderAdjuster converts bot order to the order with values valid for the exchange pair
+type OrderAdjuster struct {
+	order            pkgStructs.BotOrder
+	pairData         structs.ExchangePairData
+	bumpToMinDeposit bool
+}
+
+func NewOrderAdjuster() *OrderAdjuster {
+	return &OrderAdjuster{}
+}
+
+func (s *OrderAdjuster) Order(order pkgStructs.BotOrder) *OrderAdjuster {
+	s.order = order
+	return s
+}
+
+func (s *OrderAdjuster) PairData(pairData structs.ExchangePairData) *OrderAdjuster {
+	s.pairData = pairData
+	return s
+}
+
+// BumpToMinDeposit - increase order qty to pass pair min qty & min deposit
+func (s *OrderAdjuster) BumpToMinDeposit() *OrderAdjuster {
+	s.bumpToMinDeposit = true
+	return s
+}
+
+func (s *OrderAdjuster) checkParams() error {
+	if !s.order.Qty.IsPositive() {
+		return fmt.Errorf("invalid order qty (%s)", s.order.Qty.String())
+	}
+	if !s.order.Price.IsPositive() {
+		return fmt.Errorf("invalid order price (%s)", s.order.Price.String())
+	}
+	if s.pairData.IsEmpty() {
+		return errors.New("pair data is not set")
+	}
+	if s.pairData.QtyStep.IsZero() {
+		return errors.New("invalid qty step value (0)")
+	}
+	if s.pairData.PriceStep.IsZero() {
+		return errors.New("invalid price step value (0)")
+	}
+	return nil
+}
+
+func (s *OrderAdjuster) Do() (structs.BotOrderAdjusted, error) {
+	if err := s.checkParams(); err != nil {
+		return structs.BotOrderAdjusted{}, fmt.Errorf("check params: %w", err)
+	}
+
+	price := floorToStep(s.order.Price, s.pairData.PriceStep)
+	if price.LessThan(s.pairData.MinPrice) || price.IsZero() {
+		return structs.BotOrderAdjusted{}, fmt.Errorf(
+			"too low price (%s with a minimum of %s)",
+			price.String(),
+			s.pairData.MinPrice.String(),
+		)
+	}
+
+	qty := floorToStep(s.order.Qty, s.pairData.QtyStep)
+	if s.bumpToMinDeposit {
+		qty = s.bumpQty(qty, price)
+	}
+
+	if s.pairData.MaxQty.IsPositive() && qty.GreaterThan(s.pairData.MaxQty) {
+		return structs.BotOrderAdjusted{}, fmt.Errorf(
+			"too many coins (%s %s with a max of %s %s)",
+			qty.String(),
+			s.pairData.BaseAsset,
+			s.pairData.MaxQty.String(),
+			s.pairData.BaseAsset,
+		)
+	}
+
+	deposit := qty.Mul(price)
+	qtyPrecision := int32(GetDecimalPrecision(s.pairData.QtyStep))
+	pricePrecision := int32(GetDecimalPrecision(s.pairData.PriceStep))
+
+	return structs.BotOrderAdjusted{
+		PairSymbol:       s.order.PairSymbol,
+		Type:             s.order.Type,
+		Qty:              qty.StringFixed(qtyPrecision),
+		Price:            price.StringFixed(pricePrecision),
+		Deposit:          deposit.StringFixed(qtyPrecision + pricePrecision),
+		ClientOrderID:    s.order.ClientOrderID,
+		MinQty:           s.pairData.MinQty,
+		MinQtyPassed:     qty.GreaterThanOrEqual(s.pairData.MinQty) && qty.IsPositive(),
+		MinDeposit:       s.pairData.MinDeposit,
+		MinDepositPassed: deposit.GreaterThanOrEqual(s.pairData.MinDeposit),
+	}, nil
+}
+
+// bumpQty returns the smallest qty not less than the given one
+// that passes the pair min qty & min deposit
+func (s *OrderAdjuster) bumpQty(qty, price decimal.Decimal) decimal.Decimal {
+	minQty := ceilToStep(s.pairData.MinQty, s.pairData.QtyStep)
+	if qty.LessThan(minQty) {
+		qty = minQty
+	}
+
+	if qty.Mul(price).LessThan(s.pairData.MinDeposit) {
+		qty = ceilToStep(s.pairData.MinDeposit.Div(price), s.pairData.QtyStep)
+	}
+	return qty
+}
+
+func floorToStep(value, step decimal.Decimal) decimal.Decimal {
+	return value.Div(step).Floor().Mul(step)
+}
+
+func ceilToStep(value, step decimal.Decimal) decimal.Decimal {
+	return value.Div(step).Ceil().Mul(step)
+}
@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs/v2"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestAdjusterPairData() structs.ExchangePairData {
+	return structs.ExchangePairData{
+		Symbol:     "LTCUSDT",
+		BaseAsset:  "LTC",
+		QuoteAsset: "USDT",
+		MinQty:     decimal.NewFromFloat(0.01),
+		MaxQty:     decimal.NewFromInt(1000),
+		MinDeposit: decimal.NewFromInt(5),
+		MinPrice:   decimal.NewFromFloat(0.01),
+		QtyStep:    decimal.NewFromFloat(0.001),
+		PriceStep:  decimal.NewFromFloat(0.01),
+	}
+}
+
+func TestOrderAdjusterRoundsToSteps(t *testing.T) {
+	// given
+	order := pkgStructs.BotOrder{
+		PairSymbol:    "LTCUSDT",
+		Type:          consts.OrderSideBuy,
+		Qty:           decimal.NewFromFloat(0.12345),
+		Price:         decimal.NewFromFloat(80.129),
+		ClientOrderID: "test",
+	}
+
+	// when
+	adjusted, err := NewOrderAdjuster().
+		Order(order).
+		PairData(getTestAdjusterPairData()).
+		Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.123", adjusted.Qty)
+	assert.Equal(t, "80.12", adjusted.Price)
+	assert.Equal(t, "9.85476", adjusted.Deposit)
+	assert.Equal(t, order.ClientOrderID, adjusted.ClientOrderID)
+	assert.Equal(t, consts.OrderSideBuy, adjusted.Type)
+	assert.True(t, adjusted.MinQtyPassed)
+	assert.True(t, adjusted.MinDepositPassed)
+}
+
+func TestOrderAdjusterMinDepositNotPassed(t *testing.T) {
+	// given
+	order := pkgStructs.BotOrder{
+		PairSymbol: "LTCUSDT",
+		Type:       consts.OrderSideSell,
+		Qty:        decimal.NewFromFloat(0.05),
+		Price:      decimal.NewFromInt(80),
+	}
+
+	// when
+	adjusted, err := NewOrderAdjuster().
+		Order(order).
+		PairData(getTestAdjusterPairData()).
+		Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.050", adjusted.Qty)
+	assert.True(t, adjusted.MinQtyPassed)
+	assert.False(t, adjusted.MinDepositPassed)
+	assert.True(t, adjusted.MinDeposit.Equal(decimal.NewFromInt(5)))
+}
+
+func TestOrderAdjusterBumpToMinDeposit(t *testing.T) {
+	// given
+	order := pkgStructs.BotOrder{
+		PairSymbol: "LTCUSDT",
+		Type:       consts.OrderSideBuy,
+		Qty:        decimal.NewFromFloat(0.005),
+		Price:      decimal.NewFromInt(80),
+	}
+
+	// when
+	adjusted, err := NewOrderAdjuster().
+		Order(order).
+		PairData(getTestAdjusterPairData()).
+		BumpToMinDeposit().
+		Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.063", adjusted.Qty)
+	assert.Equal(t, "5.04000", adjusted.Deposit)
+	assert.True(t, adjusted.MinQtyPassed)
+	assert.True(t, adjusted.MinDepositPassed)
+}
+
+func TestOrderAdjusterMaxQtyError(t *testing.T) {
+	// given
+	order := pkgStructs.BotOrder{
+		PairSymbol: "LTCUSDT",
+		Qty:        decimal.NewFromInt(1500),
+		Price:      decimal.NewFromInt(80),
+	}
+
+	// when
+	_, err := NewOrderAdjuster().
+		Order(order).
+		PairData(getTestAdjusterPairData()).
+		Do()
+
+	// then
+	require.ErrorContains(t, err, "too many coins")
+}
+
+func TestOrderAdjusterMinPriceError(t *testing.T) {
+	// given
+	pairData := getTestAdjusterPairData()
+	pairData.MinPrice = decimal.NewFromInt(1)
+
+	order := pkgStructs.BotOrder{
+		PairSymbol: "LTCUSDT",
+		Qty:        decimal.NewFromInt(1),
+		Price:      decimal.NewFromFloat(0.5),
+	}
+
+	// when
+	_, err := NewOrderAdjuster().
+		Order(order).
+		PairData(pairData).
+		Do()
+
+	// then
+	require.ErrorContains(t, err, "too low price")
+}
+
+func TestOrderAdjusterEmptyPairData(t *testing.T) {
+	// given
+	order := pkgStructs.BotOrder{Qty: decimal.NewFromInt(1), Price: decimal.NewFromInt(1)}
+
+	// when
+	_, err := NewOrderAdjuster().Order(order).Do()
+
+	// then
+	require.ErrorContains(t, err, "pair data is not set")
+}
@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs/v2"
+)
+
+func GetTPOrderType(strategy pkgStructs.BotStrategy) pkgStructs.OrderSide {
+	if strategy == pkgStructs.BotStrategyLong {
+		return consts.OrderSideSell
+	}
+	return consts.OrderSideBuy
+}
+
+// GetMarginSideEffect returns the margin order side effect for the bot strategy:
+// the entry orders borrow the missing asset, the TP order repays the debt.
+// E.g. the short strategy sells borrowed base asset and buys it back on TP
+func GetMarginSideEffect(
+	strategy pkgStructs.BotStrategy,
+	orderSide pkgStructs.OrderSide,
+) consts.MarginSideEffect {
+	if orderSide == GetTPOrderType(strategy) {
+		return consts.MarginSideEffectAutoRepay
+	}
+	return consts.MarginSideEffectAutoBorrow
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs/v2"
+)
+
+func TestGetMarginSideEffectShort(t *testing.T) {
+	assert.Equal(
+		t,
+		consts.MarginSideEffectAutoBorrow,
+		GetMarginSideEffect(pkgStructs.BotStrategyShort, consts.OrderSideSell),
+	)
+	assert.Equal(
+		t,
+		consts.MarginSideEffectAutoRepay,
+		GetMarginSideEffect(pkgStructs.BotStrategyShort, consts.OrderSideBuy),
+	)
+}
+
+func TestGetMarginSideEffectLong(t *testing.T) {
+	assert.Equal(
+		t,
+		consts.MarginSideEffectAutoBorrow,
+		GetMarginSideEffect(pkgStructs.BotStrategyLong, consts.OrderSideBuy),
+	)
+	assert.Equal(
+		t,
+		consts.MarginSideEffectAutoRepay,
+		GetMarginSideEffect(pkgStructs.BotStrategyLong, consts.OrderSideSell),
+	)
+}
@@ -0,0 +1,368 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgErrors "github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs/v2"
+	"github.com/shopspring/decimal"
+)
+
+type CalcTPProcessor struct {
+	strategy        pkgStructs.BotStrategy
+	coinsQty        decimal.Decimal
+	profit          decimal.Decimal
+	depositSpent    decimal.Decimal
+	fees            structs.OrderFees
+	tradeFees       structs.TradeFees
+	isFeesEstimated bool
+	pairData        structs.ExchangePairData
+	clientOrderID   string
+
+	accBase  decimal.Decimal
+	accQuote decimal.Decimal
+}
+
+func NewCalcTPOrderProcessor() *CalcTPProcessor {
+	return &CalcTPProcessor{}
+}
+
+func (s *CalcTPProcessor) Remains(
+	accBase decimal.Decimal,
+	accQuote decimal.Decimal,
+) *CalcTPProcessor {
+	s.accBase = accBase
+	s.accQuote = accQuote
+	return s
+}
+
+func (s *CalcTPProcessor) Strategy(strategy pkgStructs.BotStrategy) *CalcTPProcessor {
+	s.strategy = strategy
+	return s
+}
+
+func (s *CalcTPProcessor) CoinsQty(coinsQty decimal.Decimal) *CalcTPProcessor {
+	s.coinsQty = coinsQty
+	return s
+}
+
+// Profit - TP profit percent, e.g. 0.5 is 0.5%
+func (s *CalcTPProcessor) Profit(profit decimal.Decimal) *CalcTPProcessor {
+	s.profit = profit
+	return s
+}
+
+func (s *CalcTPProcessor) DepositSpent(depositSpent decimal.Decimal) *CalcTPProcessor {
+	s.depositSpent = depositSpent
+	return s
+}
+
+func (s *CalcTPProcessor) PairData(pairData structs.ExchangePairData) *CalcTPProcessor {
+	s.pairData = pairData
+	return s
+}
+
+// Fees - the entry order fees. Zero fees are used as is,
+// e.g. for zero-fee pairs or fees paid in BNB
+func (s *CalcTPProcessor) Fees(fees structs.OrderFees) *CalcTPProcessor {
+	s.fees = fees
+	s.isFeesEstimated = false
+	return s
+}
+
+// EstimatedFees - estimate the entry order fees by the fee rates
+// instead of Fees, e.g. when the order is not filled yet
+func (s *CalcTPProcessor) EstimatedFees(fees structs.TradeFees) *CalcTPProcessor {
+	s.tradeFees = fees
+	s.isFeesEstimated = true
+	return s
+}
+
+func (s *CalcTPProcessor) ClientOrderID(id string) *CalcTPProcessor {
+	s.clientOrderID = id
+	return s
+}
+
+func (s *CalcTPProcessor) checkParams() error {
+	if s.strategy == "" {
+		return errors.New("strategy is not set")
+	}
+	if s.coinsQty.IsZero() {
+		return errors.New("invalid coins qty (0)")
+	}
+	if s.profit.IsZero() {
+		return errors.New("invalid profit value (0)")
+	}
+	if s.depositSpent.IsZero() {
+		return errors.New("invalid depositSpent value (0)")
+	}
+	if s.pairData.IsEmpty() {
+		return errors.New("pair data is not set")
+	}
+	if s.pairData.Symbol == "" {
+		return errors.New("pair symbol is not set in pair data")
+	}
+	if s.pairData.QtyStep.IsZero() {
+		return errors.New("invalid qty step value (0)")
+	}
+	if s.pairData.PriceStep.IsZero() {
+		return errors.New("invalid price step value (0)")
+	}
+	return nil
+}
+
+func (s *CalcTPProcessor) Do() (pkgStructs.BotOrder, error) {
+	if err := s.checkParams(); err != nil {
+		return pkgStructs.BotOrder{}, fmt.Errorf("check params: %w", err)
+	}
+
+	if s.strategy == pkgStructs.BotStrategyShort {
+		return s.calcShortTPOrder()
+	}
+	return s.calcLongOrder()
+}
+
+func (s *CalcTPProcessor) getFees() structs.OrderFees {
+	if s.isFeesEstimated {
+		return s.estimateFees()
+	}
+	return s.fees
+}
+
+// estimateFees - entry order fees by the taker fee rate as the worst case:
+// the long strategy pays fees in base asset for BUY order,
+// the short strategy pays fees in quote asset for SELL order
+func (s *CalcTPProcessor) estimateFees() structs.OrderFees {
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.Zero,
+		QuoteAsset: decimal.Zero,
+	}
+
+	if s.strategy == pkgStructs.BotStrategyShort {
+		fees.QuoteAsset = s.depositSpent.Mul(s.tradeFees.Taker)
+	} else {
+		fees.BaseAsset = s.coinsQty.Mul(s.tradeFees.Taker)
+	}
+	return fees
+}
+
+func (s *CalcTPProcessor) getMinQtyError(qty decimal.Decimal) error {
+	return fmt.Errorf(
+		"%w: not enough coins (%s %s with a minimum of %s %s)",
+		pkgErrors.ErrMinimumTP,
+		qty.String(),
+		s.pairData.BaseAsset,
+		s.pairData.MinQty.String(),
+		s.pairData.BaseAsset,
+	)
+}
+
+func (s *CalcTPProcessor) getMaxQtyError(qty decimal.Decimal) error {
+	return fmt.Errorf(
+		"too many coins (%s %s with a max of %s %s)",
+		qty.String(),
+		s.pairData.BaseAsset,
+		s.pairData.MaxQty.String(),
+		s.pairData.BaseAsset,
+	)
+}
+
+func (s *CalcTPProcessor) getMinAmountError(amount decimal.Decimal) error {
+	return fmt.Errorf(
+		"%w: not enough amount (%s %s with a minimum of %s %s)",
+		pkgErrors.ErrMinimumTP,
+		amount.String(),
+		s.pairData.QuoteAsset,
+		s.pairData.MinDeposit.String(),
+		s.pairData.QuoteAsset,
+	)
+}
+
+func (s *CalcTPProcessor) getMinPriceError(price decimal.Decimal) error {
+	return fmt.Errorf(
+		"too low price (%s with a minimum of %s)",
+		price.String(),
+		s.pairData.MinPrice.String(),
+	)
+}
+
+func (s *CalcTPProcessor) calcShortTPQty(coinsQtyDec, amountAvailable decimal.Decimal) (
+	decimal.Decimal,
+	error,
+) {
+	// increase qty by profit %
+	profitDelta := decimal.NewFromInt(1).Add(s.profit.Div(decimal.NewFromInt(100)))
+
+	// qty = coinsQty * (1 + profit/100)
+	tpQty := coinsQtyDec.Mul(profitDelta)
+
+	// check max qty
+	if s.pairData.MaxQty.IsPositive() &&
+		tpQty.GreaterThan(s.pairData.MaxQty) {
+		return decimal.Zero, s.getMaxQtyError(tpQty)
+	}
+
+	if s.accQuote.IsZero() {
+		// remains not set
+		return tpQty, nil
+	}
+
+	// Let's try to calculate how much remains amount we can
+	// convert to qty to add to the order
+	zeroProfitPrice := amountAvailable.Div(tpQty)
+	remainsQty := s.accQuote.Div(zeroProfitPrice)
+	qtyWithRemains := tpQty.Add(remainsQty)
+
+	// round qty with remains
+	return qtyWithRemains, nil
+}
+
+func (s *CalcTPProcessor) roundQtyDown(qty decimal.Decimal) decimal.Decimal {
+	qtyPrecision := GetDecimalPrecision(s.pairData.QtyStep)
+	return qty.RoundFloor(int32(qtyPrecision))
+}
+
+func (s *CalcTPProcessor) roundQtyUp(qty decimal.Decimal) decimal.Decimal {
+	qtyPrecision := GetDecimalPrecision(s.pairData.QtyStep)
+	return qty.Round(int32(qtyPrecision))
+}
+
+func (s *CalcTPProcessor) roundPrice(price decimal.Decimal) decimal.Decimal {
+	pricePrecision := GetDecimalPrecision(s.pairData.PriceStep)
+	return price.RoundFloor(int32(pricePrecision))
+}
+
+func (s *CalcTPProcessor) roundAmount(amount decimal.Decimal) decimal.Decimal {
+	return RoundAmount(
+		amount,
+		string(s.strategy),
+		s.pairData.BasePrecision,
+		s.pairData.QuotePrecision,
+	)
+}
+
+func (s *CalcTPProcessor) genClientOrderID() string {
+	if s.clientOrderID == "" {
+		return GenerateUUID()
+	}
+
+	return s.clientOrderID
+}
+
+func (s *CalcTPProcessor) calcShortTPOrder() (pkgStructs.BotOrder, error) {
+	fees := s.getFees()
+
+	// coins qty - fees
+	coinsQtyDec := s.coinsQty.
+		Sub(fees.BaseAsset)
+
+	amountAvailable := s.depositSpent.Sub(fees.QuoteAsset)
+	tpQtyRaw, err := s.calcShortTPQty(coinsQtyDec, amountAvailable)
+	if err != nil {
+		return pkgStructs.BotOrder{}, err
+	}
+
+	tpQty := s.roundQtyDown(tpQtyRaw)
+
+	// check min qty
+	if tpQty.LessThan(s.pairData.MinQty) {
+		return pkgStructs.BotOrder{}, s.getMinQtyError(tpQty)
+	}
+
+	// price = depositSpent / tpQty
+	tpPrice := amountAvailable.Add(s.accQuote).Div(tpQtyRaw)
+
+	// check price
+	if tpPrice.LessThan(s.pairData.MinPrice) {
+		return pkgStructs.BotOrder{}, s.getMinPriceError(tpPrice)
+	}
+
+	tpPrice = s.roundPrice(tpPrice)
+
+	// recalc amount
+	tpAmount := s.roundAmount(tpQty.Mul(tpPrice))
+
+	// check min amount
+	if tpAmount.LessThan(s.pairData.MinDeposit) {
+		return pkgStructs.BotOrder{}, s.getMinAmountError(tpAmount)
+	}
+
+	order := pkgStructs.BotOrder{
+		PairSymbol:    s.pairData.Symbol,
+		Type:          GetTPOrderType(pkgStructs.BotStrategyShort),
+		Qty:           tpQty,
+		Price:         tpPrice,
+		Deposit:       tpAmount,
+		ClientOrderID: s.genClientOrderID(),
+	}
+
+	// let's check that the TP order will not close in the minus
+	zeroProfitPrice := s.depositSpent.Div(coinsQtyDec)
+	if tpPrice.GreaterThan(zeroProfitPrice) {
+		return pkgStructs.BotOrder{},
+			fmt.Errorf(
+				"invalid TP calc: order: %s",
+				order.String(),
+			)
+	}
+
+	return order, nil
+}
+
+func (s *CalcTPProcessor) calcLongOrder() (pkgStructs.BotOrder, error) {
+	// subtract fees from coins qty in base asset (from default BUY orders)
+	// example: when pair is LTCUSDT, fees summed up for BUY orders in LTC
+	coinsQtyDec := s.coinsQty.
+		Sub(s.getFees().BaseAsset)
+
+	profitDelta := decimal.NewFromInt(1).Add(s.profit.Div(decimal.NewFromInt(100)))
+
+	// deposit = (1 + profit/100) * depositSpent
+	tpAmount := profitDelta.Mul(s.depositSpent)
+	tpPrice := tpAmount.Div(coinsQtyDec)
+	tpQty := coinsQtyDec.Add(s.accBase)
+	tpQty = s.roundQtyDown(tpQty)
+
+	// check min qty
+	if tpQty.LessThan(s.pairData.MinQty) {
+		return pkgStructs.BotOrder{}, s.getMinQtyError(tpQty)
+	}
+
+	tpPrice = s.roundPrice(tpPrice)
+
+	// recalc amount
+	tpAmount = s.roundAmount(tpQty.Mul(tpPrice))
+
+	// check amount
+	if tpAmount.LessThan(s.pairData.MinDeposit) {
+		return pkgStructs.BotOrder{}, s.getMinAmountError(tpAmount)
+	}
+
+	// check price
+	if tpPrice.LessThan(s.pairData.MinPrice) {
+		return pkgStructs.BotOrder{}, s.getMinPriceError(tpPrice)
+	}
+
+	order := pkgStructs.BotOrder{
+		PairSymbol:    s.pairData.Symbol,
+		Type:          GetTPOrderType(pkgStructs.BotStrategyLong),
+		Qty:           tpQty,
+		Price:         tpPrice,
+		Deposit:       tpAmount,
+		ClientOrderID: s.genClientOrderID(),
+	}
+
+	// let's check that the TP order will not close in the minus
+	zeroProfitPrice := s.depositSpent.Div(coinsQtyDec)
+	if tpPrice.LessThan(zeroProfitPrice) {
+		return pkgStructs.BotOrder{},
+			fmt.Errorf(
+				"invalid TP calc: order: %s",
+				order.String(),
+			)
+	}
+
+	return order, nil
+}
@@ -0,0 +1,773 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs/v2"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testTPCoinsQty      = decimal.RequireFromString("0.00126")
+	testTPProfitPercent = decimal.RequireFromString("0.3")
+	testTPDepositSpent  = decimal.NewFromFloat(32.64787)
+)
+
+func TestCalcTPOrderErrorEmptyStrategy(t *testing.T) {
+	// given
+	proc := NewCalcTPOrderProcessor().CoinsQty(decimal.Zero)
+
+	// when
+	_, err := proc.Do()
+
+	// then
+	require.Contains(t, err.Error(), "strategy is not set")
+}
+
+func TestCalcTPOrderShortNoFees(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "BTCBUSD",
+		QtyStep:   decimal.NewFromFloat(0.00001),
+		PriceStep: decimal.NewFromFloat(0.01),
+	}
+	zeroProfitPrice := testTPDepositSpent.Div(testTPCoinsQty)
+
+	proc := NewCalcTPOrderProcessor().CoinsQty(testTPCoinsQty).
+		Profit(testTPProfitPercent).
+		DepositSpent(testTPDepositSpent).
+		Strategy(pkgStructs.BotStrategyShort).
+		PairData(pairData)
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "25833.5", order.Price.String())
+	assert.Equal(t, "0.00126", order.Qty.String())
+	assert.LessOrEqual(t, order.Price.InexactFloat64(), zeroProfitPrice.InexactFloat64())
+	assert.Equal(t, consts.OrderSideBuy, order.Type)
+	assert.NotEmpty(t, order.ClientOrderID)
+}
+
+func TestCalcTPOrderShortNoFeesBigQty(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:     "TWTBUSD",
+		MinQty:     decimal.NewFromInt(1),
+		MinDeposit: decimal.NewFromInt(10),
+		QtyStep:    decimal.NewFromInt(1),
+		MinPrice:   decimal.NewFromFloat(0.0001),
+		PriceStep:  decimal.NewFromFloat(0.0001),
+	}
+
+	srv := NewCalcTPOrderProcessor().
+		CoinsQty(decimal.RequireFromString("348")).
+		Profit(decimal.RequireFromString("0.1")).
+		DepositSpent(decimal.NewFromFloat(262.33212)).
+		Strategy(pkgStructs.BotStrategyShort).
+		PairData(pairData)
+
+	// when
+	order, err := srv.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.753", order.Price.String())
+	assert.Equal(t, "348", order.Qty.String())
+	assert.Equal(t, consts.OrderSideBuy, order.Type)
+	assert.NotEmpty(t, order.ClientOrderID)
+}
+
+func TestCalcTPOrderShortWithFees(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "BTCBUSD",
+		QtyStep:   decimal.NewFromFloat(0.00001),
+		PriceStep: decimal.NewFromFloat(0.01),
+	}
+	zeroProfitPrice := testTPDepositSpent.Div(testTPCoinsQty)
+	zeroProfitPriceFloat, _ := zeroProfitPrice.Float64()
+
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.NewFromInt(0),
+		QuoteAsset: decimal.NewFromFloat(0.03264),
+	}
+
+	proc := NewCalcTPOrderProcessor().CoinsQty(testTPCoinsQty).
+		Profit(testTPProfitPercent).
+		DepositSpent(testTPDepositSpent).
+		Strategy(pkgStructs.BotStrategyShort).
+		PairData(pairData).
+		Fees(fees)
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "25807.68", order.Price.String())
+	assert.Equal(t, "0.00126", order.Qty.String())
+	assert.LessOrEqual(t, order.Price.InexactFloat64(), zeroProfitPriceFloat)
+	assert.Equal(t, consts.OrderSideBuy, order.Type)
+	assert.NotEmpty(t, order.ClientOrderID)
+}
+
+func TestCalcTPOrderLongNoFees(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "BTCBUSD",
+		QtyStep:   decimal.NewFromFloat(0.00001),
+		PriceStep: decimal.NewFromFloat(0.01),
+	}
+	zeroProfitPrice := testTPDepositSpent.Div(testTPCoinsQty)
+	zeroProfitPriceFloat, _ := zeroProfitPrice.Float64()
+
+	proc := NewCalcTPOrderProcessor().
+		CoinsQty(testTPCoinsQty).
+		Profit(testTPProfitPercent).
+		DepositSpent(testTPDepositSpent).
+		Strategy(pkgStructs.BotStrategyLong).
+		PairData(pairData)
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "25988.74", order.Price.String())
+	assert.Equal(t, "0.00126", order.Qty.String())
+	assert.GreaterOrEqual(t, order.Price.InexactFloat64(), zeroProfitPriceFloat)
+	assert.Equal(t, consts.OrderSideSell, order.Type)
+	assert.NotEmpty(t, order.ClientOrderID)
+}
+
+func TestCalcTPOrderLongFees(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "BTCBUSD",
+		QtyStep:   decimal.NewFromFloat(0.00001),
+		PriceStep: decimal.NewFromFloat(0.01),
+	}
+	zeroProfitPrice := testTPDepositSpent.Div(testTPCoinsQty)
+	zeroProfitPriceFloat, _ := zeroProfitPrice.Float64()
+
+	fees := structs.OrderFees{
+		BaseAsset:  testTPCoinsQty.Mul(decimal.NewFromFloat(0.001)),
+		QuoteAsset: decimal.NewFromFloat(0),
+	}
+
+	proc := NewCalcTPOrderProcessor().
+		CoinsQty(testTPCoinsQty).
+		Profit(testTPProfitPercent).
+		DepositSpent(testTPDepositSpent).
+		Strategy(pkgStructs.BotStrategyLong).
+		PairData(pairData).
+		Fees(fees)
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "26014.75", order.Price.String())
+	assert.Equal(t, "0.00125", order.Qty.String())
+	assert.GreaterOrEqual(t, order.Price.InexactFloat64(), zeroProfitPriceFloat)
+	assert.Equal(t, consts.OrderSideSell, order.Type)
+	assert.NotEmpty(t, order.ClientOrderID)
+}
+
+func TestCalcTPOrderLongRemains(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "QNTUSDT",
+		QtyStep:   decimal.NewFromFloat(0.001),
+		PriceStep: decimal.NewFromFloat(0.1),
+	}
+	coinsQty := decimal.RequireFromString("0.088")
+	depoSpent := decimal.NewFromFloat(6.1205)
+
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.NewFromFloat(0.000088),
+		QuoteAsset: decimal.Zero,
+	}
+	accBase := decimal.NewFromFloat(0.001)
+	accQuote := decimal.Zero
+
+	proc := NewCalcTPOrderProcessor().
+		CoinsQty(coinsQty).
+		Profit(decimal.RequireFromString("0.53")).
+		DepositSpent(depoSpent).
+		Strategy(pkgStructs.BotStrategyLong).
+		PairData(pairData).
+		Remains(accBase, accQuote).
+		Fees(fees)
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "69.9", order.Price.String())
+	assert.Equal(t, "0.088", order.Qty.String())
+	assert.Equal(t, consts.OrderSideSell, order.Type)
+	assert.NotEmpty(t, order.ClientOrderID)
+}
+
+func TestCalcTPOrderLongRemainsBigQtyStep(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "FIREUSDT",
+		QtyStep:   decimal.NewFromInt(1),
+		PriceStep: decimal.NewFromFloat(0.0001),
+	}
+	coinsQty := decimal.RequireFromString("2")
+	depoSpent := decimal.NewFromFloat(2.6694)
+
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.NewFromFloat(0.000088),
+		QuoteAsset: decimal.Zero,
+	}
+
+	zeroProfitPrice := depoSpent.Div(coinsQty).InexactFloat64()
+
+	accBase := decimal.NewFromFloat(0.9966683)
+	accQuote := decimal.Zero
+
+	proc := NewCalcTPOrderProcessor().
+		CoinsQty(coinsQty).
+		Profit(decimal.RequireFromString("0.7")).
+		DepositSpent(depoSpent).
+		Strategy(pkgStructs.BotStrategyLong).
+		PairData(pairData).
+		Remains(accBase, accQuote).
+		Fees(fees)
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "1.3441", order.Price.String())
+	assert.Equal(t, "2", order.Qty.String())
+	assert.Equal(t, consts.OrderSideSell, order.Type)
+	assert.NotEmpty(t, order.ClientOrderID)
+	assert.Greater(t, order.Price.InexactFloat64(), zeroProfitPrice)
+}
+
+func TestCalcTPOrderShortRemains(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "BTCUSDT",
+		QtyStep:   decimal.NewFromFloat(0.000001),
+		PriceStep: decimal.NewFromFloat(0.01),
+	}
+	coinsQty := decimal.RequireFromString("0.000484")
+	depoSpent := decimal.NewFromFloat(28.80802933)
+	profit := decimal.RequireFromString("1")
+
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.NewFromInt(0),
+		QuoteAsset: decimal.NewFromFloat(0.02880802933),
+	}
+
+	accBase := decimal.NewFromFloat(0)
+	accQuote := decimal.NewFromFloat(0.05853762067)
+
+	zeroProfitPrice := depoSpent.Add(accQuote).
+		Sub(fees.QuoteAsset).Div(coinsQty).
+		InexactFloat64()
+
+	// when
+	order, err := NewCalcTPOrderProcessor().CoinsQty(coinsQty).
+		Profit(profit).
+		DepositSpent(depoSpent).
+		Strategy(pkgStructs.BotStrategyShort).
+		PairData(pairData).
+		Remains(accBase, accQuote).
+		Fees(fees).
+		Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "58872.47", order.Price.String())
+	assert.Equal(t, "0.000489", order.Qty.String())
+	assert.Equal(t, consts.OrderSideBuy, order.Type)
+	assert.LessOrEqual(t, order.Price.InexactFloat64(), zeroProfitPrice)
+	assert.LessOrEqual(
+		t, order.Deposit.InexactFloat64(),
+		depoSpent.Add(accQuote).Sub(fees.QuoteAsset).InexactFloat64(),
+	)
+	assert.NotEmpty(t, order.ClientOrderID)
+}
+
+func TestCalcTPOrderShortRemainsBigQtyStep(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "FIREUSDT",
+		QtyStep:   decimal.NewFromInt(1),
+		PriceStep: decimal.NewFromFloat(0.0001),
+	}
+	coinsQty := decimal.RequireFromString("2")
+	depoSpent := decimal.NewFromFloat(2.6694)
+	profit := decimal.RequireFromString("1")
+
+	zeroProfitPrice := depoSpent.Div(coinsQty).InexactFloat64()
+
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.NewFromInt(0),
+		QuoteAsset: decimal.NewFromFloat(0.02880802933),
+	}
+
+	accBase := decimal.NewFromFloat(0)
+	accQuote := decimal.NewFromFloat(0.00585)
+
+	// when
+	order, err := NewCalcTPOrderProcessor().CoinsQty(coinsQty).
+		Profit(profit).
+		DepositSpent(depoSpent).
+		Strategy(pkgStructs.BotStrategyShort).
+		PairData(pairData).
+		Remains(accBase, accQuote).
+		Fees(fees).
+		Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "1.3072", order.Price.String())
+	assert.Equal(t, "2", order.Qty.String())
+	assert.Equal(t, consts.OrderSideBuy, order.Type)
+	assert.NotEmpty(t, order.ClientOrderID)
+	assert.Less(t, order.Price.InexactFloat64(), zeroProfitPrice)
+}
+
+func TestCalcTPOrderShortRemainsBigQtyStep2(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		ExchangeID:     2,
+		BaseAsset:      "SUI",
+		QuoteAsset:     "USDT",
+		Symbol:         "SUIUSDT",
+		BasePrecision:  4,
+		QuotePrecision: 4,
+		MinQty:         decimal.NewFromInt(1),
+		MaxQty:         decimal.NewFromInt(9999999),
+		QtyStep:        decimal.NewFromInt(1),
+		MinPrice:       decimal.NewFromFloat(0.000001),
+		PriceStep:      decimal.NewFromFloat(0.0001),
+		MinDeposit:     decimal.NewFromInt(1),
+	}
+	coinsQty := decimal.RequireFromString("1")
+	depoSpent := decimal.NewFromFloat(1.9068)
+	profit := decimal.RequireFromString("0.15")
+
+	zeroProfitPrice := depoSpent.Div(coinsQty).InexactFloat64()
+
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.NewFromInt(0),
+		QuoteAsset: decimal.NewFromFloat(0.0019068),
+	}
+
+	accBase := decimal.NewFromFloat(0)
+	accQuote := decimal.NewFromFloat(0.0057874)
+
+	// when
+	order, err := NewCalcTPOrderProcessor().
+		CoinsQty(coinsQty).
+		Profit(profit).
+		DepositSpent(depoSpent).
+		Strategy(pkgStructs.BotStrategyShort).
+		PairData(pairData).
+		Remains(accBase, accQuote).
+		Fees(fees).
+		Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "1.902", order.Price.String())
+	assert.Equal(t, "1", order.Qty.String())
+	assert.Equal(t, consts.OrderSideBuy, order.Type)
+	assert.NotEmpty(t, order.ClientOrderID)
+	assert.Less(t, order.Price.InexactFloat64(), zeroProfitPrice)
+}
+
+func TestCalcTPOrderLongRemainsBig(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "FIREUSDT",
+		QtyStep:   decimal.NewFromInt(1),
+		PriceStep: decimal.NewFromFloat(0.0001),
+	}
+	coinsQty := decimal.RequireFromString("2.9")
+	depoSpent := decimal.NewFromFloat(4.986434)
+	profit := decimal.RequireFromString("1")
+
+	zeroProfitPrice := depoSpent.Div(coinsQty).InexactFloat64()
+
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.NewFromFloat(0.00522),
+		QuoteAsset: decimal.NewFromFloat(0),
+	}
+
+	accBase := decimal.NewFromFloat(0.558992)
+	accQuote := decimal.NewFromFloat(0)
+
+	// when
+	order, err := NewCalcTPOrderProcessor().CoinsQty(coinsQty).
+		Profit(profit).
+		DepositSpent(depoSpent).
+		Strategy(pkgStructs.BotStrategyLong).
+		PairData(pairData).
+		Remains(accBase, accQuote).
+		Fees(fees).
+		Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "1.7397", order.Price.String())
+	assert.Equal(t, "3", order.Qty.String())
+	assert.Equal(t, consts.OrderSideSell, order.Type)
+	assert.NotEmpty(t, order.ClientOrderID)
+	assert.Greater(t, order.Price.InexactFloat64(), zeroProfitPrice)
+}
+
+func TestCalcTPOrderLongFeesBigQty(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "XRPUSDT",
+		QtyStep:   decimal.NewFromFloat(0.01),
+		PriceStep: decimal.NewFromFloat(0.001),
+	}
+
+	averagePrice := decimal.RequireFromString("2.5")
+	coinsQty := decimal.RequireFromString("125.16")
+	depoSpent := coinsQty.Mul(averagePrice)
+	zeroProfitPrice := depoSpent.Div(coinsQty).InexactFloat64()
+
+	fees := structs.OrderFees{
+		BaseAsset:  coinsQty.Mul(decimal.NewFromFloat(0.001)),
+		QuoteAsset: decimal.NewFromFloat(0),
+	}
+
+	proc := NewCalcTPOrderProcessor().
+		CoinsQty(coinsQty).
+		Profit(decimal.RequireFromString("0.5")).
+		DepositSpent(depoSpent).
+		Strategy(pkgStructs.BotStrategyLong).
+		PairData(pairData).
+		Fees(fees)
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "2.515", order.Price.String())
+	assert.Equal(t, "125.03", order.Qty.String())
+	assert.GreaterOrEqual(t, order.Price.InexactFloat64(), zeroProfitPrice)
+	assert.Equal(t, consts.OrderSideSell, order.Type)
+	assert.NotEmpty(t, order.ClientOrderID)
+}
+
+func TestCalcTPOrderShortFeesInBaseAsset(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "BNBUSDT",
+		QtyStep:   decimal.NewFromFloat(0.001),
+		PriceStep: decimal.NewFromFloat(0.1),
+	}
+
+	strategy := pkgStructs.BotStrategyShort
+	profit := decimal.RequireFromString("1")
+	gridOrderPrice := decimal.NewFromFloat(596)
+	gridOrderCoinsQty := decimal.NewFromFloat(0.009)
+	depoSpent := gridOrderCoinsQty.Mul(gridOrderPrice)
+
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.NewFromFloat(0.00000675),
+		QuoteAsset: decimal.NewFromFloat(0),
+	}
+
+	accBase := decimal.Zero
+	accQuote := decimal.NewFromFloat(0.0013)
+
+	proc := NewCalcTPOrderProcessor().
+		CoinsQty(gridOrderCoinsQty).
+		Profit(profit).
+		DepositSpent(depoSpent).
+		Strategy(strategy).
+		PairData(pairData).
+		Fees(fees).
+		Remains(accBase, accQuote)
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "590.5", order.Price.String())
+	assert.Equal(t, "0.009", order.Qty.String())
+	assert.Less(t, order.Price.InexactFloat64(), gridOrderPrice.InexactFloat64())
+	assert.Equal(t, consts.OrderSideBuy, order.Type)
+	assert.NotEmpty(t, order.ClientOrderID)
+}
+
+func TestCalcTPShort(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:     "HFTUSDT",
+		QtyStep:    decimal.NewFromFloat(0.1),
+		PriceStep:  decimal.NewFromFloat(0.0001),
+		MinQty:     decimal.NewFromFloat(2.5),
+		MinDeposit: decimal.NewFromInt(1),
+	}
+
+	strategy := pkgStructs.BotStrategyShort
+	profit := decimal.RequireFromString("0.65")
+	lapCoinsQty := decimal.NewFromFloat(290.5)
+	depoSpent := decimal.NewFromFloat(73.97813)
+
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.NewFromFloat(0),
+		QuoteAsset: decimal.NewFromFloat(0),
+	}
+
+	accBase := decimal.Zero
+	accQuote := decimal.NewFromFloat(0.39016245)
+
+	zeroProfitPrice := depoSpent.Add(accQuote).
+		Sub(fees.QuoteAsset).Div(lapCoinsQty).InexactFloat64()
+
+	proc := NewCalcTPOrderProcessor().
+		CoinsQty(lapCoinsQty).
+		Profit(profit).
+		DepositSpent(depoSpent).
+		Strategy(strategy).
+		PairData(pairData).
+		Fees(fees).
+		Remains(accBase, accQuote)
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.253", order.Price.String())
+	assert.Equal(t, "293.9", order.Qty.String())
+	assert.Equal(t, consts.OrderSideBuy, order.Type)
+	assert.LessOrEqual(t, order.Price.InexactFloat64(), zeroProfitPrice)
+	assert.LessOrEqual(
+		t, order.Deposit.InexactFloat64(),
+		depoSpent.Add(accQuote).Sub(fees.QuoteAsset).InexactFloat64(),
+	)
+	assert.NotEmpty(t, order.ClientOrderID)
+}
+
+func TestCalcTPShort2(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:     "INJUSDT",
+		QtyStep:    decimal.NewFromFloat(0.01),
+		PriceStep:  decimal.NewFromFloat(0.001),
+		MinQty:     decimal.NewFromFloat(0.03),
+		MinDeposit: decimal.NewFromInt(1),
+	}
+
+	strategy := pkgStructs.BotStrategyShort
+	profit := decimal.RequireFromString("0.89")
+	lapCoinsQty := decimal.NewFromFloat(0.15)
+	depoSpent := decimal.NewFromFloat(3.7865)
+
+	fees := structs.OrderFees{
+		BaseAsset:  decimal.NewFromFloat(0),
+		QuoteAsset: decimal.NewFromFloat(0.0037865),
+	}
+
+	accBase := decimal.Zero
+	accQuote := decimal.NewFromFloat(0.59157425)
+
+	proc := NewCalcTPOrderProcessor().
+		CoinsQty(lapCoinsQty).
+		Profit(profit).
+		DepositSpent(depoSpent).
+		Strategy(strategy).
+		PairData(pairData).
+		Fees(fees).
+		Remains(accBase, accQuote)
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "24.995", order.Price.String())
+	assert.Equal(t, "0.17", order.Qty.String())
+	assert.Equal(t, consts.OrderSideBuy, order.Type)
+	assert.LessOrEqual(
+		t, order.Deposit.InexactFloat64(),
+		depoSpent.Add(accQuote).Sub(fees.QuoteAsset).InexactFloat64(),
+	)
+	assert.NotEmpty(t, order.ClientOrderID)
+}
+
+func TestRoundQtyDown(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "BNBUSDT",
+		QtyStep:   decimal.NewFromFloat(0.001),
+		PriceStep: decimal.NewFromFloat(0.1),
+	}
+
+	val := decimal.NewFromFloat(0.00899325)
+
+	proc := NewCalcTPOrderProcessor().
+		PairData(pairData)
+
+		// when
+	result := proc.roundQtyDown(val)
+
+	// then
+	assert.Equal(t, 0.008, result.InexactFloat64())
+}
+
+func TestRoundQtyUp(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "BNBUSDT",
+		QtyStep:   decimal.NewFromFloat(0.001),
+		PriceStep: decimal.NewFromFloat(0.1),
+	}
+
+	val := decimal.NewFromFloat(0.00899325)
+
+	proc := NewCalcTPOrderProcessor().
+		PairData(pairData)
+
+		// when
+	result := proc.roundQtyUp(val)
+
+	// then
+	assert.Equal(t, 0.009, result.InexactFloat64())
+}
+
+func TestCalcTPOrderLongEstimatedFees(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "BTCBUSD",
+		QtyStep:   decimal.NewFromFloat(0.00001),
+		PriceStep: decimal.NewFromFloat(0.01),
+	}
+
+	proc := NewCalcTPOrderProcessor().
+		CoinsQty(testTPCoinsQty).
+		Profit(testTPProfitPercent).
+		DepositSpent(testTPDepositSpent).
+		Strategy(pkgStructs.BotStrategyLong).
+		PairData(pairData).
+		EstimatedFees(structs.TradeFees{
+			Symbol: pairData.Symbol,
+			Maker:  decimal.NewFromFloat(0.0008),
+			Taker:  decimal.NewFromFloat(0.001),
+		})
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "26014.75", order.Price.String())
+	assert.Equal(t, "0.00125", order.Qty.String())
+	assert.Equal(t, consts.OrderSideSell, order.Type)
+}
+
+func TestCalcTPOrderShortEstimatedFees(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "BTCBUSD",
+		QtyStep:   decimal.NewFromFloat(0.00001),
+		PriceStep: decimal.NewFromFloat(0.01),
+	}
+	zeroProfitPrice := testTPDepositSpent.Div(testTPCoinsQty)
+
+	proc := NewCalcTPOrderProcessor().
+		CoinsQty(testTPCoinsQty).
+		Profit(testTPProfitPercent).
+		DepositSpent(testTPDepositSpent).
+		Strategy(pkgStructs.BotStrategyShort).
+		PairData(pairData).
+		EstimatedFees(structs.TradeFees{
+			Symbol: pairData.Symbol,
+			Maker:  decimal.NewFromFloat(0.0008),
+			Taker:  decimal.NewFromFloat(0.001),
+		})
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "25807.67", order.Price.String())
+	assert.Equal(t, "0.00126", order.Qty.String())
+	assert.LessOrEqual(t, order.Price.InexactFloat64(), zeroProfitPrice.InexactFloat64())
+	assert.Equal(t, consts.OrderSideBuy, order.Type)
+}
+
+func TestCalcTPOrderFeesOverrideEstimatedFees(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "BTCBUSD",
+		QtyStep:   decimal.NewFromFloat(0.00001),
+		PriceStep: decimal.NewFromFloat(0.01),
+	}
+
+	proc := NewCalcTPOrderProcessor().CoinsQty(testTPCoinsQty).
+		Profit(testTPProfitPercent).
+		DepositSpent(testTPDepositSpent).
+		Strategy(pkgStructs.BotStrategyShort).
+		PairData(pairData).
+		EstimatedFees(structs.TradeFees{
+			Symbol: pairData.Symbol,
+			Taker:  decimal.NewFromFloat(0.01),
+		}).
+		Fees(structs.OrderFees{
+			BaseAsset:  decimal.Zero,
+			QuoteAsset: decimal.NewFromFloat(0.03264),
+		})
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "25807.68", order.Price.String())
+}
+
+func TestCalcTPOrderZeroFeesNotEstimated(t *testing.T) {
+	// given
+	pairData := structs.ExchangePairData{
+		Symbol:    "BTCBUSD",
+		QtyStep:   decimal.NewFromFloat(0.00001),
+		PriceStep: decimal.NewFromFloat(0.01),
+	}
+
+	proc := NewCalcTPOrderProcessor().
+		CoinsQty(testTPCoinsQty).
+		Profit(testTPProfitPercent).
+		DepositSpent(testTPDepositSpent).
+		Strategy(pkgStructs.BotStrategyLong).
+		PairData(pairData).
+		Fees(structs.OrderFees{BaseAsset: decimal.Zero, QuoteAsset: decimal.Zero})
+
+	// when
+	order, err := proc.Do()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "0.00126", order.Qty.String())
+	assert.True(t, proc.fees.BaseAsset.IsZero())
+}
@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+// TopUpTask - spot account top up params
+type TopUpTask struct {
+	Asset       string
+	Required    decimal.Decimal // required spot free balance
+	MaxTransfer decimal.Decimal // max amount to transfer, configured by user
+	FromAccount consts.AccountType
+}
+
+// TopUpSpotBalance transfers the missing amount of the asset to the spot account.
+// Returns false when the spot balance is already sufficient
+func TopUpSpotBalance(
+	adapter adapters.Adapter,
+	task TopUpTask,
+) (structs.TransferResult, bool, error) {
+	spotFree, err := getAccountAssetFree(adapter, consts.AccountTypeSpot, task.Asset)
+	if err != nil {
+		return structs.TransferResult{}, false, fmt.Errorf("get spot balance: %w", err)
+	}
+
+	deficit := task.Required.Sub(spotFree)
+	if !deficit.IsPositive() {
+		return structs.TransferResult{}, false, nil
+	}
+	if deficit.GreaterThan(task.MaxTransfer) {
+		return structs.TransferResult{}, false, fmt.Errorf(
+			"%w: need %s %s, max %s",
+			errs.ErrTransferLimitExceeded, deficit.String(), task.Asset, task.MaxTransfer.String(),
+		)
+	}
+
+	sourceFree, err := getAccountAssetFree(adapter, task.FromAccount, task.Asset)
+	if err != nil {
+		return structs.TransferResult{}, false,
+			fmt.Errorf("get %s balance: %w", task.FromAccount, err)
+	}
+	if sourceFree.LessThan(deficit) {
+		return structs.TransferResult{}, false, fmt.Errorf(
+			"%w: need %s %s, %s account has %s",
+			errs.ErrInsufficientBalance, deficit.String(), task.Asset,
+			task.FromAccount, sourceFree.String(),
+		)
+	}
+
+	result, err := adapter.Transfer(
+		task.Asset, deficit, task.FromAccount, consts.AccountTypeSpot,
+	)
+	if err != nil {
+		return structs.TransferResult{}, false, fmt.Errorf("transfer: %w", err)
+	}
+	return result, true, nil
+}
+
+func getAccountAssetFree(
+	adapter adapters.Adapter,
+	accountType consts.AccountType,
+	asset string,
+) (decimal.Decimal, error) {
+	balances, err := adapter.GetAccountBalances(accountType)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	for _, balance := range balances {
+		if balance.Asset == asset {
+			return balance.Free, nil
+		}
+	}
+	return decimal.Zero, nil
+}
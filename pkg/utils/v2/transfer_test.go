@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/matrixbotio/exchange-gates-lib/internal/adapters"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	"github.com/matrixbotio/exchange-gates-lib/pkg/errs"
+)
+
+const testTopUpAsset = "USDT"
+
+func getTestTopUpTask() TopUpTask {
+	return TopUpTask{
+		Asset:       testTopUpAsset,
+		Required:    decimal.NewFromInt(100),
+		MaxTransfer: decimal.NewFromInt(50),
+		FromAccount: consts.AccountTypeFunding,
+	}
+}
+
+func getTestTopUpBalances(free int64) []structs.Balance {
+	return []structs.Balance{
+		{Asset: "BTC", Free: decimal.NewFromInt(1)},
+		{Asset: testTopUpAsset, Free: decimal.NewFromInt(free)},
+	}
+}
+
+func TestTopUpSpotBalance(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetAccountBalances(consts.AccountTypeSpot).
+		Return(getTestTopUpBalances(70), nil)
+	a.EXPECT().GetAccountBalances(consts.AccountTypeFunding).
+		Return(getTestTopUpBalances(40), nil)
+
+	expectedResult := structs.TransferResult{
+		ID:          "12345",
+		Asset:       testTopUpAsset,
+		Amount:      decimal.NewFromInt(30),
+		FromAccount: consts.AccountTypeFunding,
+		ToAccount:   consts.AccountTypeSpot,
+	}
+	a.EXPECT().Transfer(
+		testTopUpAsset,
+		decimal.NewFromInt(30),
+		consts.AccountTypeFunding,
+		consts.AccountTypeSpot,
+	).Return(expectedResult, nil)
+
+	// when
+	result, isTransferred, err := TopUpSpotBalance(a, getTestTopUpTask())
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, isTransferred)
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestTopUpSpotBalanceSufficient(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetAccountBalances(consts.AccountTypeSpot).
+		Return(getTestTopUpBalances(100), nil)
+
+	// when
+	_, isTransferred, err := TopUpSpotBalance(a, getTestTopUpTask())
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, isTransferred)
+}
+
+func TestTopUpSpotBalanceLimitExceeded(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetAccountBalances(consts.AccountTypeSpot).
+		Return(getTestTopUpBalances(10), nil)
+
+	// when
+	_, isTransferred, err := TopUpSpotBalance(a, getTestTopUpTask())
+
+	// then
+	require.ErrorIs(t, err, errs.ErrTransferLimitExceeded)
+	assert.False(t, isTransferred)
+}
+
+func TestTopUpSpotBalanceInsufficientSource(t *testing.T) {
+	// given
+	a := adapters.NewMockAdapter(gomock.NewController(t))
+	a.EXPECT().GetAccountBalances(consts.AccountTypeSpot).
+		Return(nil, nil)
+	a.EXPECT().GetAccountBalances(consts.AccountTypeFunding).
+		Return(getTestTopUpBalances(40), nil)
+
+	task := getTestTopUpTask()
+	task.Required = decimal.NewFromInt(45)
+
+	// when
+	_, isTransferred, err := TopUpSpotBalance(a, task)
+
+	// then
+	require.ErrorIs(t, err, errs.ErrInsufficientBalance)
+	assert.False(t, isTransferred)
+}
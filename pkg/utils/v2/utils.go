@@ -0,0 +1,167 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matrixbotio/exchange-gates-lib/internal/consts"
+	"github.com/matrixbotio/exchange-gates-lib/internal/structs"
+	pkgStructs "github.com/matrixbotio/exchange-gates-lib/pkg/structs/v2"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	defaultCheckOrdersTimeout = time.Second * 30
+)
+
+func GetCheckOrdersTimeout(exchangeID int) time.Duration {
+	switch exchangeID {
+	default:
+		return defaultCheckOrdersTimeout
+	case consts.ExchangeIDbinanceSpot, consts.ExchangeIDbinanceUSDM:
+		return consts.CheckOrdersTimeoutBinance
+	case consts.ExchangeIDbybitSpot, consts.ExchangeIDbybitLinear:
+		return consts.CheckOrdersTimeoutBybit
+	case consts.ExchangeIDbingx:
+		return consts.CheckOrdersTimeoutBings
+	case consts.ExchangeIDgateSpot:
+		return consts.CheckOrdersTimeoutGate
+	case consts.ExchangeIDokx:
+		return consts.CheckOrdersTimeoutOKX
+	case consts.ExchangeIDkucoin:
+		return consts.CheckOrdersTimeoutKuCoin
+	case consts.ExchangeIDbitget:
+		return consts.CheckOrdersTimeoutBitget
+	}
+}
+
+func GenerateUUID() string {
+	return uuid.New().String()
+}
+
+// GetFloatPrecision returns the number of decimal places in a float
+func GetFloatPrecision(value float64) int {
+	precision := 0
+	for {
+		rounded := math.Round(value*math.Pow(10, float64(precision))) / math.Pow(10, float64(precision))
+		if value == rounded {
+			break
+		}
+		precision++
+	}
+	return precision
+}
+
+// GetDecimalPrecision returns the number of decimal places in a decimal value
+func GetDecimalPrecision(value decimal.Decimal) int {
+	precision := 0
+	for !value.Shift(int32(precision)).IsInteger() {
+		precision++
+	}
+	return precision
+}
+
+func roundFloatToDecimal(val float64, precision int) decimal.Decimal {
+	return decimal.NewFromFloat(val).RoundFloor(int32(precision))
+}
+
+func RoundFloatFloor(val float64, precision int) (float64, error) {
+	if math.IsNaN(val) {
+		return 0, errors.New("value is NaN")
+	}
+	if math.IsInf(val, 0) {
+		return 0, errors.New("value is Inf")
+	}
+
+	return roundFloatToDecimal(val, precision).InexactFloat64(), nil
+}
+
+// ParseAdjustedOrder - parse rounded order to bot order
+func ParseAdjustedOrder(order structs.BotOrderAdjusted) (pkgStructs.BotOrder, error) {
+	resultOrder := pkgStructs.BotOrder{
+		PairSymbol:    order.PairSymbol,
+		Type:          order.Type,
+		ClientOrderID: order.ClientOrderID,
+	}
+	// parse qty
+	var err error
+	resultOrder.Qty, err = decimal.NewFromString(order.Qty)
+	if err != nil {
+		return resultOrder, fmt.Errorf("parse order qty: %w", err)
+	}
+	// parse price
+	resultOrder.Price, err = decimal.NewFromString(order.Price)
+	if err != nil {
+		return resultOrder, fmt.Errorf("parse order price: %w", err)
+	}
+	// parse deposit
+	resultOrder.Deposit, err = decimal.NewFromString(order.Deposit)
+	if err != nil {
+		return resultOrder, fmt.Errorf("parse order deposit: %w", err)
+	}
+	return resultOrder, nil
+}
+
+// GetDefaultPairData !
+func GetDefaultPairData() structs.ExchangePairData {
+	return structs.ExchangePairData{
+		ExchangeID:    consts.PairDefaultExchangeID,
+		BaseAsset:     consts.PairDefaultBaseAsset,
+		QuoteAsset:    consts.PairDefaultQuoteAsset,
+		MinQty:        decimal.NewFromFloat(consts.PairDefaultMinQty),
+		MaxQty:        decimal.NewFromFloat(consts.PairDefaultMaxQty),
+		MinDeposit:    decimal.NewFromFloat(consts.PairMinDeposit),
+		MinPrice:      decimal.NewFromFloat(consts.PairDefaultMinPrice),
+		QtyStep:       decimal.NewFromFloat(consts.PairDefaultQtyStep),
+		PriceStep:     decimal.NewFromFloat(consts.PairDefaultPriceStep),
+		AllowedMargin: true,
+		AllowedSpot:   true,
+	}
+}
+
+func GetValueStep(minValue float64) float64 {
+	precision := GetFloatPrecision(minValue)
+	divisor := decimal.NewFromFloat(math.Pow(10, float64(precision)))
+	valueStep, _ := decimal.NewFromInt(1).Div(divisor).Float64()
+	return valueStep
+}
+
+// GetDecimalValueStep returns the minimal step for the precision of the value,
+// e.g. 0.00048 -> 0.00001
+func GetDecimalValueStep(minValue decimal.Decimal) decimal.Decimal {
+	return decimal.New(1, -int32(GetDecimalPrecision(minValue)))
+}
+
+func PrintObject(o any) {
+	data, err := json.MarshalIndent(o, "", "	")
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	fmt.Println(string(data))
+}
+
+func StringPointer(val string) *string {
+	return &val
+}
+
+func RoundAmount(
+	amount decimal.Decimal,
+	strategy string,
+	basePrecision int,
+	quotePrecision int,
+) decimal.Decimal {
+	var assetPrecision int
+	if strategy == string(pkgStructs.BotStrategyLong) {
+		assetPrecision = quotePrecision
+	} else {
+		assetPrecision = basePrecision
+	}
+
+	return amount.RoundFloor(int32(assetPrecision))
+}
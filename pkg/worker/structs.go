@@ -1,7 +1,7 @@
 package worker
 
-import "github.com/matrixbotio/exchange-gates-lib/internal/workers"
+import structs "github.com/matrixbotio/exchange-gates-lib/internal/structs/v1"
 
 type (
-	CandleEvent = workers.CandleEvent
+	CandleEvent = structs.CandleEvent
 )
@@ -0,0 +1,7 @@
+package worker
+
+import "github.com/matrixbotio/exchange-gates-lib/internal/workers"
+
+type (
+	CandleEvent = workers.CandleEvent
+)